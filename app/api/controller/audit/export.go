@@ -0,0 +1,109 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+)
+
+// exportPageSize is the number of audit events read from the store at once during export.
+const exportPageSize = 100
+
+// Export streams all audit events of the system matching the filter, it's reserved for admins.
+func (c *Controller) Export(
+	ctx context.Context,
+	session *auth.Session,
+	filter *types.AuditFilter,
+) (types.Stream[*types.AuditEvent], error) {
+	if err := checkAdmin(session); err != nil {
+		return nil, err
+	}
+
+	return newEventStream(ctx, c.auditStore, filter), nil
+}
+
+// ExportSpace streams all audit events of a space and its sub-spaces matching the filter,
+// it's reserved for space owners.
+func (c *Controller) ExportSpace(
+	ctx context.Context,
+	session *auth.Session,
+	spaceRef string,
+	filter *types.AuditFilter,
+) (types.Stream[*types.AuditEvent], error) {
+	spaceID, err := c.getSpaceCheckAccess(ctx, session, spaceRef)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.SpaceID = spaceID
+
+	return newEventStream(ctx, c.auditStore, filter), nil
+}
+
+// eventStream reads audit events page by page from the store.
+type eventStream struct {
+	ctx        context.Context
+	auditStore store.AuditStore
+	filter     types.AuditFilter
+	events     []*types.AuditEvent
+	done       bool
+}
+
+func newEventStream(ctx context.Context, auditStore store.AuditStore, filter *types.AuditFilter) *eventStream {
+	s := &eventStream{
+		ctx:        ctx,
+		auditStore: auditStore,
+		filter:     *filter,
+	}
+
+	s.filter.Page = 0
+	s.filter.Size = exportPageSize
+	s.filter.Cursor = nil
+
+	return s
+}
+
+func (s *eventStream) Next() (*types.AuditEvent, error) {
+	if len(s.events) == 0 && !s.done {
+		events, err := s.auditStore.List(s.ctx, &s.filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list audit events: %w", err)
+		}
+
+		s.events = events
+		s.done = len(events) < exportPageSize
+
+		// continue after the last event, offsets would skip or repeat events recorded during the export.
+		if len(events) > 0 {
+			last := events[len(events)-1]
+			s.filter.Cursor = &types.AuditCursor{Created: last.Created, ID: last.ID}
+		}
+	}
+
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+
+	event := s.events[0]
+	s.events = s.events[1:]
+
+	return event, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// List lists the audit events of the whole system, it's reserved for admins.
+func (c *Controller) List(
+	ctx context.Context,
+	session *auth.Session,
+	filter *types.AuditFilter,
+) ([]*types.AuditEvent, int64, error) {
+	if err := checkAdmin(session); err != nil {
+		return nil, 0, err
+	}
+
+	return c.list(ctx, filter)
+}
+
+// ListSpace lists the audit events of a space and its sub-spaces, it's reserved for space owners.
+func (c *Controller) ListSpace(
+	ctx context.Context,
+	session *auth.Session,
+	spaceRef string,
+	filter *types.AuditFilter,
+) ([]*types.AuditEvent, int64, error) {
+	spaceID, err := c.getSpaceCheckAccess(ctx, session, spaceRef)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter.SpaceID = spaceID
+
+	return c.list(ctx, filter)
+}
+
+func (c *Controller) list(ctx context.Context, filter *types.AuditFilter) ([]*types.AuditEvent, int64, error) {
+	count, err := c.auditStore.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit events: %w", err)
+	}
+
+	events, err := c.auditStore.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	return events, count, nil
+}
+
+// checkAdmin ensures the principal is a system admin.
+func checkAdmin(session *auth.Session) error {
+	if session == nil {
+		return apiauth.ErrNotAuthenticated
+	}
+
+	if !session.Principal.Admin {
+		return usererror.ErrForbidden
+	}
+
+	return nil
+}
+
+// getSpaceCheckAccess ensures the principal is allowed to view the audit events of the space.
+// Audit events are as sensitive as the memberships of the space, so editing the space is required.
+func (c *Controller) getSpaceCheckAccess(ctx context.Context, session *auth.Session, spaceRef string) (int64, error) {
+	space, err := c.spaceStore.FindByRef(ctx, spaceRef)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find space: %w", err)
+	}
+
+	if err = apiauth.CheckSpace(ctx, c.authorizer, session, space, enum.PermissionSpaceEdit, false); err != nil {
+		return 0, err
+	}
+
+	return space.ID, nil
+}
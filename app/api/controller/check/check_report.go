@@ -24,6 +24,7 @@ import (
 
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
+	checkevents "github.com/harness/gitness/app/events/check"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
@@ -176,5 +177,13 @@ func (c *Controller) Report(
 		return nil, fmt.Errorf("failed to upsert status check result for repo=%s: %w", repo.UID, err)
 	}
 
+	c.checkReporter.Reported(ctx, &checkevents.ReportedPayload{
+		RepoID:      repo.ID,
+		PrincipalID: session.Principal.ID,
+		CommitSHA:   commitSHA,
+		CheckUID:    statusCheckReport.UID,
+		Status:      statusCheckReport.Status,
+	})
+
 	return statusCheckReport, nil
 }
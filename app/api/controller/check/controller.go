@@ -22,6 +22,7 @@ import (
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/auth/authz"
+	checkevents "github.com/harness/gitness/app/events/check"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/store/database/dbtx"
@@ -30,11 +31,13 @@ import (
 )
 
 type Controller struct {
-	tx           dbtx.Transactor
-	authorizer   authz.Authorizer
-	repoStore    store.RepoStore
-	checkStore   store.CheckStore
-	gitRPCClient gitrpc.Interface
+	tx            dbtx.Transactor
+	authorizer    authz.Authorizer
+	repoStore     store.RepoStore
+	checkStore    store.CheckStore
+	reqCheckStore store.ReqCheckStore
+	gitRPCClient  gitrpc.Interface
+	checkReporter *checkevents.Reporter
 }
 
 func NewController(
@@ -42,14 +45,18 @@ func NewController(
 	authorizer authz.Authorizer,
 	repoStore store.RepoStore,
 	checkStore store.CheckStore,
+	reqCheckStore store.ReqCheckStore,
 	gitRPCClient gitrpc.Interface,
+	checkReporter *checkevents.Reporter,
 ) *Controller {
 	return &Controller{
-		tx:           tx,
-		authorizer:   authorizer,
-		repoStore:    repoStore,
-		checkStore:   checkStore,
-		gitRPCClient: gitRPCClient,
+		tx:            tx,
+		authorizer:    authorizer,
+		repoStore:     repoStore,
+		checkStore:    checkStore,
+		reqCheckStore: reqCheckStore,
+		gitRPCClient:  gitRPCClient,
+		checkReporter: checkReporter,
 	}
 }
 
@@ -0,0 +1,267 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/controller/controllertest"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestReqCheckCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission enum.Permission
+		in         ReqCheckCreateInput
+		expErr     error
+		expInvalid bool
+	}{
+		{
+			name:       "created",
+			permission: enum.PermissionRepoEdit,
+			in:         ReqCheckCreateInput{BranchPattern: "release/*", CheckUID: "build"},
+		},
+		{
+			name:       "same-check-other-branches",
+			permission: enum.PermissionRepoEdit,
+			in:         ReqCheckCreateInput{BranchPattern: "main", CheckUID: "test"},
+		},
+		{
+			name:       "duplicate",
+			permission: enum.PermissionRepoEdit,
+			in:         ReqCheckCreateInput{BranchPattern: "main", CheckUID: "build"},
+			expErr:     usererror.ErrDuplicate,
+		},
+		{
+			name:       "no-edit-permission",
+			permission: enum.PermissionRepoView,
+			in:         ReqCheckCreateInput{BranchPattern: "release/*", CheckUID: "build"},
+			expErr:     apiauth.ErrNotAuthorized,
+		},
+		{
+			name:       "empty-pattern",
+			permission: enum.PermissionRepoEdit,
+			in:         ReqCheckCreateInput{BranchPattern: "", CheckUID: "build"},
+			expInvalid: true,
+		},
+		{
+			name:       "invalid-pattern",
+			permission: enum.PermissionRepoEdit,
+			in:         ReqCheckCreateInput{BranchPattern: "[main", CheckUID: "build"},
+			expInvalid: true,
+		},
+		{
+			name:       "missing-check-uid",
+			permission: enum.PermissionRepoEdit,
+			in:         ReqCheckCreateInput{BranchPattern: "main", CheckUID: ""},
+			expInvalid: true,
+		},
+		{
+			name:       "invalid-check-uid",
+			permission: enum.PermissionRepoEdit,
+			in:         ReqCheckCreateInput{BranchPattern: "main", CheckUID: "1build"},
+			expInvalid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reqChecks := &fakeReqCheckStore{checks: []*types.ReqCheck{
+				{ID: 1, RepoID: 1, BranchPattern: "main", CheckUID: "build"},
+			}}
+			c := testController(test.permission, reqChecks)
+
+			in := test.in
+			reqCheck, err := c.ReqCheckCreate(context.Background(), controllertest.Session(), controllertest.RepoRef, &in)
+
+			if test.expErr != nil || test.expInvalid {
+				if test.expInvalid {
+					controllertest.ExpectStatus(t, err, http.StatusBadRequest)
+				} else {
+					checkErr(t, err, test.expErr)
+				}
+				if len(reqChecks.checks) != 1 {
+					t.Errorf("expected no new required status check, got %d checks", len(reqChecks.checks))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to create required status check: %v", err)
+			}
+
+			if reqCheck.RepoID != 1 || reqCheck.CreatedBy != controllertest.PrincipalID ||
+				reqCheck.AddedBy.ID != controllertest.PrincipalID {
+				t.Errorf("unexpected required status check: %+v", reqCheck)
+			}
+			if reqCheck.BranchPattern != test.in.BranchPattern || reqCheck.CheckUID != test.in.CheckUID {
+				t.Errorf("unexpected required status check: %+v", reqCheck)
+			}
+			if len(reqChecks.checks) != 2 {
+				t.Errorf("expected 2 required status checks, got %d", len(reqChecks.checks))
+			}
+		})
+	}
+}
+
+func TestReqCheckList(t *testing.T) {
+	reqChecks := &fakeReqCheckStore{checks: []*types.ReqCheck{
+		{ID: 1, RepoID: 1, BranchPattern: "main", CheckUID: "build"},
+		{ID: 2, RepoID: 2, BranchPattern: "main", CheckUID: "test"},
+		{ID: 3, RepoID: 1, BranchPattern: "release/*", CheckUID: "lint"},
+	}}
+
+	c := testController(enum.PermissionRepoView, reqChecks)
+
+	list, err := c.ReqCheckList(context.Background(), controllertest.Session(), controllertest.RepoRef)
+	if err != nil {
+		t.Fatalf("failed to list required status checks: %v", err)
+	}
+
+	if len(list) != 2 || list[0].ID != 1 || list[1].ID != 3 {
+		t.Errorf("expected the required status checks 1 and 3, got %+v", list)
+	}
+
+	_, err = c.ReqCheckList(context.Background(), controllertest.Session(), "space/other")
+	checkErr(t, err, gitness_store.ErrResourceNotFound)
+}
+
+func TestReqCheckDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission enum.Permission
+		id         int64
+		expErr     error
+		expIDs     []int64
+	}{
+		{
+			name:       "deleted",
+			permission: enum.PermissionRepoEdit,
+			id:         1,
+			expIDs:     []int64{2},
+		},
+		{
+			name:       "other-repo",
+			permission: enum.PermissionRepoEdit,
+			id:         2,
+			expErr:     gitness_store.ErrResourceNotFound,
+			expIDs:     []int64{1, 2},
+		},
+		{
+			name:       "no-edit-permission",
+			permission: enum.PermissionRepoView,
+			id:         1,
+			expErr:     apiauth.ErrNotAuthorized,
+			expIDs:     []int64{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reqChecks := &fakeReqCheckStore{checks: []*types.ReqCheck{
+				{ID: 1, RepoID: 1, BranchPattern: "main", CheckUID: "build"},
+				{ID: 2, RepoID: 2, BranchPattern: "main", CheckUID: "build"},
+			}}
+			c := testController(test.permission, reqChecks)
+
+			err := c.ReqCheckDelete(context.Background(), controllertest.Session(), controllertest.RepoRef, test.id)
+			if test.expErr != nil {
+				checkErr(t, err, test.expErr)
+			} else if err != nil {
+				t.Fatalf("failed to delete required status check: %v", err)
+			}
+
+			ids := make([]int64, len(reqChecks.checks))
+			for i, reqCheck := range reqChecks.checks {
+				ids[i] = reqCheck.ID
+			}
+			if len(ids) != len(test.expIDs) {
+				t.Fatalf("expected required status checks %v, got %v", test.expIDs, ids)
+			}
+			for i := range ids {
+				if ids[i] != test.expIDs[i] {
+					t.Errorf("expected required status checks %v, got %v", test.expIDs, ids)
+				}
+			}
+		})
+	}
+}
+
+func checkErr(t *testing.T, err, expErr error) {
+	t.Helper()
+
+	if !errors.Is(err, expErr) {
+		t.Fatalf("expected error %v, got %v", expErr, err)
+	}
+}
+
+func testController(permission enum.Permission, reqChecks *fakeReqCheckStore) *Controller {
+	// the view permission is implied by every other permission.
+	return NewController(
+		fakeTransactor{},
+		controllertest.NewAuthorizer(permission, enum.PermissionRepoView),
+		&controllertest.RepoStore{Repo: controllertest.Repo()},
+		nil,
+		reqChecks,
+		nil,
+		nil,
+	)
+}
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...interface{}) error {
+	return txFn(ctx)
+}
+
+type fakeReqCheckStore struct {
+	store.ReqCheckStore
+	checks []*types.ReqCheck
+}
+
+func (f *fakeReqCheckStore) Create(_ context.Context, reqCheck *types.ReqCheck) error {
+	reqCheck.ID = f.checks[len(f.checks)-1].ID + 1
+	f.checks = append(f.checks, reqCheck)
+	return nil
+}
+
+func (f *fakeReqCheckStore) List(_ context.Context, repoID int64) ([]*types.ReqCheck, error) {
+	var list []*types.ReqCheck
+	for _, reqCheck := range f.checks {
+		if reqCheck.RepoID == repoID {
+			list = append(list, reqCheck)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeReqCheckStore) Delete(_ context.Context, repoID, reqCheckID int64) error {
+	for i, reqCheck := range f.checks {
+		if reqCheck.RepoID == repoID && reqCheck.ID == reqCheckID {
+			f.checks = append(f.checks[:i], f.checks[i+1:]...)
+			return nil
+		}
+	}
+	return gitness_store.ErrResourceNotFound
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type ReqCheckCreateInput struct {
+	BranchPattern string `json:"branch_pattern"`
+	CheckUID      string `json:"check_uid"`
+}
+
+// Validate validates the ReqCheckCreateInput data.
+func (in *ReqCheckCreateInput) Validate() error {
+	if err := protection.ValidatePattern(in.BranchPattern); err != nil {
+		return usererror.BadRequestf("Branch pattern %q is invalid: %s", in.BranchPattern, err)
+	}
+
+	if in.CheckUID == "" {
+		return usererror.BadRequest("Status check UID is missing")
+	}
+
+	if !matcherCheckUID.MatchString(in.CheckUID) {
+		return usererror.BadRequestf("Status check UID must match the regular expression: %s", regexpCheckUID)
+	}
+
+	return nil
+}
+
+// ReqCheckCreate adds a required status check to a repository.
+func (c *Controller) ReqCheckCreate(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	in *ReqCheckCreateInput,
+) (*types.ReqCheck, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoEdit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access access to repo: %w", err)
+	}
+
+	if err = in.Validate(); err != nil {
+		return nil, err
+	}
+
+	reqCheck := &types.ReqCheck{
+		CreatedBy:     session.Principal.ID,
+		Created:       time.Now().UnixMilli(),
+		RepoID:        repo.ID,
+		BranchPattern: in.BranchPattern,
+		CheckUID:      in.CheckUID,
+		AddedBy:       *session.Principal.ToPrincipalInfo(),
+	}
+
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		reqChecks, err := c.reqCheckStore.List(ctx, repo.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list required status checks for repo=%s: %w", repo.UID, err)
+		}
+
+		for _, existing := range reqChecks {
+			if existing.BranchPattern == reqCheck.BranchPattern && existing.CheckUID == reqCheck.CheckUID {
+				return usererror.ErrDuplicate
+			}
+		}
+
+		return c.reqCheckStore.Create(ctx, reqCheck)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reqCheck, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ReqCheckDelete removes a required status check from a repository.
+func (c *Controller) ReqCheckDelete(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	reqCheckID int64,
+) error {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoEdit)
+	if err != nil {
+		return fmt.Errorf("failed to acquire access access to repo: %w", err)
+	}
+
+	err = c.reqCheckStore.Delete(ctx, repo.ID, reqCheckID)
+	if err != nil {
+		return fmt.Errorf("failed to delete required status check for repo=%s: %w", repo.UID, err)
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ package check
 
 import (
 	"github.com/harness/gitness/app/auth/authz"
+	checkevents "github.com/harness/gitness/app/events/check"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/store/database/dbtx"
@@ -33,13 +34,17 @@ func ProvideController(
 	authorizer authz.Authorizer,
 	repoStore store.RepoStore,
 	checkStore store.CheckStore,
+	reqCheckStore store.ReqCheckStore,
 	rpcClient gitrpc.Interface,
+	checkReporter *checkevents.Reporter,
 ) *Controller {
 	return NewController(
 		tx,
 		authorizer,
 		repoStore,
 		checkStore,
+		reqCheckStore,
 		rpcClient,
+		checkReporter,
 	)
 }
@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package controllertest provides the fixtures shared by the tests of the api controllers.
+package controllertest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	// RepoID is the id of the repository returned by Repo.
+	RepoID = 1
+	// RepoRef is the path of the repository returned by Repo.
+	RepoRef = "space/repo"
+	// PrincipalID is the id of the principal of the session returned by Session.
+	PrincipalID = 7
+)
+
+// Session returns the session of the user the controllers are called by.
+func Session() *auth.Session {
+	return &auth.Session{Principal: types.Principal{ID: PrincipalID, UID: "user", Type: enum.PrincipalTypeUser}}
+}
+
+// Repo returns the repository the controllers are called for.
+func Repo() *types.Repository {
+	return &types.Repository{ID: RepoID, Path: RepoRef}
+}
+
+// ExpectStatus fails the test if the error isn't a user error with the status.
+func ExpectStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	var uErr *usererror.Error
+	if !errors.As(err, &uErr) || uErr.Status != status {
+		t.Errorf("expected user error with status %d, got %v", status, err)
+	}
+}
+
+// Authorizer is an authorizer that grants only the allowed permissions.
+type Authorizer struct {
+	authz.Authorizer
+	Allowed map[enum.Permission]bool
+}
+
+// NewAuthorizer returns an authorizer that grants the permissions.
+func NewAuthorizer(permissions ...enum.Permission) *Authorizer {
+	allowed := make(map[enum.Permission]bool, len(permissions))
+	for _, permission := range permissions {
+		allowed[permission] = true
+	}
+
+	return &Authorizer{Allowed: allowed}
+}
+
+func (f *Authorizer) Check(
+	_ context.Context,
+	_ *auth.Session,
+	_ *types.Scope,
+	_ *types.Resource,
+	permission enum.Permission,
+) (bool, error) {
+	return f.Allowed[permission], nil
+}
+
+// RepoStore is a repository store that finds only the provided repository.
+type RepoStore struct {
+	store.RepoStore
+	Repo *types.Repository
+}
+
+func (f *RepoStore) FindByRef(_ context.Context, repoRef string) (*types.Repository, error) {
+	if repoRef != f.Repo.Path {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return f.Repo, nil
+}
@@ -16,6 +16,7 @@ package execution
 
 import (
 	"github.com/harness/gitness/app/auth/authz"
+	checkevents "github.com/harness/gitness/app/events/check"
 	"github.com/harness/gitness/app/pipeline/canceler"
 	"github.com/harness/gitness/app/pipeline/commit"
 	"github.com/harness/gitness/app/pipeline/triggerer"
@@ -28,6 +29,7 @@ type Controller struct {
 	authorizer     authz.Authorizer
 	executionStore store.ExecutionStore
 	checkStore     store.CheckStore
+	checkReporter  *checkevents.Reporter
 	canceler       canceler.Canceler
 	commitService  commit.Service
 	triggerer      triggerer.Triggerer
@@ -41,6 +43,7 @@ func NewController(
 	authorizer authz.Authorizer,
 	executionStore store.ExecutionStore,
 	checkStore store.CheckStore,
+	checkReporter *checkevents.Reporter,
 	canceler canceler.Canceler,
 	commitService commit.Service,
 	triggerer triggerer.Triggerer,
@@ -53,6 +56,7 @@ func NewController(
 		authorizer:     authorizer,
 		executionStore: executionStore,
 		checkStore:     checkStore,
+		checkReporter:  checkReporter,
 		canceler:       canceler,
 		commitService:  commitService,
 		triggerer:      triggerer,
@@ -22,8 +22,10 @@ import (
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/auth/authz"
 	eventsgit "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
@@ -52,12 +54,14 @@ type BaseInput struct {
 }
 
 type Controller struct {
-	authorizer     authz.Authorizer
-	principalStore store.PrincipalStore
-	repoStore      store.RepoStore
-	gitReporter    *eventsgit.Reporter
-	pullreqStore   store.PullReqStore
-	urlProvider    url.Provider
+	authorizer        authz.Authorizer
+	principalStore    store.PrincipalStore
+	repoStore         store.RepoStore
+	gitReporter       *eventsgit.Reporter
+	pullreqStore      store.PullReqStore
+	urlProvider       url.Provider
+	gitRPCClient      gitrpc.Interface
+	protectionManager *protection.Manager
 }
 
 func NewController(
@@ -67,14 +71,18 @@ func NewController(
 	gitReporter *eventsgit.Reporter,
 	pullreqStore store.PullReqStore,
 	urlProvider url.Provider,
+	gitRPCClient gitrpc.Interface,
+	protectionManager *protection.Manager,
 ) *Controller {
 	return &Controller{
-		authorizer:     authorizer,
-		principalStore: principalStore,
-		repoStore:      repoStore,
-		gitReporter:    gitReporter,
-		pullreqStore:   pullreqStore,
-		urlProvider:    urlProvider,
+		authorizer:        authorizer,
+		principalStore:    principalStore,
+		repoStore:         repoStore,
+		gitReporter:       gitReporter,
+		pullreqStore:      pullreqStore,
+		urlProvider:       urlProvider,
+		gitRPCClient:      gitRPCClient,
+		protectionManager: protectionManager,
 	}
 }
 
@@ -17,6 +17,7 @@ package githook
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
@@ -33,6 +34,7 @@ func (c *Controller) PreReceive(
 	session *auth.Session,
 	repoID int64,
 	principalID int64,
+	internal bool,
 	in *githook.PreReceiveInput,
 ) (*githook.Output, error) {
 	if in == nil {
@@ -44,16 +46,54 @@ func (c *Controller) PreReceive(
 		return nil, err
 	}
 
+	mirrorOutput := c.blockMirrorUpdates(repo, in)
+	if mirrorOutput != nil {
+		return mirrorOutput, nil
+	}
+
 	branchOutput := c.blockDefaultBranchDeletion(repo, in)
 	if branchOutput != nil {
 		return branchOutput, nil
 	}
 
-	// TODO: Branch Protection, Block non-brach/tag refs (?), ...
+	// protection rules are enforced by gitness itself for internal operations (e.g. merging a pull request).
+	if !internal {
+		violations, err := c.checkProtectionRules(ctx, repo, principalID, in)
+		if err != nil {
+			return nil, err
+		}
+		if len(violations) > 0 {
+			return &githook.Output{
+				Error: ptr.String(strings.Join(violations, "\n")),
+			}, nil
+		}
+	}
+
+	// TODO: Block non-brach/tag refs (?), ...
 
 	return &githook.Output{}, nil
 }
 
+// blockMirrorUpdates blocks any changes of branches and tags of mirror repositories,
+// as they are only updated by syncing the repository with its remote.
+func (c *Controller) blockMirrorUpdates(repo *types.Repository,
+	in *githook.PreReceiveInput) *githook.Output {
+	if !repo.IsMirror {
+		return nil
+	}
+
+	for _, refUpdate := range in.RefUpdates {
+		if strings.HasPrefix(refUpdate.Ref, gitReferenceNamePrefixBranch) ||
+			strings.HasPrefix(refUpdate.Ref, gitReferenceNamePrefixTag) {
+			return &githook.Output{
+				Error: ptr.String(usererror.ErrRepoIsMirror.Error()),
+			}
+		}
+	}
+
+	return nil
+}
+
 func (c *Controller) blockDefaultBranchDeletion(repo *types.Repository,
 	in *githook.PreReceiveInput) *githook.Output {
 	repoDefaultBranchRef := gitReferenceNamePrefixBranch + repo.DefaultBranch
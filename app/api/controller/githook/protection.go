@@ -0,0 +1,149 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/githook"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+)
+
+// checkProtectionRules verifies that none of the branch updates violates a protection rule of the repository.
+// It returns a user facing message for each violation.
+func (c *Controller) checkProtectionRules(
+	ctx context.Context,
+	repo *types.Repository,
+	principalID int64,
+	in *githook.PreReceiveInput,
+) ([]string, error) {
+	rules, err := c.protectionManager.ListForRepo(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list protection rules: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return []string{}, nil
+	}
+
+	readParams := gitrpc.ReadParams{
+		RepoUID:             repo.GitUID,
+		AlternateObjectDirs: in.Environment.AlternateObjectDirs,
+	}
+
+	violations := []string{}
+	for _, refUpdate := range in.RefUpdates {
+		if !strings.HasPrefix(refUpdate.Ref, gitReferenceNamePrefixBranch) {
+			continue
+		}
+
+		branch := refUpdate.Ref[len(gitReferenceNamePrefixBranch):]
+		branchProtection := rules.ForBranch(principalID, branch)
+
+		violation, err := c.checkBranchUpdate(ctx, readParams, branch, branchProtection, refUpdate)
+		if err != nil {
+			return nil, err
+		}
+
+		if violation != "" {
+			violations = append(violations, violation)
+		}
+	}
+
+	return violations, nil
+}
+
+// checkBranchUpdate returns a user facing message in case the branch update violates the branch protection.
+func (c *Controller) checkBranchUpdate(
+	ctx context.Context,
+	readParams gitrpc.ReadParams,
+	branch string,
+	branchProtection protection.Protection,
+	refUpdate githook.ReferenceUpdate,
+) (string, error) {
+	// branch deletion
+	if refUpdate.New == types.NilSHA {
+		if branchProtection.BlockDeletion != "" {
+			return branchProtection.ViolationDeletion(branch), nil
+		}
+
+		return "", nil
+	}
+
+	// branch creation or update - creating a protected branch is a direct push as well.
+	if branchProtection.BlockDirectPush != "" {
+		return branchProtection.ViolationDirectPush(branch), nil
+	}
+
+	// branch update
+	if refUpdate.Old != types.NilSHA {
+		if branchProtection.BlockForcePush != "" {
+			ancestorOutput, err := c.gitRPCClient.IsAncestor(ctx, gitrpc.IsAncestorParams{
+				ReadParams:          readParams,
+				AncestorCommitSHA:   refUpdate.Old,
+				DescendantCommitSHA: refUpdate.New,
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to check if update of branch %q is a force push: %w", branch, err)
+			}
+
+			if !ancestorOutput.IsAncestor {
+				return branchProtection.ViolationForcePush(branch), nil
+			}
+		}
+	}
+
+	// for new branches only commits that aren't part of the repository yet are inspected.
+	baseSHA := refUpdate.Old
+	if baseSHA == types.NilSHA {
+		baseSHA = ""
+	}
+
+	if branchProtection.RequireLinearHistory != "" {
+		mergeCommitOutput, err := c.gitRPCClient.FindMergeCommit(ctx, gitrpc.FindMergeCommitParams{
+			ReadParams: readParams,
+			BaseSHA:    baseSHA,
+			HeadSHA:    refUpdate.New,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to find merge commits pushed to branch %q: %w", branch, err)
+		}
+
+		if mergeCommitOutput.MergeCommitSHA != "" {
+			return branchProtection.ViolationLinearHistory(branch), nil
+		}
+	}
+
+	if branchProtection.RequireSignedCommits != "" {
+		unsignedCommitOutput, err := c.gitRPCClient.FindUnsignedCommit(ctx, gitrpc.FindUnsignedCommitParams{
+			ReadParams: readParams,
+			BaseSHA:    baseSHA,
+			HeadSHA:    refUpdate.New,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to find unsigned commits pushed to branch %q: %w", branch, err)
+		}
+
+		if unsignedCommitOutput.UnsignedCommitSHA != "" {
+			return branchProtection.ViolationUnsignedCommit(branch, unsignedCommitOutput.UnsignedCommitSHA), nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/githook"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+)
+
+type fakeGitRPC struct {
+	gitrpc.Interface
+}
+
+func (fakeGitRPC) IsAncestor(context.Context, gitrpc.IsAncestorParams) (gitrpc.IsAncestorOutput, error) {
+	return gitrpc.IsAncestorOutput{IsAncestor: true}, nil
+}
+
+func TestCheckBranchUpdate(t *testing.T) {
+	const (
+		sha1 = "1111111111111111111111111111111111111111"
+		sha2 = "2222222222222222222222222222222222222222"
+	)
+
+	rules := protection.RuleSet{
+		{
+			UID:        "release",
+			Pattern:    "release/*",
+			Definition: types.RuleDefinition{BlockDirectPush: true, BlockDeletion: true},
+		},
+		{
+			UID:        "main",
+			Pattern:    "main",
+			Definition: types.RuleDefinition{BlockForcePush: true},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		branch       string
+		old          string
+		new          string
+		expViolation bool
+	}{
+		{name: "create-protected-branch", branch: "release/1.0", old: types.NilSHA, new: sha1, expViolation: true},
+		{name: "update-protected-branch", branch: "release/1.0", old: sha1, new: sha2, expViolation: true},
+		{name: "delete-protected-branch", branch: "release/1.0", old: sha1, new: types.NilSHA, expViolation: true},
+		{name: "create-unprotected-branch", branch: "feature", old: types.NilSHA, new: sha1},
+		{name: "update-branch-without-direct-push-rule", branch: "main", old: sha1, new: sha2},
+	}
+
+	c := &Controller{gitRPCClient: fakeGitRPC{}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			refUpdate := githook.ReferenceUpdate{
+				Ref: gitReferenceNamePrefixBranch + test.branch,
+				Old: test.old,
+				New: test.new,
+			}
+
+			violation, err := c.checkBranchUpdate(context.Background(), gitrpc.ReadParams{},
+				test.branch, rules.ForBranch(1, test.branch), refUpdate)
+			if err != nil {
+				t.Fatalf("failed to check branch update: %s", err.Error())
+			}
+
+			if test.expViolation != (violation != "") {
+				t.Errorf("expected violation=%t, got %q", test.expViolation, violation)
+			}
+		})
+	}
+}
@@ -17,8 +17,10 @@ package githook
 import (
 	"github.com/harness/gitness/app/auth/authz"
 	eventsgit "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/gitrpc"
 
 	"github.com/google/wire"
 )
@@ -30,6 +32,7 @@ var WireSet = wire.NewSet(
 
 func ProvideController(authorizer authz.Authorizer, principalStore store.PrincipalStore,
 	repoStore store.RepoStore, gitReporter *eventsgit.Reporter, pullreqStore store.PullReqStore,
-	urlProvider url.Provider) *Controller {
-	return NewController(authorizer, principalStore, repoStore, gitReporter, pullreqStore, urlProvider)
+	urlProvider url.Provider, gitRPCClient gitrpc.Interface, protectionManager *protection.Manager) *Controller {
+	return NewController(authorizer, principalStore, repoStore, gitReporter, pullreqStore, urlProvider,
+		gitRPCClient, protectionManager)
 }
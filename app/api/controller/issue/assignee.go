@@ -0,0 +1,158 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type AssigneeAddInput struct {
+	PrincipalID int64 `json:"principal_id"`
+}
+
+// AssigneeAdd assigns a principal to an issue.
+func (c *Controller) AssigneeAdd(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	issueNum int64,
+	in *AssigneeAddInput,
+) (*types.PrincipalInfo, error) {
+	if in.PrincipalID == 0 {
+		return nil, usererror.BadRequest("Must specify principal ID.")
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	issue, err := c.issueStore.FindByNumber(ctx, repo.ID, issueNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find issue by number: %w", err)
+	}
+
+	if err = c.checkModifyAccess(ctx, session, repo, issue); err != nil {
+		return nil, err
+	}
+
+	principal, err := c.principalStore.Find(ctx, in.PrincipalID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, usererror.BadRequestf("Principal %d doesn't exist.", in.PrincipalID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find principal: %w", err)
+	}
+
+	principalInfo := principal.ToPrincipalInfo()
+
+	// TODO: To check the assignee's access to the repo we create a dummy session object. Fix it.
+	if err = apiauth.CheckRepo(ctx, c.authorizer, &auth.Session{
+		Principal: *principal,
+		Metadata:  nil,
+	}, repo, enum.PermissionRepoView, false); err != nil {
+		log.Ctx(ctx).Info().Msgf("Assignee principal: %s access error: %s", principalInfo.UID, err)
+		return nil, usererror.BadRequest("The assignee doesn't have enough permissions for the repository.")
+	}
+
+	err = c.assigneeStore.Assign(ctx, &types.IssueAssignee{
+		IssueID:     issue.ID,
+		PrincipalID: principal.ID,
+		CreatedBy:   session.Principal.ID,
+		Created:     time.Now().UnixMilli(),
+	})
+	if errors.Is(err, store.ErrDuplicate) {
+		return principalInfo, nil // no changes are necessary: the principal is already assigned
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign principal to issue: %w", err)
+	}
+
+	c.writeSystemActivity(ctx, session, issue, &types.IssueActivityPayloadAssignee{
+		Type:        enum.IssueAssigneeActivityTypeAssign,
+		PrincipalID: principal.ID,
+		DisplayName: principal.DisplayName,
+	})
+
+	c.publishUpdate(ctx, repo, issue)
+
+	return principalInfo, nil
+}
+
+// AssigneeRemove removes a principal from the assignees of an issue.
+func (c *Controller) AssigneeRemove(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	issueNum int64,
+	principalID int64,
+) error {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	issue, err := c.issueStore.FindByNumber(ctx, repo.ID, issueNum)
+	if err != nil {
+		return fmt.Errorf("failed to find issue by number: %w", err)
+	}
+
+	if err = c.checkModifyAccess(ctx, session, repo, issue); err != nil {
+		return err
+	}
+
+	assignees, err := c.assigneeStore.List(ctx, issue.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list assignees of issue: %w", err)
+	}
+
+	var assignee *types.PrincipalInfo
+	for _, a := range assignees {
+		if a.ID == principalID {
+			assignee = a
+			break
+		}
+	}
+	if assignee == nil {
+		return usererror.NotFound("Principal is not assigned to the issue")
+	}
+
+	err = c.assigneeStore.Unassign(ctx, issue.ID, assignee.ID)
+	if err != nil {
+		return fmt.Errorf("failed to remove assignee from issue: %w", err)
+	}
+
+	c.writeSystemActivity(ctx, session, issue, &types.IssueActivityPayloadAssignee{
+		Type:        enum.IssueAssigneeActivityTypeUnassign,
+		PrincipalID: assignee.ID,
+		DisplayName: assignee.DisplayName,
+	})
+
+	c.publishUpdate(ctx, repo, issue)
+
+	return nil
+}
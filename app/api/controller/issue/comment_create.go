@@ -0,0 +1,169 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type CommentCreateInput struct {
+	// ParentID is set only for replies
+	ParentID int64 `json:"parent_id"`
+	// Text is comment text
+	Text string `json:"text"`
+}
+
+// CommentCreate creates a new issue comment (issue activity, type=comment).
+func (c *Controller) CommentCreate(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	issueNum int64,
+	in *CommentCreateInput,
+) (*types.PullReqActivity, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	issue, err := c.issueStore.FindByNumber(ctx, repo.ID, issueNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find issue by number: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	act := &types.PullReqActivity{
+		CreatedBy: session.Principal.ID,
+		Created:   now,
+		Updated:   now,
+		Edited:    now,
+		RepoID:    issue.RepoID,
+		IssueID:   issue.ID,
+		Type:      enum.PullReqActivityTypeComment,
+		Kind:      enum.PullReqActivityKindComment,
+		Text:      in.Text,
+		Author:    *session.Principal.ToPrincipalInfo(),
+	}
+
+	_ = act.SetPayload(types.PullRequestActivityPayloadComment{})
+
+	if in.ParentID != 0 {
+		var parentAct *types.PullReqActivity
+		parentAct, err = c.checkIsReplyable(ctx, issue, in.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		act.ParentID = &parentAct.ID
+		err = c.writeReplyActivity(ctx, parentAct, act)
+	} else {
+		err = c.writeActivity(ctx, issue, act)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	issue, err = c.issueStore.UpdateOptLock(ctx, issue, func(issue *types.Issue) error {
+		issue.CommentCount++
+		return nil
+	})
+	if err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msgf("failed to increment issue comment counter")
+	} else {
+		c.publishUpdate(ctx, repo, issue)
+	}
+
+	return act, nil
+}
+
+func (c *Controller) checkIsReplyable(ctx context.Context,
+	issue *types.Issue, parentID int64) (*types.PullReqActivity, error) {
+	// make sure the parent comment exists, belongs to the same issue and isn't itself a reply
+	parentAct, err := c.activityStore.Find(ctx, parentID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, usererror.BadRequest("Parent issue activity not found.")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find parent issue activity: %w", err)
+	}
+
+	if parentAct.IssueID != issue.ID || parentAct.RepoID != issue.RepoID {
+		return nil, usererror.BadRequest("Parent issue activity doesn't belong to the same issue.")
+	}
+
+	if !parentAct.IsReplyable() {
+		return nil, usererror.BadRequest("Can't create a reply to the specified entry.")
+	}
+
+	return parentAct, nil
+}
+
+// writeActivity updates the issue's activity sequence number (using the optimistic locking mechanism),
+// sets the correct Order value and writes the activity to the database.
+// Even if the writing fails, the updating of the sequence number can succeed.
+func (c *Controller) writeActivity(ctx context.Context, issue *types.Issue, act *types.PullReqActivity) error {
+	issueUpd, err := c.issueStore.UpdateActivitySeq(ctx, issue)
+	if err != nil {
+		return fmt.Errorf("failed to get issue activity number: %w", err)
+	}
+
+	*issue = *issueUpd // update the issue object
+
+	act.Order = issueUpd.ActivitySeq
+
+	err = c.activityStore.Create(ctx, act)
+	if err != nil {
+		return fmt.Errorf("failed to create issue activity: %w", err)
+	}
+
+	return nil
+}
+
+// writeReplyActivity updates the parent activity's reply sequence number (using the optimistic locking mechanism),
+// sets the correct Order and SubOrder values and writes the activity to the database.
+// Even if the writing fails, the updating of the sequence number can succeed.
+func (c *Controller) writeReplyActivity(ctx context.Context, parent, act *types.PullReqActivity) error {
+	parentUpd, err := c.activityStore.UpdateOptLock(ctx, parent, func(act *types.PullReqActivity) error {
+		act.ReplySeq++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get issue activity number: %w", err)
+	}
+
+	*parent = *parentUpd // update the parent issue activity object
+
+	act.Order = parentUpd.Order
+	act.SubOrder = parentUpd.ReplySeq
+
+	err = c.activityStore.Create(ctx, act)
+	if err != nil {
+		return fmt.Errorf("failed to create issue activity: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/label"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type Controller struct {
+	tx              dbtx.Transactor
+	authorizer      authz.Authorizer
+	issueStore      store.IssueStore
+	activityStore   store.PullReqActivityStore
+	assigneeStore   store.IssueAssigneeStore
+	issueLabelStore store.IssueLabelStore
+	repoStore       store.RepoStore
+	principalStore  store.PrincipalStore
+	labelService    *label.Service
+	sseStreamer     sse.Streamer
+}
+
+func NewController(
+	tx dbtx.Transactor,
+	authorizer authz.Authorizer,
+	issueStore store.IssueStore,
+	activityStore store.PullReqActivityStore,
+	assigneeStore store.IssueAssigneeStore,
+	issueLabelStore store.IssueLabelStore,
+	repoStore store.RepoStore,
+	principalStore store.PrincipalStore,
+	labelService *label.Service,
+	sseStreamer sse.Streamer,
+) *Controller {
+	return &Controller{
+		tx:              tx,
+		authorizer:      authorizer,
+		issueStore:      issueStore,
+		activityStore:   activityStore,
+		assigneeStore:   assigneeStore,
+		issueLabelStore: issueLabelStore,
+		repoStore:       repoStore,
+		principalStore:  principalStore,
+		labelService:    labelService,
+		sseStreamer:     sseStreamer,
+	}
+}
+
+func (c *Controller) getRepoCheckAccess(ctx context.Context,
+	session *auth.Session, repoRef string, reqPermission enum.Permission,
+) (*types.Repository, error) {
+	if repoRef == "" {
+		return nil, usererror.BadRequest("A valid repository reference must be provided.")
+	}
+
+	repo, err := c.repoStore.FindByRef(ctx, repoRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find repository: %w", err)
+	}
+
+	if repo.Importing {
+		return nil, usererror.BadRequest("Repository import is in progress.")
+	}
+
+	if err = apiauth.CheckRepo(ctx, c.authorizer, session, repo, reqPermission, false); err != nil {
+		return nil, fmt.Errorf("access check failed: %w", err)
+	}
+
+	return repo, nil
+}
+
+// checkModifyAccess verifies that the principal is allowed to modify the issue.
+// The author of an issue can always modify it, everyone else requires push access to the repository.
+func (c *Controller) checkModifyAccess(ctx context.Context,
+	session *auth.Session, repo *types.Repository, issue *types.Issue,
+) error {
+	if issue.CreatedBy == session.Principal.ID {
+		return nil
+	}
+
+	if err := apiauth.CheckRepo(ctx, c.authorizer, session, repo, enum.PermissionRepoPush, false); err != nil {
+		return fmt.Errorf("access check failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Controller) getCommentCheckEditAccess(ctx context.Context,
+	session *auth.Session, issue *types.Issue, commentID int64,
+) (*types.PullReqActivity, error) {
+	if commentID <= 0 {
+		return nil, usererror.BadRequest("A valid comment ID must be provided.")
+	}
+
+	comment, err := c.activityStore.Find(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comment by ID: %w", err)
+	}
+
+	if comment.Deleted != nil || comment.RepoID != issue.RepoID || comment.IssueID != issue.ID {
+		return nil, usererror.ErrNotFound
+	}
+
+	if comment.Kind == enum.PullReqActivityKindSystem || comment.Type != enum.PullReqActivityTypeComment {
+		return nil, usererror.BadRequest("Only comments can be edited.")
+	}
+
+	if comment.CreatedBy != session.Principal.ID {
+		return nil, usererror.BadRequest("Only own comments may be updated.")
+	}
+
+	return comment, nil
+}
+
+// writeSystemActivity updates the issue's activity sequence number and
+// writes the system activity with the provided payload to the database.
+func (c *Controller) writeSystemActivity(
+	ctx context.Context,
+	session *auth.Session,
+	issue *types.Issue,
+	payload types.PullReqActivityPayload,
+) {
+	issue, err := c.issueStore.UpdateActivitySeq(ctx, issue)
+	if err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msgf("failed to update activity sequence of issue")
+		return
+	}
+
+	if _, errAct := c.activityStore.CreateWithPayloadForIssue(ctx, issue, session.Principal.ID, payload); errAct != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(errAct).Msgf("failed to write issue '%s' activity", payload.ActivityType())
+	}
+}
+
+func (c *Controller) publishUpdate(ctx context.Context, repo *types.Repository, issue *types.Issue) {
+	if err := c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypeIssueUpdated, issue); err != nil {
+		log.Ctx(ctx).Warn().Msg("failed to publish issue changed event")
+	}
+}
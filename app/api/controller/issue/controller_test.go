@@ -0,0 +1,281 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/harness/gitness/app/api/controller/controllertest"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const testIssueID = 1
+
+func TestCommentCreate(t *testing.T) {
+	c, activities := testController()
+
+	// the pull request shares the ID with the issue, its comments must not be reachable through the issue.
+	prComment := activities.add(&types.PullReqActivity{
+		RepoID:    controllertest.RepoID,
+		PullReqID: testIssueID,
+		Type:      enum.PullReqActivityTypeComment,
+		Kind:      enum.PullReqActivityKindComment,
+	})
+
+	comment, err := c.CommentCreate(context.Background(), controllertest.Session(), controllertest.RepoRef, 1,
+		&CommentCreateInput{Text: "comment"})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+	if comment.IssueID != testIssueID || comment.PullReqID != 0 || comment.Order != 1 {
+		t.Errorf("unexpected comment: issue=%d pullreq=%d order=%d",
+			comment.IssueID, comment.PullReqID, comment.Order)
+	}
+
+	reply, err := c.CommentCreate(context.Background(), controllertest.Session(), controllertest.RepoRef, 1,
+		&CommentCreateInput{ParentID: comment.ID, Text: "reply"})
+	if err != nil {
+		t.Fatalf("failed to create reply: %v", err)
+	}
+	if reply.IssueID != testIssueID || reply.Order != comment.Order || reply.SubOrder != 1 {
+		t.Errorf("unexpected reply: issue=%d order=%d sub-order=%d", reply.IssueID, reply.Order, reply.SubOrder)
+	}
+
+	_, err = c.CommentCreate(context.Background(), controllertest.Session(), controllertest.RepoRef, 1,
+		&CommentCreateInput{ParentID: prComment.ID, Text: "reply"})
+	controllertest.ExpectStatus(t, err, http.StatusBadRequest)
+}
+
+func TestCommentUpdate(t *testing.T) {
+	tests := []struct {
+		name   string
+		act    *types.PullReqActivity
+		status int
+	}{
+		{
+			name: "issue-comment",
+			act: &types.PullReqActivity{
+				CreatedBy: controllertest.PrincipalID,
+				RepoID:    controllertest.RepoID,
+				IssueID:   testIssueID,
+				Type:      enum.PullReqActivityTypeComment,
+				Kind:      enum.PullReqActivityKindComment,
+			},
+		},
+		{
+			name: "pullreq-comment",
+			act: &types.PullReqActivity{
+				CreatedBy: controllertest.PrincipalID,
+				RepoID:    controllertest.RepoID,
+				PullReqID: testIssueID,
+				Type:      enum.PullReqActivityTypeComment,
+				Kind:      enum.PullReqActivityKindComment,
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "system-activity",
+			act: &types.PullReqActivity{
+				CreatedBy: controllertest.PrincipalID,
+				RepoID:    controllertest.RepoID,
+				IssueID:   testIssueID,
+				Type:      enum.PullReqActivityTypeIssueStateChange,
+				Kind:      enum.PullReqActivityKindSystem,
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "foreign-comment",
+			act: &types.PullReqActivity{
+				CreatedBy: controllertest.PrincipalID + 1,
+				RepoID:    controllertest.RepoID,
+				IssueID:   testIssueID,
+				Type:      enum.PullReqActivityTypeComment,
+				Kind:      enum.PullReqActivityKindComment,
+			},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, activities := testController()
+			act := activities.add(test.act)
+
+			updated, err := c.CommentUpdate(context.Background(), controllertest.Session(), controllertest.RepoRef, 1, act.ID,
+				&CommentUpdateInput{Text: "updated"})
+			if test.status != 0 {
+				controllertest.ExpectStatus(t, err, test.status)
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to update comment: %v", err)
+			}
+			if updated.Text != "updated" {
+				t.Errorf("expected updated text, got %q", updated.Text)
+			}
+		})
+	}
+}
+
+func TestState(t *testing.T) {
+	c, activities := testController()
+
+	for i := 0; i < 2; i++ {
+		issue, err := c.State(context.Background(), controllertest.Session(), controllertest.RepoRef, 1,
+			&StateInput{State: enum.IssueStateClosed, Message: "done"})
+		if err != nil {
+			t.Fatalf("failed to close issue: %v", err)
+		}
+		if issue.State != enum.IssueStateClosed {
+			t.Errorf("expected closed issue, got %s", issue.State)
+		}
+	}
+
+	// closing an already closed issue must not write another activity.
+	if len(activities.acts) != 1 {
+		t.Fatalf("expected exactly one activity, got %d", len(activities.acts))
+	}
+
+	act := activities.acts[1]
+	if act.IssueID != testIssueID || act.PullReqID != 0 || act.Type != enum.PullReqActivityTypeIssueStateChange {
+		t.Errorf("unexpected activity: issue=%d pullreq=%d type=%s", act.IssueID, act.PullReqID, act.Type)
+	}
+
+	payload, err := act.GetPayload()
+	if err != nil {
+		t.Fatalf("failed to get activity payload: %v", err)
+	}
+	p, ok := payload.(*types.IssueActivityPayloadStateChange)
+	if !ok || p.Old != enum.IssueStateOpen || p.New != enum.IssueStateClosed || p.Message != "done" {
+		t.Errorf("unexpected activity payload: %#v", payload)
+	}
+}
+
+func testController() (*Controller, *fakeActivityStore) {
+	activities := &fakeActivityStore{acts: map[int64]*types.PullReqActivity{}}
+
+	c := NewController(
+		nil,
+		controllertest.NewAuthorizer(enum.PermissionRepoView, enum.PermissionRepoReview, enum.PermissionRepoPush),
+		&fakeIssueStore{issue: &types.Issue{ID: testIssueID, Number: 1, RepoID: controllertest.RepoID,
+			State: enum.IssueStateOpen, CreatedBy: controllertest.PrincipalID}},
+		activities,
+		nil,
+		nil,
+		&controllertest.RepoStore{Repo: controllertest.Repo()},
+		nil,
+		nil,
+		&fakeStreamer{},
+	)
+
+	return c, activities
+}
+
+type fakeIssueStore struct {
+	store.IssueStore
+	issue *types.Issue
+}
+
+func (f *fakeIssueStore) FindByNumber(_ context.Context, repoID int64, num int64) (*types.Issue, error) {
+	if repoID != f.issue.RepoID || num != f.issue.Number {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	issue := *f.issue
+	return &issue, nil
+}
+
+func (f *fakeIssueStore) UpdateOptLock(_ context.Context,
+	issue *types.Issue, mutateFn func(issue *types.Issue) error,
+) (*types.Issue, error) {
+	updated := *issue
+	if err := mutateFn(&updated); err != nil {
+		return nil, err
+	}
+	*f.issue = updated
+	return &updated, nil
+}
+
+func (f *fakeIssueStore) UpdateActivitySeq(_ context.Context, issue *types.Issue) (*types.Issue, error) {
+	f.issue.ActivitySeq++
+	updated := *issue
+	updated.ActivitySeq = f.issue.ActivitySeq
+	return &updated, nil
+}
+
+type fakeActivityStore struct {
+	store.PullReqActivityStore
+	acts map[int64]*types.PullReqActivity
+}
+
+func (f *fakeActivityStore) add(act *types.PullReqActivity) *types.PullReqActivity {
+	act.ID = int64(len(f.acts) + 1)
+	f.acts[act.ID] = act
+	return act
+}
+
+func (f *fakeActivityStore) Find(_ context.Context, id int64) (*types.PullReqActivity, error) {
+	act, ok := f.acts[id]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	actCopy := *act
+	return &actCopy, nil
+}
+
+func (f *fakeActivityStore) Create(_ context.Context, act *types.PullReqActivity) error {
+	f.add(act)
+	return nil
+}
+
+func (f *fakeActivityStore) CreateWithPayloadForIssue(_ context.Context,
+	issue *types.Issue, principalID int64, payload types.PullReqActivityPayload,
+) (*types.PullReqActivity, error) {
+	act := &types.PullReqActivity{
+		CreatedBy: principalID,
+		RepoID:    issue.RepoID,
+		IssueID:   issue.ID,
+		Order:     issue.ActivitySeq,
+		Type:      payload.ActivityType(),
+		Kind:      enum.PullReqActivityKindSystem,
+	}
+	_ = act.SetPayload(payload)
+	return f.add(act), nil
+}
+
+func (f *fakeActivityStore) UpdateOptLock(_ context.Context,
+	act *types.PullReqActivity, mutateFn func(act *types.PullReqActivity) error,
+) (*types.PullReqActivity, error) {
+	updated := *act
+	if err := mutateFn(&updated); err != nil {
+		return nil, err
+	}
+	f.acts[updated.ID] = &updated
+	return &updated, nil
+}
+
+type fakeStreamer struct {
+	sse.Streamer
+}
+
+func (f *fakeStreamer) Publish(context.Context, int64, enum.SSEType, any) error {
+	return nil
+}
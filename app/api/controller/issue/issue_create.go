@@ -0,0 +1,91 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type CreateInput struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func (in *CreateInput) sanitize() error {
+	in.Title = strings.TrimSpace(in.Title)
+	if in.Title == "" {
+		return usererror.BadRequest("issue title can't be empty")
+	}
+
+	in.Description = strings.TrimSpace(in.Description)
+
+	return nil
+}
+
+// Create creates a new issue.
+func (c *Controller) Create(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	in *CreateInput,
+) (*types.Issue, error) {
+	if err := in.sanitize(); err != nil {
+		return nil, err
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	repo, err = c.repoStore.UpdateOptLock(ctx, repo, func(repo *types.Repository) error {
+		repo.IssueSeq++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire IssueSeq number: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	issue := &types.Issue{
+		Number:      repo.IssueSeq,
+		CreatedBy:   session.Principal.ID,
+		Created:     now,
+		Updated:     now,
+		Edited:      now,
+		State:       enum.IssueStateOpen,
+		Title:       in.Title,
+		Description: in.Description,
+		RepoID:      repo.ID,
+		Author:      *session.Principal.ToPrincipalInfo(),
+	}
+
+	err = c.issueStore.Create(ctx, issue)
+	if err != nil {
+		return nil, fmt.Errorf("issue creation failed: %w", err)
+	}
+
+	c.publishUpdate(ctx, repo, issue)
+
+	return issue, nil
+}
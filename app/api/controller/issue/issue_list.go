@@ -0,0 +1,101 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// List returns a list of issues from the provided repository.
+func (c *Controller) List(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	filter *types.IssueFilter,
+) ([]*types.Issue, int64, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	var list []*types.Issue
+	var count int64
+
+	filter.RepoID = repo.ID
+
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		list, err = c.issueStore.List(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list issues: %w", err)
+		}
+
+		err = c.backfill(ctx, list)
+		if err != nil {
+			return err
+		}
+
+		if filter.Page == 1 && len(list) < filter.Size {
+			count = int64(len(list))
+			return nil
+		}
+
+		count, err = c.issueStore.Count(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to count issues: %w", err)
+		}
+
+		return nil
+	}, dbtx.TxDefaultReadOnly)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return list, count, nil
+}
+
+// backfill sets the assignees and the assigned labels for every issue in the list.
+func (c *Controller) backfill(ctx context.Context, list []*types.Issue) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(list))
+	for i, issue := range list {
+		ids[i] = issue.ID
+	}
+
+	assigneeMap, err := c.assigneeStore.ListForIssues(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load assignees of issues: %w", err)
+	}
+
+	labelMap, err := c.issueLabelStore.ListLabelsForIssues(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load labels of issues: %w", err)
+	}
+
+	for _, issue := range list {
+		issue.Assignees = assigneeMap[issue.ID]
+		issue.Labels = labelMap[issue.ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type StateInput struct {
+	State   enum.IssueState `json:"state"`
+	Message string          `json:"message"`
+}
+
+// State opens or closes an issue.
+func (c *Controller) State(ctx context.Context,
+	session *auth.Session, repoRef string, issueNum int64, in *StateInput,
+) (*types.Issue, error) {
+	state, ok := in.State.Sanitize()
+	if !ok {
+		return nil, usererror.BadRequestf("Allowed states are: %s and %s",
+			enum.IssueStateOpen, enum.IssueStateClosed)
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	issue, err := c.issueStore.FindByNumber(ctx, repo.ID, issueNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find issue by number: %w", err)
+	}
+
+	if err = c.checkModifyAccess(ctx, session, repo, issue); err != nil {
+		return nil, err
+	}
+
+	if issue.State == state {
+		return issue, nil // no changes are necessary
+	}
+
+	oldState := issue.State
+
+	issue, err = c.issueStore.UpdateOptLock(ctx, issue, func(issue *types.Issue) error {
+		setState(issue, state, session.Principal.ID, time.Now().UnixMilli())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update issue: %w", err)
+	}
+
+	c.writeSystemActivity(ctx, session, issue, &types.IssueActivityPayloadStateChange{
+		Old:     oldState,
+		New:     issue.State,
+		Message: in.Message,
+	})
+
+	c.publishUpdate(ctx, repo, issue)
+
+	return issue, nil
+}
+
+// setState changes the state of the issue and sets the closer info accordingly.
+func setState(issue *types.Issue, state enum.IssueState, principalID int64, now int64) {
+	issue.State = state
+	issue.Edited = now
+
+	if state == enum.IssueStateClosed {
+		issue.ClosedBy = &principalID
+		issue.Closed = &now
+	} else {
+		issue.ClosedBy = nil
+		issue.Closed = nil
+		issue.Closer = nil
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type UpdateInput struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// Update updates the title and the description of an issue.
+func (c *Controller) Update(ctx context.Context,
+	session *auth.Session, repoRef string, issueNum int64, in *UpdateInput,
+) (*types.Issue, error) {
+	if err := (*CreateInput)(in).sanitize(); err != nil {
+		return nil, err
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	issue, err := c.issueStore.FindByNumber(ctx, repo.ID, issueNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find issue by number: %w", err)
+	}
+
+	if err = c.checkModifyAccess(ctx, session, repo, issue); err != nil {
+		return nil, err
+	}
+
+	if issue.Title == in.Title && issue.Description == in.Description {
+		return issue, nil
+	}
+
+	needToWriteActivity := in.Title != issue.Title
+	oldTitle := issue.Title
+
+	issue, err = c.issueStore.UpdateOptLock(ctx, issue, func(issue *types.Issue) error {
+		issue.Title = in.Title
+		issue.Description = in.Description
+		issue.Edited = time.Now().UnixMilli()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update issue: %w", err)
+	}
+
+	if needToWriteActivity {
+		c.writeSystemActivity(ctx, session, issue, &types.PullRequestActivityPayloadTitleChange{
+			Old: oldTitle,
+			New: issue.Title,
+		})
+	}
+
+	c.publishUpdate(ctx, repo, issue)
+
+	return issue, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type LabelAssignInput struct {
+	LabelID int64 `json:"label_id"`
+}
+
+// LabelAssign assigns a label to an issue.
+// Only labels defined in the repository or in any of its ancestor spaces can be assigned.
+func (c *Controller) LabelAssign(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	issueNum int64,
+	in *LabelAssignInput,
+) (*types.Label, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	issue, err := c.issueStore.FindByNumber(ctx, repo.ID, issueNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find issue by number: %w", err)
+	}
+
+	if err = c.checkModifyAccess(ctx, session, repo, issue); err != nil {
+		return nil, err
+	}
+
+	label, err := c.labelService.FindForRepo(ctx, repo, in.LabelID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, usererror.BadRequestf("Label %d doesn't exist or isn't available in the repository.", in.LabelID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.issueLabelStore.Assign(ctx, &types.IssueLabel{
+		IssueID:   issue.ID,
+		LabelID:   label.ID,
+		CreatedBy: session.Principal.ID,
+		Created:   time.Now().UnixMilli(),
+	})
+	if errors.Is(err, store.ErrDuplicate) {
+		return label, nil // no changes are necessary: the label is already assigned
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign label to issue: %w", err)
+	}
+
+	c.writeSystemActivity(ctx, session, issue, &types.PullRequestActivityPayloadLabel{
+		Type:    enum.PullReqLabelActivityTypeAssign,
+		LabelID: label.ID,
+		Label:   label.Name,
+		Color:   label.Color,
+	})
+
+	c.publishUpdate(ctx, repo, issue)
+
+	return label, nil
+}
+
+// LabelUnassign removes a label from an issue.
+func (c *Controller) LabelUnassign(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	issueNum int64,
+	labelID int64,
+) error {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	issue, err := c.issueStore.FindByNumber(ctx, repo.ID, issueNum)
+	if err != nil {
+		return fmt.Errorf("failed to find issue by number: %w", err)
+	}
+
+	if err = c.checkModifyAccess(ctx, session, repo, issue); err != nil {
+		return err
+	}
+
+	labels, err := c.issueLabelStore.ListLabels(ctx, issue.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list labels of issue: %w", err)
+	}
+
+	var label *types.Label
+	for _, l := range labels {
+		if l.ID == labelID {
+			label = l
+			break
+		}
+	}
+	if label == nil {
+		return usererror.NotFound("Label is not assigned to the issue")
+	}
+
+	err = c.issueLabelStore.Unassign(ctx, issue.ID, label.ID)
+	if err != nil {
+		return fmt.Errorf("failed to remove label from issue: %w", err)
+	}
+
+	c.writeSystemActivity(ctx, session, issue, &types.PullRequestActivityPayloadLabel{
+		Type:    enum.PullReqLabelActivityTypeUnassign,
+		LabelID: label.ID,
+		Label:   label.Name,
+		Color:   label.Color,
+	})
+
+	c.publishUpdate(ctx, repo, issue)
+
+	return nil
+}
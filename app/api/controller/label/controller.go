@@ -0,0 +1,158 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/label"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+const labelNameMaxLength = 50
+
+var labelColorRegex = regexp.MustCompile("^#[0-9a-f]{6}$")
+
+type Controller struct {
+	authorizer   authz.Authorizer
+	labelStore   store.LabelStore
+	repoStore    store.RepoStore
+	spaceStore   store.SpaceStore
+	labelService *label.Service
+}
+
+func NewController(
+	authorizer authz.Authorizer,
+	labelStore store.LabelStore,
+	repoStore store.RepoStore,
+	spaceStore store.SpaceStore,
+	labelService *label.Service,
+) *Controller {
+	return &Controller{
+		authorizer:   authorizer,
+		labelStore:   labelStore,
+		repoStore:    repoStore,
+		spaceStore:   spaceStore,
+		labelService: labelService,
+	}
+}
+
+// getParentCheckAccess fetches the repo or space the labels belong to
+// and checks if the current user has permission to access it. It returns the id of the parent.
+func (c *Controller) getParentCheckAccess(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.LabelParent,
+	parentRef string,
+	edit bool,
+) (int64, error) {
+	switch parentType {
+	case enum.LabelParentRepo:
+		if parentRef == "" {
+			return 0, usererror.BadRequest("A valid repository reference must be provided.")
+		}
+
+		repo, err := c.repoStore.FindByRef(ctx, parentRef)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find repo: %w", err)
+		}
+
+		permission := enum.PermissionRepoView
+		if edit {
+			permission = enum.PermissionRepoEdit
+		}
+
+		if err = apiauth.CheckRepo(ctx, c.authorizer, session, repo, permission, false); err != nil {
+			return 0, fmt.Errorf("failed to verify authorization: %w", err)
+		}
+
+		return repo.ID, nil
+
+	case enum.LabelParentSpace:
+		if parentRef == "" {
+			return 0, usererror.BadRequest("A valid space reference must be provided.")
+		}
+
+		space, err := c.spaceStore.FindByRef(ctx, parentRef)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find space: %w", err)
+		}
+
+		permission := enum.PermissionSpaceView
+		if edit {
+			permission = enum.PermissionSpaceEdit
+		}
+
+		if err = apiauth.CheckSpace(ctx, c.authorizer, session, space, permission, false); err != nil {
+			return 0, fmt.Errorf("failed to verify authorization: %w", err)
+		}
+
+		return space.ID, nil
+
+	default:
+		return 0, fmt.Errorf("label parent type '%s' is not supported", parentType)
+	}
+}
+
+// findLabel finds the label with the provided id that is defined directly in the repo or space.
+func (c *Controller) findLabel(
+	ctx context.Context,
+	parentType enum.LabelParent,
+	parentID int64,
+	labelID int64,
+) (*types.Label, error) {
+	label, err := c.labelStore.Find(ctx, labelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find label: %w", err)
+	}
+
+	if label.ParentType != parentType || label.ParentID != parentID {
+		return nil, usererror.ErrNotFound
+	}
+
+	return label, nil
+}
+
+func sanitizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", check.NewValidationError("Label name can't be empty.")
+	}
+	if utf8.RuneCountInString(name) > labelNameMaxLength {
+		return "", check.NewValidationErrorf("Label name can't be longer than %d characters.", labelNameMaxLength)
+	}
+
+	return name, nil
+}
+
+func sanitizeColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if !labelColorRegex.MatchString(color) {
+		return "", check.NewValidationError("Label color has to be a hex color code (e.g. #d73a4a).")
+	}
+
+	return color, nil
+}
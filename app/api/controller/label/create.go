@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+type CreateInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+}
+
+// Create creates a new label.
+func (c *Controller) Create(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.LabelParent,
+	parentRef string,
+	in *CreateInput,
+) (*types.Label, error) {
+	parentID, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sanitizeCreateInput(in); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	label := &types.Label{
+		ID:         0, // the ID will be populated in the data layer
+		ParentID:   parentID,
+		ParentType: parentType,
+		CreatedBy:  session.Principal.ID,
+		Created:    now,
+		Updated:    now,
+
+		// user input
+		Name:        in.Name,
+		Description: in.Description,
+		Color:       in.Color,
+	}
+
+	err = c.labelStore.Create(ctx, label)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create label: %w", err)
+	}
+
+	return label, nil
+}
+
+func sanitizeCreateInput(in *CreateInput) error {
+	var err error
+
+	if in.Name, err = sanitizeName(in.Name); err != nil {
+		return err
+	}
+	if err = check.Description(in.Description); err != nil {
+		return err
+	}
+	if in.Color, err = sanitizeColor(in.Color); err != nil {
+		return err
+	}
+
+	return nil
+}
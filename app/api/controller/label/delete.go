@@ -0,0 +1,44 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Delete deletes a label of the provided repo or space.
+// The label gets removed from all pull requests it's assigned to.
+func (c *Controller) Delete(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.LabelParent,
+	parentRef string,
+	labelID int64,
+) error {
+	parentID, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, true)
+	if err != nil {
+		return err
+	}
+
+	label, err := c.findLabel(ctx, parentType, parentID, labelID)
+	if err != nil {
+		return err
+	}
+
+	return c.labelStore.Delete(ctx, label.ID)
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// List returns the labels of the provided repo or space.
+func (c *Controller) List(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.LabelParent,
+	parentRef string,
+	filter *types.LabelFilter,
+) ([]*types.Label, int64, error) {
+	parentID, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, false)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	labels, count, err := c.labelService.List(ctx, parentType, parentID, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list labels for %s with id %d: %w", parentType, parentID, err)
+	}
+
+	return labels, count, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+type UpdateInput struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	Color       *string `json:"color"`
+}
+
+// Update updates a label of the provided repo or space.
+func (c *Controller) Update(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.LabelParent,
+	parentRef string,
+	labelID int64,
+	in *UpdateInput,
+) (*types.Label, error) {
+	parentID, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sanitizeUpdateInput(in); err != nil {
+		return nil, err
+	}
+
+	label, err := c.findLabel(ctx, parentType, parentID, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// update values only if provided
+	if in.Name != nil {
+		label.Name = *in.Name
+	}
+	if in.Description != nil {
+		label.Description = *in.Description
+	}
+	if in.Color != nil {
+		label.Color = *in.Color
+	}
+
+	label.Updated = time.Now().UnixMilli()
+
+	if err = c.labelStore.Update(ctx, label); err != nil {
+		return nil, fmt.Errorf("failed to update label: %w", err)
+	}
+
+	return label, nil
+}
+
+func sanitizeUpdateInput(in *UpdateInput) error {
+	if in.Name != nil {
+		name, err := sanitizeName(*in.Name)
+		if err != nil {
+			return err
+		}
+		in.Name = &name
+	}
+	if in.Description != nil {
+		if err := check.Description(*in.Description); err != nil {
+			return err
+		}
+	}
+	if in.Color != nil {
+		color, err := sanitizeColor(*in.Color)
+		if err != nil {
+			return err
+		}
+		in.Color = &color
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	OperationDownload = "download"
+	OperationUpload   = "upload"
+
+	TransferBasic  = "basic"
+	HashAlgoSHA256 = "sha256"
+)
+
+// Pointer identifies an LFS object.
+type Pointer struct {
+	OID  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+// BatchInput is the request of the LFS batch API.
+// See https://github.com/git-lfs/git-lfs/blob/main/docs/api/batch.md.
+type BatchInput struct {
+	Operation string    `json:"operation"`
+	Transfers []string  `json:"transfers,omitempty"`
+	Objects   []Pointer `json:"objects"`
+	HashAlgo  string    `json:"hash_algo,omitempty"`
+}
+
+// Action describes how the client can transfer an LFS object.
+type Action struct {
+	Href   string            `json:"href"`
+	Header map[string]string `json:"header,omitempty"`
+}
+
+// ObjectError describes why an LFS object can't be transferred.
+type ObjectError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ObjectResponse describes the actions available for an LFS object.
+type ObjectResponse struct {
+	Pointer
+	Authenticated bool               `json:"authenticated,omitempty"`
+	Actions       map[string]*Action `json:"actions,omitempty"`
+	Error         *ObjectError       `json:"error,omitempty"`
+}
+
+// BatchOutput is the response of the LFS batch API.
+type BatchOutput struct {
+	Transfer string           `json:"transfer"`
+	Objects  []ObjectResponse `json:"objects"`
+	HashAlgo string           `json:"hash_algo"`
+}
+
+// Batch returns the actions the client has to take to download or upload the requested LFS objects.
+// The provided header is added to all actions so the client can authenticate the object transfers.
+func (c *Controller) Batch(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	header map[string]string,
+	in *BatchInput,
+) (*BatchOutput, error) {
+	var permission enum.Permission
+	var orPublic bool
+	switch in.Operation {
+	case OperationDownload:
+		permission, orPublic = enum.PermissionRepoView, true
+	case OperationUpload:
+		permission, orPublic = enum.PermissionRepoPush, false
+	default:
+		return nil, usererror.BadRequestf("Unsupported LFS operation '%s'.", in.Operation)
+	}
+
+	if in.HashAlgo != "" && in.HashAlgo != HashAlgoSHA256 {
+		return nil, usererror.Newf(http.StatusConflict, "Unsupported hash algorithm '%s'.", in.HashAlgo)
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, permission, orPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	oids := make([]string, len(in.Objects))
+	for i, obj := range in.Objects {
+		oids[i] = obj.OID
+	}
+
+	existing, err := c.lfsObjectStore.FindMany(ctx, repo.ID, oids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find LFS objects: %w", err)
+	}
+
+	existingMap := make(map[string]*types.LFSObject, len(existing))
+	for _, obj := range existing {
+		existingMap[obj.OID] = obj
+	}
+
+	objectsURL := c.urlProvider.GenerateGITCloneURL(repo.Path) + "/info/lfs/objects/"
+
+	out := &BatchOutput{
+		Transfer: TransferBasic,
+		Objects:  make([]ObjectResponse, len(in.Objects)),
+		HashAlgo: HashAlgoSHA256,
+	}
+	for i, pointer := range in.Objects {
+		res := ObjectResponse{Pointer: pointer}
+		obj, exists := existingMap[pointer.OID]
+
+		switch {
+		case checkOID(pointer.OID) != nil || pointer.Size < 0:
+			res.Error = &ObjectError{Code: http.StatusUnprocessableEntity, Message: "Invalid object."}
+		case in.Operation == OperationUpload && pointer.Size > c.maxObjectSize:
+			res.Error = &ObjectError{Code: http.StatusUnprocessableEntity, Message: fmt.Sprintf(
+				"Object is larger than the maximum size of %d bytes.", c.maxObjectSize)}
+		case in.Operation == OperationDownload && !exists:
+			res.Error = &ObjectError{Code: http.StatusNotFound, Message: "Object does not exist."}
+		case in.Operation == OperationDownload:
+			res.Size = obj.Size
+			res.Authenticated = true
+			res.Actions = map[string]*Action{
+				OperationDownload: {Href: objectsURL + pointer.OID, Header: header},
+			}
+		case exists:
+			// objects that already exist don't have to be uploaded again.
+		default:
+			// the upload is verified against the size of the pointer, hence it's part of the href.
+			res.Authenticated = true
+			res.Actions = map[string]*Action{
+				OperationUpload: {
+					Href:   objectsURL + pointer.OID + "?size=" + strconv.FormatInt(pointer.Size, 10),
+					Header: header,
+				},
+			}
+		}
+
+		out.Objects[i] = res
+	}
+
+	return out, nil
+}
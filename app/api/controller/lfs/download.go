@@ -0,0 +1,54 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Download returns the details and the content of an LFS object of a repository.
+func (c *Controller) Download(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	oid string,
+) (*types.LFSObject, io.ReadCloser, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = checkOID(oid); err != nil {
+		return nil, nil, err
+	}
+
+	obj, err := c.lfsObjectStore.Find(ctx, repo.ID, oid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find LFS object: %w", err)
+	}
+
+	content, err := c.contentStore.Find(ctx, repo.ID, oid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read LFS object content: %w", err)
+	}
+
+	return obj, content, nil
+}
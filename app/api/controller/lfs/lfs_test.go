@@ -0,0 +1,406 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/app/api/controller/controllertest"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/store/lfs"
+	"github.com/harness/gitness/app/url"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	// oidFoo and oidBar are the sha256 hashes of the contents "foo" and "bar".
+	oidFoo = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	oidBar = "fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9"
+
+	testMaxObjectSize = 10
+	testObjectsURL    = "https://git.example.com/space/repo.git/info/lfs/objects/"
+)
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		operation   string
+		pointer     Pointer
+		permissions []enum.Permission
+		expErr      bool
+		expHref     string
+		expCode     int
+	}{
+		{
+			name:        "download existing object",
+			operation:   OperationDownload,
+			pointer:     Pointer{OID: oidFoo, Size: 3},
+			permissions: []enum.Permission{enum.PermissionRepoView},
+			expHref:     testObjectsURL + oidFoo,
+		},
+		{
+			name:        "download missing object",
+			operation:   OperationDownload,
+			pointer:     Pointer{OID: oidBar, Size: 3},
+			permissions: []enum.Permission{enum.PermissionRepoView},
+			expCode:     http.StatusNotFound,
+		},
+		{
+			name:      "download without permission",
+			operation: OperationDownload,
+			pointer:   Pointer{OID: oidFoo, Size: 3},
+			expErr:    true,
+		},
+		{
+			name:        "upload new object",
+			operation:   OperationUpload,
+			pointer:     Pointer{OID: oidBar, Size: 3},
+			permissions: []enum.Permission{enum.PermissionRepoPush},
+			expHref:     testObjectsURL + oidBar + "?size=3",
+		},
+		{
+			name:        "upload existing object",
+			operation:   OperationUpload,
+			pointer:     Pointer{OID: oidFoo, Size: 3},
+			permissions: []enum.Permission{enum.PermissionRepoPush},
+		},
+		{
+			name:        "upload too large object",
+			operation:   OperationUpload,
+			pointer:     Pointer{OID: oidBar, Size: testMaxObjectSize + 1},
+			permissions: []enum.Permission{enum.PermissionRepoPush},
+			expCode:     http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "upload invalid object",
+			operation:   OperationUpload,
+			pointer:     Pointer{OID: "../../etc/passwd", Size: 3},
+			permissions: []enum.Permission{enum.PermissionRepoPush},
+			expCode:     http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "upload without permission",
+			operation:   OperationUpload,
+			pointer:     Pointer{OID: oidBar, Size: 3},
+			permissions: []enum.Permission{enum.PermissionRepoView},
+			expErr:      true,
+		},
+		{
+			name:        "unsupported operation",
+			operation:   "verify",
+			pointer:     Pointer{OID: oidFoo, Size: 3},
+			permissions: []enum.Permission{enum.PermissionRepoView, enum.PermissionRepoPush},
+			expErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, _ := testController(t, test.permissions...)
+			storeObject(t, c, oidFoo, "foo")
+
+			out, err := c.Batch(context.Background(), controllertest.Session(), controllertest.RepoRef, nil, &BatchInput{
+				Operation: test.operation,
+				Objects:   []Pointer{test.pointer},
+			})
+			if test.expErr {
+				if err == nil {
+					t.Errorf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			obj := out.Objects[0]
+			if test.expCode != 0 {
+				if obj.Error == nil || obj.Error.Code != test.expCode {
+					t.Errorf("expected error code %d, got %+v", test.expCode, obj.Error)
+				}
+				return
+			}
+			if obj.Error != nil {
+				t.Fatalf("unexpected object error: %+v", obj.Error)
+			}
+
+			var href string
+			if action := obj.Actions[test.operation]; action != nil {
+				href = action.Href
+			}
+			if href != test.expHref {
+				t.Errorf("expected href %q, got %q", test.expHref, href)
+			}
+		})
+	}
+}
+
+func TestUpload(t *testing.T) {
+	tests := []struct {
+		name       string
+		oid        string
+		size       int64
+		content    string
+		expStatus  int
+		expTracked bool
+	}{
+		{
+			name:       "valid",
+			oid:        oidFoo,
+			size:       3,
+			content:    "foo",
+			expTracked: true,
+		},
+		{
+			name:      "content doesn't match oid",
+			oid:       oidFoo,
+			size:      3,
+			content:   "bar",
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "content smaller than pointer",
+			oid:       oidFoo,
+			size:      4,
+			content:   "foo",
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "content larger than pointer",
+			oid:       oidFoo,
+			size:      2,
+			content:   "foo",
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "pointer larger than maximum size",
+			oid:       oidFoo,
+			size:      testMaxObjectSize + 1,
+			content:   "foo",
+			expStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:      "invalid oid",
+			oid:       "../" + oidFoo,
+			size:      3,
+			content:   "foo",
+			expStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, root := testController(t, enum.PermissionRepoPush, enum.PermissionRepoView)
+
+			err := c.Upload(context.Background(), controllertest.Session(), controllertest.RepoRef, test.oid, test.size,
+				strings.NewReader(test.content))
+			if test.expStatus != 0 {
+				controllertest.ExpectStatus(t, err, test.expStatus)
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, err = c.lfsObjectStore.Find(context.Background(), controllertest.RepoID, test.oid)
+			if tracked := err == nil; tracked != test.expTracked {
+				t.Errorf("expected object to be tracked: %t, got %t", test.expTracked, tracked)
+			}
+
+			if test.expTracked {
+				expectDownload(t, c, test.oid, test.content)
+			}
+
+			expectNoTempObjects(t, root)
+		})
+	}
+}
+
+// TestUploadMismatchKeepsExistingContent verifies that an upload with mismatching content
+// never touches content that a concurrent upload already moved into place.
+func TestUploadMismatchKeepsExistingContent(t *testing.T) {
+	c, root := testController(t, enum.PermissionRepoPush, enum.PermissionRepoView)
+	ctx := context.Background()
+
+	tmpKey, err := c.contentStore.CreateTemp(ctx, strings.NewReader("foo"))
+	if err != nil {
+		t.Fatalf("failed to create temporary object: %v", err)
+	}
+	if err = c.contentStore.Move(ctx, tmpKey, controllertest.RepoID, oidFoo); err != nil {
+		t.Fatalf("failed to move object into place: %v", err)
+	}
+
+	err = c.Upload(ctx, controllertest.Session(), controllertest.RepoRef, oidFoo, 3, strings.NewReader("bar"))
+	controllertest.ExpectStatus(t, err, http.StatusBadRequest)
+
+	content, err := c.contentStore.Find(ctx, controllertest.RepoID, oidFoo)
+	if err != nil {
+		t.Fatalf("expected existing content to be kept, got %v", err)
+	}
+	defer content.Close()
+
+	data, _ := io.ReadAll(content)
+	if string(data) != "foo" {
+		t.Errorf("expected existing content %q, got %q", "foo", string(data))
+	}
+
+	expectNoTempObjects(t, root)
+}
+
+func TestUploadWithoutPermission(t *testing.T) {
+	c, _ := testController(t, enum.PermissionRepoView)
+
+	err := c.Upload(context.Background(), controllertest.Session(), controllertest.RepoRef,
+		oidFoo, 3, strings.NewReader("foo"))
+	if err == nil {
+		t.Fatalf("expected an error but got none")
+	}
+
+	if _, err = c.lfsObjectStore.Find(context.Background(), controllertest.RepoID, oidFoo); err == nil {
+		t.Errorf("expected object not to be tracked")
+	}
+}
+
+func TestDownload(t *testing.T) {
+	c, _ := testController(t, enum.PermissionRepoView)
+	storeObject(t, c, oidFoo, "foo")
+
+	expectDownload(t, c, oidFoo, "foo")
+
+	_, _, err := c.Download(context.Background(), controllertest.Session(), controllertest.RepoRef, oidBar)
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		t.Errorf("expected not found for missing object, got %v", err)
+	}
+
+	_, _, err = c.Download(context.Background(), controllertest.Session(), controllertest.RepoRef, "invalid")
+	controllertest.ExpectStatus(t, err, http.StatusBadRequest)
+}
+
+func testController(t *testing.T, permissions ...enum.Permission) (*Controller, string) {
+	root := t.TempDir()
+
+	c := NewController(
+		controllertest.NewAuthorizer(permissions...),
+		&controllertest.RepoStore{Repo: controllertest.Repo()},
+		&fakeLFSObjectStore{objects: map[string]*types.LFSObject{}},
+		lfs.NewLocalContentStore(root),
+		&fakeURLProvider{},
+		testMaxObjectSize,
+	)
+
+	return c, root
+}
+
+// storeObject stores the content and tracks the object, as a completed upload would.
+func storeObject(t *testing.T, c *Controller, oid string, content string) {
+	t.Helper()
+	ctx := context.Background()
+
+	tmpKey, err := c.contentStore.CreateTemp(ctx, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("failed to create temporary object: %v", err)
+	}
+	if err = c.contentStore.Move(ctx, tmpKey, controllertest.RepoID, oid); err != nil {
+		t.Fatalf("failed to move object into place: %v", err)
+	}
+
+	err = c.lfsObjectStore.Create(ctx, &types.LFSObject{
+		RepoID: controllertest.RepoID,
+		OID:    oid,
+		Size:   int64(len(content)),
+	})
+	if err != nil {
+		t.Fatalf("failed to track object: %v", err)
+	}
+}
+
+func expectDownload(t *testing.T, c *Controller, oid string, exp string) {
+	t.Helper()
+
+	obj, content, err := c.Download(context.Background(), controllertest.Session(), controllertest.RepoRef, oid)
+	if err != nil {
+		t.Fatalf("failed to download object: %v", err)
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		t.Fatalf("failed to read object: %v", err)
+	}
+	if string(data) != exp || obj.Size != int64(len(exp)) {
+		t.Errorf("expected content %q with size %d, got %q with size %d", exp, len(exp), string(data), obj.Size)
+	}
+}
+
+func expectNoTempObjects(t *testing.T, root string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(filepath.Join(root, "tmp"))
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		t.Fatalf("failed to read directory of temporary objects: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no temporary objects, got %d", len(entries))
+	}
+}
+
+type fakeLFSObjectStore struct {
+	store.LFSObjectStore
+	objects map[string]*types.LFSObject
+}
+
+func (f *fakeLFSObjectStore) Find(_ context.Context, _ int64, oid string) (*types.LFSObject, error) {
+	obj, ok := f.objects[oid]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return obj, nil
+}
+
+func (f *fakeLFSObjectStore) FindMany(_ context.Context, _ int64, oids []string) ([]*types.LFSObject, error) {
+	var objects []*types.LFSObject
+	for _, oid := range oids {
+		if obj, ok := f.objects[oid]; ok {
+			objects = append(objects, obj)
+		}
+	}
+	return objects, nil
+}
+
+func (f *fakeLFSObjectStore) Create(_ context.Context, obj *types.LFSObject) error {
+	if _, ok := f.objects[obj.OID]; ok {
+		return gitness_store.ErrDuplicate
+	}
+	f.objects[obj.OID] = obj
+	return nil
+}
+
+type fakeURLProvider struct {
+	url.Provider
+}
+
+func (f *fakeURLProvider) GenerateGITCloneURL(repoPath string) string {
+	return "https://git.example.com/" + repoPath + ".git"
+}
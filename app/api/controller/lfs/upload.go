@@ -0,0 +1,139 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Upload stores the content of an LFS object of a repository.
+// The content is verified against the oid and the size of the pointer before it's moved into place
+// and the object is tracked for the repository - content that fails verification is never served.
+func (c *Controller) Upload(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	oid string,
+	size int64,
+	content io.Reader,
+) error {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush, false)
+	if err != nil {
+		return err
+	}
+
+	if err = checkOID(oid); err != nil {
+		return err
+	}
+
+	if size < 0 {
+		return usererror.BadRequest("The size of the LFS object can't be negative.")
+	}
+	if size > c.maxObjectSize {
+		return usererror.Newf(http.StatusRequestEntityTooLarge,
+			"The LFS object is larger than the maximum size of %d bytes.", c.maxObjectSize)
+	}
+
+	_, err = c.lfsObjectStore.Find(ctx, repo.ID, oid)
+	if err == nil {
+		// the object already exists, no need to store it again.
+		return nil
+	}
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return fmt.Errorf("failed to find LFS object: %w", err)
+	}
+
+	// read at most one byte more than expected, that's enough to detect content that is too large.
+	hash := sha256.New()
+	counter := &countingReader{r: io.TeeReader(io.LimitReader(content, size+1), hash)}
+
+	tmpKey, err := c.contentStore.CreateTemp(ctx, counter)
+	if err != nil {
+		return fmt.Errorf("failed to store LFS object: %w", err)
+	}
+
+	err = c.verifyAndMove(ctx, tmpKey, repo.ID, oid, size, counter.n, hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		if dErr := c.contentStore.DeleteTemp(ctx, tmpKey); dErr != nil {
+			log.Ctx(ctx).Warn().Err(dErr).Msgf("failed to delete temporary LFS object %s", tmpKey)
+		}
+		return err
+	}
+
+	err = c.lfsObjectStore.Create(ctx, &types.LFSObject{
+		RepoID:    repo.ID,
+		OID:       oid,
+		Size:      size,
+		Created:   time.Now().UnixMilli(),
+		CreatedBy: session.Principal.ID,
+	})
+	if err != nil && !errors.Is(err, gitness_store.ErrDuplicate) {
+		return fmt.Errorf("failed to create LFS object: %w", err)
+	}
+
+	return nil
+}
+
+// verifyAndMove moves the temporary object into place in case its size and hash match the pointer.
+func (c *Controller) verifyAndMove(
+	ctx context.Context,
+	tmpKey string,
+	repoID int64,
+	oid string,
+	expectedSize int64,
+	size int64,
+	hash string,
+) error {
+	if size != expectedSize {
+		return usererror.BadRequest("The size of the LFS object doesn't match the size of its pointer.")
+	}
+
+	if hash != oid {
+		return usererror.BadRequest("The content of the LFS object doesn't match its object ID.")
+	}
+
+	if err := c.contentStore.Move(ctx, tmpKey, repoID, oid); err != nil {
+		return fmt.Errorf("failed to move LFS object into place: %w", err)
+	}
+
+	return nil
+}
+
+// countingReader counts the number of bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -16,6 +16,7 @@ package pipeline
 
 import (
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types/check"
 )
@@ -27,6 +28,7 @@ type Controller struct {
 	triggerStore  store.TriggerStore
 	authorizer    authz.Authorizer
 	pipelineStore store.PipelineStore
+	cron          *trigger.Cron
 }
 
 func NewController(
@@ -35,6 +37,7 @@ func NewController(
 	repoStore store.RepoStore,
 	triggerStore store.TriggerStore,
 	pipelineStore store.PipelineStore,
+	cron *trigger.Cron,
 ) *Controller {
 	return &Controller{
 		uidCheck:      uidCheck,
@@ -42,5 +45,6 @@ func NewController(
 		triggerStore:  triggerStore,
 		authorizer:    authorizer,
 		pipelineStore: pipelineStore,
+		cron:          cron,
 	}
 }
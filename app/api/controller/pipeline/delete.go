@@ -34,6 +34,17 @@ func (c *Controller) Delete(ctx context.Context, session *auth.Session, repoRef
 		return fmt.Errorf("failed to authorize pipeline: %w", err)
 	}
 
+	pipeline, err := c.pipelineStore.FindByUID(ctx, repo.ID, uid)
+	if err != nil {
+		return fmt.Errorf("failed to find pipeline: %w", err)
+	}
+
+	// triggers are removed together with the pipeline, so their scheduled executions need to be removed too.
+	err = c.cron.UnschedulePipeline(ctx, pipeline.ID)
+	if err != nil {
+		return fmt.Errorf("failed to unschedule pipeline triggers: %w", err)
+	}
+
 	err = c.pipelineStore.DeleteByUID(ctx, repo.ID, uid)
 	if err != nil {
 		return fmt.Errorf("could not delete pipeline: %w", err)
@@ -16,6 +16,7 @@ package pipeline
 
 import (
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types/check"
 
@@ -33,7 +34,8 @@ func ProvideController(
 	triggerStore store.TriggerStore,
 	authorizer authz.Authorizer,
 	pipelineStore store.PipelineStore,
+	cron *trigger.Cron,
 ) *Controller {
 	return NewController(uidCheck, authorizer,
-		repoStore, triggerStore, pipelineStore)
+		repoStore, triggerStore, pipelineStore, cron)
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/store"
 	"github.com/harness/gitness/types"
@@ -89,7 +90,7 @@ func (c *Controller) CommentCreate(
 	prNum int64,
 	in *CommentCreateInput,
 ) (*types.PullReqActivity, error) {
-	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
 	}
@@ -193,6 +194,11 @@ func (c *Controller) CommentCreate(
 		log.Ctx(ctx).Warn().Msg("failed to publish PR changed event")
 	}
 
+	c.eventReporter.CommentCreated(ctx, &pullreqevents.CommentCreatedPayload{
+		Base:       eventBase(pr, &session.Principal),
+		ActivityID: act.ID,
+	})
+
 	return act, nil
 }
 
@@ -34,7 +34,7 @@ func (c *Controller) CommentDelete(
 	prNum int64,
 	commentID int64,
 ) error {
-	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
 	if err != nil {
 		return fmt.Errorf("failed to acquire access to repo: %w", err)
 	}
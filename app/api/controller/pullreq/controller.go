@@ -24,13 +24,15 @@ import (
 	"github.com/harness/gitness/app/auth/authz"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/services/codecomments"
+	"github.com/harness/gitness/app/services/label"
+	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/services/pullreq"
+	"github.com/harness/gitness/app/services/signing"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/gitrpc"
 	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
-	"github.com/harness/gitness/lock"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
@@ -50,10 +52,13 @@ type Controller struct {
 	fileViewStore       store.PullReqFileViewStore
 	gitRPCClient        gitrpc.Interface
 	eventReporter       *pullreqevents.Reporter
-	mtxManager          lock.MutexManager
 	codeCommentMigrator *codecomments.Migrator
 	pullreqService      *pullreq.Service
 	sseStreamer         sse.Streamer
+	protectionManager   *protection.Manager
+	signatureVerifier   *signing.Verifier
+	labelService        *label.Service
+	pullReqLabelStore   store.PullReqLabelStore
 }
 
 func NewController(
@@ -70,10 +75,13 @@ func NewController(
 	fileViewStore store.PullReqFileViewStore,
 	gitRPCClient gitrpc.Interface,
 	eventReporter *pullreqevents.Reporter,
-	mtxManager lock.MutexManager,
 	codeCommentMigrator *codecomments.Migrator,
 	pullreqService *pullreq.Service,
 	sseStreamer sse.Streamer,
+	protectionManager *protection.Manager,
+	signatureVerifier *signing.Verifier,
+	labelService *label.Service,
+	pullReqLabelStore store.PullReqLabelStore,
 ) *Controller {
 	return &Controller{
 		tx:                  tx,
@@ -90,9 +98,12 @@ func NewController(
 		gitRPCClient:        gitRPCClient,
 		codeCommentMigrator: codeCommentMigrator,
 		eventReporter:       eventReporter,
-		mtxManager:          mtxManager,
 		pullreqService:      pullreqService,
 		sseStreamer:         sseStreamer,
+		protectionManager:   protectionManager,
+		signatureVerifier:   signatureVerifier,
+		labelService:        labelService,
+		pullReqLabelStore:   pullReqLabelStore,
 	}
 }
 
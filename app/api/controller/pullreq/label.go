@@ -0,0 +1,195 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type LabelAssignInput struct {
+	LabelID int64 `json:"label_id"`
+}
+
+// LabelAssign assigns a label to a pull request.
+// Only labels defined in the repository or in any of its ancestor spaces can be assigned.
+func (c *Controller) LabelAssign(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	in *LabelAssignInput,
+) (*types.Label, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	label, err := c.labelService.FindForRepo(ctx, repo, in.LabelID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, usererror.BadRequestf("Label %d doesn't exist or isn't available in the repository.", in.LabelID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.pullReqLabelStore.Assign(ctx, &types.PullReqLabel{
+		PullReqID: pr.ID,
+		LabelID:   label.ID,
+		CreatedBy: session.Principal.ID,
+		Created:   time.Now().UnixMilli(),
+	})
+	if errors.Is(err, store.ErrDuplicate) {
+		return label, nil // no changes are necessary: the label is already assigned
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign label to pull request: %w", err)
+	}
+
+	c.writeLabelActivity(ctx, session, repo, pr, label, enum.PullReqLabelActivityTypeAssign)
+
+	c.eventReporter.LabelAdded(ctx, &pullreqevents.LabelAddedPayload{
+		Base:    eventBase(pr, &session.Principal),
+		LabelID: label.ID,
+	})
+
+	return label, nil
+}
+
+// LabelUnassign removes a label from a pull request.
+func (c *Controller) LabelUnassign(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	labelID int64,
+) error {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	if err != nil {
+		return fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	labels, err := c.pullReqLabelStore.ListLabels(ctx, pr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list labels of pull request: %w", err)
+	}
+
+	var label *types.Label
+	for _, l := range labels {
+		if l.ID == labelID {
+			label = l
+			break
+		}
+	}
+	if label == nil {
+		return usererror.NotFound("Label is not assigned to the pull request")
+	}
+
+	err = c.pullReqLabelStore.Unassign(ctx, pr.ID, label.ID)
+	if err != nil {
+		return fmt.Errorf("failed to remove label from pull request: %w", err)
+	}
+
+	c.writeLabelActivity(ctx, session, repo, pr, label, enum.PullReqLabelActivityTypeUnassign)
+
+	c.eventReporter.LabelRemoved(ctx, &pullreqevents.LabelRemovedPayload{
+		Base:    eventBase(pr, &session.Principal),
+		LabelID: label.ID,
+	})
+
+	return nil
+}
+
+// LabelList returns the labels assigned to a pull request.
+func (c *Controller) LabelList(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+) ([]*types.Label, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	labels, err := c.pullReqLabelStore.ListLabels(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels of pull request: %w", err)
+	}
+
+	if labels == nil {
+		labels = []*types.Label{}
+	}
+
+	return labels, nil
+}
+
+// writeLabelActivity adds the activity entry for a label change of the pull request.
+func (c *Controller) writeLabelActivity(
+	ctx context.Context,
+	session *auth.Session,
+	repo *types.Repository,
+	pr *types.PullReq,
+	label *types.Label,
+	activityType enum.PullReqLabelActivityType,
+) {
+	pr, err := c.pullreqStore.UpdateActivitySeq(ctx, pr)
+	if err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msgf("failed to update activity sequence of pull request after label change")
+		return
+	}
+
+	payload := &types.PullRequestActivityPayloadLabel{
+		Type:    activityType,
+		LabelID: label.ID,
+		Label:   label.Name,
+		Color:   label.Color,
+	}
+	if _, errAct := c.activityStore.CreateWithPayload(ctx, pr, session.Principal.ID, payload); errAct != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(errAct).Msgf("failed to write pull request activity after label change")
+	}
+
+	if err = c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullrequesUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Msg("failed to publish PR changed event")
+	}
+}
@@ -58,6 +58,7 @@ func (c *Controller) Commits(
 	}
 
 	commits := make([]types.Commit, len(rpcOut.Commits))
+	commitRefs := make([]*types.Commit, len(rpcOut.Commits))
 	for i := range rpcOut.Commits {
 		var commit *types.Commit
 		commit, err = controller.MapCommit(&rpcOut.Commits[i])
@@ -65,6 +66,11 @@ func (c *Controller) Commits(
 			return nil, fmt.Errorf("failed to map commit: %w", err)
 		}
 		commits[i] = *commit
+		commitRefs[i] = &commits[i]
+	}
+
+	if err = c.signatureVerifier.VerifyCommits(ctx, commitRefs...); err != nil {
+		return nil, err
 	}
 
 	return commits, nil
@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
@@ -53,7 +54,7 @@ func (c *Controller) Create(
 		return nil, usererror.BadRequest("pull request title can't be empty")
 	}
 
-	targetRepo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	targetRepo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire access access to target repo: %w", err)
 	}
@@ -66,17 +67,26 @@ func (c *Controller) Create(
 		}
 	}
 
+	// contributing from a fork requires push access to the fork only.
+	if err = apiauth.CheckRepo(ctx, c.authorizer, session, sourceRepo, enum.PermissionRepoPush, false); err != nil {
+		return nil, fmt.Errorf("failed to acquire push access to source repo: %w", err)
+	}
+
 	if sourceRepo.ID == targetRepo.ID && in.TargetBranch == in.SourceBranch {
 		return nil, usererror.BadRequest("target and source branch can't be the same")
 	}
 
-	var sourceSHA string
+	if sourceRepo.ID != targetRepo.ID && sourceRepo.ForkID != targetRepo.ID {
+		return nil, usererror.BadRequest("source repository has to be a fork of the target repository")
+	}
+
+	var sourceSHA, targetSHA string
 
 	if sourceSHA, err = c.verifyBranchExistence(ctx, sourceRepo, in.SourceBranch); err != nil {
 		return nil, err
 	}
 
-	if _, err = c.verifyBranchExistence(ctx, targetRepo, in.TargetBranch); err != nil {
+	if targetSHA, err = c.verifyBranchExistence(ctx, targetRepo, in.TargetBranch); err != nil {
 		return nil, err
 	}
 
@@ -84,10 +94,11 @@ func (c *Controller) Create(
 		return nil, err
 	}
 
+	// a fork has access to all objects of its upstream, so the merge base is calculated in the source repository.
 	mergeBaseResult, err := c.gitRPCClient.MergeBase(ctx, gitrpc.MergeBaseParams{
 		ReadParams: gitrpc.ReadParams{RepoUID: sourceRepo.GitUID},
-		Ref1:       in.SourceBranch,
-		Ref2:       in.TargetBranch,
+		Ref1:       sourceSHA,
+		Ref2:       targetSHA,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to find merge base: %w", err)
@@ -153,6 +164,7 @@ func newPullReq(
 		SourceRepoID:     sourceRepo.ID,
 		SourceBranch:     in.SourceBranch,
 		SourceSHA:        sourceSHA,
+		SourcePusher:     session.Principal.ID, // the author is considered the pusher until the branch is updated
 		TargetRepoID:     targetRepo.ID,
 		TargetBranch:     in.TargetBranch,
 		ActivitySeq:      0,
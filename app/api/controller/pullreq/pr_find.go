@@ -61,5 +61,23 @@ func (c *Controller) Find(
 	pr.Stats.DiffStats.Commits = output.Commits
 	pr.Stats.DiffStats.FilesChanged = output.FilesChanged
 
+	pr.Labels, err = c.pullReqLabelStore.ListLabels(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load list of labels: %w", err)
+	}
+
+	if pr.State == enum.PullReqStateOpen {
+		var reviewers []*types.PullReqReviewer
+		reviewers, err = c.reviewerStore.List(ctx, pr.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load list of reviewers: %w", err)
+		}
+
+		pr.UnmetRequirements, err = c.protectionManager.VerifyMerge(ctx, repo, session.Principal.ID, pr, reviewers)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify merge requirements: %w", err)
+		}
+	}
+
 	return pr, nil
 }
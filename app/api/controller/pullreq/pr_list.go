@@ -58,6 +58,11 @@ func (c *Controller) List(
 			return fmt.Errorf("failed to list pull requests: %w", err)
 		}
 
+		err = c.backfillLabels(ctx, list)
+		if err != nil {
+			return err
+		}
+
 		if filter.Page == 1 && len(list) < filter.Size {
 			count = int64(len(list))
 			return nil
@@ -76,3 +81,26 @@ func (c *Controller) List(
 
 	return list, count, nil
 }
+
+// backfillLabels sets the assigned labels for every pull request in the list.
+func (c *Controller) backfillLabels(ctx context.Context, list []*types.PullReq) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(list))
+	for i, pr := range list {
+		ids[i] = pr.ID
+	}
+
+	labelMap, err := c.pullReqLabelStore.ListLabelsForPullReqs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load labels of pull requests: %w", err)
+	}
+
+	for _, pr := range list {
+		pr.Labels = labelMap[pr.ID]
+	}
+
+	return nil
+}
@@ -116,7 +116,8 @@ func (c *Controller) State(ctx context.Context,
 			return nil, err
 		}
 
-		if _, err = c.verifyBranchExistence(ctx, targetRepo, pr.TargetBranch); err != nil {
+		var targetSHA string
+		if targetSHA, err = c.verifyBranchExistence(ctx, targetRepo, pr.TargetBranch); err != nil {
 			return nil, err
 		}
 
@@ -129,8 +130,8 @@ func (c *Controller) State(ctx context.Context,
 
 		mergeBaseResult, err = c.gitRPCClient.MergeBase(ctx, gitrpc.MergeBaseParams{
 			ReadParams: gitrpc.ReadParams{RepoUID: sourceRepo.GitUID},
-			Ref1:       pr.SourceBranch,
-			Ref2:       pr.TargetBranch,
+			Ref1:       sourceSHA,
+			Ref2:       targetSHA,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to find merge base: %w", err)
@@ -154,6 +155,8 @@ func (c *Controller) State(ctx context.Context,
 			pr.MergeCheckStatus = enum.MergeCheckStatusUnchecked
 			pr.MergeSHA = nil
 			pr.MergeConflicts = nil
+			pr.AutoMergeBy = nil
+			pr.AutoMergeMethod = nil
 		case changeReopen:
 			pr.SourceSHA = sourceSHA
 			pr.MergeBaseSHA = mergeBaseSHA
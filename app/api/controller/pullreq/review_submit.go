@@ -23,6 +23,7 @@ import (
 
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/store"
 	"github.com/harness/gitness/types"
@@ -71,7 +72,7 @@ func (c *Controller) ReviewSubmit(
 		return nil, err
 	}
 
-	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
 	}
@@ -139,6 +140,13 @@ func (c *Controller) ReviewSubmit(
 		log.Ctx(ctx).Err(err).Msgf("failed to write pull request activity after review submit")
 	}
 
+	c.eventReporter.ReviewSubmitted(ctx, &pullreqevents.ReviewSubmittedPayload{
+		Base:       eventBase(pr, &session.Principal),
+		ReviewerID: session.Principal.ID,
+		Decision:   review.Decision,
+		CommitSHA:  commitSHA,
+	})
+
 	return review, nil
 }
 
@@ -23,6 +23,7 @@ import (
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/store"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
@@ -93,6 +94,7 @@ func (c *Controller) ReviewerAdd(
 	}
 
 	var reviewer *types.PullReqReviewer
+	var added bool
 
 	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
 		reviewer, err = c.reviewerStore.Find(ctx, pr.ID, in.ReviewerID)
@@ -105,6 +107,7 @@ func (c *Controller) ReviewerAdd(
 		}
 
 		reviewer = newPullReqReviewer(session, pr, repo, reviewerInfo, addedByInfo, reviewerType, in)
+		added = true
 
 		return c.reviewerStore.Create(ctx, reviewer)
 	})
@@ -112,6 +115,13 @@ func (c *Controller) ReviewerAdd(
 		return nil, fmt.Errorf("failed to create pull request reviewer: %w", err)
 	}
 
+	if added {
+		c.eventReporter.ReviewerAdded(ctx, &pullreqevents.ReviewerAddedPayload{
+			Base:       eventBase(pr, &session.Principal),
+			ReviewerID: in.ReviewerID,
+		})
+	}
+
 	return reviewer, err
 }
 
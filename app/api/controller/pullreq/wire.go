@@ -18,12 +18,14 @@ import (
 	"github.com/harness/gitness/app/auth/authz"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/services/codecomments"
+	"github.com/harness/gitness/app/services/label"
+	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/services/pullreq"
+	"github.com/harness/gitness/app/services/signing"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/gitrpc"
-	"github.com/harness/gitness/lock"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/wire"
@@ -40,8 +42,10 @@ func ProvideController(tx dbtx.Transactor, urlProvider url.Provider, authorizer
 	pullReqReviewStore store.PullReqReviewStore, pullReqReviewerStore store.PullReqReviewerStore,
 	repoStore store.RepoStore, principalStore store.PrincipalStore, fileViewStore store.PullReqFileViewStore,
 	rpcClient gitrpc.Interface, eventReporter *pullreqevents.Reporter,
-	mtxManager lock.MutexManager, codeCommentMigrator *codecomments.Migrator,
+	codeCommentMigrator *codecomments.Migrator,
 	pullreqService *pullreq.Service, sseStreamer sse.Streamer,
+	protectionManager *protection.Manager, signatureVerifier *signing.Verifier,
+	labelService *label.Service, pullReqLabelStore store.PullReqLabelStore,
 ) *Controller {
 	return NewController(tx, urlProvider, authorizer,
 		pullReqStore, pullReqActivityStore,
@@ -49,5 +53,7 @@ func ProvideController(tx dbtx.Transactor, urlProvider url.Provider, authorizer
 		pullReqReviewStore, pullReqReviewerStore,
 		repoStore, principalStore, fileViewStore,
 		rpcClient, eventReporter,
-		mtxManager, codeCommentMigrator, pullreqService, sseStreamer)
+		codeCommentMigrator, pullreqService, sseStreamer,
+		protectionManager, signatureVerifier,
+		labelService, pullReqLabelStore)
 }
@@ -0,0 +1,67 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/types/enum"
+)
+
+type ArchiveOutput struct {
+	// Filename is the suggested file name of the archive.
+	Filename string
+	Content  io.Reader
+}
+
+// Archive returns the tree of the provided git ref as an archive of the requested format.
+// If prefix is provided, all paths in the archive are placed under the prefix directory,
+// and if paths are provided only those paths are included in the archive.
+func (c *Controller) Archive(ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	gitRef string,
+	format enum.ArchiveFormat,
+	prefix string,
+	paths []string,
+) (*ArchiveOutput, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView, true)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := c.gitRPCClient.GetArchive(ctx, &gitrpc.GetArchiveParams{
+		ReadParams: CreateRPCReadParams(repo),
+		GitRef:     gitRef,
+		Format:     gitrpcenum.ArchiveFormat(format),
+		Prefix:     prefix,
+		Paths:      paths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive from gitrpc: %w", err)
+	}
+
+	return &ArchiveOutput{
+		Filename: fmt.Sprintf("%s-%s.%s", repo.UID, strings.ReplaceAll(gitRef, "/", "-"),
+			gitrpcenum.ArchiveFormat(format).Extension()),
+		Content: content,
+	}, nil
+}
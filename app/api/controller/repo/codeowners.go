@@ -0,0 +1,90 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// CodeOwnersOutput holds the code owners of a path.
+type CodeOwnersOutput struct {
+	// FilePath is the path of the CODEOWNERS file.
+	FilePath string `json:"file_path"`
+	// Pattern is the pattern of the CODEOWNERS entry that applies to the path.
+	Pattern string `json:"pattern"`
+	// Owners are the code owners as written in the CODEOWNERS file.
+	Owners []string `json:"owners"`
+	// Principals are the code owners that could be resolved to principals.
+	Principals []types.PrincipalInfo `json:"principals"`
+}
+
+// CodeOwners returns the code owners of a path in the repository.
+func (c *Controller) CodeOwners(ctx context.Context,
+	session *auth.Session,
+	repoRef, gitRef, path string,
+) (*CodeOwnersOutput, error) {
+	path = strings.Trim(strings.TrimSpace(path), "/")
+	if path == "" {
+		return nil, usererror.BadRequest("File path needs to specified.")
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if gitRef == "" {
+		gitRef = repo.DefaultBranch
+	}
+
+	codeOwners, err := c.codeOwners.Get(ctx, repo, gitRef)
+	if errors.Is(err, codeowners.ErrNotFound) {
+		return nil, usererror.NotFound("The repository doesn't contain a CODEOWNERS file.")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code owners: %w", err)
+	}
+
+	out := &CodeOwnersOutput{
+		FilePath:   codeOwners.FilePath,
+		Owners:     []string{},
+		Principals: []types.PrincipalInfo{},
+	}
+
+	entry, ok := codeOwners.Find(path)
+	if !ok {
+		return out, nil
+	}
+
+	principals, err := c.codeOwners.NewResolver().Resolve(ctx, entry.Owners)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve code owners: %w", err)
+	}
+
+	out.Pattern = entry.Pattern
+	out.Owners = entry.Owners
+	out.Principals = principals
+
+	return out, nil
+}
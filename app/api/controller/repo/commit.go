@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/bootstrap"
 	"github.com/harness/gitness/gitrpc"
@@ -59,6 +60,10 @@ func (c *Controller) CommitFiles(ctx context.Context,
 		return CommitFilesResponse{}, err
 	}
 
+	if repo.IsMirror {
+		return CommitFilesResponse{}, usererror.ErrRepoIsMirror
+	}
+
 	actions := make([]gitrpc.CommitFileAction, len(in.Actions))
 	for i, action := range in.Actions {
 		var rawPayload []byte
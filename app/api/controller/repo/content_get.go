@@ -126,6 +126,10 @@ func (c *Controller) GetContent(ctx context.Context,
 		return nil, err
 	}
 
+	if err = c.signatureVerifier.VerifyCommits(ctx, info.LatestCommit); err != nil {
+		return nil, err
+	}
+
 	var content Content
 	switch info.Type {
 	case ContentTypeDir:
@@ -22,10 +22,17 @@ import (
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/githook"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/services/codesearch"
 	"github.com/harness/gitness/app/services/importer"
+	"github.com/harness/gitness/app/services/mirror"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/signing"
+	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/gitrpc"
@@ -47,6 +54,21 @@ type Controller struct {
 	principalStore store.PrincipalStore
 	gitRPCClient   gitrpc.Interface
 	importer       *importer.Repository
+
+	pullreqStore      store.PullReqStore
+	reviewerStore     store.PullReqReviewerStore
+	protectionManager *protection.Manager
+	codeOwners        *codeowners.Service
+
+	lfsObjectStore  store.LFSObjectStore
+	lfsContentStore store.LFSContentStore
+
+	signatureVerifier *signing.Verifier
+	codeSearch        *codesearch.Service
+	mirror            *mirror.Service
+	pushMirror        *mirror.PushService
+	auditRecorder     audit.Recorder
+	cron              *trigger.Cron
 }
 
 func NewController(
@@ -61,6 +83,18 @@ func NewController(
 	principalStore store.PrincipalStore,
 	gitRPCClient gitrpc.Interface,
 	importer *importer.Repository,
+	pullreqStore store.PullReqStore,
+	reviewerStore store.PullReqReviewerStore,
+	protectionManager *protection.Manager,
+	codeOwners *codeowners.Service,
+	lfsObjectStore store.LFSObjectStore,
+	lfsContentStore store.LFSContentStore,
+	signatureVerifier *signing.Verifier,
+	codeSearch *codesearch.Service,
+	mirror *mirror.Service,
+	pushMirror *mirror.PushService,
+	auditRecorder audit.Recorder,
+	cron *trigger.Cron,
 ) *Controller {
 	return &Controller{
 		defaultBranch:  defaultBranch,
@@ -74,6 +108,21 @@ func NewController(
 		principalStore: principalStore,
 		gitRPCClient:   gitRPCClient,
 		importer:       importer,
+
+		pullreqStore:      pullreqStore,
+		reviewerStore:     reviewerStore,
+		protectionManager: protectionManager,
+		codeOwners:        codeOwners,
+
+		lfsObjectStore:  lfsObjectStore,
+		lfsContentStore: lfsContentStore,
+
+		signatureVerifier: signatureVerifier,
+		codeSearch:        codeSearch,
+		mirror:            mirror,
+		pushMirror:        pushMirror,
+		auditRecorder:     auditRecorder,
+		cron:              cron,
 	}
 }
 
@@ -117,6 +166,7 @@ func CreateRPCWriteParams(ctx context.Context, urlProvider url.Provider,
 		repo.ID,
 		session.Principal.ID,
 		false,
+		false,
 	)
 	if err != nil {
 		return gitrpc.WriteParams{}, fmt.Errorf("failed to generate git hook environment variables: %w", err)
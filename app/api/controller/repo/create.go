@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -94,6 +95,14 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, in *Crea
 	// backfil GitURL
 	repo.GitURL = c.urlProvider.GenerateGITCloneURL(repo.Path)
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeRepo,
+		ResourceName: repo.Path,
+		SpaceID:      repo.ParentID,
+		Data:         map[string]string{"is_public": strconv.FormatBool(repo.IsPublic)},
+	})
+
 	return repo, nil
 }
 
@@ -185,6 +194,7 @@ func (c *Controller) createGitRPCRepository(ctx context.Context, session *auth.S
 		0,
 		session.Principal.ID,
 		true,
+		false,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate git hook environment variables: %w", err)
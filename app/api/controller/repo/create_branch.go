@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/types/enum"
@@ -43,6 +44,10 @@ func (c *Controller) CreateBranch(ctx context.Context,
 		return nil, err
 	}
 
+	if repo.IsMirror {
+		return nil, usererror.ErrRepoIsMirror
+	}
+
 	// set target to default branch in case no target was provided
 	if in.Target == "" {
 		in.Target = repo.DefaultBranch
@@ -67,5 +72,9 @@ func (c *Controller) CreateBranch(ctx context.Context,
 		return nil, fmt.Errorf("failed to map branch: %w", err)
 	}
 
+	if err = c.signatureVerifier.VerifyCommits(ctx, branch.Commit); err != nil {
+		return nil, err
+	}
+
 	return &branch, nil
 }
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/types/enum"
@@ -47,6 +48,10 @@ func (c *Controller) CreateCommitTag(ctx context.Context,
 		return nil, err
 	}
 
+	if repo.IsMirror {
+		return nil, usererror.ErrRepoIsMirror
+	}
+
 	// set target to default branch in case no branch or commit was provided
 	if in.Target == "" {
 		in.Target = repo.DefaultBranch
@@ -75,5 +80,10 @@ func (c *Controller) CreateCommitTag(ctx context.Context,
 	if err != nil {
 		return nil, fmt.Errorf("failed to map tag received from service output: %w", err)
 	}
+
+	if err = c.verifyCommitTags(ctx, &commitTag); err != nil {
+		return nil, err
+	}
+
 	return &commitTag, nil
 }
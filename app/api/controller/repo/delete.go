@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/types"
@@ -54,13 +55,93 @@ func (c *Controller) Delete(ctx context.Context, session *auth.Session, repoRef
 }
 
 func (c *Controller) DeleteNoAuth(ctx context.Context, session *auth.Session, repo *types.Repository) error {
-	if err := c.DeleteGitRPCRepositories(ctx, session, repo); err != nil {
+	// forks are using the git objects of their upstream repository. The repository row is locked while
+	// checking for forks, creating a fork locks the same row before registering the fork.
+	var lfsObjects []*types.LFSObject
+	err := c.tx.WithTx(ctx, func(ctx context.Context) error {
+		current, err := c.repoStore.FindWithLock(ctx, repo.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find repository: %w", err)
+		}
+		if current.NumForks > 0 {
+			return usererror.ErrRepoWithForksCantBeDeleted
+		}
+
+		// the LFS object records are deleted together with the repository, the content is purged afterwards.
+		lfsObjects, err = c.lfsObjectStore.List(ctx, repo.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list LFS objects: %w", err)
+		}
+
+		// pipelines and their triggers are removed together with the repository,
+		// so their scheduled executions need to be removed too.
+		if err = c.cron.UnscheduleRepo(ctx, repo.ID); err != nil {
+			return fmt.Errorf("failed to unschedule pipeline triggers: %w", err)
+		}
+
+		if err = c.repoStore.Delete(ctx, repo.ID); err != nil {
+			return err
+		}
+
+		if current.ForkID != 0 {
+			if err = c.decrementNumForks(ctx, current.ForkID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
-	if err := c.repoStore.Delete(ctx, repo.ID); err != nil {
-		return err
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionDelete,
+		ResourceType: enum.AuditResourceTypeRepo,
+		ResourceName: repo.Path,
+		SpaceID:      repo.ParentID,
+	})
+
+	// the repository is gone, failures below only leave orphaned data behind.
+	if err = c.DeleteGitRPCRepositories(ctx, session, repo); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to delete git repository of repo %d", repo.ID)
 	}
+
+	c.deleteLFSObjects(ctx, repo.ID, lfsObjects)
+
+	if err = c.codeSearch.DeleteIndex(repo.ID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to delete code search index of repo %d", repo.ID)
+	}
+
+	return nil
+}
+
+// deleteLFSObjects purges the content of the LFS objects of a repository.
+// Failures aren't critical and only leave orphaned content in the LFS storage.
+func (c *Controller) deleteLFSObjects(ctx context.Context, repoID int64, objects []*types.LFSObject) {
+	for _, obj := range objects {
+		if err := c.lfsContentStore.Delete(ctx, repoID, obj.OID); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to delete LFS object %s of repo %d", obj.OID, repoID)
+		}
+	}
+}
+
+func (c *Controller) decrementNumForks(ctx context.Context, upstreamID int64) error {
+	upstream, err := c.repoStore.FindWithLock(ctx, upstreamID)
+	if err != nil {
+		return fmt.Errorf("failed to find upstream repository: %w", err)
+	}
+
+	_, err = c.repoStore.UpdateOptLock(ctx, upstream, func(upstream *types.Repository) error {
+		if upstream.NumForks > 0 {
+			upstream.NumForks--
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update upstream repository: %w", err)
+	}
+
 	return nil
 }
 
@@ -35,6 +35,10 @@ func (c *Controller) DeleteBranch(ctx context.Context,
 		return err
 	}
 
+	if repo.IsMirror {
+		return usererror.ErrRepoIsMirror
+	}
+
 	// make sure user isn't deleting the default branch
 	// ASSUMPTION: lower layer calls explicit branch api
 	// and 'refs/heads/branch1' would fail if 'branch1' exists.
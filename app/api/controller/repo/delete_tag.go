@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/types/enum"
@@ -34,6 +35,10 @@ func (c *Controller) DeleteTag(ctx context.Context,
 		return err
 	}
 
+	if repo.IsMirror {
+		return usererror.ErrRepoIsMirror
+	}
+
 	writeParams, err := CreateRPCWriteParams(ctx, c.urlProvider, session, repo)
 	if err != nil {
 		return fmt.Errorf("failed to create RPC write params: %w", err)
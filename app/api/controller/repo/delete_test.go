@@ -0,0 +1,78 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepoStore struct {
+	store.RepoStore
+	repos map[int64]*types.Repository
+}
+
+func (s *fakeRepoStore) Find(_ context.Context, id int64) (*types.Repository, error) {
+	repo, ok := s.repos[id]
+	if !ok {
+		return nil, usererror.ErrNotFound
+	}
+	clone := *repo
+	return &clone, nil
+}
+
+func (s *fakeRepoStore) FindWithLock(ctx context.Context, id int64) (*types.Repository, error) {
+	return s.Find(ctx, id)
+}
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...interface{}) error {
+	return txFn(ctx)
+}
+
+type fakeGitRPC struct {
+	gitrpc.Interface
+	deleted []string
+}
+
+func (g *fakeGitRPC) DeleteRepository(_ context.Context, params *gitrpc.DeleteRepositoryParams) error {
+	g.deleted = append(g.deleted, params.RepoUID)
+	return nil
+}
+
+func TestDeleteNoAuthRepoWithForks(t *testing.T) {
+	repoStore := &fakeRepoStore{repos: map[int64]*types.Repository{
+		1: {ID: 1, GitUID: "upstream", NumForks: 1},
+	}}
+	gitRPC := &fakeGitRPC{}
+	c := &Controller{tx: fakeTransactor{}, repoStore: repoStore, gitRPCClient: gitRPC}
+
+	// the fork was created after the repository has been loaded.
+	outdated := &types.Repository{ID: 1, GitUID: "upstream"}
+
+	err := c.DeleteNoAuth(context.Background(), &auth.Session{}, outdated)
+
+	require.ErrorIs(t, err, usererror.ErrRepoWithForksCantBeDeleted)
+	require.Empty(t, gitRPC.deleted)
+}
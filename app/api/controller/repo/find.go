@@ -38,5 +38,13 @@ func (c *Controller) Find(ctx context.Context, session *auth.Session, repoRef st
 	// backfill clone url
 	repo.GitURL = c.urlProvider.GenerateGITCloneURL(repo.Path)
 
+	// backfill sync status of mirrors
+	if repo.IsMirror {
+		repo.Mirror, err = c.mirror.Find(ctx, repo.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return repo, nil
 }
@@ -0,0 +1,170 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/githook"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ForkInput struct {
+	ParentRef   string `json:"parent_ref"`
+	UID         string `json:"uid"`
+	Description string `json:"description"`
+	IsPublic    bool   `json:"is_public"`
+}
+
+// Fork creates a fork of the repository in the provided space.
+// The fork shares the git objects of its upstream repository and contains all of its branches and tags.
+func (c *Controller) Fork(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	in *ForkInput,
+) (*types.Repository, error) {
+	upstream, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView, true)
+	if err != nil {
+		return nil, err
+	}
+
+	parentSpace, err := c.getSpaceCheckAuthRepoCreation(ctx, session, in.ParentRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.sanitizeForkInput(in, upstream); err != nil {
+		return nil, fmt.Errorf("failed to sanitize input: %w", err)
+	}
+
+	// generate envars (add everything githook CLI needs for execution)
+	envVars, err := githook.GenerateEnvironmentVariables(
+		ctx,
+		c.urlProvider.GetInternalAPIURL(),
+		0,
+		session.Principal.ID,
+		true,
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate git hook environment variables: %w", err)
+	}
+
+	gitRPCResp, err := c.gitRPCClient.ForkRepository(ctx, &gitrpc.ForkRepositoryParams{
+		Actor:           *rpcIdentityFromPrincipal(session.Principal),
+		EnvVars:         envVars,
+		UpstreamRepoUID: upstream.GitUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error forking repository on GitRPC: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	repo := &types.Repository{
+		Version:       0,
+		ParentID:      parentSpace.ID,
+		UID:           in.UID,
+		GitUID:        gitRPCResp.UID,
+		Description:   in.Description,
+		IsPublic:      in.IsPublic,
+		CreatedBy:     session.Principal.ID,
+		Created:       now,
+		Updated:       now,
+		ForkID:        upstream.ID,
+		DefaultBranch: gitRPCResp.DefaultBranch,
+	}
+
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		// the upstream repository row is locked to prevent its concurrent deletion.
+		upstream, err := c.repoStore.FindWithLock(ctx, upstream.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find upstream repository: %w", err)
+		}
+
+		if err = c.repoStore.Create(ctx, repo); err != nil {
+			return fmt.Errorf("failed to create repository in storage: %w", err)
+		}
+
+		_, err = c.repoStore.UpdateOptLock(ctx, upstream, func(upstream *types.Repository) error {
+			upstream.NumForks++
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update number of forks of upstream repository: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if dErr := c.DeleteGitRPCRepositories(ctx, session, repo); dErr != nil {
+			log.Ctx(ctx).Warn().Err(dErr).Msg("gitrpc failed to delete repo for cleanup")
+		}
+		return nil, err
+	}
+
+	// backfil GitURL
+	repo.GitURL = c.urlProvider.GenerateGITCloneURL(repo.Path)
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeRepo,
+		ResourceName: repo.Path,
+		SpaceID:      repo.ParentID,
+		Data:         map[string]string{"is_public": strconv.FormatBool(repo.IsPublic), "fork_of": upstream.Path},
+	})
+
+	return repo, nil
+}
+
+func (c *Controller) sanitizeForkInput(in *ForkInput, upstream *types.Repository) error {
+	if err := c.validateParentRef(in.ParentRef); err != nil {
+		return err
+	}
+
+	if in.UID == "" {
+		in.UID = upstream.UID
+	}
+
+	if err := c.uidCheck(in.UID, false); err != nil {
+		return err
+	}
+
+	in.Description = strings.TrimSpace(in.Description)
+	if in.Description == "" {
+		in.Description = upstream.Description
+	}
+
+	if err := check.Description(in.Description); err != nil {
+		return err
+	}
+
+	if in.IsPublic && !upstream.IsPublic {
+		return usererror.BadRequest("A fork of a private repository can't be public.")
+	}
+
+	return nil
+}
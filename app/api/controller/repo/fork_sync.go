@@ -0,0 +1,136 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/types/enum"
+)
+
+type SyncForkInput struct {
+	// Branch is the branch of the fork that is updated with the same branch of the upstream repository.
+	// If no branch is provided, the default branch of the fork is synced.
+	Branch string `json:"branch"`
+}
+
+type SyncForkOutput struct {
+	Branch string `json:"branch"`
+	OldSHA string `json:"old_sha"`
+	NewSHA string `json:"new_sha"`
+}
+
+// SyncFork fast-forwards a branch of a fork to the latest commit of the same branch in the upstream repository.
+func (c *Controller) SyncFork(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	in *SyncForkInput,
+) (*SyncForkOutput, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if repo.IsMirror {
+		return nil, usererror.ErrRepoIsMirror
+	}
+
+	if repo.ForkID == 0 {
+		return nil, usererror.BadRequest("Repository is not a fork.")
+	}
+
+	upstream, err := c.repoStore.Find(ctx, repo.ForkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find upstream repository: %w", err)
+	}
+
+	if err = apiauth.CheckRepo(ctx, c.authorizer, session, upstream, enum.PermissionRepoView, true); err != nil {
+		return nil, fmt.Errorf("access check on upstream repository failed: %w", err)
+	}
+
+	branch := in.Branch
+	if branch == "" {
+		branch = repo.DefaultBranch
+	}
+
+	upstreamRef, err := c.gitRPCClient.GetRef(ctx, gitrpc.GetRefParams{
+		ReadParams: CreateRPCReadParams(upstream),
+		Name:       branch,
+		Type:       gitrpcenum.RefTypeBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch of upstream repository: %w", err)
+	}
+
+	forkRef, err := c.gitRPCClient.GetRef(ctx, gitrpc.GetRefParams{
+		ReadParams: CreateRPCReadParams(repo),
+		Name:       branch,
+		Type:       gitrpcenum.RefTypeBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch of fork: %w", err)
+	}
+
+	out := &SyncForkOutput{
+		Branch: branch,
+		OldSHA: forkRef.SHA,
+		NewSHA: upstreamRef.SHA,
+	}
+
+	if forkRef.SHA == upstreamRef.SHA {
+		return out, nil
+	}
+
+	// the fork has access to all objects of its upstream, so ancestry can be checked in the fork.
+	ancestry, err := c.gitRPCClient.IsAncestor(ctx, gitrpc.IsAncestorParams{
+		ReadParams:          CreateRPCReadParams(repo),
+		AncestorCommitSHA:   forkRef.SHA,
+		DescendantCommitSHA: upstreamRef.SHA,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ancestry of branch: %w", err)
+	}
+
+	if !ancestry.IsAncestor {
+		return nil, usererror.BadRequestf(
+			"Branch '%s' of the fork has diverged from the upstream repository and can't be fast-forwarded.", branch)
+	}
+
+	writeParams, err := CreateRPCWriteParams(ctx, c.urlProvider, session, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RPC write params: %w", err)
+	}
+
+	err = c.gitRPCClient.UpdateRef(ctx, gitrpc.UpdateRefParams{
+		WriteParams:   writeParams,
+		Name:          branch,
+		Type:          gitrpcenum.RefTypeBranch,
+		NewValue:      upstreamRef.SHA,
+		OldValue:      forkRef.SHA,
+		SourceRepoUID: upstream.GitUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update branch of fork: %w", err)
+	}
+
+	return out, nil
+}
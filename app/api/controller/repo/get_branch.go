@@ -47,5 +47,9 @@ func (c *Controller) GetBranch(ctx context.Context,
 		return nil, fmt.Errorf("failed to map branch: %w", err)
 	}
 
+	if err = c.signatureVerifier.VerifyCommits(ctx, branch.Commit); err != nil {
+		return nil, err
+	}
+
 	return &branch, nil
 }
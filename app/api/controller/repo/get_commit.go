@@ -50,5 +50,9 @@ func (c *Controller) GetCommit(ctx context.Context,
 		return nil, fmt.Errorf("failed to map commit: %w", err)
 	}
 
+	if err = c.signatureVerifier.VerifyCommits(ctx, commit); err != nil {
+		return nil, err
+	}
+
 	return commit, nil
 }
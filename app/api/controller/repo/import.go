@@ -17,10 +17,12 @@ package repo
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/services/importer"
 	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
 )
 
 type ImportInput struct {
@@ -71,6 +73,14 @@ func (c *Controller) Import(ctx context.Context, session *auth.Session, in *Impo
 
 	repo.GitURL = c.urlProvider.GenerateGITCloneURL(repo.Path)
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeRepo,
+		ResourceName: repo.Path,
+		SpaceID:      repo.ParentID,
+		Data:         map[string]string{"is_public": strconv.FormatBool(repo.IsPublic), "import": "true"},
+	})
+
 	return repo, nil
 }
 
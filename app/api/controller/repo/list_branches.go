@@ -57,11 +57,17 @@ func (c *Controller) ListBranches(ctx context.Context,
 	}
 
 	branches := make([]Branch, len(rpcOut.Branches))
+	commits := make([]*types.Commit, len(rpcOut.Branches))
 	for i := range rpcOut.Branches {
 		branches[i], err = mapBranch(rpcOut.Branches[i])
 		if err != nil {
 			return nil, fmt.Errorf("failed to map branch: %w", err)
 		}
+		commits[i] = branches[i].Commit
+	}
+
+	if err = c.signatureVerifier.VerifyCommits(ctx, commits...); err != nil {
+		return nil, err
 	}
 
 	return branches, nil
@@ -33,6 +33,11 @@ type CommitTag struct {
 	Message     string           `json:"message,omitempty"`
 	Tagger      *types.Signature `json:"tagger,omitempty"`
 	Commit      *types.Commit    `json:"commit,omitempty"`
+
+	// Signature is the cryptographic signature of the tag (nil if the tag isn't signed).
+	Signature *types.ObjectSignature `json:"-"`
+	// Verification is the result of the signature verification (nil for lightweight tags).
+	Verification *types.SignatureVerification `json:"verification,omitempty"`
 }
 
 // ListCommitTags lists the commit tags of a repo.
@@ -61,11 +66,17 @@ func (c *Controller) ListCommitTags(ctx context.Context,
 	}
 
 	tags := make([]CommitTag, len(rpcOut.Tags))
+	tagRefs := make([]*CommitTag, len(rpcOut.Tags))
 	for i := range rpcOut.Tags {
 		tags[i], err = mapCommitTag(rpcOut.Tags[i])
 		if err != nil {
 			return nil, fmt.Errorf("failed to map CommitTag: %w", err)
 		}
+		tagRefs[i] = &tags[i]
+	}
+
+	if err = c.verifyCommitTags(ctx, tagRefs...); err != nil {
+		return nil, err
 	}
 
 	return tags, nil
@@ -112,5 +123,32 @@ func mapCommitTag(t gitrpc.CommitTag) (CommitTag, error) {
 		Message:     t.Message,
 		Tagger:      tagger,
 		Commit:      commit,
+		Signature:   controller.MapObjectSignature(t.Signature),
 	}, nil
 }
+
+// verifyCommitTags verifies the signatures of the annotated tags and of the commits the tags point to.
+func (c *Controller) verifyCommitTags(ctx context.Context, tags ...*CommitTag) error {
+	commits := make([]*types.Commit, 0, len(tags))
+	for _, tag := range tags {
+		commits = append(commits, tag.Commit)
+
+		if !tag.IsAnnotated {
+			continue
+		}
+
+		var email string
+		if tag.Tagger != nil {
+			email = tag.Tagger.Identity.Email
+		}
+
+		verification, err := c.signatureVerifier.Verify(ctx, email, tag.Signature)
+		if err != nil {
+			return fmt.Errorf("failed to verify signature of tag %s: %w", tag.Name, err)
+		}
+
+		tag.Verification = verification
+	}
+
+	return c.signatureVerifier.VerifyCommits(ctx, commits...)
+}
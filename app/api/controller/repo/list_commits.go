@@ -58,6 +58,7 @@ func (c *Controller) ListCommits(ctx context.Context,
 	}
 
 	commits := make([]types.Commit, len(rpcOut.Commits))
+	commitRefs := make([]*types.Commit, len(rpcOut.Commits))
 	for i := range rpcOut.Commits {
 		var commit *types.Commit
 		commit, err = controller.MapCommit(&rpcOut.Commits[i])
@@ -65,6 +66,11 @@ func (c *Controller) ListCommits(ctx context.Context,
 			return types.ListCommitResponse{}, fmt.Errorf("failed to map commit: %w", err)
 		}
 		commits[i] = *commit
+		commitRefs[i] = &commits[i]
+	}
+
+	if err = c.signatureVerifier.VerifyCommits(ctx, commitRefs...); err != nil {
+		return types.ListCommitResponse{}, err
 	}
 
 	renameDetailList := make([]types.RenameDetails, len(rpcOut.RenameDetails))
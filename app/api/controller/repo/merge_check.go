@@ -18,14 +18,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
 
 type MergeCheck struct {
 	Mergeable     bool     `json:"mergeable"`
 	ConflictFiles []string `json:"conflict_files,omitempty"`
+
+	// UnmetRequirements lists the merge requirements not yet fulfilled by the open pull request
+	// between the two branches (if there is one).
+	UnmetRequirements []string `json:"unmet_requirements,omitempty"`
 }
 
 func (c *Controller) MergeCheck(
@@ -33,12 +40,23 @@ func (c *Controller) MergeCheck(
 	session *auth.Session,
 	repoRef string,
 	diffPath string,
+	method enum.MergeMethod,
 ) (MergeCheck, error) {
 	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView, false)
 	if err != nil {
 		return MergeCheck{}, err
 	}
 
+	if method != "" {
+		if _, ok := method.Sanitize(); !ok {
+			return MergeCheck{}, usererror.BadRequestf("Merge method %q is invalid.", method)
+		}
+
+		if !repo.IsMergeMethodAllowed(method) {
+			return MergeCheck{}, usererror.BadRequestf("Merge method %q is not allowed in this repository.", method)
+		}
+	}
+
 	info, err := parseDiffPath(diffPath)
 	if err != nil {
 		return MergeCheck{}, err
@@ -54,6 +72,7 @@ func (c *Controller) MergeCheck(
 		BaseBranch:  info.BaseRef,
 		HeadRepoUID: writeParams.RepoUID, // forks are not supported for now
 		HeadBranch:  info.HeadRef,
+		Method:      gitrpcenum.MergeMethod(method),
 	})
 	if err != nil {
 		if gitrpc.ErrorStatus(err) == gitrpc.StatusNotMergeable {
@@ -62,10 +81,61 @@ func (c *Controller) MergeCheck(
 				ConflictFiles: gitrpc.AsConflictFilesError(err),
 			}, nil
 		}
+		if gitrpc.ErrorStatus(err) == gitrpc.StatusPreconditionFailed {
+			// the branches can't be merged with the method, e.g. fast-forward isn't possible.
+			return MergeCheck{Mergeable: false}, nil
+		}
 		return MergeCheck{}, fmt.Errorf("merge check execution failed: %w", err)
 	}
 
+	unmet, err := c.verifyPullReqMerge(ctx, repo, session.Principal.ID, info.BaseRef, info.HeadRef)
+	if err != nil {
+		return MergeCheck{}, err
+	}
+
 	return MergeCheck{
-		Mergeable: true,
+		Mergeable:         true,
+		UnmetRequirements: unmet,
 	}, nil
 }
+
+// verifyPullReqMerge returns the unmet merge requirements of the open pull request between the branches.
+func (c *Controller) verifyPullReqMerge(
+	ctx context.Context,
+	repo *types.Repository,
+	principalID int64,
+	targetBranch string,
+	sourceBranch string,
+) ([]string, error) {
+	prs, err := c.pullreqStore.List(ctx, &types.PullReqFilter{
+		SourceRepoID: repo.ID,
+		SourceBranch: sourceBranch,
+		TargetRepoID: repo.ID,
+		TargetBranch: targetBranch,
+		States:       []enum.PullReqState{enum.PullReqStateOpen},
+		Size:         1,
+		Sort:         enum.PullReqSortNumber,
+		Order:        enum.OrderAsc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open pull request: %w", err)
+	}
+
+	if len(prs) == 0 {
+		return nil, nil
+	}
+
+	pr := prs[0]
+
+	reviewers, err := c.reviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load list of reviewers: %w", err)
+	}
+
+	unmet, err := c.protectionManager.VerifyMerge(ctx, repo, principalID, pr, reviewers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify merge requirements: %w", err)
+	}
+
+	return unmet, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/importer"
+	"github.com/harness/gitness/app/services/mirror"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+type CreateMirrorInput struct {
+	ParentRef   string `json:"parent_ref"`
+	UID         string `json:"uid"`
+	Description string `json:"description"`
+	IsPublic    bool   `json:"is_public"`
+
+	RemoteURL string `json:"remote_url"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	// Interval is the time between two syncs in seconds, the configured default is used if zero.
+	Interval int64 `json:"interval"`
+}
+
+// CreateMirror creates a new mirror repository and starts the initial import of it from the remote.
+// Afterwards the repository is synced with the remote periodically.
+func (c *Controller) CreateMirror(ctx context.Context,
+	session *auth.Session,
+	in *CreateMirrorInput,
+) (*types.Repository, error) {
+	parentSpace, err := c.getSpaceCheckAuthRepoCreation(ctx, session, in.ParentRef)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.sanitizeCreateMirrorInput(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sanitize input: %w", err)
+	}
+
+	var repo *types.Repository
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		remoteRepository := importer.RepositoryInfo{
+			Space:    parentSpace.Path,
+			UID:      in.UID,
+			CloneURL: in.RemoteURL,
+			IsPublic: in.IsPublic,
+		}
+
+		repo = remoteRepository.ToRepo(parentSpace.ID, in.UID, in.Description, &session.Principal)
+		repo.IsMirror = true
+
+		err = c.repoStore.Create(ctx, repo)
+		if err != nil {
+			return fmt.Errorf("failed to create repository in storage: %w", err)
+		}
+
+		repo.Mirror, err = c.mirror.Create(ctx, repo, &session.Principal, mirror.Settings{
+			RemoteURL: in.RemoteURL,
+			Credentials: mirror.Credentials{
+				Username: in.Username,
+				Password: in.Password,
+			},
+			Interval: in.Interval,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = c.importer.Run(ctx, importer.Provider{
+			Username: in.Username,
+			Password: in.Password,
+		}, repo, repo.Mirror.RemoteURL)
+		if err != nil {
+			return fmt.Errorf("failed to start import repository job: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	repo.GitURL = c.urlProvider.GenerateGITCloneURL(repo.Path)
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeRepo,
+		ResourceName: repo.Path,
+		SpaceID:      repo.ParentID,
+		Data:         map[string]string{"is_public": strconv.FormatBool(repo.IsPublic), "mirror": "true"},
+	})
+
+	return repo, nil
+}
+
+func (c *Controller) sanitizeCreateMirrorInput(in *CreateMirrorInput) error {
+	if err := c.validateParentRef(in.ParentRef); err != nil {
+		return err
+	}
+
+	if err := c.uidCheck(in.UID, false); err != nil {
+		return err
+	}
+
+	in.Description = strings.TrimSpace(in.Description)
+	if err := check.Description(in.Description); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// FindMirror returns the mirror details (e.g. the status of the last sync) of a mirror repository.
+func (c *Controller) FindMirror(ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+) (*types.RepoMirror, error) {
+	// note: can't use c.getRepoCheckAccess because the mirror details are available during the initial import.
+	repo, err := c.repoStore.FindByRef(ctx, repoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = apiauth.CheckRepo(ctx, c.authorizer, session, repo, enum.PermissionRepoView, true); err != nil {
+		return nil, err
+	}
+
+	if !repo.IsMirror {
+		return nil, usererror.BadRequest("Repository is not a mirror.")
+	}
+
+	return c.mirror.Find(ctx, repo.ID)
+}
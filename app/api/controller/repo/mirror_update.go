@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/mirror"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type UpdateMirrorInput struct {
+	RemoteURL *string `json:"remote_url"`
+	Username  *string `json:"username"`
+	Password  *string `json:"password"`
+	Interval  *int64  `json:"interval"`
+}
+
+// UpdateMirror updates the remote, the credentials or the sync interval of a mirror repository.
+// Changing the remote URL drops the stored credentials, new ones have to be provided with the same request.
+func (c *Controller) UpdateMirror(ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	in *UpdateMirrorInput,
+) (*types.RepoMirror, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoEdit, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !repo.IsMirror {
+		return nil, usererror.BadRequest("Repository is not a mirror.")
+	}
+
+	return c.mirror.Update(ctx, repo.ID, mirror.UpdateSettings{
+		RemoteURL: in.RemoteURL,
+		Username:  in.Username,
+		Password:  in.Password,
+		Interval:  in.Interval,
+	})
+}
@@ -68,6 +68,8 @@ func (c *Controller) Move(ctx context.Context,
 		return nil, fmt.Errorf("failed to sanitize input: %w", err)
 	}
 
+	oldPath := repo.Path
+
 	repo, err = c.repoStore.UpdateOptLock(ctx, repo, func(r *types.Repository) error {
 		if in.UID != nil {
 			r.UID = *in.UID
@@ -80,6 +82,14 @@ func (c *Controller) Move(ctx context.Context,
 
 	repo.GitURL = c.urlProvider.GenerateGITCloneURL(repo.Path)
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionMove,
+		ResourceType: enum.AuditResourceTypeRepo,
+		ResourceName: repo.Path,
+		SpaceID:      repo.ParentID,
+		Data:         map[string]string{"old_path": oldPath},
+	})
+
 	return repo, nil
 }
 
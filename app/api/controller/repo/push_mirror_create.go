@@ -0,0 +1,67 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/mirror"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+type CreatePushMirrorInput struct {
+	UID       string `json:"uid"`
+	RemoteURL string `json:"remote_url"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	// ProtectedBranchesOnly restricts the mirror to branches that are protected by a protection rule.
+	ProtectedBranchesOnly bool `json:"protected_branches_only"`
+}
+
+// CreatePushMirror adds a new push mirror to the repository.
+// The repository is pushed to the remote right away and after every change of its branches or tags.
+func (c *Controller) CreatePushMirror(ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	in *CreatePushMirrorInput,
+) (*types.PushMirror, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoEdit, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = check.UID(in.UID); err != nil {
+		return nil, err
+	}
+
+	pushMirror, err := c.pushMirror.Create(ctx, repo, &session.Principal, mirror.PushSettings{
+		UID:       in.UID,
+		RemoteURL: in.RemoteURL,
+		Credentials: mirror.Credentials{
+			Username: in.Username,
+			Password: in.Password,
+		},
+		ProtectedBranchesOnly: in.ProtectedBranchesOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create push mirror: %w", err)
+	}
+
+	return pushMirror, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListPushMirrors lists the push mirrors of a repository including the status of their last push.
+func (c *Controller) ListPushMirrors(ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+) ([]*types.PushMirror, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.pushMirror.List(ctx, repo.ID)
+}
+
+// FindPushMirror finds a push mirror of a repository.
+func (c *Controller) FindPushMirror(ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	uid string,
+) (*types.PushMirror, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.pushMirror.FindByUID(ctx, repo.ID, uid)
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// PushToMirror schedules an immediate push of the repository to a push mirror.
+func (c *Controller) PushToMirror(ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	uid string,
+) (*types.PushMirror, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoEdit, false)
+	if err != nil {
+		return nil, err
+	}
+
+	pushMirror, err := c.pushMirror.FindByUID(ctx, repo.ID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.pushMirror.SchedulePush(ctx, pushMirror); err != nil {
+		return nil, err
+	}
+
+	return pushMirror, nil
+}
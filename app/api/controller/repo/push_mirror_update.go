@@ -0,0 +1,66 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/mirror"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+type UpdatePushMirrorInput struct {
+	UID                   *string `json:"uid"`
+	RemoteURL             *string `json:"remote_url"`
+	Username              *string `json:"username"`
+	Password              *string `json:"password"`
+	ProtectedBranchesOnly *bool   `json:"protected_branches_only"`
+}
+
+// UpdatePushMirror updates a push mirror of a repository.
+// Changing the remote URL drops the stored credentials, new ones have to be provided with the same request.
+func (c *Controller) UpdatePushMirror(ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	uid string,
+	in *UpdatePushMirrorInput,
+) (*types.PushMirror, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoEdit, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.UID != nil {
+		if err = check.UID(*in.UID); err != nil {
+			return nil, err
+		}
+	}
+
+	pushMirror, err := c.pushMirror.FindByUID(ctx, repo.ID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.pushMirror.Update(ctx, pushMirror, mirror.PushUpdateSettings{
+		UID:                   in.UID,
+		RemoteURL:             in.RemoteURL,
+		Username:              in.Username,
+		Password:              in.Password,
+		ProtectedBranchesOnly: in.ProtectedBranchesOnly,
+	})
+}
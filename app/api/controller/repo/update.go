@@ -17,23 +17,54 @@ package repo
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/check"
 	"github.com/harness/gitness/types/enum"
+
+	"golang.org/x/exp/slices"
 )
 
 // UpdateInput is used for updating a repo.
 type UpdateInput struct {
 	Description *string `json:"description"`
 	IsPublic    *bool   `json:"is_public"`
+
+	PullReqMinApprovals          *int  `json:"pullreq_min_approvals"`
+	PullReqDismissStaleApprovals *bool `json:"pullreq_dismiss_stale_approvals"`
+	PullReqRequirePeerApproval   *bool `json:"pullreq_require_peer_approval"`
+
+	PullReqRequireCodeOwnerApproval *bool `json:"pullreq_require_code_owner_approval"`
+
+	PullReqAllowedMergeMethods []enum.MergeMethod `json:"pullreq_allowed_merge_methods"`
+
+	PullReqMergeTitleTemplate    *string `json:"pullreq_merge_title_template"`
+	PullReqMergeMessageTemplate  *string `json:"pullreq_merge_message_template"`
+	PullReqSquashTitleTemplate   *string `json:"pullreq_squash_title_template"`
+	PullReqSquashMessageTemplate *string `json:"pullreq_squash_message_template"`
 }
 
 func (in *UpdateInput) hasChanges(repo *types.Repository) bool {
 	return (in.Description != nil && *in.Description != repo.Description) ||
-		(in.IsPublic != nil && *in.IsPublic != repo.IsPublic)
+		(in.IsPublic != nil && *in.IsPublic != repo.IsPublic) ||
+		(in.PullReqMinApprovals != nil && *in.PullReqMinApprovals != repo.PullReqMinApprovals) ||
+		(in.PullReqDismissStaleApprovals != nil &&
+			*in.PullReqDismissStaleApprovals != repo.PullReqDismissStaleApprovals) ||
+		(in.PullReqRequirePeerApproval != nil && *in.PullReqRequirePeerApproval != repo.PullReqRequirePeerApproval) ||
+		(in.PullReqRequireCodeOwnerApproval != nil &&
+			*in.PullReqRequireCodeOwnerApproval != repo.PullReqRequireCodeOwnerApproval) ||
+		(in.PullReqAllowedMergeMethods != nil &&
+			!slices.Equal(in.PullReqAllowedMergeMethods, repo.PullReqAllowedMergeMethods)) ||
+		(in.PullReqMergeTitleTemplate != nil && *in.PullReqMergeTitleTemplate != repo.PullReqMergeTitleTemplate) ||
+		(in.PullReqMergeMessageTemplate != nil &&
+			*in.PullReqMergeMessageTemplate != repo.PullReqMergeMessageTemplate) ||
+		(in.PullReqSquashTitleTemplate != nil && *in.PullReqSquashTitleTemplate != repo.PullReqSquashTitleTemplate) ||
+		(in.PullReqSquashMessageTemplate != nil &&
+			*in.PullReqSquashMessageTemplate != repo.PullReqSquashMessageTemplate)
 }
 
 // Update updates a repository.
@@ -55,6 +86,8 @@ func (c *Controller) Update(ctx context.Context,
 		return nil, fmt.Errorf("failed to sanitize input: %w", err)
 	}
 
+	wasPublic := repo.IsPublic
+
 	repo, err = c.repoStore.UpdateOptLock(ctx, repo, func(repo *types.Repository) error {
 		// update values only if provided
 		if in.Description != nil {
@@ -63,6 +96,33 @@ func (c *Controller) Update(ctx context.Context,
 		if in.IsPublic != nil {
 			repo.IsPublic = *in.IsPublic
 		}
+		if in.PullReqMinApprovals != nil {
+			repo.PullReqMinApprovals = *in.PullReqMinApprovals
+		}
+		if in.PullReqDismissStaleApprovals != nil {
+			repo.PullReqDismissStaleApprovals = *in.PullReqDismissStaleApprovals
+		}
+		if in.PullReqRequirePeerApproval != nil {
+			repo.PullReqRequirePeerApproval = *in.PullReqRequirePeerApproval
+		}
+		if in.PullReqRequireCodeOwnerApproval != nil {
+			repo.PullReqRequireCodeOwnerApproval = *in.PullReqRequireCodeOwnerApproval
+		}
+		if in.PullReqAllowedMergeMethods != nil {
+			repo.PullReqAllowedMergeMethods = in.PullReqAllowedMergeMethods
+		}
+		if in.PullReqMergeTitleTemplate != nil {
+			repo.PullReqMergeTitleTemplate = *in.PullReqMergeTitleTemplate
+		}
+		if in.PullReqMergeMessageTemplate != nil {
+			repo.PullReqMergeMessageTemplate = *in.PullReqMergeMessageTemplate
+		}
+		if in.PullReqSquashTitleTemplate != nil {
+			repo.PullReqSquashTitleTemplate = *in.PullReqSquashTitleTemplate
+		}
+		if in.PullReqSquashMessageTemplate != nil {
+			repo.PullReqSquashMessageTemplate = *in.PullReqSquashMessageTemplate
+		}
 
 		return nil
 	})
@@ -73,6 +133,19 @@ func (c *Controller) Update(ctx context.Context,
 	// backfill repo url
 	repo.GitURL = c.urlProvider.GenerateGITCloneURL(repo.Path)
 
+	auditData := map[string]string{}
+	if repo.IsPublic != wasPublic {
+		auditData["is_public"] = strconv.FormatBool(repo.IsPublic)
+	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionUpdate,
+		ResourceType: enum.AuditResourceTypeRepo,
+		ResourceName: repo.Path,
+		SpaceID:      repo.ParentID,
+		Data:         auditData,
+	})
+
 	return repo, nil
 }
 
@@ -84,5 +157,38 @@ func sanitizeUpdateInput(in *UpdateInput) error {
 		}
 	}
 
+	if in.PullReqMinApprovals != nil && *in.PullReqMinApprovals < 0 {
+		return usererror.BadRequest("Minimum number of approvals can't be negative.")
+	}
+
+	if in.PullReqAllowedMergeMethods != nil {
+		methods := make([]enum.MergeMethod, 0, len(in.PullReqAllowedMergeMethods))
+		for _, method := range in.PullReqAllowedMergeMethods {
+			if _, ok := method.Sanitize(); !ok {
+				return usererror.BadRequestf("Merge method '%s' is invalid.", method)
+			}
+			if !slices.Contains(methods, method) {
+				methods = append(methods, method)
+			}
+		}
+		in.PullReqAllowedMergeMethods = methods
+	}
+
+	for _, template := range []*string{in.PullReqMergeTitleTemplate, in.PullReqSquashTitleTemplate} {
+		if template == nil {
+			continue
+		}
+		*template = strings.TrimSpace(*template)
+		if strings.ContainsAny(*template, "\r\n") {
+			return usererror.BadRequest("Merge commit title template must be a single line.")
+		}
+	}
+
+	for _, template := range []*string{in.PullReqMergeMessageTemplate, in.PullReqSquashMessageTemplate} {
+		if template != nil {
+			*template = strings.TrimSpace(*template)
+		}
+	}
+
 	return nil
 }
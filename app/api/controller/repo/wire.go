@@ -15,8 +15,15 @@
 package repo
 
 import (
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/services/codesearch"
 	"github.com/harness/gitness/app/services/importer"
+	"github.com/harness/gitness/app/services/mirror"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/signing"
+	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/gitrpc"
@@ -36,10 +43,17 @@ func ProvideController(config *types.Config, tx dbtx.Transactor, urlProvider url
 	uidCheck check.PathUID, authorizer authz.Authorizer, repoStore store.RepoStore,
 	spaceStore store.SpaceStore, pipelineStore store.PipelineStore,
 	principalStore store.PrincipalStore, rpcClient gitrpc.Interface,
-	importer *importer.Repository,
+	importer *importer.Repository, pullreqStore store.PullReqStore,
+	reviewerStore store.PullReqReviewerStore, protectionManager *protection.Manager,
+	codeOwners *codeowners.Service, lfsObjectStore store.LFSObjectStore,
+	lfsContentStore store.LFSContentStore, signatureVerifier *signing.Verifier,
+	codeSearch *codesearch.Service, mirror *mirror.Service, pushMirror *mirror.PushService,
+	auditRecorder audit.Recorder, cron *trigger.Cron,
 ) *Controller {
 	return NewController(config.Git.DefaultBranch, tx, urlProvider,
 		uidCheck, authorizer, repoStore,
 		spaceStore, pipelineStore, principalStore, rpcClient,
-		importer)
+		importer, pullreqStore, reviewerStore, protectionManager, codeOwners,
+		lfsObjectStore, lfsContentStore, signatureVerifier, codeSearch, mirror, pushMirror,
+		auditRecorder, cron)
 }
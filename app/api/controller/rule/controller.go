@@ -0,0 +1,150 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/audit"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type Controller struct {
+	authorizer     authz.Authorizer
+	ruleStore      store.RuleStore
+	repoStore      store.RepoStore
+	spaceStore     store.SpaceStore
+	principalStore store.PrincipalStore
+	auditRecorder  audit.Recorder
+}
+
+func NewController(
+	authorizer authz.Authorizer,
+	ruleStore store.RuleStore,
+	repoStore store.RepoStore,
+	spaceStore store.SpaceStore,
+	principalStore store.PrincipalStore,
+	auditRecorder audit.Recorder,
+) *Controller {
+	return &Controller{
+		authorizer:     authorizer,
+		ruleStore:      ruleStore,
+		repoStore:      repoStore,
+		spaceStore:     spaceStore,
+		principalStore: principalStore,
+		auditRecorder:  auditRecorder,
+	}
+}
+
+// ruleParent is the repo or space the protection rules belong to.
+type ruleParent struct {
+	id      int64
+	path    string
+	spaceID int64
+}
+
+// getParentCheckAccess fetches the repo or space the protection rules belong to
+// and checks if the current user has permission to access it.
+func (c *Controller) getParentCheckAccess(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.RuleParent,
+	parentRef string,
+	edit bool,
+) (ruleParent, error) {
+	switch parentType {
+	case enum.RuleParentRepo:
+		if parentRef == "" {
+			return ruleParent{}, usererror.BadRequest("A valid repository reference must be provided.")
+		}
+
+		repo, err := c.repoStore.FindByRef(ctx, parentRef)
+		if err != nil {
+			return ruleParent{}, fmt.Errorf("failed to find repo: %w", err)
+		}
+
+		permission := enum.PermissionRepoView
+		if edit {
+			permission = enum.PermissionRepoEdit
+		}
+
+		if err = apiauth.CheckRepo(ctx, c.authorizer, session, repo, permission, false); err != nil {
+			return ruleParent{}, fmt.Errorf("failed to verify authorization: %w", err)
+		}
+
+		return ruleParent{id: repo.ID, path: repo.Path, spaceID: repo.ParentID}, nil
+
+	case enum.RuleParentSpace:
+		if parentRef == "" {
+			return ruleParent{}, usererror.BadRequest("A valid space reference must be provided.")
+		}
+
+		space, err := c.spaceStore.FindByRef(ctx, parentRef)
+		if err != nil {
+			return ruleParent{}, fmt.Errorf("failed to find space: %w", err)
+		}
+
+		permission := enum.PermissionSpaceView
+		if edit {
+			permission = enum.PermissionSpaceEdit
+		}
+
+		if err = apiauth.CheckSpace(ctx, c.authorizer, session, space, permission, false); err != nil {
+			return ruleParent{}, fmt.Errorf("failed to verify authorization: %w", err)
+		}
+
+		return ruleParent{id: space.ID, path: space.Path, spaceID: space.ID}, nil
+
+	default:
+		return ruleParent{}, fmt.Errorf("rule parent type '%s' is not supported", parentType)
+	}
+}
+
+// checkBypassPrincipals ensures all principals allowed to bypass a rule exist.
+// auditEvent returns the audit event for an action on a protection rule.
+// The definition is recorded as well, as it contains the principals allowed to bypass the rule.
+func auditEvent(action enum.AuditAction, parent ruleParent, rule *types.Rule) types.AuditEvent {
+	data := map[string]string{"pattern": rule.Pattern}
+	if definition, err := json.Marshal(rule.Definition); err == nil {
+		data["definition"] = string(definition)
+	}
+
+	return types.AuditEvent{
+		Action:       action,
+		ResourceType: enum.AuditResourceTypeRule,
+		ResourceName: paths.Concatinate(parent.path, rule.UID),
+		SpaceID:      parent.spaceID,
+		Data:         data,
+	}
+}
+
+func (c *Controller) checkBypassPrincipals(ctx context.Context, def *types.RuleDefinition) error {
+	for _, id := range def.BypassIDs {
+		if _, err := c.principalStore.Find(ctx, id); err != nil {
+			return usererror.BadRequestf("Bypass principal with id %d doesn't exist.", id)
+		}
+	}
+
+	return nil
+}
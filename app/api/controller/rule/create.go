@@ -0,0 +1,118 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"time"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+type CreateInput struct {
+	UID         string               `json:"uid"`
+	Description string               `json:"description"`
+	Pattern     string               `json:"pattern"`
+	Definition  types.RuleDefinition `json:"definition"`
+}
+
+// Create creates a new protection rule.
+func (c *Controller) Create(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.RuleParent,
+	parentRef string,
+	in *CreateInput,
+) (*types.Rule, error) {
+	parent, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkCreateInput(in); err != nil {
+		return nil, err
+	}
+
+	in.Definition.BypassIDs = deduplicateIDs(in.Definition.BypassIDs)
+	if err = c.checkBypassPrincipals(ctx, &in.Definition); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	rule := &types.Rule{
+		ID:         0, // the ID will be populated in the data layer
+		Version:    0, // the Version will be populated in the data layer
+		ParentID:   parent.id,
+		ParentType: parentType,
+		CreatedBy:  session.Principal.ID,
+		Created:    now,
+		Updated:    now,
+
+		// user input
+		UID:         in.UID,
+		Description: in.Description,
+		Pattern:     in.Pattern,
+		Definition:  in.Definition,
+	}
+
+	err = c.ruleStore.Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditRecorder.Record(ctx, session, auditEvent(enum.AuditActionCreate, parent, rule))
+
+	return rule, nil
+}
+
+func checkCreateInput(in *CreateInput) error {
+	if err := check.UID(in.UID); err != nil {
+		return err
+	}
+	if err := check.Description(in.Description); err != nil {
+		return err
+	}
+	if err := checkPattern(in.Pattern); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func checkPattern(pattern string) error {
+	if err := protection.ValidatePattern(pattern); err != nil {
+		return check.NewValidationErrorf("Branch pattern %q is invalid: %s", pattern, err)
+	}
+
+	return nil
+}
+
+func deduplicateIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+
+	return res
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Delete deletes a protection rule of the provided repo or space.
+func (c *Controller) Delete(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.RuleParent,
+	parentRef string,
+	uid string,
+) error {
+	parent, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, true)
+	if err != nil {
+		return err
+	}
+
+	rule, err := c.ruleStore.FindByUID(ctx, parentType, parent.id, uid)
+	if err != nil {
+		return fmt.Errorf("failed to find protection rule by uid: %w", err)
+	}
+
+	if err = c.ruleStore.Delete(ctx, rule.ID); err != nil {
+		return fmt.Errorf("failed to delete protection rule: %w", err)
+	}
+
+	c.auditRecorder.Record(ctx, session, auditEvent(enum.AuditActionDelete, parent, rule))
+
+	return nil
+}
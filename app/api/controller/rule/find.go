@@ -0,0 +1,45 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Find finds a protection rule of the provided repo or space.
+func (c *Controller) Find(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.RuleParent,
+	parentRef string,
+	uid string,
+) (*types.Rule, error) {
+	parent, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := c.ruleStore.FindByUID(ctx, parentType, parent.id, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find protection rule by uid: %w", err)
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// List returns the protection rules of the provided repo or space.
+func (c *Controller) List(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.RuleParent,
+	parentRef string,
+	filter *types.RuleFilter,
+) ([]*types.Rule, int64, error) {
+	parent, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, false)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := c.ruleStore.Count(ctx, parentType, parent.id, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count protection rules for %s with id %d: %w", parentType, parent.id, err)
+	}
+
+	rules, err := c.ruleStore.List(ctx, parentType, parent.id, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list protection rules for %s with id %d: %w", parentType, parent.id, err)
+	}
+
+	return rules, count, nil
+}
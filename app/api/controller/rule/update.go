@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+type UpdateInput struct {
+	UID         *string               `json:"uid"`
+	Description *string               `json:"description"`
+	Pattern     *string               `json:"pattern"`
+	Definition  *types.RuleDefinition `json:"definition"`
+}
+
+// Update updates a protection rule of the provided repo or space.
+func (c *Controller) Update(
+	ctx context.Context,
+	session *auth.Session,
+	parentType enum.RuleParent,
+	parentRef string,
+	uid string,
+	in *UpdateInput,
+) (*types.Rule, error) {
+	parent, err := c.getParentCheckAccess(ctx, session, parentType, parentRef, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkUpdateInput(in); err != nil {
+		return nil, err
+	}
+
+	if in.Definition != nil {
+		in.Definition.BypassIDs = deduplicateIDs(in.Definition.BypassIDs)
+		if err = c.checkBypassPrincipals(ctx, in.Definition); err != nil {
+			return nil, err
+		}
+	}
+
+	rule, err := c.ruleStore.FindByUID(ctx, parentType, parent.id, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find protection rule by uid: %w", err)
+	}
+
+	rule, err = c.ruleStore.UpdateOptLock(ctx, rule, func(rule *types.Rule) error {
+		// update values only if provided
+		if in.UID != nil {
+			rule.UID = *in.UID
+		}
+		if in.Description != nil {
+			rule.Description = *in.Description
+		}
+		if in.Pattern != nil {
+			rule.Pattern = *in.Pattern
+		}
+		if in.Definition != nil {
+			rule.Definition = *in.Definition
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update protection rule: %w", err)
+	}
+
+	c.auditRecorder.Record(ctx, session, auditEvent(enum.AuditActionUpdate, parent, rule))
+
+	return rule, nil
+}
+
+func checkUpdateInput(in *UpdateInput) error {
+	if in.UID != nil {
+		if err := check.UID(*in.UID); err != nil {
+			return err
+		}
+	}
+	if in.Description != nil {
+		if err := check.Description(*in.Description); err != nil {
+			return err
+		}
+	}
+	if in.Pattern != nil {
+		if err := checkPattern(*in.Pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@
 package secret
 
 import (
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/encrypt"
@@ -22,11 +23,12 @@ import (
 )
 
 type Controller struct {
-	uidCheck    check.PathUID
-	encrypter   encrypt.Encrypter
-	secretStore store.SecretStore
-	authorizer  authz.Authorizer
-	spaceStore  store.SpaceStore
+	uidCheck      check.PathUID
+	encrypter     encrypt.Encrypter
+	secretStore   store.SecretStore
+	authorizer    authz.Authorizer
+	spaceStore    store.SpaceStore
+	auditRecorder audit.Recorder
 }
 
 func NewController(
@@ -35,12 +37,14 @@ func NewController(
 	encrypter encrypt.Encrypter,
 	secretStore store.SecretStore,
 	spaceStore store.SpaceStore,
+	auditRecorder audit.Recorder,
 ) *Controller {
 	return &Controller{
-		uidCheck:    uidCheck,
-		encrypter:   encrypter,
-		secretStore: secretStore,
-		authorizer:  authorizer,
-		spaceStore:  spaceStore,
+		uidCheck:      uidCheck,
+		encrypter:     encrypter,
+		secretStore:   secretStore,
+		authorizer:    authorizer,
+		spaceStore:    spaceStore,
+		auditRecorder: auditRecorder,
 	}
 }
@@ -24,6 +24,7 @@ import (
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/paths"
 	"github.com/harness/gitness/encrypt"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/check"
@@ -79,6 +80,13 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, in *Crea
 		return nil, fmt.Errorf("secret creation failed: %w", err)
 	}
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeSecret,
+		ResourceName: paths.Concatinate(parentSpace.Path, secret.UID),
+		SpaceID:      parentSpace.ID,
+	})
+
 	return secret, nil
 }
 
@@ -20,6 +20,8 @@ import (
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
 
@@ -37,5 +39,13 @@ func (c *Controller) Delete(ctx context.Context, session *auth.Session, spaceRef
 	if err != nil {
 		return fmt.Errorf("could not delete secret: %w", err)
 	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionDelete,
+		ResourceType: enum.AuditResourceTypeSecret,
+		ResourceName: paths.Concatinate(space.Path, uid),
+		SpaceID:      space.ID,
+	})
+
 	return nil
 }
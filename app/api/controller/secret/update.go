@@ -17,10 +17,12 @@ package secret
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/paths"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/check"
 	"github.com/harness/gitness/types/enum"
@@ -59,7 +61,9 @@ func (c *Controller) Update(
 		return nil, fmt.Errorf("failed to find secret: %w", err)
 	}
 
-	return c.secretStore.UpdateOptLock(ctx, secret, func(original *types.Secret) error {
+	oldUID := secret.UID
+
+	secret, err = c.secretStore.UpdateOptLock(ctx, secret, func(original *types.Secret) error {
 		if in.UID != nil {
 			original.UID = *in.UID
 		}
@@ -76,6 +80,25 @@ func (c *Controller) Update(
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the secret data itself is never recorded, only whether it changed.
+	auditData := map[string]string{"data_changed": strconv.FormatBool(in.Data != nil)}
+	if secret.UID != oldUID {
+		auditData["old_uid"] = oldUID
+	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionUpdate,
+		ResourceType: enum.AuditResourceTypeSecret,
+		ResourceName: paths.Concatinate(space.Path, secret.UID),
+		SpaceID:      space.ID,
+		Data:         auditData,
+	})
+
+	return secret, nil
 }
 
 func (c *Controller) sanitizeUpdateInput(in *UpdateInput) error {
@@ -15,6 +15,7 @@
 package secret
 
 import (
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/encrypt"
@@ -34,6 +35,7 @@ func ProvideController(
 	secretStore store.SecretStore,
 	authorizer authz.Authorizer,
 	spaceStore store.SpaceStore,
+	auditRecorder audit.Recorder,
 ) *Controller {
-	return NewController(uidCheck, authorizer, encrypter, secretStore, spaceStore)
+	return NewController(uidCheck, authorizer, encrypter, secretStore, spaceStore, auditRecorder)
 }
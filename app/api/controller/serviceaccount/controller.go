@@ -17,10 +17,14 @@ package serviceaccount
 import (
 	"context"
 
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
 )
 
 type Controller struct {
@@ -30,11 +34,12 @@ type Controller struct {
 	spaceStore        store.SpaceStore
 	repoStore         store.RepoStore
 	tokenStore        store.TokenStore
+	auditRecorder     audit.Recorder
 }
 
 func NewController(principalUIDCheck check.PrincipalUID, authorizer authz.Authorizer,
 	principalStore store.PrincipalStore, spaceStore store.SpaceStore, repoStore store.RepoStore,
-	tokenStore store.TokenStore) *Controller {
+	tokenStore store.TokenStore, auditRecorder audit.Recorder) *Controller {
 	return &Controller{
 		principalUIDCheck: principalUIDCheck,
 		authorizer:        authorizer,
@@ -42,7 +47,24 @@ func NewController(principalUIDCheck check.PrincipalUID, authorizer authz.Author
 		spaceStore:        spaceStore,
 		repoStore:         repoStore,
 		tokenStore:        tokenStore,
+		auditRecorder:     auditRecorder,
+	}
+}
+
+// getParentSpaceID returns the ID of the space the service account belongs to,
+// either directly or via its parent repository. It returns zero in case the space can't be determined.
+func (c *Controller) getParentSpaceID(ctx context.Context, sa *types.ServiceAccount) int64 {
+	if sa.ParentType == enum.ParentResourceTypeSpace {
+		return sa.ParentID
 	}
+
+	repo, err := c.repoStore.Find(ctx, sa.ParentID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to find parent repo of service account %d", sa.ID)
+		return 0
+	}
+
+	return repo.ParentID
 }
 
 func findServiceAccountFromUID(ctx context.Context,
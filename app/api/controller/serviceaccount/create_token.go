@@ -19,6 +19,7 @@ import (
 	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/controller"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/token"
 	"github.com/harness/gitness/types"
@@ -29,6 +30,7 @@ import (
 type CreateTokenInput struct {
 	UID      string         `json:"uid"`
 	Lifetime *time.Duration `json:"lifetime"`
+	controller.TokenRestrictionsInput
 }
 
 // CreateToken creates a new service account access token.
@@ -55,6 +57,13 @@ func (c *Controller) CreateToken(
 		sa.ParentType, sa.ParentID, sa.UID, enum.PermissionServiceAccountEdit); err != nil {
 		return nil, err
 	}
+
+	restrictions, err := controller.MapTokenRestrictions(ctx, c.authorizer, session,
+		c.spaceStore, c.repoStore, &in.TokenRestrictionsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	token, jwtToken, err := token.CreateSAT(
 		ctx,
 		c.tokenStore,
@@ -62,10 +71,14 @@ func (c *Controller) CreateToken(
 		sa,
 		in.UID,
 		in.Lifetime,
+		restrictions,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	c.auditRecorder.Record(ctx, session,
+		controller.TokenAuditEvent(enum.AuditActionCreate, sa.UID, token, c.getParentSpaceID(ctx, sa)))
+
 	return &types.TokenResponse{Token: *token, AccessToken: jwtToken}, nil
 }
@@ -15,6 +15,7 @@
 package serviceaccount
 
 import (
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types/check"
@@ -29,6 +30,7 @@ var WireSet = wire.NewSet(
 
 func ProvideController(principalUIDCheck check.PrincipalUID, authorizer authz.Authorizer,
 	principalStore store.PrincipalStore, spaceStore store.SpaceStore, repoStore store.RepoStore,
-	tokenStore store.TokenStore) *Controller {
-	return NewController(principalUIDCheck, authorizer, principalStore, spaceStore, repoStore, tokenStore)
+	tokenStore store.TokenStore, auditRecorder audit.Recorder) *Controller {
+	return NewController(principalUIDCheck, authorizer, principalStore, spaceStore, repoStore, tokenStore,
+		auditRecorder)
 }
@@ -17,7 +17,9 @@ package space
 import (
 	"github.com/harness/gitness/app/api/controller/repo"
 	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/codesearch"
 	"github.com/harness/gitness/app/services/exporter"
 	"github.com/harness/gitness/app/services/importer"
 	"github.com/harness/gitness/app/sse"
@@ -53,6 +55,8 @@ type Controller struct {
 	membershipStore store.MembershipStore
 	importer        *importer.Repository
 	exporter        *exporter.Repository
+	codeSearch      *codesearch.Service
+	auditRecorder   audit.Recorder
 }
 
 func NewController(config *types.Config, tx dbtx.Transactor, urlProvider url.Provider,
@@ -61,6 +65,7 @@ func NewController(config *types.Config, tx dbtx.Transactor, urlProvider url.Pro
 	connectorStore store.ConnectorStore, templateStore store.TemplateStore, spaceStore store.SpaceStore,
 	repoStore store.RepoStore, principalStore store.PrincipalStore, repoCtrl *repo.Controller,
 	membershipStore store.MembershipStore, importer *importer.Repository, exporter *exporter.Repository,
+	codeSearch *codesearch.Service, auditRecorder audit.Recorder,
 ) *Controller {
 	return &Controller{
 		nestedSpacesEnabled: config.NestedSpacesEnabled,
@@ -81,5 +86,7 @@ func NewController(config *types.Config, tx dbtx.Transactor, urlProvider url.Pro
 		membershipStore:     membershipStore,
 		importer:            importer,
 		exporter:            exporter,
+		codeSearch:          codeSearch,
+		auditRecorder:       auditRecorder,
 	}
 }
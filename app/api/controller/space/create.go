@@ -68,6 +68,14 @@ func (c *Controller) Create(
 		return nil, err
 	}
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeSpace,
+		ResourceName: space.Path,
+		SpaceID:      space.ID,
+		Data:         map[string]string{"is_public": strconv.FormatBool(space.IsPublic)},
+	})
+
 	return space, nil
 }
 
@@ -131,7 +139,8 @@ func (c *Controller) createSpaceInnerInTX(
 				SpaceID:     space.ID,
 				PrincipalID: session.Principal.ID,
 			},
-			Role: enum.MembershipRoleSpaceOwner,
+			Role:   enum.MembershipRoleSpaceOwner,
+			Source: enum.MembershipSourceManual,
 
 			// membership has been created by the system
 			CreatedBy: bootstrap.NewSystemServiceSession().Principal.ID,
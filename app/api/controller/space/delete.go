@@ -18,8 +18,10 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
@@ -41,37 +43,89 @@ func (c *Controller) Delete(ctx context.Context, session *auth.Session, spaceRef
 // DeleteNoAuth deletes the space - no authorization is verified.
 // WARNING this is meant for internal calls only.
 func (c *Controller) DeleteNoAuth(ctx context.Context, session *auth.Session, spaceID int64) error {
-	filter := &types.SpaceFilter{
-		Page:  1,
-		Size:  math.MaxInt,
-		Query: "",
-		Order: enum.OrderAsc,
-		Sort:  enum.SpaceAttrNone,
-	}
-	subSpaces, _, err := c.ListSpacesNoAuth(ctx, spaceID, filter)
+	repos, err := c.listRepositoriesRecursivelyNoAuth(ctx, spaceID)
 	if err != nil {
-		return fmt.Errorf("failed to list space %d sub spaces: %w", spaceID, err)
+		return err
+	}
+
+	// repositories with forks can't be deleted, nothing is deleted in case a fork outside of the space would
+	// prevent the deletion of a repository.
+	if err = verifyNoExternalForks(repos); err != nil {
+		return err
 	}
-	for _, space := range subSpaces {
-		err = c.DeleteNoAuth(ctx, session, space.ID)
+
+	// newest repositories first, forks have to be deleted before their upstream repositories
+	// independent of the sub space they are in.
+	sort.SliceStable(repos, func(i, j int) bool {
+		return repos[i].Created > repos[j].Created
+	})
+	for _, repo := range repos {
+		err = c.repoCtrl.DeleteNoAuth(ctx, session, repo)
 		if err != nil {
-			return fmt.Errorf("failed to delete space %d: %w", space.ID, err)
+			return fmt.Errorf("failed to delete repository %d: %w", repo.ID, err)
 		}
 	}
-	err = c.deleteRepositoriesNoAuth(ctx, session, spaceID)
+
+	return c.deleteSpacesNoAuth(ctx, session, spaceID)
+}
+
+// deleteSpacesNoAuth deletes the space and all its sub spaces, the repositories have to be deleted already.
+// WARNING this is meant for internal calls only.
+func (c *Controller) deleteSpacesNoAuth(ctx context.Context, session *auth.Session, spaceID int64) error {
+	space, err := c.spaceStore.Find(ctx, spaceID)
 	if err != nil {
-		return fmt.Errorf("failed to delete repositories of space %d: %w", spaceID, err)
+		return fmt.Errorf("failed to find space %d: %w", spaceID, err)
+	}
+
+	subSpaces, err := c.listSubSpacesNoAuth(ctx, spaceID)
+	if err != nil {
+		return err
+	}
+	for _, subSpace := range subSpaces {
+		err = c.deleteSpacesNoAuth(ctx, session, subSpace.ID)
+		if err != nil {
+			return fmt.Errorf("failed to delete space %d: %w", subSpace.ID, err)
+		}
 	}
 	err = c.spaceStore.Delete(ctx, spaceID)
 	if err != nil {
 		return fmt.Errorf("spaceStore failed to delete space %d: %w", spaceID, err)
 	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionDelete,
+		ResourceType: enum.AuditResourceTypeSpace,
+		ResourceName: space.Path,
+		// the space is gone, the event is kept visible to the owners of the parent space.
+		SpaceID: space.ParentID,
+	})
+
 	return nil
 }
 
-// deleteRepositoriesNoAuth deletes all repositories in a space - no authorization is verified.
+func (c *Controller) listSubSpacesNoAuth(ctx context.Context, spaceID int64) ([]*types.Space, error) {
+	filter := &types.SpaceFilter{
+		Page:  1,
+		Size:  math.MaxInt,
+		Query: "",
+		Order: enum.OrderAsc,
+		Sort:  enum.SpaceAttrNone,
+	}
+	subSpaces, _, err := c.ListSpacesNoAuth(ctx, spaceID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list space %d sub spaces: %w", spaceID, err)
+	}
+
+	return subSpaces, nil
+}
+
+// listRepositoriesRecursivelyNoAuth lists the repositories of a space and its sub spaces.
+// No authorization is verified.
 // WARNING this is meant for internal calls only.
-func (c *Controller) deleteRepositoriesNoAuth(ctx context.Context, session *auth.Session, spaceID int64) error {
+func (c *Controller) listRepositoriesRecursivelyNoAuth(
+	ctx context.Context,
+	spaceID int64,
+) ([]*types.Repository, error) {
 	filter := &types.RepoFilter{
 		Page:  1,
 		Size:  int(math.MaxInt),
@@ -81,13 +135,38 @@ func (c *Controller) deleteRepositoriesNoAuth(ctx context.Context, session *auth
 	}
 	repos, _, err := c.ListRepositoriesNoAuth(ctx, spaceID, filter)
 	if err != nil {
-		return fmt.Errorf("failed to list space repositories: %w", err)
+		return nil, fmt.Errorf("failed to list repositories of space %d: %w", spaceID, err)
 	}
-	for _, repo := range repos {
-		err = c.repoCtrl.DeleteNoAuth(ctx, session, repo)
+
+	subSpaces, err := c.listSubSpacesNoAuth(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, subSpace := range subSpaces {
+		subRepos, err := c.listRepositoriesRecursivelyNoAuth(ctx, subSpace.ID)
 		if err != nil {
-			return fmt.Errorf("failed to delete repository %d: %w", repo.ID, err)
+			return nil, err
+		}
+		repos = append(repos, subRepos...)
+	}
+
+	return repos, nil
+}
+
+// verifyNoExternalForks returns an error if any of the repositories has forks that aren't part of the list.
+func verifyNoExternalForks(repos []*types.Repository) error {
+	forks := make(map[int64]int, len(repos))
+	for _, repo := range repos {
+		if repo.ForkID != 0 {
+			forks[repo.ForkID]++
 		}
 	}
+
+	for _, repo := range repos {
+		if repo.NumForks > forks[repo.ID] {
+			return usererror.ErrSpaceWithExternalForksCantBeDeleted
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package space
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyNoExternalForks(t *testing.T) {
+	tests := []struct {
+		name   string
+		repos  []*types.Repository
+		expErr error
+	}{
+		{
+			name: "no-forks",
+			repos: []*types.Repository{
+				{ID: 1},
+				{ID: 2},
+			},
+		},
+		{
+			name: "forks-in-space",
+			repos: []*types.Repository{
+				{ID: 1, NumForks: 2},
+				{ID: 2, ForkID: 1, NumForks: 1},
+				{ID: 3, ForkID: 1},
+				{ID: 4, ForkID: 2},
+			},
+		},
+		{
+			name: "fork-outside-of-space",
+			repos: []*types.Repository{
+				{ID: 1, NumForks: 2},
+				{ID: 2, ForkID: 1},
+			},
+			expErr: usererror.ErrSpaceWithExternalForksCantBeDeleted,
+		},
+		{
+			name: "fork-of-repo-outside-of-space",
+			repos: []*types.Repository{
+				{ID: 2, ForkID: 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := verifyNoExternalForks(test.repos)
+			if test.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, test.expErr)
+		})
+	}
+}
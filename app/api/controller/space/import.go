@@ -17,11 +17,13 @@ package space
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/services/importer"
 	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
 )
 
 type ImportInput struct {
@@ -90,5 +92,13 @@ func (c *Controller) Import(ctx context.Context, session *auth.Session, in *Impo
 		return nil, err
 	}
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeSpace,
+		ResourceName: space.Path,
+		SpaceID:      space.ID,
+		Data:         map[string]string{"is_public": strconv.FormatBool(space.IsPublic), "import": "true"},
+	})
+
 	return space, nil
 }
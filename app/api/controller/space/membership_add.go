@@ -93,6 +93,7 @@ func (c *Controller) MembershipAdd(ctx context.Context,
 		Created:   now,
 		Updated:   now,
 		Role:      in.Role,
+		Source:    enum.MembershipSourceManual,
 	}
 
 	err = c.membershipStore.Create(ctx, &membership)
@@ -100,6 +101,14 @@ func (c *Controller) MembershipAdd(ctx context.Context,
 		return nil, fmt.Errorf("failed to create new membership: %w", err)
 	}
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeMembership,
+		ResourceName: user.UID,
+		SpaceID:      space.ID,
+		Data:         map[string]string{"space": space.Path, "role": string(membership.Role)},
+	})
+
 	result := &types.MembershipUser{
 		Membership: membership,
 		Principal:  *user.ToPrincipalInfo(),
@@ -52,5 +52,13 @@ func (c *Controller) MembershipDelete(ctx context.Context,
 		return fmt.Errorf("failed to delete user membership: %w", err)
 	}
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionDelete,
+		ResourceType: enum.AuditResourceTypeMembership,
+		ResourceName: user.UID,
+		SpaceID:      space.ID,
+		Data:         map[string]string{"space": space.Path},
+	})
+
 	return nil
 }
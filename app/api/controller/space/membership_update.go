@@ -84,12 +84,22 @@ func (c *Controller) MembershipUpdate(ctx context.Context,
 		return membership, nil
 	}
 
+	// memberships changed by users are no longer managed by group syncs.
 	membership.Role = in.Role
+	membership.Source = enum.MembershipSourceManual
 
 	err = c.membershipStore.Update(ctx, &membership.Membership)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update membership")
 	}
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionUpdate,
+		ResourceType: enum.AuditResourceTypeMembership,
+		ResourceName: user.UID,
+		SpaceID:      space.ID,
+		Data:         map[string]string{"space": space.Path, "role": string(membership.Role)},
+	})
+
 	return membership, nil
 }
@@ -21,6 +21,7 @@ import (
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/paths"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
@@ -66,6 +67,8 @@ func (c *Controller) Move(
 		return space, nil
 	}
 
+	oldPath := space.Path
+
 	if err = c.moveInner(
 		ctx,
 		session,
@@ -75,6 +78,16 @@ func (c *Controller) Move(
 		return nil, err
 	}
 
+	parentPath, _, _ := paths.DisectLeaf(oldPath)
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionMove,
+		ResourceType: enum.AuditResourceTypeSpace,
+		ResourceName: paths.Concatinate(parentPath, space.UID),
+		SpaceID:      space.ID,
+		Data:         map[string]string{"old_path": oldPath},
+	})
+
 	return space, nil
 }
 
@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package space
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Search searches the content of the default branches of all repositories in a space and its sub spaces.
+// Repositories the caller isn't allowed to view are skipped.
+func (c *Controller) Search(ctx context.Context,
+	session *auth.Session,
+	spaceRef string,
+	filter *types.CodeSearchFilter,
+) ([]types.CodeSearchResult, error) {
+	space, err := c.spaceStore.FindByRef(ctx, spaceRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = apiauth.CheckSpace(ctx, c.authorizer, session, space, enum.PermissionRepoView, true); err != nil {
+		return nil, err
+	}
+
+	repos, err := c.listRepositoriesRecursive(ctx, space.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	visibleRepos := make([]*types.Repository, 0, len(repos))
+	for _, repo := range repos {
+		err = apiauth.CheckRepo(ctx, c.authorizer, session, repo, enum.PermissionRepoView, true)
+		if errors.Is(err, apiauth.ErrNotAuthorized) || errors.Is(err, apiauth.ErrNotAuthenticated) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to check access to repo %d: %w", repo.ID, err)
+		}
+
+		visibleRepos = append(visibleRepos, repo)
+	}
+
+	return c.codeSearch.Search(ctx, visibleRepos, filter)
+}
+
+// listRepositoriesRecursive lists all repositories of a space and its sub spaces - no authorization is verified.
+func (c *Controller) listRepositoriesRecursive(ctx context.Context, spaceID int64) ([]*types.Repository, error) {
+	repos, _, err := c.ListRepositoriesNoAuth(ctx, spaceID, &types.RepoFilter{
+		Page:  1,
+		Size:  math.MaxInt,
+		Order: enum.OrderAsc,
+		Sort:  enum.RepoAttrUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories of space %d: %w", spaceID, err)
+	}
+
+	subSpaces, _, err := c.ListSpacesNoAuth(ctx, spaceID, &types.SpaceFilter{
+		Page:  1,
+		Size:  math.MaxInt,
+		Order: enum.OrderAsc,
+		Sort:  enum.SpaceAttrUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sub spaces of space %d: %w", spaceID, err)
+	}
+
+	for _, subSpace := range subSpaces {
+		subRepos, err := c.listRepositoriesRecursive(ctx, subSpace.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		repos = append(repos, subRepos...)
+	}
+
+	return repos, nil
+}
@@ -17,6 +17,7 @@ package space
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
@@ -57,6 +58,8 @@ func (c *Controller) Update(ctx context.Context, session *auth.Session,
 		return nil, fmt.Errorf("failed to sanitize input: %w", err)
 	}
 
+	wasPublic := space.IsPublic
+
 	space, err = c.spaceStore.UpdateOptLock(ctx, space, func(space *types.Space) error {
 		// update values only if provided
 		if in.Description != nil {
@@ -72,6 +75,19 @@ func (c *Controller) Update(ctx context.Context, session *auth.Session,
 		return nil, err
 	}
 
+	auditData := map[string]string{}
+	if space.IsPublic != wasPublic {
+		auditData["is_public"] = strconv.FormatBool(space.IsPublic)
+	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionUpdate,
+		ResourceType: enum.AuditResourceTypeSpace,
+		ResourceName: space.Path,
+		SpaceID:      space.ID,
+		Data:         auditData,
+	})
+
 	return space, nil
 }
 
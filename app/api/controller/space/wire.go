@@ -16,7 +16,9 @@ package space
 
 import (
 	"github.com/harness/gitness/app/api/controller/repo"
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/codesearch"
 	"github.com/harness/gitness/app/services/exporter"
 	"github.com/harness/gitness/app/services/importer"
 	"github.com/harness/gitness/app/sse"
@@ -40,11 +42,11 @@ func ProvideController(config *types.Config, tx dbtx.Transactor, urlProvider url
 	connectorStore store.ConnectorStore, templateStore store.TemplateStore,
 	spaceStore store.SpaceStore, repoStore store.RepoStore, principalStore store.PrincipalStore,
 	repoCtrl *repo.Controller, membershipStore store.MembershipStore, importer *importer.Repository,
-	exporter *exporter.Repository,
+	exporter *exporter.Repository, codeSearch *codesearch.Service, auditRecorder audit.Recorder,
 ) *Controller {
 	return NewController(config, tx, urlProvider, sseStreamer, uidCheck, authorizer,
 		spacePathStore, pipelineStore, secretStore,
 		connectorStore, templateStore,
 		spaceStore, repoStore, principalStore,
-		repoCtrl, membershipStore, importer, exporter)
+		repoCtrl, membershipStore, importer, exporter, codeSearch, auditRecorder)
 }
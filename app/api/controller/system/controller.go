@@ -34,6 +34,11 @@ func NewController(principalStore store.PrincipalStore, config *types.Config) *C
 }
 
 func (c *Controller) IsUserSignupAllowed(ctx context.Context) (bool, error) {
+	// users have to be provisioned via single sign-on.
+	if c.IsPasswordLoginDisabled() {
+		return false, nil
+	}
+
 	usrCount, err := c.principalStore.CountUsers(ctx, &types.UserFilter{})
 	if err != nil {
 		return false, err
@@ -41,3 +46,13 @@ func (c *Controller) IsUserSignupAllowed(ctx context.Context) (bool, error) {
 
 	return usrCount == 0 || c.config.UserSignupEnabled, nil
 }
+
+// IsOIDCLoginEnabled returns true if users can log in via OpenID Connect single sign-on.
+func (c *Controller) IsOIDCLoginEnabled() bool {
+	return c.config.OIDC.Enabled
+}
+
+// IsPasswordLoginDisabled returns true if users (other than admins) have to log in via single sign-on.
+func (c *Controller) IsPasswordLoginDisabled() bool {
+	return c.config.OIDC.Enabled && c.config.OIDC.DisablePasswordLogin
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"golang.org/x/exp/slices"
+)
+
+// TokenRestrictionsInput restricts a new access token to a subset of the permissions of its principal.
+type TokenRestrictionsInput struct {
+	Scopes []enum.TokenScope `json:"scopes"`
+	// Spaces restricts the token to the spaces (including their subspaces) with the given refs.
+	Spaces []string `json:"spaces"`
+	// Repos restricts the token to the repositories with the given refs.
+	Repos []string `json:"repos"`
+}
+
+// MapTokenRestrictions validates the token restrictions and resolves the spaces and repositories.
+// The session is required to have view access to all of them.
+func MapTokenRestrictions(
+	ctx context.Context,
+	authorizer authz.Authorizer,
+	session *auth.Session,
+	spaceStore store.SpaceStore,
+	repoStore store.RepoStore,
+	in *TokenRestrictionsInput,
+) (types.TokenRestrictions, error) {
+	res := types.TokenRestrictions{}
+
+	for _, rawScope := range in.Scopes {
+		scope, ok := rawScope.Sanitize()
+		if !ok {
+			return res, usererror.BadRequestf("Token scope %q is not supported", rawScope)
+		}
+		if !slices.Contains(res.Scopes, scope) {
+			res.Scopes = append(res.Scopes, scope)
+		}
+	}
+
+	for _, spaceRef := range in.Spaces {
+		space, err := spaceStore.FindByRef(ctx, spaceRef)
+		if err != nil {
+			return res, fmt.Errorf("failed to find space %q: %w", spaceRef, err)
+		}
+
+		if err = apiauth.CheckSpace(ctx, authorizer, session, space, enum.PermissionSpaceView, false); err != nil {
+			return res, err
+		}
+
+		if !slices.Contains(res.SpaceIDs, space.ID) {
+			res.SpaceIDs = append(res.SpaceIDs, space.ID)
+		}
+	}
+
+	for _, repoRef := range in.Repos {
+		repo, err := repoStore.FindByRef(ctx, repoRef)
+		if err != nil {
+			return res, fmt.Errorf("failed to find repository %q: %w", repoRef, err)
+		}
+
+		if err = apiauth.CheckRepo(ctx, authorizer, session, repo, enum.PermissionRepoView, false); err != nil {
+			return res, err
+		}
+
+		if !slices.Contains(res.RepoIDs, repo.ID) {
+			res.RepoIDs = append(res.RepoIDs, repo.ID)
+		}
+	}
+
+	return res, nil
+}
+
+// TokenAuditEvent returns the audit event for an action on an access token of a principal.
+func TokenAuditEvent(action enum.AuditAction, principalUID string, token *types.Token, spaceID int64) types.AuditEvent {
+	data := map[string]string{"type": string(token.Type)}
+	if len(token.Scopes) > 0 {
+		scopes := make([]string, len(token.Scopes))
+		for i, scope := range token.Scopes {
+			scopes[i] = string(scope)
+		}
+		data["scopes"] = strings.Join(scopes, ",")
+	}
+	if token.IsResourceRestricted() {
+		data["resource_restricted"] = "true"
+	}
+
+	return types.AuditEvent{
+		Action:       action,
+		ResourceType: enum.AuditResourceTypeToken,
+		ResourceName: paths.Concatinate(principalUID, token.UID),
+		SpaceID:      spaceID,
+		Data:         data,
+	}
+}
@@ -15,8 +15,12 @@
 package trigger
 
 import (
+	"time"
+
 	"github.com/harness/gitness/types/check"
 	"github.com/harness/gitness/types/enum"
+
+	"github.com/gorhill/cronexpr"
 )
 
 const (
@@ -24,6 +28,9 @@ const (
 	// TODO: Check whether this is sufficient for other SCM providers once we
 	// add support. For now it's good to have a limit and increase if needed.
 	triggerMaxSecretLength = 4096
+
+	// triggerCronMinInterval defines the minimum allowed interval between two scheduled executions.
+	triggerCronMinInterval = time.Minute
 )
 
 // checkSecret validates the secret of a trigger.
@@ -48,6 +55,38 @@ func checkActions(actions []enum.TriggerAction) error {
 	return nil
 }
 
+// checkCron validates the cron expression of a trigger. An empty expression is valid.
+func checkCron(cron string) error {
+	if cron == "" {
+		return nil
+	}
+
+	exp, err := cronexpr.Parse(cron)
+	if err != nil {
+		return check.NewValidationErrorf("The provided cron expression is invalid: %s", err)
+	}
+
+	next := exp.NextN(time.Now(), 2)
+	if len(next) < 2 {
+		return check.NewValidationError("The provided cron expression never triggers an execution.")
+	}
+
+	if next[1].Sub(next[0]) < triggerCronMinInterval {
+		return check.NewValidationError("Executions can't be scheduled more often than once a minute.")
+	}
+
+	return nil
+}
+
+// checkTimezone validates the time zone in which the cron expression of a trigger is evaluated.
+func checkTimezone(timezone string) error {
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return check.NewValidationErrorf("The provided time zone '%s' is invalid.", timezone)
+	}
+
+	return nil
+}
+
 // deduplicateActions de-duplicates the actions provided by in the trigger.
 func deduplicateActions(in []enum.TriggerAction) []enum.TriggerAction {
 	if len(in) == 0 {
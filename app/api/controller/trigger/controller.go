@@ -16,6 +16,7 @@ package trigger
 
 import (
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types/check"
 )
@@ -26,6 +27,7 @@ type Controller struct {
 	uidCheck      check.PathUID
 	pipelineStore store.PipelineStore
 	repoStore     store.RepoStore
+	cron          *trigger.Cron
 }
 
 func NewController(
@@ -34,6 +36,7 @@ func NewController(
 	uidCheck check.PathUID,
 	pipelineStore store.PipelineStore,
 	repoStore store.RepoStore,
+	cron *trigger.Cron,
 ) *Controller {
 	return &Controller{
 		authorizer:    authorizer,
@@ -41,5 +44,6 @@ func NewController(
 		uidCheck:      uidCheck,
 		pipelineStore: pipelineStore,
 		repoStore:     repoStore,
+		cron:          cron,
 	}
 }
@@ -17,6 +17,7 @@ package trigger
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
@@ -33,6 +34,12 @@ type CreateInput struct {
 	Secret      string               `json:"secret"`
 	Disabled    bool                 `json:"disabled"`
 	Actions     []enum.TriggerAction `json:"actions"`
+
+	// Cron schedules periodic executions of the pipeline for the branch.
+	// If the branch is empty, the default branch of the pipeline is used.
+	Cron     string `json:"cron"`
+	Branch   string `json:"branch"`
+	Timezone string `json:"timezone"`
 }
 
 func (c *Controller) Create(
@@ -71,6 +78,9 @@ func (c *Controller) Create(
 		CreatedBy:   session.Principal.ID,
 		RepoID:      repo.ID,
 		Actions:     deduplicateActions(in.Actions),
+		Cron:        in.Cron,
+		Branch:      in.Branch,
+		Timezone:    in.Timezone,
 		UID:         in.UID,
 		PipelineID:  pipeline.ID,
 		Created:     now,
@@ -82,6 +92,11 @@ func (c *Controller) Create(
 		return nil, fmt.Errorf("trigger creation failed: %w", err)
 	}
 
+	err = c.cron.Schedule(ctx, trigger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule trigger: %w", err)
+	}
+
 	return trigger, nil
 }
 
@@ -95,6 +110,14 @@ func (c *Controller) checkCreateInput(in *CreateInput) error {
 	if err := checkActions(in.Actions); err != nil {
 		return err
 	}
+	in.Cron = strings.TrimSpace(in.Cron)
+	if err := checkCron(in.Cron); err != nil {
+		return err
+	}
+	in.Branch = strings.TrimSpace(in.Branch)
+	if err := checkTimezone(in.Timezone); err != nil {
+		return err
+	}
 	if err := c.uidCheck(in.UID, false); err != nil {
 		return err
 	}
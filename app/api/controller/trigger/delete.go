@@ -46,9 +46,20 @@ func (c *Controller) Delete(
 		return fmt.Errorf("failed to find pipeline: %w", err)
 	}
 
+	trigger, err := c.triggerStore.FindByUID(ctx, pipeline.ID, triggerUID)
+	if err != nil {
+		return fmt.Errorf("failed to find trigger: %w", err)
+	}
+
 	err = c.triggerStore.DeleteByUID(ctx, pipeline.ID, triggerUID)
 	if err != nil {
 		return fmt.Errorf("could not delete trigger: %w", err)
 	}
+
+	err = c.cron.Unschedule(ctx, trigger.ID)
+	if err != nil {
+		return fmt.Errorf("failed to unschedule trigger: %w", err)
+	}
+
 	return nil
 }
@@ -33,6 +33,9 @@ type UpdateInput struct {
 	Actions     []enum.TriggerAction `json:"actions"`
 	Secret      *string              `json:"secret"`
 	Disabled    *bool                `json:"disabled"` // can be nil, so keeping it a pointer
+	Cron        *string              `json:"cron"`
+	Branch      *string              `json:"branch"`
+	Timezone    *string              `json:"timezone"`
 }
 
 func (c *Controller) Update(
@@ -68,7 +71,7 @@ func (c *Controller) Update(
 		return nil, fmt.Errorf("failed to find trigger: %w", err)
 	}
 
-	return c.triggerStore.UpdateOptLock(ctx,
+	trigger, err = c.triggerStore.UpdateOptLock(ctx,
 		trigger, func(original *types.Trigger) error {
 			if in.UID != nil {
 				original.UID = *in.UID
@@ -85,9 +88,28 @@ func (c *Controller) Update(
 			if in.Disabled != nil {
 				original.Disabled = *in.Disabled
 			}
+			if in.Cron != nil {
+				original.Cron = *in.Cron
+			}
+			if in.Branch != nil {
+				original.Branch = *in.Branch
+			}
+			if in.Timezone != nil {
+				original.Timezone = *in.Timezone
+			}
 
 			return nil
 		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update trigger: %w", err)
+	}
+
+	err = c.cron.Schedule(ctx, trigger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule trigger: %w", err)
+	}
+
+	return trigger, nil
 }
 
 func (c *Controller) checkUpdateInput(in *UpdateInput) error {
@@ -116,5 +138,22 @@ func (c *Controller) checkUpdateInput(in *UpdateInput) error {
 		}
 	}
 
+	if in.Cron != nil {
+		*in.Cron = strings.TrimSpace(*in.Cron)
+		if err := checkCron(*in.Cron); err != nil {
+			return err
+		}
+	}
+
+	if in.Branch != nil {
+		*in.Branch = strings.TrimSpace(*in.Branch)
+	}
+
+	if in.Timezone != nil {
+		if err := checkTimezone(*in.Timezone); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
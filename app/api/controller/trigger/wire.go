@@ -16,6 +16,7 @@ package trigger
 
 import (
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types/check"
 
@@ -33,6 +34,7 @@ func ProvideController(
 	uidCheck check.PathUID,
 	pipelineStore store.PipelineStore,
 	repoStore store.RepoStore,
+	cron *trigger.Cron,
 ) *Controller {
-	return NewController(authorizer, triggerStore, uidCheck, pipelineStore, repoStore)
+	return NewController(authorizer, triggerStore, uidCheck, pipelineStore, repoStore, cron)
 }
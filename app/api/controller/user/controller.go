@@ -16,9 +16,16 @@ package user
 
 import (
 	"context"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/auth/ldap"
+	"github.com/harness/gitness/app/auth/oidc"
 	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/encrypt"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/check"
@@ -33,7 +40,19 @@ type Controller struct {
 	authorizer        authz.Authorizer
 	principalStore    store.PrincipalStore
 	tokenStore        store.TokenStore
+	publicKeyStore    store.PublicKeyStore
+	signingKeyStore   store.SigningKeyStore
 	membershipStore   store.MembershipStore
+	spaceStore        store.SpaceStore
+	repoStore         store.RepoStore
+	oidcProvider      *oidc.Provider
+	ldapAuthenticator *ldap.Authenticator
+	totpStore         store.TOTPStore
+	encrypter         encrypt.Encrypter
+	auditRecorder     audit.Recorder
+	totpRequired      bool
+	totpIssuer        string
+	totpChallengeTTL  time.Duration
 }
 
 func NewController(
@@ -42,7 +61,19 @@ func NewController(
 	authorizer authz.Authorizer,
 	principalStore store.PrincipalStore,
 	tokenStore store.TokenStore,
+	publicKeyStore store.PublicKeyStore,
+	signingKeyStore store.SigningKeyStore,
 	membershipStore store.MembershipStore,
+	spaceStore store.SpaceStore,
+	repoStore store.RepoStore,
+	oidcProvider *oidc.Provider,
+	ldapAuthenticator *ldap.Authenticator,
+	totpStore store.TOTPStore,
+	encrypter encrypt.Encrypter,
+	auditRecorder audit.Recorder,
+	totpRequired bool,
+	totpIssuer string,
+	totpChallengeTTL time.Duration,
 ) *Controller {
 	return &Controller{
 		tx:                tx,
@@ -50,10 +81,34 @@ func NewController(
 		authorizer:        authorizer,
 		principalStore:    principalStore,
 		tokenStore:        tokenStore,
+		publicKeyStore:    publicKeyStore,
+		signingKeyStore:   signingKeyStore,
 		membershipStore:   membershipStore,
+		spaceStore:        spaceStore,
+		repoStore:         repoStore,
+		oidcProvider:      oidcProvider,
+		ldapAuthenticator: ldapAuthenticator,
+		totpStore:         totpStore,
+		encrypter:         encrypter,
+		auditRecorder:     auditRecorder,
+		totpRequired:      totpRequired,
+		totpIssuer:        totpIssuer,
+		totpChallengeTTL:  totpChallengeTTL,
 	}
 }
 
+const (
+	// externalPasswordLength is the length of the random password of just-in-time provisioned users.
+	// The password isn't known to anyone, such users can only log in via their identity provider.
+	externalPasswordLength = 64
+
+	// externalUIDMaxAttempts is the number of uids that are tried in case the uid of a new user is already taken.
+	externalUIDMaxAttempts = 10
+	externalUIDMaxLength   = 90
+)
+
+var externalUIDInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9-_.]`)
+
 var hashPassword = bcrypt.GenerateFromPassword
 
 func findUserFromUID(ctx context.Context,
@@ -71,3 +126,21 @@ func findUserFromEmail(ctx context.Context,
 func isUserTokenType(tokenType enum.TokenType) bool {
 	return tokenType == enum.TokenTypePAT || tokenType == enum.TokenTypeSession
 }
+
+// sanitizeExternalUID converts the uid of an identity provider or directory into a valid principal uid.
+func sanitizeExternalUID(uid string) string {
+	uid = externalUIDInvalidChars.ReplaceAllString(uid, "_")
+	if uid == "" || !isLetterOrUnderscore(uid[0]) {
+		uid = "_" + uid
+	}
+
+	if len(uid) > externalUIDMaxLength {
+		uid = uid[:externalUIDMaxLength]
+	}
+
+	return strings.TrimRight(uid, "-.")
+}
+
+func isLetterOrUnderscore(b byte) bool {
+	return b == '_' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
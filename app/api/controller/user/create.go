@@ -17,6 +17,7 @@ package user
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,7 +51,19 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, in *Crea
 		return nil, err
 	}
 
-	return c.CreateNoAuth(ctx, in, false)
+	user, err := c.CreateNoAuth(ctx, in, false)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeUser,
+		ResourceName: user.UID,
+		Data:         map[string]string{"admin": strconv.FormatBool(user.Admin)},
+	})
+
+	return user, nil
 }
 
 /*
@@ -60,6 +73,17 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, in *Crea
  * Note: take admin separately to avoid potential vulnerabilities for user calls.
  */
 func (c *Controller) CreateNoAuth(ctx context.Context, in *CreateInput, admin bool) (*types.User, error) {
+	return c.createUser(ctx, in, admin, enum.UserSourceLocal, "")
+}
+
+// createUser creates a new user that is linked to the provided external identity.
+func (c *Controller) createUser(
+	ctx context.Context,
+	in *CreateInput,
+	admin bool,
+	source enum.UserSource,
+	externalID string,
+) (*types.User, error) {
 	if err := c.sanitizeCreateInput(in); err != nil {
 		return nil, fmt.Errorf("invalid input: %w", err)
 	}
@@ -78,6 +102,8 @@ func (c *Controller) CreateNoAuth(ctx context.Context, in *CreateInput, admin bo
 		Created:     time.Now().UnixMilli(),
 		Updated:     time.Now().UnixMilli(),
 		Admin:       admin,
+		Source:      source,
+		ExternalID:  externalID,
 	}
 
 	err = c.principalStore.CreateUser(ctx, user)
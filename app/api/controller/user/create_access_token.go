@@ -19,6 +19,7 @@ import (
 	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/controller"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/token"
 	"github.com/harness/gitness/types"
@@ -29,6 +30,7 @@ import (
 type CreateTokenInput struct {
 	UID      string         `json:"uid"`
 	Lifetime *time.Duration `json:"lifetime"`
+	controller.TokenRestrictionsInput
 }
 
 /*
@@ -57,6 +59,12 @@ func (c *Controller) CreateAccessToken(
 		return nil, err
 	}
 
+	restrictions, err := controller.MapTokenRestrictions(ctx, c.authorizer, session,
+		c.spaceStore, c.repoStore, &in.TokenRestrictionsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	token, jwtToken, err := token.CreatePAT(
 		ctx,
 		c.tokenStore,
@@ -64,10 +72,13 @@ func (c *Controller) CreateAccessToken(
 		user,
 		in.UID,
 		in.Lifetime,
+		restrictions,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	c.auditRecorder.Record(ctx, session, controller.TokenAuditEvent(enum.AuditActionCreate, user.UID, token, 0))
+
 	return &types.TokenResponse{Token: *token, AccessToken: jwtToken}, nil
 }
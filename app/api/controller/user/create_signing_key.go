@@ -0,0 +1,89 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/app/services/signing"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+type CreateSigningKeyInput struct {
+	UID string `json:"uid"`
+	// Content is either an armored gpg public key or an ssh public key in authorized_keys format.
+	Content string `json:"content"`
+}
+
+/*
+ * CreateSigningKey adds a new gpg or ssh signing key to a user.
+ */
+func (c *Controller) CreateSigningKey(
+	ctx context.Context,
+	session *auth.Session,
+	userUID string,
+	in *CreateSigningKeyInput,
+) (*types.SigningKey, error) {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure principal has required permissions on parent
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return nil, err
+	}
+
+	if err = check.UID(in.UID); err != nil {
+		return nil, err
+	}
+
+	content := strings.TrimSpace(in.Content)
+	key, err := signing.ParseKey(content)
+	if err != nil {
+		return nil, usererror.BadRequestf("Invalid signing key: %s", err)
+	}
+
+	signingKey := &types.SigningKey{
+		PrincipalID: user.ID,
+		Created:     time.Now().UnixMilli(),
+		UID:         in.UID,
+		Type:        key.Type,
+		Fingerprint: key.Fingerprint,
+		Content:     content,
+	}
+
+	err = c.signingKeyStore.Create(ctx, signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeSigningKey,
+		ResourceName: paths.Concatinate(user.UID, signingKey.UID),
+		Data:         map[string]string{"type": string(signingKey.Type), "fingerprint": signingKey.Fingerprint},
+	})
+
+	return signingKey, nil
+}
@@ -57,5 +57,15 @@ func (c *Controller) Delete(ctx context.Context, session *auth.Session,
 		return fmt.Errorf("failed to delete tokens for user: %w", err)
 	}
 
-	return c.principalStore.DeleteUser(ctx, user.ID)
+	if err = c.principalStore.DeleteUser(ctx, user.ID); err != nil {
+		return err
+	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionDelete,
+		ResourceType: enum.AuditResourceTypeUser,
+		ResourceName: user.UID,
+	})
+
+	return nil
 }
@@ -0,0 +1,60 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+/*
+ * DeleteSigningKey deletes a gpg or ssh signing key of a user.
+ */
+func (c *Controller) DeleteSigningKey(ctx context.Context, session *auth.Session,
+	userUID string, signingKeyUID string) error {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return err
+	}
+
+	// Ensure principal has required permissions on parent.
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return err
+	}
+
+	// the lookup is scoped to the user, keys of other principals are reported as not found.
+	signingKey, err := c.signingKeyStore.FindByUID(ctx, user.ID, signingKeyUID)
+	if err != nil {
+		return err
+	}
+
+	if err = c.signingKeyStore.Delete(ctx, signingKey.ID); err != nil {
+		return err
+	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionDelete,
+		ResourceType: enum.AuditResourceTypeSigningKey,
+		ResourceName: paths.Concatinate(user.UID, signingKey.UID),
+		Data:         map[string]string{"type": string(signingKey.Type), "fingerprint": signingKey.Fingerprint},
+	})
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/controller"
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/types/enum"
@@ -59,5 +60,11 @@ func (c *Controller) DeleteToken(ctx context.Context, session *auth.Session,
 		return usererror.ErrNotFound
 	}
 
-	return c.tokenStore.Delete(ctx, token.ID)
+	if err = c.tokenStore.Delete(ctx, token.ID); err != nil {
+		return err
+	}
+
+	c.auditRecorder.Record(ctx, session, controller.TokenAuditEvent(enum.AuditActionDelete, user.UID, token, 0))
+
+	return nil
 }
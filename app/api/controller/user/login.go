@@ -39,11 +39,30 @@ type LoginInput struct {
 
 /*
  * Login attempts to login as a specific user - returns the session token if successful.
+ * If LDAP authentication is enabled, the directory is used in case the local password check fails.
+ * Users with two-factor authentication get a challenge instead, which has to be completed with LoginTOTP.
  */
 func (c *Controller) Login(ctx context.Context, session *auth.Session,
-	in *LoginInput) (*types.TokenResponse, error) {
+	in *LoginInput) (*types.LoginResponse, error) {
 	// no auth check required, password is used for it.
 
+	user, err := c.loginWithPassword(ctx, in)
+	if errors.Is(err, usererror.ErrNotFound) && c.ldapAuthenticator.Enabled() {
+		user, err = c.loginWithLDAP(ctx, in)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Blocked {
+		return nil, usererror.Forbidden("User is blocked")
+	}
+
+	return c.createSessionOrChallenge(ctx, user)
+}
+
+// loginWithPassword verifies the credentials against the local password of the user.
+func (c *Controller) loginWithPassword(ctx context.Context, in *LoginInput) (*types.User, error) {
 	user, err := findUserFromUID(ctx, c.principalStore, in.LoginIdentifier)
 	if errors.Is(err, store.ErrResourceNotFound) {
 		user, err = findUserFromEmail(ctx, c.principalStore, in.LoginIdentifier)
@@ -69,6 +88,34 @@ func (c *Controller) Login(ctx context.Context, session *auth.Session,
 		return nil, usererror.ErrNotFound
 	}
 
+	// admins can still use their password in case the identity provider isn't available.
+	if c.oidcProvider.PasswordLoginDisabled() && !user.Admin {
+		return nil, usererror.Forbidden("Password login is disabled, please use single sign-on")
+	}
+
+	return user, nil
+}
+
+// createSessionOrChallenge returns a new session token for the user,
+// or a two-factor authentication challenge in case the user has to provide a one-time password.
+func (c *Controller) createSessionOrChallenge(ctx context.Context, user *types.User) (*types.LoginResponse, error) {
+	challenge, err := c.createTwoFactorChallenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return &types.LoginResponse{TwoFactorChallenge: challenge}, nil
+	}
+
+	tokenResponse, err := c.createSession(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LoginResponse{TokenResponse: tokenResponse}, nil
+}
+
+func (c *Controller) createSession(ctx context.Context, user *types.User) (*types.TokenResponse, error) {
 	tokenUID, err := generateSessionTokenUID()
 	if err != nil {
 		return nil, err
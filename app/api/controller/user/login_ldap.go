@@ -0,0 +1,146 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth/ldap"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/dchest/uniuri"
+	"github.com/rs/zerolog/log"
+)
+
+// errLDAPUserConflict is returned if a directory user would be linked to an existing local user.
+var errLDAPUserConflict = usererror.Forbidden(
+	"A local user with the same uid or email already exists, please ask an administrator to resolve the conflict")
+
+// loginWithLDAP verifies the credentials against the directory.
+// The local user is created on the first login and updated with the directory entry on every login.
+// Directory users are only ever linked to users that were provisioned from the directory,
+// never to existing local users, as that would allow the directory to take over local accounts.
+func (c *Controller) loginWithLDAP(ctx context.Context, in *LoginInput) (*types.User, error) {
+	entry, err := c.ldapAuthenticator.Authenticate(ctx, in.LoginIdentifier, in.Password)
+	if errors.Is(err, ldap.ErrInvalidCredentials) {
+		log.Ctx(ctx).Debug().
+			Str("user_uid", in.LoginIdentifier).
+			Msg("invalid LDAP credentials")
+
+		return nil, usererror.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate with LDAP: %w", err)
+	}
+
+	if entry.UID == "" {
+		return nil, usererror.Forbidden("The directory entry of the user has no uid")
+	}
+	if entry.Email == "" {
+		return nil, usererror.Forbidden("The directory entry of the user has no email address")
+	}
+
+	uid := sanitizeExternalUID(entry.UID)
+
+	displayName := strings.TrimSpace(entry.DisplayName)
+	if displayName == "" {
+		displayName = uid
+	}
+
+	user, err := c.principalStore.FindUserByExternalID(ctx, enum.UserSourceLDAP, entry.UID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return c.provisionLDAPUser(ctx, entry.UID, uid, entry.Email, displayName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if user.Email == entry.Email && user.DisplayName == displayName {
+		return user, nil
+	}
+
+	if err = check.Email(entry.Email); err != nil {
+		return nil, err
+	}
+	if err = check.DisplayName(displayName); err != nil {
+		return nil, err
+	}
+
+	user.Email = entry.Email
+	user.DisplayName = displayName
+	user.Updated = time.Now().UnixMilli()
+
+	err = c.principalStore.UpdateUser(ctx, user)
+	if errors.Is(err, store.ErrDuplicate) {
+		return nil, errLDAPUserConflict
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user with directory entry: %w", err)
+	}
+
+	return user, nil
+}
+
+// provisionLDAPUser creates the user for the directory entry, unless its uid or email is already taken.
+func (c *Controller) provisionLDAPUser(
+	ctx context.Context,
+	externalID string,
+	uid string,
+	email string,
+	displayName string,
+) (*types.User, error) {
+	_, err := findUserFromUID(ctx, c.principalStore, uid)
+	if err == nil {
+		return nil, errLDAPUserConflict
+	}
+	if !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find user by uid: %w", err)
+	}
+
+	_, err = findUserFromEmail(ctx, c.principalStore, email)
+	if err == nil {
+		return nil, errLDAPUserConflict
+	}
+	if !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find user by email: %w", err)
+	}
+
+	user, err := c.createUser(ctx, &CreateInput{
+		UID:         uid,
+		Email:       email,
+		DisplayName: displayName,
+		Password:    uniuri.NewLen(externalPasswordLength),
+	}, false, enum.UserSourceLDAP, externalID)
+	if errors.Is(err, store.ErrDuplicate) {
+		return nil, errLDAPUserConflict
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to provision user: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("user_uid", user.UID).
+		Msg("provisioned user on first LDAP login")
+
+	return user, nil
+}
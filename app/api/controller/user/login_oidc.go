@@ -0,0 +1,310 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/oidc"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/dchest/uniuri"
+	"github.com/rs/zerolog/log"
+)
+
+// oidcMembershipPageSize is the page size used to list the existing space memberships of a user.
+const oidcMembershipPageSize = 100
+
+var errOIDCNotEnabled = usererror.NotFound("OpenID Connect login is not enabled")
+
+// errOIDCUserConflict is returned if an identity would be linked to an existing user that wasn't provisioned from it.
+var errOIDCUserConflict = usererror.Forbidden(
+	"A user with the same email already exists, please ask an administrator to resolve the conflict")
+
+// OIDCCallbackInput contains the parameters the identity provider redirects the user back with.
+type OIDCCallbackInput struct {
+	State            string
+	Code             string
+	Error            string
+	ErrorDescription string
+}
+
+// OIDCLoginStart starts an OpenID Connect login. It returns the url of the identity provider the user
+// has to be redirected to, and the login flow that has to be provided again to OIDCLoginCallback.
+func (c *Controller) OIDCLoginStart(ctx context.Context, returnTo string) (string, *oidc.Flow, error) {
+	if !c.oidcProvider.Enabled() {
+		return "", nil, errOIDCNotEnabled
+	}
+
+	flow, err := oidc.NewFlow(returnTo)
+	if err != nil {
+		return "", nil, err
+	}
+
+	authURL, err := c.oidcProvider.AuthCodeURL(ctx, flow)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create authorization url: %w", err)
+	}
+
+	return authURL, flow, nil
+}
+
+// OIDCLoginCallback completes an OpenID Connect login - returns the session token if successful,
+// or a two-factor authentication challenge in case the user has to provide a one-time password.
+// Users are provisioned on their first login and their space memberships are updated based on their groups.
+func (c *Controller) OIDCLoginCallback(
+	ctx context.Context,
+	flow *oidc.Flow,
+	in *OIDCCallbackInput,
+) (*types.LoginResponse, error) {
+	if !c.oidcProvider.Enabled() {
+		return nil, errOIDCNotEnabled
+	}
+
+	if flow == nil || in.State != flow.State {
+		return nil, usererror.BadRequest("Login expired or invalid, please try again")
+	}
+
+	if in.Error != "" {
+		log.Ctx(ctx).Info().
+			Str("error", in.Error).
+			Str("error_description", in.ErrorDescription).
+			Msg("identity provider returned an error")
+
+		return nil, usererror.ErrUnauthorized
+	}
+
+	if in.Code == "" {
+		return nil, usererror.BadRequest("Authorization code is missing")
+	}
+
+	identity, err := c.oidcProvider.Exchange(ctx, flow, in.Code)
+	if errors.Is(err, oidc.ErrAuthenticationFailed) {
+		log.Ctx(ctx).Warn().Err(err).Msg("OpenID Connect login failed")
+		return nil, usererror.ErrUnauthorized
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete OpenID Connect login: %w", err)
+	}
+
+	user, err := c.findOrProvisionOIDCUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Blocked {
+		return nil, usererror.Forbidden("User is blocked")
+	}
+
+	err = c.syncOIDCMemberships(ctx, user, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update space memberships: %w", err)
+	}
+
+	return c.createSessionOrChallenge(ctx, user)
+}
+
+// findOrProvisionOIDCUser returns the user that was provisioned from the identity,
+// or creates a new user in case there is none.
+// Identities are only ever linked to users that were provisioned from the identity provider,
+// never to existing users with the same email, as that would allow the identity provider to take over accounts.
+func (c *Controller) findOrProvisionOIDCUser(ctx context.Context, identity *oidc.Identity) (*types.User, error) {
+	user, err := c.principalStore.FindUserByExternalID(ctx, enum.UserSourceOIDC, identity.ExternalID())
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	_, err = findUserFromEmail(ctx, c.principalStore, identity.Email)
+	if err == nil {
+		return nil, errOIDCUserConflict
+	}
+	if !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find user by email: %w", err)
+	}
+
+	uid := sanitizeExternalUID(identity.UID)
+	for i := 1; i <= externalUIDMaxAttempts; i++ {
+		user, err = c.createUser(ctx, &CreateInput{
+			UID:         uid,
+			Email:       identity.Email,
+			DisplayName: identity.DisplayName,
+			Password:    uniuri.NewLen(externalPasswordLength),
+		}, false, enum.UserSourceOIDC, identity.ExternalID())
+		if !errors.Is(err, store.ErrDuplicate) {
+			break
+		}
+
+		uid = fmt.Sprintf("%s-%d", sanitizeExternalUID(identity.UID), i+1)
+	}
+	if errors.Is(err, store.ErrDuplicate) {
+		// the email might have been taken in the meantime.
+		return nil, errOIDCUserConflict
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to provision user: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("user_uid", user.UID).
+		Str("subject", identity.Subject).
+		Msg("provisioned user on first single sign-on login")
+
+	return user, nil
+}
+
+// syncOIDCMemberships grants the user the space memberships the groups of the identity are mapped to,
+// and revokes the ones granted through group mappings that no longer apply.
+// Memberships that were granted manually are left untouched.
+func (c *Controller) syncOIDCMemberships(ctx context.Context, user *types.User, identity *oidc.Identity) error {
+	// the memberships are granted on behalf of the user logging in, the user is recorded as the actor.
+	session := &auth.Session{Principal: *user.ToPrincipal()}
+
+	mapped := make(map[int64]struct{})
+	for _, mapping := range c.oidcProvider.GroupMappings(identity.Groups) {
+		space, err := c.spaceStore.FindByRef(ctx, mapping.SpacePath)
+		if errors.Is(err, store.ErrResourceNotFound) {
+			log.Ctx(ctx).Warn().
+				Str("group", mapping.Group).
+				Str("space_path", mapping.SpacePath).
+				Msg("space of OpenID Connect group mapping doesn't exist")
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find space %q: %w", mapping.SpacePath, err)
+		}
+
+		mapped[space.ID] = struct{}{}
+
+		key := types.MembershipKey{SpaceID: space.ID, PrincipalID: user.ID}
+		now := time.Now().UnixMilli()
+
+		membership, err := c.membershipStore.Find(ctx, key)
+		if errors.Is(err, store.ErrResourceNotFound) {
+			err = c.membershipStore.Create(ctx, &types.Membership{
+				MembershipKey: key,
+				CreatedBy:     user.ID,
+				Created:       now,
+				Updated:       now,
+				Role:          mapping.Role,
+				Source:        enum.MembershipSourceOIDC,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create membership of space %q: %w", mapping.SpacePath, err)
+			}
+
+			c.auditRecorder.Record(ctx, session,
+				oidcMembershipAuditEvent(enum.AuditActionCreate, user, space, mapping.Role))
+
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find membership of space %q: %w", mapping.SpacePath, err)
+		}
+
+		if membership.Source != enum.MembershipSourceOIDC || membership.Role == mapping.Role {
+			continue
+		}
+
+		membership.Role = mapping.Role
+		membership.Updated = now
+
+		err = c.membershipStore.Update(ctx, membership)
+		if err != nil {
+			return fmt.Errorf("failed to update membership of space %q: %w", mapping.SpacePath, err)
+		}
+
+		c.auditRecorder.Record(ctx, session,
+			oidcMembershipAuditEvent(enum.AuditActionUpdate, user, space, mapping.Role))
+	}
+
+	return c.revokeOIDCMemberships(ctx, session, user, mapped)
+}
+
+// revokeOIDCMemberships deletes the memberships granted through group mappings of spaces that aren't mapped anymore.
+func (c *Controller) revokeOIDCMemberships(
+	ctx context.Context,
+	session *auth.Session,
+	user *types.User,
+	mapped map[int64]struct{},
+) error {
+	// the memberships are listed first, deleting them while paging would skip some.
+	var revoked []types.MembershipSpace
+	for page := 1; ; page++ {
+		list, err := c.membershipStore.ListSpaces(ctx, user.ID, types.MembershipSpaceFilter{
+			ListQueryFilter: types.ListQueryFilter{
+				Pagination: types.Pagination{Page: page, Size: oidcMembershipPageSize},
+			},
+			Sort: enum.MembershipSpaceSortCreated,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list memberships: %w", err)
+		}
+
+		for _, membership := range list {
+			if _, ok := mapped[membership.SpaceID]; ok || membership.Source != enum.MembershipSourceOIDC {
+				continue
+			}
+
+			revoked = append(revoked, membership)
+		}
+
+		if len(list) < oidcMembershipPageSize {
+			break
+		}
+	}
+
+	for i := range revoked {
+		membership := &revoked[i]
+
+		err := c.membershipStore.Delete(ctx, membership.MembershipKey)
+		if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+			return fmt.Errorf("failed to delete membership of space %q: %w", membership.Space.Path, err)
+		}
+
+		c.auditRecorder.Record(ctx, session,
+			oidcMembershipAuditEvent(enum.AuditActionDelete, user, &membership.Space, membership.Role))
+	}
+
+	return nil
+}
+
+func oidcMembershipAuditEvent(
+	action enum.AuditAction,
+	user *types.User,
+	space *types.Space,
+	role enum.MembershipRole,
+) types.AuditEvent {
+	return types.AuditEvent{
+		Action:       action,
+		ResourceType: enum.AuditResourceTypeMembership,
+		ResourceName: user.UID,
+		SpaceID:      space.ID,
+		Data: map[string]string{
+			"space":  space.Path,
+			"role":   string(role),
+			"source": string(enum.MembershipSourceOIDC),
+		},
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/oidc"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	testUserID = 2
+
+	testMappedSpaceID   = 1
+	testUnmappedSpaceID = 2
+	testManualSpaceID   = 3
+)
+
+var testSpacePaths = map[int64]string{
+	testMappedSpaceID:   "acme",
+	testUnmappedSpaceID: "legacy",
+	testManualSpaceID:   "manual",
+}
+
+func TestSyncOIDCMembershipsRevokesUnmapped(t *testing.T) {
+	memberships := &fakeMembershipStore{memberships: map[types.MembershipKey]*types.Membership{}}
+	memberships.add(testMappedSpaceID, enum.MembershipRoleReader, enum.MembershipSourceOIDC)
+	// the group mapping the membership was granted through doesn't apply to the user anymore.
+	memberships.add(testUnmappedSpaceID, enum.MembershipRoleReader, enum.MembershipSourceOIDC)
+	memberships.add(testManualSpaceID, enum.MembershipRoleReader, enum.MembershipSourceManual)
+
+	config := &types.Config{}
+	config.OIDC.Enabled = true
+	config.OIDC.Issuer = "https://idp.example.com"
+	config.OIDC.ClientID = "gitness"
+	config.OIDC.GroupMappings = []string{"developers=acme:contributor", "admins=legacy:space_owner"}
+
+	provider, err := oidc.NewProvider(config)
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+
+	recorder := &fakeRecorder{}
+	c := &Controller{
+		membershipStore: memberships,
+		spaceStore:      fakeSpaceStore{},
+		oidcProvider:    provider,
+		auditRecorder:   recorder,
+	}
+
+	user := &types.User{ID: testUserID, UID: "alice"}
+	err = c.syncOIDCMemberships(context.Background(), user, &oidc.Identity{Groups: []string{"developers"}})
+	if err != nil {
+		t.Fatalf("failed to sync memberships: %v", err)
+	}
+
+	if m, ok := memberships.memberships[memberships.key(testMappedSpaceID)]; !ok ||
+		m.Role != enum.MembershipRoleContributor {
+		t.Errorf("expected membership of the mapped space to be updated, got %+v", m)
+	}
+	if _, ok := memberships.memberships[memberships.key(testUnmappedSpaceID)]; ok {
+		t.Errorf("expected membership granted through the removed group to be deleted")
+	}
+	if _, ok := memberships.memberships[memberships.key(testManualSpaceID)]; !ok {
+		t.Errorf("expected manually granted membership to be kept")
+	}
+
+	if len(recorder.events) != 2 {
+		t.Fatalf("expected an update and a delete audit event, got %+v", recorder.events)
+	}
+	if e := recorder.events[1]; e.Action != enum.AuditActionDelete || e.SpaceID != testUnmappedSpaceID ||
+		e.ResourceName != user.UID || e.Data["space"] != "legacy" {
+		t.Errorf("expected a delete audit event of the unmapped space, got %+v", e)
+	}
+}
+
+type fakeRecorder struct {
+	events []types.AuditEvent
+}
+
+func (f *fakeRecorder) Record(_ context.Context, _ *auth.Session, event types.AuditEvent) {
+	f.events = append(f.events, event)
+}
+
+type fakeSpaceStore struct {
+	store.SpaceStore
+}
+
+func (fakeSpaceStore) FindByRef(_ context.Context, spaceRef string) (*types.Space, error) {
+	for id, path := range testSpacePaths {
+		if path == spaceRef {
+			return &types.Space{ID: id, Path: path}, nil
+		}
+	}
+	return nil, gitness_store.ErrResourceNotFound
+}
+
+type fakeMembershipStore struct {
+	store.MembershipStore
+	memberships map[types.MembershipKey]*types.Membership
+}
+
+func (f *fakeMembershipStore) key(spaceID int64) types.MembershipKey {
+	return types.MembershipKey{SpaceID: spaceID, PrincipalID: testUserID}
+}
+
+func (f *fakeMembershipStore) add(spaceID int64, role enum.MembershipRole, source enum.MembershipSource) {
+	f.memberships[f.key(spaceID)] = &types.Membership{MembershipKey: f.key(spaceID), Role: role, Source: source}
+}
+
+func (f *fakeMembershipStore) Find(_ context.Context, key types.MembershipKey) (*types.Membership, error) {
+	membership, ok := f.memberships[key]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	dup := *membership
+	return &dup, nil
+}
+
+func (f *fakeMembershipStore) Create(_ context.Context, membership *types.Membership) error {
+	f.memberships[membership.MembershipKey] = membership
+	return nil
+}
+
+func (f *fakeMembershipStore) Update(_ context.Context, membership *types.Membership) error {
+	f.memberships[membership.MembershipKey] = membership
+	return nil
+}
+
+func (f *fakeMembershipStore) Delete(_ context.Context, key types.MembershipKey) error {
+	delete(f.memberships, key)
+	return nil
+}
+
+func (f *fakeMembershipStore) ListSpaces(
+	_ context.Context,
+	userID int64,
+	filter types.MembershipSpaceFilter,
+) ([]types.MembershipSpace, error) {
+	if filter.Page > 1 {
+		return nil, nil
+	}
+
+	var list []types.MembershipSpace
+	for key, membership := range f.memberships {
+		if key.PrincipalID != userID {
+			continue
+		}
+		list = append(list, types.MembershipSpace{
+			Membership: *membership,
+			Space:      types.Space{ID: key.SpaceID, Path: testSpacePaths[key.SpaceID]},
+		})
+	}
+	return list, nil
+}
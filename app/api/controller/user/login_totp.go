@@ -0,0 +1,279 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth/totp"
+	"github.com/harness/gitness/app/jwt"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/dchest/uniuri"
+	gojwt "github.com/golang-jwt/jwt"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// totpMaxFailedAttempts is the number of invalid one-time passwords after which logins of the user are locked.
+	// Each further invalid one-time password before the next successful login locks them again.
+	totpMaxFailedAttempts = 5
+
+	// totpLockoutDuration is the duration logins of a user are locked after too many invalid one-time passwords.
+	totpLockoutDuration = 15 * time.Minute
+
+	totpChallengeLength = 32
+)
+
+var (
+	errTwoFactorChallengeInvalid = usererror.BadRequest("Login expired or invalid, please try again")
+	errTwoFactorLocked           = usererror.New(http.StatusTooManyRequests,
+		"Too many invalid one-time passwords, please try again later")
+)
+
+type LoginTOTPInput struct {
+	// Challenge is the token of the two-factor challenge returned by login.
+	Challenge string `json:"challenge"`
+	// Code is either the one-time password of the authenticator app or a recovery code.
+	Code string `json:"code"`
+}
+
+// LoginTOTP completes a login of a user with two-factor authentication - returns the session token if successful.
+// Users that enroll during login get their recovery codes in addition.
+func (c *Controller) LoginTOTP(ctx context.Context, in *LoginTOTPInput) (*types.LoginResponse, error) {
+	// no auth check required, the challenge and the one-time password are used for it.
+
+	user, challenge, err := c.parseTwoFactorChallenge(ctx, in.Challenge)
+	if err != nil {
+		log.Ctx(ctx).Debug().Err(err).Msg("invalid two-factor authentication challenge")
+		return nil, errTwoFactorChallengeInvalid
+	}
+
+	if user.Blocked {
+		return nil, usererror.Forbidden("User is blocked")
+	}
+
+	userTOTP, err := c.totpStore.Find(ctx, user.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, errTwoFactorChallengeInvalid
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find two-factor authentication of user: %w", err)
+	}
+
+	if userTOTP.Challenge == "" || subtle.ConstantTimeCompare([]byte(userTOTP.Challenge), []byte(challenge)) != 1 {
+		return nil, errTwoFactorChallengeInvalid
+	}
+
+	if isTOTPLocked(userTOTP) {
+		return nil, errTwoFactorLocked
+	}
+
+	// the code is verified against a copy, the failed attempt is recorded for the stored configuration.
+	verified := *userTOTP
+	verified.RecoveryCodes = append([]string(nil), userTOTP.RecoveryCodes...)
+
+	ok, err := c.verifyTOTPCode(&verified, in.Code, verified.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	if ok {
+		verified.Challenge = ""
+		verified.FailedAttempts = 0
+		verified.LockedUntil = 0
+
+		if !verified.Enabled {
+			verified.Enabled = true
+			recoveryCodes, verified.RecoveryCodes, err = totp.GenerateRecoveryCodes()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// the update fails in case the challenge, the one-time password or the recovery code
+		// has been used by a concurrent request - it counts as a failed attempt.
+		err = c.totpStore.Update(ctx, &verified)
+		if errors.Is(err, store.ErrVersionConflict) {
+			ok = false
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to update two-factor authentication of user: %w", err)
+		}
+	}
+
+	if !ok {
+		return nil, c.failTwoFactorLogin(ctx, user, userTOTP)
+	}
+
+	tokenResponse, err := c.createSession(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LoginResponse{TokenResponse: tokenResponse, RecoveryCodes: recoveryCodes}, nil
+}
+
+// createTwoFactorChallenge returns a new login challenge in case the user has to provide a one-time password,
+// or nil in case the user can get a session right away.
+// Users that didn't enroll yet although two-factor authentication is required get the enrollment details as well.
+func (c *Controller) createTwoFactorChallenge(
+	ctx context.Context,
+	user *types.User,
+) (*types.TwoFactorChallenge, error) {
+	userTOTP, err := c.totpStore.Find(ctx, user.ID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find two-factor authentication of user: %w", err)
+	}
+
+	enabled := userTOTP != nil && userTOTP.Enabled
+	if !enabled && !c.totpRequired {
+		return nil, nil //nolint:nilnil // nil challenge means no second factor is needed.
+	}
+
+	if userTOTP != nil && isTOTPLocked(userTOTP) {
+		return nil, errTwoFactorLocked
+	}
+
+	challenge := &types.TwoFactorChallenge{}
+	if !enabled {
+		userTOTP, challenge.Enrollment, err = c.startTOTPEnrollment(ctx, user, userTOTP)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the failed attempts are kept, they are reset only by a successful login.
+	nonce := uniuri.NewLen(totpChallengeLength)
+	_, err = c.totpStore.UpdateOptLock(ctx, userTOTP, func(userTOTP *types.TOTP) error {
+		if isTOTPLocked(userTOTP) {
+			return errTwoFactorLocked
+		}
+
+		userTOTP.Challenge = nonce
+		return nil
+	})
+	if errors.Is(err, errTwoFactorLocked) {
+		return nil, errTwoFactorLocked
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update two-factor authentication of user: %w", err)
+	}
+
+	expiresAt := time.Now().Add(c.totpChallengeTTL)
+	challenge.Token, err = jwt.GenerateForTwoFactorChallenge(user.ID, nonce, expiresAt, user.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create two-factor authentication challenge: %w", err)
+	}
+	challenge.ExpiresAt = expiresAt.UnixMilli()
+
+	return challenge, nil
+}
+
+// failTwoFactorLogin records a failed second factor of a login and locks further logins of the user
+// after too many failed attempts. It returns the error to respond with.
+func (c *Controller) failTwoFactorLogin(ctx context.Context, user *types.User, userTOTP *types.TOTP) error {
+	userTOTP, err := c.totpStore.UpdateOptLock(ctx, userTOTP, func(userTOTP *types.TOTP) error {
+		userTOTP.FailedAttempts++
+		if userTOTP.FailedAttempts >= totpMaxFailedAttempts {
+			userTOTP.Challenge = ""
+			userTOTP.LockedUntil = time.Now().Add(totpLockoutDuration).UnixMilli()
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update two-factor authentication of user: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("user_uid", user.UID).
+		Int("failed_attempts", userTOTP.FailedAttempts).
+		Msg("invalid one-time password during login")
+
+	if isTOTPLocked(userTOTP) {
+		return errTwoFactorLocked
+	}
+
+	return usererror.New(usererror.ErrUnauthorized.Status, "Invalid one-time password")
+}
+
+// isTOTPLocked returns true if logins of the user are locked because of too many invalid one-time passwords.
+func isTOTPLocked(userTOTP *types.TOTP) bool {
+	return userTOTP.LockedUntil > time.Now().UnixMilli()
+}
+
+// parseTwoFactorChallenge verifies the challenge token and returns the user and challenge nonce it was created for.
+func (c *Controller) parseTwoFactorChallenge(ctx context.Context, token string) (*types.User, string, error) {
+	var user *types.User
+	claims := &jwt.Claims{}
+	parsed, err := gojwt.ParseWithClaims(token, claims, func(*gojwt.Token) (interface{}, error) {
+		var err error
+		user, err = c.principalStore.FindUser(ctx, claims.PrincipalID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user for challenge: %w", err)
+		}
+		return []byte(user.Salt), nil
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("parsing of JWT claims failed: %w", err)
+	}
+
+	if _, ok := parsed.Method.(*gojwt.SigningMethodHMAC); !ok || !parsed.Valid {
+		return nil, "", errors.New("invalid JWT")
+	}
+
+	if claims.TwoFactor == nil || claims.TwoFactor.Challenge == "" {
+		return nil, "", errors.New("jwt is missing two-factor authentication sub-claims")
+	}
+
+	return user, claims.TwoFactor.Challenge, nil
+}
+
+// verifyTOTPCode checks the one-time password or, if allowed, the recovery code against the user configuration.
+// Accepted codes are marked as used in the configuration, it's up to the caller to persist it.
+func (c *Controller) verifyTOTPCode(userTOTP *types.TOTP, code string, allowRecoveryCode bool) (bool, error) {
+	if allowRecoveryCode && totp.IsRecoveryCode(code) {
+		hash := totp.HashRecoveryCode(code)
+		for i, recoveryCode := range userTOTP.RecoveryCodes {
+			if subtle.ConstantTimeCompare([]byte(recoveryCode), []byte(hash)) == 1 {
+				userTOTP.RecoveryCodes = append(userTOTP.RecoveryCodes[:i], userTOTP.RecoveryCodes[i+1:]...)
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	secret, err := c.encrypter.Decrypt(userTOTP.Secret)
+	if err != nil {
+		return false, fmt.Errorf("failed to decrypt two-factor authentication secret: %w", err)
+	}
+
+	step, ok := totp.Validate(secret, code, time.Now(), userTOTP.LastUsedStep)
+	if !ok {
+		return false, nil
+	}
+
+	userTOTP.LastUsedStep = step
+
+	return true, nil
+}
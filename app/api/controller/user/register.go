@@ -34,7 +34,7 @@ type RegisterInput struct {
 // Register creates a new user and returns a new session token on success.
 // This doesn't require auth, but has limited functionalities (unable to create admin user for example).
 func (c *Controller) Register(ctx context.Context, sysCtrl *system.Controller,
-	in *RegisterInput) (*types.TokenResponse, error) {
+	in *RegisterInput) (*types.LoginResponse, error) {
 	signUpAllowed, err := sysCtrl.IsUserSignupAllowed(ctx)
 	if err != nil {
 		return nil, err
@@ -54,11 +54,22 @@ func (c *Controller) Register(ctx context.Context, sysCtrl *system.Controller,
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
+	// in case two-factor authentication is required, users have to enroll before they get a session.
+	challenge, err := c.createTwoFactorChallenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return &types.LoginResponse{TwoFactorChallenge: challenge}, nil
+	}
+
 	// TODO: how should we name session tokens?
 	token, jwtToken, err := token.CreateUserSession(ctx, c.tokenStore, user, "register")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create token after successful user creation: %w", err)
 	}
 
-	return &types.TokenResponse{Token: *token, AccessToken: jwtToken}, nil
+	return &types.LoginResponse{
+		TokenResponse: &types.TokenResponse{Token: *token, AccessToken: jwtToken},
+	}, nil
 }
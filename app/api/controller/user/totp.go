@@ -0,0 +1,351 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/totp"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	errTOTPNotEnabled     = usererror.BadRequest("Two-factor authentication is not enabled")
+	errTOTPAlreadyEnabled = usererror.New(usererror.ErrDuplicate.Status, "Two-factor authentication is already enabled")
+	errTOTPInvalidCode    = usererror.BadRequest("Invalid one-time password")
+)
+
+type TOTPCodeInput struct {
+	Code string `json:"code"`
+}
+
+// FindTOTP returns the two-factor authentication status of a user.
+func (c *Controller) FindTOTP(ctx context.Context, session *auth.Session, userUID string) (*types.TOTPStatus, error) {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure principal has required permissions on parent
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserView); err != nil {
+		return nil, err
+	}
+
+	status := &types.TOTPStatus{Required: c.totpRequired}
+
+	userTOTP, err := c.totpStore.Find(ctx, user.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return status, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find two-factor authentication of user: %w", err)
+	}
+
+	status.Enabled = userTOTP.Enabled
+	status.RecoveryCodesLeft = len(userTOTP.RecoveryCodes)
+
+	return status, nil
+}
+
+// EnrollTOTP starts the two-factor authentication enrollment of a user.
+// It has to be completed with EnableTOTP and a one-time password of the authenticator app.
+func (c *Controller) EnrollTOTP(
+	ctx context.Context,
+	session *auth.Session,
+	userUID string,
+) (*types.TOTPEnrollment, error) {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure principal has required permissions on parent
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return nil, err
+	}
+
+	userTOTP, err := c.totpStore.Find(ctx, user.ID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find two-factor authentication of user: %w", err)
+	}
+
+	if userTOTP != nil && userTOTP.Enabled {
+		return nil, errTOTPAlreadyEnabled
+	}
+
+	_, enrollment, err := c.startTOTPEnrollment(ctx, user, userTOTP)
+	if err != nil {
+		return nil, err
+	}
+
+	return enrollment, nil
+}
+
+// EnableTOTP completes the two-factor authentication enrollment of a user - returns the recovery codes if successful.
+func (c *Controller) EnableTOTP(
+	ctx context.Context,
+	session *auth.Session,
+	userUID string,
+	in *TOTPCodeInput,
+) (*types.TOTPRecoveryCodes, error) {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure principal has required permissions on parent
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return nil, err
+	}
+
+	userTOTP, err := c.totpStore.Find(ctx, user.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, usererror.BadRequest("Two-factor authentication enrollment wasn't started")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find two-factor authentication of user: %w", err)
+	}
+
+	if userTOTP.Enabled {
+		return nil, errTOTPAlreadyEnabled
+	}
+
+	ok, err := c.verifyTOTPCode(userTOTP, in.Code, false)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errTOTPInvalidCode
+	}
+
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	userTOTP.Enabled = true
+	userTOTP.RecoveryCodes = hashes
+
+	if err = c.totpStore.Update(ctx, userTOTP); err != nil {
+		return nil, fmt.Errorf("failed to update two-factor authentication of user: %w", err)
+	}
+
+	return &types.TOTPRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// RegenerateTOTPRecoveryCodes replaces the recovery codes of a user with new ones.
+func (c *Controller) RegenerateTOTPRecoveryCodes(
+	ctx context.Context,
+	session *auth.Session,
+	userUID string,
+	in *TOTPCodeInput,
+) (*types.TOTPRecoveryCodes, error) {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure principal has required permissions on parent
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return nil, err
+	}
+
+	userTOTP, err := c.findEnabledTOTP(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := c.verifyTOTPCode(userTOTP, in.Code, false)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errTOTPInvalidCode
+	}
+
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	userTOTP.RecoveryCodes = hashes
+
+	if err = c.totpStore.Update(ctx, userTOTP); err != nil {
+		return nil, fmt.Errorf("failed to update two-factor authentication of user: %w", err)
+	}
+
+	return &types.TOTPRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP disables the two-factor authentication of a user.
+// It requires a one-time password or a recovery code to ensure it's the user disabling it.
+func (c *Controller) DisableTOTP(ctx context.Context, session *auth.Session, userUID string, in *TOTPCodeInput) error {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return err
+	}
+
+	// Ensure principal has required permissions on parent
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return err
+	}
+
+	if c.totpRequired {
+		return usererror.Forbidden("Two-factor authentication is required and can't be disabled")
+	}
+
+	userTOTP, err := c.findEnabledTOTP(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	ok, err := c.verifyTOTPCode(userTOTP, in.Code, true)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errTOTPInvalidCode
+	}
+
+	return c.totpStore.Delete(ctx, user.ID)
+}
+
+// ResetTOTP removes the two-factor authentication of a user, e.g. in case the user lost the device
+// and all recovery codes. If two-factor authentication is required, the user has to enroll again on the next login.
+func (c *Controller) ResetTOTP(ctx context.Context, session *auth.Session, userUID string) error {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return err
+	}
+
+	// Ensure principal has required permissions on parent
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEditAdmin); err != nil {
+		return err
+	}
+
+	if _, err = c.findEnabledTOTP(ctx, user); err != nil {
+		return err
+	}
+
+	if err = c.totpStore.Delete(ctx, user.ID); err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("user_uid", user.UID).
+		Str("admin_uid", session.Principal.UID).
+		Msg("two-factor authentication of user was reset")
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionUpdate,
+		ResourceType: enum.AuditResourceTypeUser,
+		ResourceName: user.UID,
+		Data:         map[string]string{"totp": "reset"},
+	})
+
+	return nil
+}
+
+func (c *Controller) findEnabledTOTP(ctx context.Context, user *types.User) (*types.TOTP, error) {
+	userTOTP, err := c.totpStore.Find(ctx, user.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, errTOTPNotEnabled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find two-factor authentication of user: %w", err)
+	}
+
+	if !userTOTP.Enabled {
+		return nil, errTOTPNotEnabled
+	}
+
+	return userTOTP, nil
+}
+
+// startTOTPEnrollment creates the pending two-factor authentication of a user and returns its enrollment details.
+// The secret of an already pending enrollment is kept, so users don't have to add the account to their app again.
+func (c *Controller) startTOTPEnrollment(
+	ctx context.Context,
+	user *types.User,
+	userTOTP *types.TOTP,
+) (*types.TOTP, *types.TOTPEnrollment, error) {
+	if userTOTP != nil {
+		secret, err := c.encrypter.Decrypt(userTOTP.Secret)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decrypt two-factor authentication secret: %w", err)
+		}
+
+		enrollment, err := c.totpEnrollment(user, secret)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return userTOTP, enrollment, nil
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encryptedSecret, err := c.encrypter.Encrypt(secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt two-factor authentication secret: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	userTOTP = &types.TOTP{
+		PrincipalID: user.ID,
+		Created:     now,
+		Updated:     now,
+		Secret:      encryptedSecret,
+	}
+
+	if err = c.totpStore.Create(ctx, userTOTP); err != nil {
+		return nil, nil, fmt.Errorf("failed to create two-factor authentication of user: %w", err)
+	}
+
+	enrollment, err := c.totpEnrollment(user, secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return userTOTP, enrollment, nil
+}
+
+func (c *Controller) totpEnrollment(user *types.User, secret string) (*types.TOTPEnrollment, error) {
+	uri := totp.URI(c.totpIssuer, user.Email, secret)
+
+	qrCode, err := totp.QRCode(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TOTPEnrollment{
+		Secret: secret,
+		URI:    uri,
+		QRCode: qrCode,
+	}, nil
+}
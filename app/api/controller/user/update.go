@@ -17,6 +17,7 @@ package user
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -74,6 +75,18 @@ func (c *Controller) Update(ctx context.Context, session *auth.Session,
 		return nil, err
 	}
 
+	auditData := map[string]string{"password_changed": strconv.FormatBool(in.Password != nil)}
+	if in.Email != nil {
+		auditData["email"] = user.Email
+	}
+
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionUpdate,
+		ResourceType: enum.AuditResourceTypeUser,
+		ResourceName: user.UID,
+		Data:         auditData,
+	})
+
 	return user, nil
 }
 
@@ -17,6 +17,7 @@ package user
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
@@ -63,5 +64,12 @@ func (c *Controller) UpdateAdmin(ctx context.Context, session *auth.Session,
 		return nil, err
 	}
 
+	c.auditRecorder.Record(ctx, session, types.AuditEvent{
+		Action:       enum.AuditActionUpdate,
+		ResourceType: enum.AuditResourceTypeUser,
+		ResourceName: user.UID,
+		Data:         map[string]string{"admin": strconv.FormatBool(user.Admin)},
+	})
+
 	return user, nil
 }
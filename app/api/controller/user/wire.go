@@ -15,9 +15,14 @@
 package user
 
 import (
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/auth/ldap"
+	"github.com/harness/gitness/app/auth/oidc"
 	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/encrypt"
 	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/check"
 
 	"github.com/google/wire"
@@ -29,12 +34,22 @@ var WireSet = wire.NewSet(
 )
 
 func ProvideController(
+	config *types.Config,
 	tx dbtx.Transactor,
 	principalUIDCheck check.PrincipalUID,
 	authorizer authz.Authorizer,
 	principalStore store.PrincipalStore,
 	tokenStore store.TokenStore,
+	publicKeyStore store.PublicKeyStore,
+	signingKeyStore store.SigningKeyStore,
 	membershipStore store.MembershipStore,
+	spaceStore store.SpaceStore,
+	repoStore store.RepoStore,
+	oidcProvider *oidc.Provider,
+	ldapAuthenticator *ldap.Authenticator,
+	totpStore store.TOTPStore,
+	encrypter encrypt.Encrypter,
+	auditRecorder audit.Recorder,
 ) *Controller {
 	return NewController(
 		tx,
@@ -42,5 +57,17 @@ func ProvideController(
 		authorizer,
 		principalStore,
 		tokenStore,
-		membershipStore)
+		publicKeyStore,
+		signingKeyStore,
+		membershipStore,
+		spaceStore,
+		repoStore,
+		oidcProvider,
+		ldapAuthenticator,
+		totpStore,
+		encrypter,
+		auditRecorder,
+		config.TOTP.Required,
+		config.TOTP.Issuer,
+		config.TOTP.ChallengeLifetime)
 }
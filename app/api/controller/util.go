@@ -34,6 +34,19 @@ import (
 // function will be best fit.
 func CreateRPCWriteParams(ctx context.Context, urlProvider url.Provider,
 	session *auth.Session, repo *types.Repository) (gitrpc.WriteParams, error) {
+	return createRPCWriteParams(ctx, urlProvider, session, repo, false)
+}
+
+// CreateRPCInternalWriteParams creates base write parameters for gitrpc write operations
+// that are executed by gitness itself on behalf of the user (e.g. merging a pull request).
+// IMPORTANT: session & repo are assumed to be not nil!
+func CreateRPCInternalWriteParams(ctx context.Context, urlProvider url.Provider,
+	session *auth.Session, repo *types.Repository) (gitrpc.WriteParams, error) {
+	return createRPCWriteParams(ctx, urlProvider, session, repo, true)
+}
+
+func createRPCWriteParams(ctx context.Context, urlProvider url.Provider,
+	session *auth.Session, repo *types.Repository, internal bool) (gitrpc.WriteParams, error) {
 	// generate envars (add everything githook CLI needs for execution)
 	envVars, err := githook.GenerateEnvironmentVariables(
 		ctx,
@@ -41,6 +54,7 @@ func CreateRPCWriteParams(ctx context.Context, urlProvider url.Provider,
 		repo.ID,
 		session.Principal.ID,
 		false,
+		internal,
 	)
 	if err != nil {
 		return gitrpc.WriteParams{}, fmt.Errorf("failed to generate git hook environment variables: %w", err)
@@ -77,9 +91,21 @@ func MapCommit(c *gitrpc.Commit) (*types.Commit, error) {
 		Message:   c.Message,
 		Author:    *author,
 		Committer: *committer,
+		Signature: MapObjectSignature(c.Signature),
 	}, nil
 }
 
+func MapObjectSignature(s *gitrpc.ObjectSignature) *types.ObjectSignature {
+	if s == nil {
+		return nil
+	}
+
+	return &types.ObjectSignature{
+		Signature: s.Signature,
+		Payload:   s.Payload,
+	}
+}
+
 func MapRenameDetails(c *gitrpc.RenameDetails) *types.RenameDetails {
 	if c == nil {
 		return nil
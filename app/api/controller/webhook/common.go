@@ -17,7 +17,10 @@ package webhook
 import (
 	"net"
 	"net/url"
+	"strconv"
 
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/check"
 	"github.com/harness/gitness/types/enum"
 )
@@ -29,6 +32,30 @@ const (
 	webhookMaxSecretLength = 4096
 )
 
+// auditEvent returns the audit event for an action on a repository webhook.
+// The URL is recorded without credentials, query and fragment as those commonly carry tokens,
+// the secret of the webhook is never recorded.
+func auditEvent(action enum.AuditAction, repo *types.Repository, hook *types.Webhook) types.AuditEvent {
+	data := map[string]string{
+		"display_name": hook.DisplayName,
+		"enabled":      strconv.FormatBool(hook.Enabled),
+	}
+	if parsedURL, err := url.Parse(hook.URL); err == nil {
+		parsedURL.User = nil
+		parsedURL.RawQuery = ""
+		parsedURL.Fragment = ""
+		data["url"] = parsedURL.String()
+	}
+
+	return types.AuditEvent{
+		Action:       action,
+		ResourceType: enum.AuditResourceTypeWebhook,
+		ResourceName: paths.Concatinate(repo.Path, strconv.FormatInt(hook.ID, 10)),
+		SpaceID:      repo.ParentID,
+		Data:         data,
+	}
+}
+
 // checkURL validates the url of a webhook.
 func checkURL(rawURL string, allowLoopback bool, allowPrivateNetwork bool) error {
 	// check URL
@@ -93,6 +120,16 @@ func checkTriggers(triggers []enum.WebhookTrigger) error {
 	return nil
 }
 
+// checkFormat validates the format of a webhook and returns the sanitized value (empty => native).
+func checkFormat(format enum.WebhookFormat) (enum.WebhookFormat, error) {
+	sanitized, ok := format.Sanitize()
+	if !ok {
+		return "", check.NewValidationErrorf("The provided webhook format '%s' is invalid.", format)
+	}
+
+	return sanitized, nil
+}
+
 // deduplicateTriggers de-duplicates the triggers provided by the user.
 func deduplicateTriggers(in []enum.WebhookTrigger) []enum.WebhookTrigger {
 	if len(in) == 0 {
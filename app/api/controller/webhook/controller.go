@@ -20,6 +20,7 @@ import (
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/services/webhook"
@@ -39,6 +40,7 @@ type Controller struct {
 	repoStore             store.RepoStore
 	webhookService        *webhook.Service
 	encrypter             encrypt.Encrypter
+	auditRecorder         audit.Recorder
 }
 
 func NewController(
@@ -50,6 +52,7 @@ func NewController(
 	repoStore store.RepoStore,
 	webhookService *webhook.Service,
 	encrypter encrypt.Encrypter,
+	auditRecorder audit.Recorder,
 ) *Controller {
 	return &Controller{
 		allowLoopback:         allowLoopback,
@@ -60,6 +63,7 @@ func NewController(
 		repoStore:             repoStore,
 		webhookService:        webhookService,
 		encrypter:             encrypter,
+		auditRecorder:         auditRecorder,
 	}
 }
 
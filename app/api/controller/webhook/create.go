@@ -32,6 +32,7 @@ type CreateInput struct {
 	Secret      string                `json:"secret"`
 	Enabled     bool                  `json:"enabled"`
 	Insecure    bool                  `json:"insecure"`
+	Format      enum.WebhookFormat    `json:"format"`
 	Triggers    []enum.WebhookTrigger `json:"triggers"`
 }
 
@@ -79,6 +80,7 @@ func (c *Controller) Create(
 		Secret:                string(encryptedSecret),
 		Enabled:               in.Enabled,
 		Insecure:              in.Insecure,
+		Format:                in.Format,
 		Triggers:              deduplicateTriggers(in.Triggers),
 		LatestExecutionResult: nil,
 	}
@@ -88,6 +90,8 @@ func (c *Controller) Create(
 		return nil, err
 	}
 
+	c.auditRecorder.Record(ctx, session, auditEvent(enum.AuditActionCreate, repo, hook))
+
 	return hook, nil
 }
 
@@ -107,6 +111,11 @@ func checkCreateInput(in *CreateInput, allowLoopback bool, allowPrivateNetwork b
 	if err := checkTriggers(in.Triggers); err != nil {
 		return err
 	}
+	format, err := checkFormat(in.Format)
+	if err != nil {
+		return err
+	}
+	in.Format = format
 
 	return nil
 }
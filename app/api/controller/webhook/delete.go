@@ -40,5 +40,11 @@ func (c *Controller) Delete(
 	}
 
 	// delete webhook
-	return c.webhookStore.Delete(ctx, webhook.ID)
+	if err = c.webhookStore.Delete(ctx, webhook.ID); err != nil {
+		return err
+	}
+
+	c.auditRecorder.Record(ctx, session, auditEvent(enum.AuditActionDelete, repo, webhook))
+
+	return nil
 }
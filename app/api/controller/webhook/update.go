@@ -31,6 +31,7 @@ type UpdateInput struct {
 	Secret      *string               `json:"secret"`
 	Enabled     *bool                 `json:"enabled"`
 	Insecure    *bool                 `json:"insecure"`
+	Format      *enum.WebhookFormat   `json:"format"`
 	Triggers    []enum.WebhookTrigger `json:"triggers"`
 }
 
@@ -81,6 +82,9 @@ func (c *Controller) Update(
 	if in.Insecure != nil {
 		hook.Insecure = *in.Insecure
 	}
+	if in.Format != nil {
+		hook.Format = *in.Format
+	}
 	if in.Triggers != nil {
 		hook.Triggers = deduplicateTriggers(in.Triggers)
 	}
@@ -89,6 +93,8 @@ func (c *Controller) Update(
 		return nil, err
 	}
 
+	c.auditRecorder.Record(ctx, session, auditEvent(enum.AuditActionUpdate, repo, hook))
+
 	return hook, nil
 }
 
@@ -118,6 +124,13 @@ func checkUpdateInput(in *UpdateInput, allowLoopback bool, allowPrivateNetwork b
 			return err
 		}
 	}
+	if in.Format != nil {
+		format, err := checkFormat(*in.Format)
+		if err != nil {
+			return err
+		}
+		in.Format = &format
+	}
 
 	return nil
 }
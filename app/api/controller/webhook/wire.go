@@ -15,6 +15,7 @@
 package webhook
 
 import (
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/services/webhook"
 	"github.com/harness/gitness/app/store"
@@ -31,9 +32,10 @@ var WireSet = wire.NewSet(
 func ProvideController(config webhook.Config, authorizer authz.Authorizer,
 	webhookStore store.WebhookStore, webhookExecutionStore store.WebhookExecutionStore,
 	repoStore store.RepoStore, webhookService *webhook.Service, encrypter encrypt.Encrypter,
+	auditRecorder audit.Recorder,
 ) *Controller {
 	return NewController(
 		config.AllowLoopback, config.AllowPrivateNetwork, authorizer,
 		webhookStore, webhookExecutionStore,
-		repoStore, webhookService, encrypter)
+		repoStore, webhookService, encrypter, auditRecorder)
 }
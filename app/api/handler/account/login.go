@@ -43,8 +43,9 @@ func HandleLogin(userCtrl *user.Controller, cookieName string) http.HandlerFunc
 			return
 		}
 
-		if cookieName != "" {
-			includeTokenCookie(r, w, tokenResponse, cookieName)
+		// no session is created yet in case a one-time password is required.
+		if cookieName != "" && tokenResponse.TokenResponse != nil {
+			includeTokenCookie(r, w, tokenResponse.TokenResponse, cookieName)
 		}
 
 		render.JSON(w, http.StatusOK, tokenResponse)
@@ -0,0 +1,120 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/app/api/controller/user"
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth/oidc"
+)
+
+const (
+	// oidcFlowCookieName is the name of the cookie storing the pending OpenID Connect login.
+	oidcFlowCookieName = "oidc_flow"
+
+	// oidcFlowCookieMaxAge is the time (in seconds) the user has to complete the login at the identity provider.
+	oidcFlowCookieMaxAge = 10 * 60
+)
+
+// HandleLoginOIDC returns an http.HandlerFunc that starts an OpenID Connect login
+// by redirecting the user to the identity provider.
+func HandleLoginOIDC(userCtrl *user.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		authURL, flow, err := userCtrl.OIDCLoginStart(ctx, request.GetReturnToFromQuery(r))
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		encodedFlow, err := flow.Encode()
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		cookie := newOIDCFlowCookie(r)
+		cookie.Value = encodedFlow
+		cookie.MaxAge = oidcFlowCookieMaxAge
+		http.SetCookie(w, cookie)
+
+		http.Redirect(w, r, authURL, http.StatusFound)
+	}
+}
+
+// HandleLoginOIDCCallback returns an http.HandlerFunc that completes an OpenID Connect login.
+// If a token cookie is configured the user is redirected to the UI, otherwise the token is returned.
+// Logins requiring a one-time password return the two-factor challenge like password logins.
+func HandleLoginOIDCCallback(userCtrl *user.Controller, cookieName string, uiURL string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		// the flow can only be used once.
+		var flow *oidc.Flow
+		if encodedFlow, ok := request.GetCookie(r, oidcFlowCookieName); ok {
+			flow, _ = oidc.DecodeFlow(encodedFlow)
+
+			cookie := newOIDCFlowCookie(r)
+			cookie.MaxAge = -1
+			http.SetCookie(w, cookie)
+		}
+
+		in := &user.OIDCCallbackInput{
+			State:            request.QueryParamOrDefault(r, request.QueryParamState, ""),
+			Code:             request.QueryParamOrDefault(r, request.QueryParamCode, ""),
+			Error:            request.QueryParamOrDefault(r, request.QueryParamError, ""),
+			ErrorDescription: request.QueryParamOrDefault(r, request.QueryParamErrorDescription, ""),
+		}
+
+		loginResponse, err := userCtrl.OIDCLoginCallback(ctx, flow, in)
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		// no session is created yet in case a one-time password is required.
+		if cookieName == "" || loginResponse.TokenResponse == nil {
+			render.JSON(w, http.StatusOK, loginResponse)
+			return
+		}
+
+		includeTokenCookie(r, w, loginResponse.TokenResponse, cookieName)
+
+		returnTo := flow.ReturnTo
+		if returnTo == "" {
+			returnTo = "/"
+		}
+
+		http.Redirect(w, r, strings.TrimRight(uiURL, "/")+returnTo, http.StatusFound)
+	}
+}
+
+// newOIDCFlowCookie returns the cookie of the pending login. It uses SameSite lax mode,
+// as it has to be sent along when the identity provider redirects the user back.
+func newOIDCFlowCookie(r *http.Request) *http.Cookie {
+	return &http.Cookie{
+		Name:     oidcFlowCookieName,
+		SameSite: http.SameSiteLaxMode,
+		HttpOnly: true,
+		Path:     "/",
+		Domain:   r.URL.Hostname(),
+		Secure:   r.URL.Scheme == "https",
+	}
+}
@@ -49,8 +49,8 @@ func HandleRegister(userCtrl *user.Controller, sysCtrl *system.Controller, cooki
 			return
 		}
 
-		if includeCookie {
-			includeTokenCookie(r, w, tokenResponse, cookieName)
+		if includeCookie && tokenResponse.TokenResponse != nil {
+			includeTokenCookie(r, w, tokenResponse.TokenResponse, cookieName)
 		}
 
 		render.JSON(w, http.StatusOK, tokenResponse)
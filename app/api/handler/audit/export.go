@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/app/api/controller/audit"
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/types/enum"
+)
+
+const exportFileName = "audit-events.jsonl"
+
+// HandleExport returns a http.HandlerFunc that exports the audit events of the system as JSON lines.
+func HandleExport(auditCtrl *audit.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+
+		filter, err := request.ParseAuditFilter(r)
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		if filter.Order == enum.OrderDefault {
+			filter.Order = enum.OrderAsc
+		}
+
+		stream, err := auditCtrl.Export(ctx, session, filter)
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Disposition", "attachment; filename="+exportFileName)
+		render.JSONLinesDynamic(ctx, w, stream)
+	}
+}
+
+// HandleExportSpace returns a http.HandlerFunc that exports the audit events of a space as JSON lines.
+func HandleExportSpace(auditCtrl *audit.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+
+		spaceRef, err := request.GetSpaceRefFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		filter, err := request.ParseAuditFilter(r)
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		if filter.Order == enum.OrderDefault {
+			filter.Order = enum.OrderAsc
+		}
+
+		stream, err := auditCtrl.ExportSpace(ctx, session, spaceRef, filter)
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Disposition", "attachment; filename="+exportFileName)
+		render.JSONLinesDynamic(ctx, w, stream)
+	}
+}
@@ -42,6 +42,12 @@ func HandlePreReceive(githookCtrl *controllergithook.Controller) http.HandlerFun
 			return
 		}
 
+		internal, err := request.GetInternalFromQuery(r)
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
 		in := new(githook.PreReceiveInput)
 		err = json.NewDecoder(r.Body).Decode(in)
 		if err != nil {
@@ -49,7 +55,7 @@ func HandlePreReceive(githookCtrl *controllergithook.Controller) http.HandlerFun
 			return
 		}
 
-		out, err := githookCtrl.PreReceive(ctx, session, repoID, principalID, in)
+		out, err := githookCtrl.PreReceive(ctx, session, repoID, principalID, internal, in)
 		if err != nil {
 			render.TranslatedUserError(w, err)
 			return
@@ -0,0 +1,68 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/controller/lfs"
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/request"
+)
+
+// HandleBatch returns an http.HandlerFunc that handles requests of the git LFS batch API.
+func HandleBatch(lfsCtrl *lfs.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		repoRef, err := request.GetRepoRefFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(w, err)
+			return
+		}
+
+		in := new(lfs.BatchInput)
+		err = json.NewDecoder(r.Body).Decode(in)
+		if err != nil {
+			render.BadRequestf(w, "Invalid request body: %s.", err)
+			return
+		}
+
+		// object transfers are authenticated with the same credentials as the batch request.
+		var header map[string]string
+		if authorization := r.Header.Get("Authorization"); authorization != "" {
+			header = map[string]string{"Authorization": authorization}
+		}
+
+		out, err := lfsCtrl.Batch(ctx, session, repoRef, header, in)
+		if err != nil {
+			renderError(w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, out)
+	}
+}
+
+// renderError renders the error and asks for credentials in case the request is unauthenticated.
+func renderError(w http.ResponseWriter, err error) {
+	if errors.Is(err, apiauth.ErrNotAuthenticated) {
+		w.Header().Set("LFS-Authenticate", `Basic realm="Gitness"`)
+	}
+	render.TranslatedUserError(w, err)
+}
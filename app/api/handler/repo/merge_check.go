@@ -35,7 +35,9 @@ func HandleMergeCheck(repoCtrl *repo.Controller) http.HandlerFunc {
 
 		path := request.GetOptionalRemainderFromPath(r)
 
-		output, err := repoCtrl.MergeCheck(ctx, session, repoRef, path)
+		method := request.ParseMergeMethod(r)
+
+		output, err := repoCtrl.MergeCheck(ctx, session, repoRef, path, method)
 		if err != nil {
 			render.TranslatedUserError(w, err)
 			return
@@ -22,7 +22,9 @@ import (
 )
 
 type ConfigOutput struct {
-	UserSignupAllowed bool `json:"user_signup_allowed"`
+	UserSignupAllowed     bool `json:"user_signup_allowed"`
+	OIDCLoginEnabled      bool `json:"oidc_login_enabled"`
+	PasswordLoginDisabled bool `json:"password_login_disabled"`
 }
 
 // HandleGetConfig returns an http.HandlerFunc that processes an http.Request
@@ -37,7 +39,9 @@ func HandleGetConfig(sysCtrl *system.Controller) http.HandlerFunc {
 			return
 		}
 		render.JSON(w, http.StatusOK, ConfigOutput{
-			UserSignupAllowed: userSignupAllowed,
+			UserSignupAllowed:     userSignupAllowed,
+			OIDCLoginEnabled:      sysCtrl.IsOIDCLoginEnabled(),
+			PasswordLoginDisabled: sysCtrl.IsPasswordLoginDisabled(),
 		})
 	}
 }
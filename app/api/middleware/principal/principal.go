@@ -15,10 +15,12 @@
 package principal
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/harness/gitness/app/api/render"
 	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/rs/zerolog/log"
@@ -65,7 +67,31 @@ func RestrictToAdmin() func(http.Handler) http.Handler {
 				return
 			}
 
+			if !hasAdminPrivileges(ctx) {
+				log.Ctx(ctx).Debug().Msg("The token used for auth is restricted and doesn't grant admin privileges")
+
+				render.Forbidden(w)
+				return
+			}
+
 			next.ServeHTTP(w, r)
 		})
 	}
 }
+
+// hasAdminPrivileges returns false in case the session was authenticated by a token
+// that isn't granted the admin scope or is restricted to a subset of spaces and repos.
+func hasAdminPrivileges(ctx context.Context) bool {
+	session, ok := request.AuthSessionFrom(ctx)
+	if !ok {
+		return false
+	}
+
+	tokenMetadata, ok := session.Metadata.(*auth.TokenMetadata)
+	if !ok {
+		return true
+	}
+
+	return !tokenMetadata.Restrictions.IsResourceRestricted() &&
+		tokenMetadata.Restrictions.Grants(enum.PermissionUserEditAdmin)
+}
@@ -46,6 +46,24 @@ type loginRequest struct {
 	user.LoginInput
 }
 
+// request to complete a login with a one-time password.
+type loginTOTPRequest struct {
+	user.LoginTOTPInput
+}
+
+// request to start a single sign-on login.
+type loginOIDCRequest struct {
+	ReturnTo string `query:"return_to" description:"The path the user is sent to after the login."`
+}
+
+// request sent by the identity provider to complete a single sign-on login.
+type loginOIDCCallbackRequest struct {
+	State            string `query:"state"`
+	Code             string `query:"code"`
+	Error            string `query:"error"`
+	ErrorDescription string `query:"error_description"`
+}
+
 // request to register an account.
 type registerRequest struct {
 	user.RegisterInput
@@ -59,12 +77,45 @@ func buildAccount(reflector *openapi3.Reflector) {
 	onLogin.WithParameters(queryParameterIncludeCookie)
 	onLogin.WithMapOfAnything(map[string]interface{}{"operationId": "onLogin"})
 	_ = reflector.SetRequest(&onLogin, new(loginRequest), http.MethodPost)
-	_ = reflector.SetJSONResponse(&onLogin, new(types.TokenResponse), http.StatusOK)
+	_ = reflector.SetJSONResponse(&onLogin, new(types.LoginResponse), http.StatusOK)
 	_ = reflector.SetJSONResponse(&onLogin, new(usererror.Error), http.StatusBadRequest)
 	_ = reflector.SetJSONResponse(&onLogin, new(usererror.Error), http.StatusInternalServerError)
 	_ = reflector.SetJSONResponse(&onLogin, new(usererror.Error), http.StatusNotFound)
 	_ = reflector.Spec.AddOperation(http.MethodPost, "/login", onLogin)
 
+	onLoginTOTP := openapi3.Operation{}
+	onLoginTOTP.WithTags("account")
+	onLoginTOTP.WithParameters(queryParameterIncludeCookie)
+	onLoginTOTP.WithMapOfAnything(map[string]interface{}{"operationId": "onLoginTOTP"})
+	_ = reflector.SetRequest(&onLoginTOTP, new(loginTOTPRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&onLoginTOTP, new(types.LoginResponse), http.StatusOK)
+	_ = reflector.SetJSONResponse(&onLoginTOTP, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&onLoginTOTP, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&onLoginTOTP, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&onLoginTOTP, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/login/totp", onLoginTOTP)
+
+	onLoginOIDC := openapi3.Operation{}
+	onLoginOIDC.WithTags("account")
+	onLoginOIDC.WithMapOfAnything(map[string]interface{}{"operationId": "onLoginOIDC"})
+	_ = reflector.SetRequest(&onLoginOIDC, new(loginOIDCRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&onLoginOIDC, nil, http.StatusFound)
+	_ = reflector.SetJSONResponse(&onLoginOIDC, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&onLoginOIDC, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/login/oidc", onLoginOIDC)
+
+	onLoginOIDCCallback := openapi3.Operation{}
+	onLoginOIDCCallback.WithTags("account")
+	onLoginOIDCCallback.WithMapOfAnything(map[string]interface{}{"operationId": "onLoginOIDCCallback"})
+	_ = reflector.SetRequest(&onLoginOIDCCallback, new(loginOIDCCallbackRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&onLoginOIDCCallback, new(types.LoginResponse), http.StatusOK)
+	_ = reflector.SetJSONResponse(&onLoginOIDCCallback, nil, http.StatusFound)
+	_ = reflector.SetJSONResponse(&onLoginOIDCCallback, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&onLoginOIDCCallback, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&onLoginOIDCCallback, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&onLoginOIDCCallback, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/login/oidc/callback", onLoginOIDCCallback)
+
 	opLogout := openapi3.Operation{}
 	opLogout.WithTags("account")
 	opLogout.WithMapOfAnything(map[string]interface{}{"operationId": "opLogout"})
@@ -80,7 +131,7 @@ func buildAccount(reflector *openapi3.Reflector) {
 	onRegister.WithParameters(queryParameterIncludeCookie)
 	onRegister.WithMapOfAnything(map[string]interface{}{"operationId": "onRegister"})
 	_ = reflector.SetRequest(&onRegister, new(registerRequest), http.MethodPost)
-	_ = reflector.SetJSONResponse(&onRegister, new(types.TokenResponse), http.StatusOK)
+	_ = reflector.SetJSONResponse(&onRegister, new(types.LoginResponse), http.StatusOK)
 	_ = reflector.SetJSONResponse(&onRegister, new(usererror.Error), http.StatusInternalServerError)
 	_ = reflector.SetJSONResponse(&onRegister, new(usererror.Error), http.StatusBadRequest)
 	_ = reflector.Spec.AddOperation(http.MethodPost, "/register", onRegister)
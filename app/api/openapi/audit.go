@@ -0,0 +1,156 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/gotidy/ptr"
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+var queryParameterAuditActor = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamActor,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The uid of the principal that performed the audited action."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
+var queryParameterAuditResourceType = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamResourceType,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The type of the audited resource to include in the result."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeArray),
+				Items: &openapi3.SchemaOrRef{
+					Schema: &openapi3.Schema{
+						Type: ptrSchemaType(openapi3.SchemaTypeString),
+						Enum: enum.AuditResourceType("").Enum(),
+					},
+				},
+			},
+		},
+	},
+}
+
+var queryParameterAuditAction = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamAction,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The audited action to include in the result."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeArray),
+				Items: &openapi3.SchemaOrRef{
+					Schema: &openapi3.Schema{
+						Type: ptrSchemaType(openapi3.SchemaTypeString),
+						Enum: enum.AuditAction("").Enum(),
+					},
+				},
+			},
+		},
+	},
+}
+
+var queryParameterBeforeAuditEvent = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamBefore,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The result should contain only entries created before this timestamp (unix millis)."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type:    ptrSchemaType(openapi3.SchemaTypeInteger),
+				Minimum: ptr.Float64(0),
+			},
+		},
+	},
+}
+
+// helper function that constructs the openapi specification
+// for audit resources.
+func auditOperations(reflector *openapi3.Reflector) {
+	opList := openapi3.Operation{}
+	opList.WithTags("admin")
+	opList.WithMapOfAnything(map[string]interface{}{"operationId": "adminListAuditEvents"})
+	opList.WithParameters(queryParameterAuditActor, queryParameterAuditResourceType, queryParameterAuditAction,
+		queryParameterAfter, queryParameterBeforeAuditEvent, queryParameterOrder,
+		queryParameterPage, queryParameterLimit)
+	_ = reflector.SetRequest(&opList, nil, http.MethodGet)
+	_ = reflector.SetJSONResponse(&opList, new([]*types.AuditEvent), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opList, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opList, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opList, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/admin/audit-events", opList)
+
+	opExport := openapi3.Operation{}
+	opExport.WithTags("admin")
+	opExport.WithMapOfAnything(map[string]interface{}{"operationId": "adminExportAuditEvents"})
+	opExport.WithParameters(queryParameterAuditActor, queryParameterAuditResourceType, queryParameterAuditAction,
+		queryParameterAfter, queryParameterBeforeAuditEvent, queryParameterOrder)
+	_ = reflector.SetRequest(&opExport, nil, http.MethodGet)
+	_ = reflector.SetStringResponse(&opExport, http.StatusOK, "application/x-ndjson")
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/admin/audit-events/export", opExport)
+
+	opListSpace := openapi3.Operation{}
+	opListSpace.WithTags("space")
+	opListSpace.WithMapOfAnything(map[string]interface{}{"operationId": "listSpaceAuditEvents"})
+	opListSpace.WithParameters(queryParameterAuditActor, queryParameterAuditResourceType, queryParameterAuditAction,
+		queryParameterAfter, queryParameterBeforeAuditEvent, queryParameterOrder,
+		queryParameterPage, queryParameterLimit)
+	_ = reflector.SetRequest(&opListSpace, new(spaceRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opListSpace, new([]*types.AuditEvent), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opListSpace, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opListSpace, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opListSpace, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opListSpace, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opListSpace, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/audit-events", opListSpace)
+
+	opExportSpace := openapi3.Operation{}
+	opExportSpace.WithTags("space")
+	opExportSpace.WithMapOfAnything(map[string]interface{}{"operationId": "exportSpaceAuditEvents"})
+	opExportSpace.WithParameters(queryParameterAuditActor, queryParameterAuditResourceType, queryParameterAuditAction,
+		queryParameterAfter, queryParameterBeforeAuditEvent, queryParameterOrder)
+	_ = reflector.SetRequest(&opExportSpace, new(spaceRequest), http.MethodGet)
+	_ = reflector.SetStringResponse(&opExportSpace, http.StatusOK, "application/x-ndjson")
+	_ = reflector.SetJSONResponse(&opExportSpace, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opExportSpace, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opExportSpace, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opExportSpace, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opExportSpace, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/audit-events/export", opExportSpace)
+}
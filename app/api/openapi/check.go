@@ -59,4 +59,47 @@ func checkOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&listStatusCheckResults, new(usererror.Error), http.StatusForbidden)
 	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/checks/commits/{commit_sha}",
 		listStatusCheckResults)
+
+	createReqCheck := openapi3.Operation{}
+	createReqCheck.WithTags(tag)
+	createReqCheck.WithMapOfAnything(map[string]interface{}{"operationId": "createRequiredStatusCheck"})
+	_ = reflector.SetRequest(&createReqCheck, struct {
+		repoRequest
+		check.ReqCheckCreateInput
+	}{}, http.MethodPost)
+	_ = reflector.SetJSONResponse(&createReqCheck, new(types.ReqCheck), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&createReqCheck, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&createReqCheck, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&createReqCheck, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&createReqCheck, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&createReqCheck, new(usererror.Error), http.StatusConflict)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/checks/required", createReqCheck)
+
+	listReqChecks := openapi3.Operation{}
+	listReqChecks.WithTags(tag)
+	listReqChecks.WithMapOfAnything(map[string]interface{}{"operationId": "listRequiredStatusChecks"})
+	_ = reflector.SetRequest(&listReqChecks, struct {
+		repoRequest
+	}{}, http.MethodGet)
+	_ = reflector.SetJSONResponse(&listReqChecks, new([]types.ReqCheck), http.StatusOK)
+	_ = reflector.SetJSONResponse(&listReqChecks, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&listReqChecks, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&listReqChecks, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&listReqChecks, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/checks/required", listReqChecks)
+
+	deleteReqCheck := openapi3.Operation{}
+	deleteReqCheck.WithTags(tag)
+	deleteReqCheck.WithMapOfAnything(map[string]interface{}{"operationId": "deleteRequiredStatusCheck"})
+	_ = reflector.SetRequest(&deleteReqCheck, struct {
+		repoRequest
+		ReqCheckID int64 `path:"reqcheck_id"`
+	}{}, http.MethodDelete)
+	_ = reflector.SetJSONResponse(&deleteReqCheck, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&deleteReqCheck, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&deleteReqCheck, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&deleteReqCheck, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&deleteReqCheck, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/repos/{repo_ref}/checks/required/{reqcheck_id}",
+		deleteReqCheck)
 }
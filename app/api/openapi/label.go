@@ -0,0 +1,211 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/app/api/controller/label"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/types"
+
+	"github.com/gotidy/ptr"
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type createRepoLabelRequest struct {
+	repoRequest
+	label.CreateInput
+}
+
+type listRepoLabelsRequest struct {
+	repoRequest
+}
+
+type repoLabelRequest struct {
+	repoRequest
+	ID int64 `path:"label_id"`
+}
+
+type updateRepoLabelRequest struct {
+	repoLabelRequest
+	label.UpdateInput
+}
+
+type createSpaceLabelRequest struct {
+	spaceRequest
+	label.CreateInput
+}
+
+type listSpaceLabelsRequest struct {
+	spaceRequest
+}
+
+type spaceLabelRequest struct {
+	spaceRequest
+	ID int64 `path:"label_id"`
+}
+
+type updateSpaceLabelRequest struct {
+	spaceLabelRequest
+	label.UpdateInput
+}
+
+var queryParameterQueryLabel = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamQuery,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The substring by which the labels are filtered."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
+var queryParameterInheritedLabel = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamInherited,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The result should include labels defined in the parent spaces."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type:    ptrSchemaType(openapi3.SchemaTypeBoolean),
+				Default: ptrptr(false),
+			},
+		},
+	},
+}
+
+//nolint:funlen // api spec generation no need for checking func complexity
+func labelOperations(reflector *openapi3.Reflector) {
+	createRepoLabel := openapi3.Operation{}
+	createRepoLabel.WithTags("label")
+	createRepoLabel.WithMapOfAnything(map[string]interface{}{"operationId": "createRepoLabel"})
+	_ = reflector.SetRequest(&createRepoLabel, new(createRepoLabelRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&createRepoLabel, new(types.Label), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&createRepoLabel, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&createRepoLabel, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&createRepoLabel, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&createRepoLabel, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/labels", createRepoLabel)
+
+	listRepoLabels := openapi3.Operation{}
+	listRepoLabels.WithTags("label")
+	listRepoLabels.WithMapOfAnything(map[string]interface{}{"operationId": "listRepoLabels"})
+	listRepoLabels.WithParameters(queryParameterQueryLabel, queryParameterInheritedLabel,
+		queryParameterPage, queryParameterLimit)
+	_ = reflector.SetRequest(&listRepoLabels, new(listRepoLabelsRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&listRepoLabels, new([]types.Label), http.StatusOK)
+	_ = reflector.SetJSONResponse(&listRepoLabels, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&listRepoLabels, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&listRepoLabels, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&listRepoLabels, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/labels", listRepoLabels)
+
+	getRepoLabel := openapi3.Operation{}
+	getRepoLabel.WithTags("label")
+	getRepoLabel.WithMapOfAnything(map[string]interface{}{"operationId": "getRepoLabel"})
+	_ = reflector.SetRequest(&getRepoLabel, new(repoLabelRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&getRepoLabel, new(types.Label), http.StatusOK)
+	_ = reflector.SetJSONResponse(&getRepoLabel, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&getRepoLabel, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&getRepoLabel, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&getRepoLabel, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/labels/{label_id}", getRepoLabel)
+
+	updateRepoLabel := openapi3.Operation{}
+	updateRepoLabel.WithTags("label")
+	updateRepoLabel.WithMapOfAnything(map[string]interface{}{"operationId": "updateRepoLabel"})
+	_ = reflector.SetRequest(&updateRepoLabel, new(updateRepoLabelRequest), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&updateRepoLabel, new(types.Label), http.StatusOK)
+	_ = reflector.SetJSONResponse(&updateRepoLabel, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&updateRepoLabel, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&updateRepoLabel, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&updateRepoLabel, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPatch, "/repos/{repo_ref}/labels/{label_id}", updateRepoLabel)
+
+	deleteRepoLabel := openapi3.Operation{}
+	deleteRepoLabel.WithTags("label")
+	deleteRepoLabel.WithMapOfAnything(map[string]interface{}{"operationId": "deleteRepoLabel"})
+	_ = reflector.SetRequest(&deleteRepoLabel, new(repoLabelRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&deleteRepoLabel, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&deleteRepoLabel, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&deleteRepoLabel, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&deleteRepoLabel, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&deleteRepoLabel, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/repos/{repo_ref}/labels/{label_id}", deleteRepoLabel)
+
+	createSpaceLabel := openapi3.Operation{}
+	createSpaceLabel.WithTags("label")
+	createSpaceLabel.WithMapOfAnything(map[string]interface{}{"operationId": "createSpaceLabel"})
+	_ = reflector.SetRequest(&createSpaceLabel, new(createSpaceLabelRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&createSpaceLabel, new(types.Label), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&createSpaceLabel, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&createSpaceLabel, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&createSpaceLabel, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&createSpaceLabel, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/spaces/{space_ref}/labels", createSpaceLabel)
+
+	listSpaceLabels := openapi3.Operation{}
+	listSpaceLabels.WithTags("label")
+	listSpaceLabels.WithMapOfAnything(map[string]interface{}{"operationId": "listSpaceLabels"})
+	listSpaceLabels.WithParameters(queryParameterQueryLabel, queryParameterInheritedLabel,
+		queryParameterPage, queryParameterLimit)
+	_ = reflector.SetRequest(&listSpaceLabels, new(listSpaceLabelsRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&listSpaceLabels, new([]types.Label), http.StatusOK)
+	_ = reflector.SetJSONResponse(&listSpaceLabels, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&listSpaceLabels, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&listSpaceLabels, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&listSpaceLabels, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/labels", listSpaceLabels)
+
+	getSpaceLabel := openapi3.Operation{}
+	getSpaceLabel.WithTags("label")
+	getSpaceLabel.WithMapOfAnything(map[string]interface{}{"operationId": "getSpaceLabel"})
+	_ = reflector.SetRequest(&getSpaceLabel, new(spaceLabelRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&getSpaceLabel, new(types.Label), http.StatusOK)
+	_ = reflector.SetJSONResponse(&getSpaceLabel, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&getSpaceLabel, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&getSpaceLabel, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&getSpaceLabel, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/labels/{label_id}", getSpaceLabel)
+
+	updateSpaceLabel := openapi3.Operation{}
+	updateSpaceLabel.WithTags("label")
+	updateSpaceLabel.WithMapOfAnything(map[string]interface{}{"operationId": "updateSpaceLabel"})
+	_ = reflector.SetRequest(&updateSpaceLabel, new(updateSpaceLabelRequest), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&updateSpaceLabel, new(types.Label), http.StatusOK)
+	_ = reflector.SetJSONResponse(&updateSpaceLabel, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&updateSpaceLabel, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&updateSpaceLabel, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&updateSpaceLabel, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPatch, "/spaces/{space_ref}/labels/{label_id}", updateSpaceLabel)
+
+	deleteSpaceLabel := openapi3.Operation{}
+	deleteSpaceLabel.WithTags("label")
+	deleteSpaceLabel.WithMapOfAnything(map[string]interface{}{"operationId": "deleteSpaceLabel"})
+	_ = reflector.SetRequest(&deleteSpaceLabel, new(spaceLabelRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&deleteSpaceLabel, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&deleteSpaceLabel, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&deleteSpaceLabel, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&deleteSpaceLabel, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&deleteSpaceLabel, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/spaces/{space_ref}/labels/{label_id}", deleteSpaceLabel)
+}
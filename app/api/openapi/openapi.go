@@ -61,6 +61,10 @@ func Generate() *openapi3.Spec {
 	pullReqOperations(&reflector)
 	webhookOperations(&reflector)
 	checkOperations(&reflector)
+	ruleOperations(&reflector)
+	labelOperations(&reflector)
+	issueOperations(&reflector)
+	auditOperations(&reflector)
 
 	//
 	// define security scheme
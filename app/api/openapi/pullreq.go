@@ -64,6 +64,11 @@ type mergePullReq struct {
 	pullreq.MergeInput
 }
 
+type autoMergeEnablePullReq struct {
+	pullReqRequest
+	pullreq.AutoMergeInput
+}
+
 type commentCreatePullReqRequest struct {
 	pullReqRequest
 	pullreq.CommentCreateInput
@@ -102,6 +107,20 @@ type reviewerAddPullReqRequest struct {
 	pullreq.ReviewerAddInput
 }
 
+type labelListPullReqRequest struct {
+	pullReqRequest
+}
+
+type labelAssignPullReqRequest struct {
+	pullReqRequest
+	pullreq.LabelAssignInput
+}
+
+type labelUnassignPullReqRequest struct {
+	pullReqRequest
+	LabelID int64 `path:"label_id"`
+}
+
 type reviewSubmitPullReqRequest struct {
 	pullreq.ReviewSubmitInput
 	pullReqRequest
@@ -191,6 +210,25 @@ var queryParameterCreatedByPullRequest = openapi3.ParameterOrRef{
 	},
 }
 
+var queryParameterLabelPullRequest = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamLabelID,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("List of label IDs; only pull requests with all of the labels are returned."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeArray),
+				Items: &openapi3.SchemaOrRef{
+					Schema: &openapi3.Schema{
+						Type: ptrSchemaType(openapi3.SchemaTypeInteger),
+					},
+				},
+			},
+		},
+	},
+}
+
 var queryParameterStatePullRequest = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        request.QueryParamState,
@@ -303,7 +341,7 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 		queryParameterStatePullRequest, queryParameterSourceRepoRefPullRequest,
 		queryParameterSourceBranchPullRequest, queryParameterTargetBranchPullRequest,
 		queryParameterQueryPullRequest, queryParameterCreatedByPullRequest,
-		queryParameterOrder, queryParameterSortPullRequest,
+		queryParameterLabelPullRequest, queryParameterOrder, queryParameterSortPullRequest,
 		queryParameterPage, queryParameterLimit)
 	_ = reflector.SetRequest(&listPullReq, new(listPullReqRequest), http.MethodGet)
 	_ = reflector.SetJSONResponse(&listPullReq, new([]types.PullReq), http.StatusOK)
@@ -445,6 +483,42 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 	_ = reflector.Spec.AddOperation(http.MethodDelete,
 		"/repos/{repo_ref}/pullreq/{pullreq_number}/reviewers/{pullreq_reviewer_id}", reviewerDelete)
 
+	labelList := openapi3.Operation{}
+	labelList.WithTags("pullreq")
+	labelList.WithMapOfAnything(map[string]interface{}{"operationId": "labelListPullReq"})
+	_ = reflector.SetRequest(&labelList, new(labelListPullReqRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&labelList, new([]*types.Label), http.StatusOK)
+	_ = reflector.SetJSONResponse(&labelList, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&labelList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&labelList, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&labelList, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/labels", labelList)
+
+	labelAssign := openapi3.Operation{}
+	labelAssign.WithTags("pullreq")
+	labelAssign.WithMapOfAnything(map[string]interface{}{"operationId": "labelAssignPullReq"})
+	_ = reflector.SetRequest(&labelAssign, new(labelAssignPullReqRequest), http.MethodPut)
+	_ = reflector.SetJSONResponse(&labelAssign, new(types.Label), http.StatusOK)
+	_ = reflector.SetJSONResponse(&labelAssign, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&labelAssign, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&labelAssign, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&labelAssign, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPut,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/labels", labelAssign)
+
+	labelUnassign := openapi3.Operation{}
+	labelUnassign.WithTags("pullreq")
+	labelUnassign.WithMapOfAnything(map[string]interface{}{"operationId": "labelUnassignPullReq"})
+	_ = reflector.SetRequest(&labelUnassign, new(labelUnassignPullReqRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&labelUnassign, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&labelUnassign, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&labelUnassign, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&labelUnassign, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&labelUnassign, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodDelete,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/labels/{label_id}", labelUnassign)
+
 	reviewSubmit := openapi3.Operation{}
 	reviewSubmit.WithTags("pullreq")
 	reviewSubmit.WithMapOfAnything(map[string]interface{}{"operationId": "reviewSubmitPullReq"})
@@ -471,6 +545,31 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 	_ = reflector.Spec.AddOperation(http.MethodPost,
 		"/repos/{repo_ref}/pullreq/{pullreq_number}/merge", mergePullReqOp)
 
+	autoMergeEnablePullReqOp := openapi3.Operation{}
+	autoMergeEnablePullReqOp.WithTags("pullreq")
+	autoMergeEnablePullReqOp.WithMapOfAnything(map[string]interface{}{"operationId": "autoMergeEnablePullReq"})
+	_ = reflector.SetRequest(&autoMergeEnablePullReqOp, new(autoMergeEnablePullReq), http.MethodPost)
+	_ = reflector.SetJSONResponse(&autoMergeEnablePullReqOp, new(types.PullReq), http.StatusOK)
+	_ = reflector.SetJSONResponse(&autoMergeEnablePullReqOp, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&autoMergeEnablePullReqOp, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&autoMergeEnablePullReqOp, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&autoMergeEnablePullReqOp, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&autoMergeEnablePullReqOp, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/auto-merge", autoMergeEnablePullReqOp)
+
+	autoMergeCancelPullReqOp := openapi3.Operation{}
+	autoMergeCancelPullReqOp.WithTags("pullreq")
+	autoMergeCancelPullReqOp.WithMapOfAnything(map[string]interface{}{"operationId": "autoMergeCancelPullReq"})
+	_ = reflector.SetRequest(&autoMergeCancelPullReqOp, new(pullReqRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&autoMergeCancelPullReqOp, new(types.PullReq), http.StatusOK)
+	_ = reflector.SetJSONResponse(&autoMergeCancelPullReqOp, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&autoMergeCancelPullReqOp, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&autoMergeCancelPullReqOp, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&autoMergeCancelPullReqOp, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodDelete,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/auto-merge", autoMergeCancelPullReqOp)
+
 	opListCommits := openapi3.Operation{}
 	opListCommits.WithTags("pullreq")
 	opListCommits.WithMapOfAnything(map[string]interface{}{"operationId": "listPullReqCommits"})
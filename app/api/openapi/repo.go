@@ -21,6 +21,7 @@ import (
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -52,11 +53,46 @@ type moveRepoRequest struct {
 	repo.MoveInput
 }
 
+type forkRepoRequest struct {
+	repoRequest
+	repo.ForkInput
+}
+
+type syncForkRequest struct {
+	repoRequest
+	repo.SyncForkInput
+}
+
+type updateMirrorRequest struct {
+	repoRequest
+	repo.UpdateMirrorInput
+}
+
+type pushMirrorRequest struct {
+	repoRequest
+	UID string `path:"push_mirror_uid"`
+}
+
+type createPushMirrorRequest struct {
+	repoRequest
+	repo.CreatePushMirrorInput
+}
+
+type updatePushMirrorRequest struct {
+	pushMirrorRequest
+	repo.UpdatePushMirrorInput
+}
+
 type getContentRequest struct {
 	repoRequest
 	Path string `path:"path"`
 }
 
+type getArchiveRequest struct {
+	repoRequest
+	Archive string `path:"archive" description:"The git ref followed by the archive extension (zip, tar.gz or tar)."`
+}
+
 type pathsDetailsRequest struct {
 	repoRequest
 	repo.PathsDetailsInput
@@ -67,6 +103,11 @@ type getBlameRequest struct {
 	Path string `path:"path"`
 }
 
+type getCodeOwnersRequest struct {
+	repoRequest
+	Path string `path:"path"`
+}
+
 type commitFilesRequest struct {
 	repoRequest
 	repo.CommitFilesOptions
@@ -187,6 +228,39 @@ var queryParameterPath = openapi3.ParameterOrRef{
 	},
 }
 
+var queryParameterArchivePrefix = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamPrefix,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("Directory under which all files of the archive are placed."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
+var queryParameterArchivePaths = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamPath,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("Paths to include in the archive. If not provided, the whole tree is archived."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeArray),
+				Items: &openapi3.SchemaOrRef{
+					Schema: &openapi3.Schema{
+						Type: ptrSchemaType(openapi3.SchemaTypeString),
+					},
+				},
+			},
+		},
+	},
+}
+
 var queryParameterSince = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        request.QueryParamSince,
@@ -215,6 +289,22 @@ var queryParameterUntil = openapi3.ParameterOrRef{
 	},
 }
 
+var queryParameterMergeMethod = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamMergeMethod,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The merge method for which the mergeability is checked."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type:    ptrSchemaType(openapi3.SchemaTypeString),
+				Default: ptrptr(enum.MergeMethod(gitrpcenum.MergeMethodMerge)),
+				Enum:    enum.MergeMethod("").Enum(),
+			},
+		},
+	},
+}
+
 var queryParameterIncludeCommit = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        request.QueryParamIncludeCommit,
@@ -441,6 +531,162 @@ func repoOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opMove, new(usererror.Error), http.StatusForbidden)
 	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/move", opMove)
 
+	opFork := openapi3.Operation{}
+	opFork.WithTags("repository")
+	opFork.WithMapOfAnything(map[string]interface{}{"operationId": "forkRepository"})
+	_ = reflector.SetRequest(&opFork, new(forkRepoRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opFork, new(types.Repository), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opFork, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opFork, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opFork, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opFork, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/fork", opFork)
+
+	opSyncFork := openapi3.Operation{}
+	opSyncFork.WithTags("repository")
+	opSyncFork.WithMapOfAnything(map[string]interface{}{"operationId": "syncFork"})
+	_ = reflector.SetRequest(&opSyncFork, new(syncForkRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opSyncFork, new(repo.SyncForkOutput), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSyncFork, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opSyncFork, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opSyncFork, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opSyncFork, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opSyncFork, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/fork/sync", opSyncFork)
+
+	createMirror := openapi3.Operation{}
+	createMirror.WithTags("repository")
+	createMirror.WithMapOfAnything(map[string]interface{}{"operationId": "createMirror"})
+	_ = reflector.SetRequest(&createMirror, &struct{ repo.CreateMirrorInput }{}, http.MethodPost)
+	_ = reflector.SetJSONResponse(&createMirror, new(types.Repository), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&createMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&createMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&createMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&createMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/mirror", createMirror)
+
+	opFindMirror := openapi3.Operation{}
+	opFindMirror.WithTags("repository")
+	opFindMirror.WithMapOfAnything(map[string]interface{}{"operationId": "findMirror"})
+	_ = reflector.SetRequest(&opFindMirror, new(repoRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opFindMirror, new(types.RepoMirror), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opFindMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opFindMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opFindMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opFindMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opFindMirror, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/mirror", opFindMirror)
+
+	opUpdateMirror := openapi3.Operation{}
+	opUpdateMirror.WithTags("repository")
+	opUpdateMirror.WithMapOfAnything(map[string]interface{}{"operationId": "updateMirror"})
+	_ = reflector.SetRequest(&opUpdateMirror, new(updateMirrorRequest), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&opUpdateMirror, new(types.RepoMirror), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opUpdateMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opUpdateMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opUpdateMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opUpdateMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opUpdateMirror, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPatch, "/repos/{repo_ref}/mirror", opUpdateMirror)
+
+	opSyncMirror := openapi3.Operation{}
+	opSyncMirror.WithTags("repository")
+	opSyncMirror.WithMapOfAnything(map[string]interface{}{"operationId": "syncMirror"})
+	_ = reflector.SetRequest(&opSyncMirror, new(repoRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opSyncMirror, new(types.RepoMirror), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSyncMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opSyncMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opSyncMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opSyncMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opSyncMirror, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/mirror/sync", opSyncMirror)
+
+	opCreatePushMirror := openapi3.Operation{}
+	opCreatePushMirror.WithTags("repository")
+	opCreatePushMirror.WithMapOfAnything(map[string]interface{}{"operationId": "createPushMirror"})
+	_ = reflector.SetRequest(&opCreatePushMirror, new(createPushMirrorRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opCreatePushMirror, new(types.PushMirror), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opCreatePushMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opCreatePushMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opCreatePushMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opCreatePushMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/push-mirrors", opCreatePushMirror)
+
+	opListPushMirrors := openapi3.Operation{}
+	opListPushMirrors.WithTags("repository")
+	opListPushMirrors.WithMapOfAnything(map[string]interface{}{"operationId": "listPushMirrors"})
+	_ = reflector.SetRequest(&opListPushMirrors, new(repoRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opListPushMirrors, []types.PushMirror{}, http.StatusOK)
+	_ = reflector.SetJSONResponse(&opListPushMirrors, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opListPushMirrors, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opListPushMirrors, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opListPushMirrors, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opListPushMirrors, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/push-mirrors", opListPushMirrors)
+
+	opFindPushMirror := openapi3.Operation{}
+	opFindPushMirror.WithTags("repository")
+	opFindPushMirror.WithMapOfAnything(map[string]interface{}{"operationId": "findPushMirror"})
+	_ = reflector.SetRequest(&opFindPushMirror, new(pushMirrorRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opFindPushMirror, new(types.PushMirror), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opFindPushMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opFindPushMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opFindPushMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opFindPushMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opFindPushMirror, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/push-mirrors/{push_mirror_uid}", opFindPushMirror)
+
+	opUpdatePushMirror := openapi3.Operation{}
+	opUpdatePushMirror.WithTags("repository")
+	opUpdatePushMirror.WithMapOfAnything(map[string]interface{}{"operationId": "updatePushMirror"})
+	_ = reflector.SetRequest(&opUpdatePushMirror, new(updatePushMirrorRequest), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&opUpdatePushMirror, new(types.PushMirror), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opUpdatePushMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opUpdatePushMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opUpdatePushMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opUpdatePushMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opUpdatePushMirror, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPatch,
+		"/repos/{repo_ref}/push-mirrors/{push_mirror_uid}", opUpdatePushMirror)
+
+	opDeletePushMirror := openapi3.Operation{}
+	opDeletePushMirror.WithTags("repository")
+	opDeletePushMirror.WithMapOfAnything(map[string]interface{}{"operationId": "deletePushMirror"})
+	_ = reflector.SetRequest(&opDeletePushMirror, new(pushMirrorRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&opDeletePushMirror, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&opDeletePushMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opDeletePushMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opDeletePushMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opDeletePushMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opDeletePushMirror, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodDelete,
+		"/repos/{repo_ref}/push-mirrors/{push_mirror_uid}", opDeletePushMirror)
+
+	opPushToMirror := openapi3.Operation{}
+	opPushToMirror.WithTags("repository")
+	opPushToMirror.WithMapOfAnything(map[string]interface{}{"operationId": "pushToMirror"})
+	_ = reflector.SetRequest(&opPushToMirror, new(pushMirrorRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opPushToMirror, new(types.PushMirror), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opPushToMirror, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opPushToMirror, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opPushToMirror, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opPushToMirror, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opPushToMirror, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/push-mirrors/{push_mirror_uid}/push", opPushToMirror)
+
+	opLFSUsage := openapi3.Operation{}
+	opLFSUsage.WithTags("repository")
+	opLFSUsage.WithMapOfAnything(map[string]interface{}{"operationId": "getLFSUsage"})
+	_ = reflector.SetRequest(&opLFSUsage, new(repoRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opLFSUsage, new(types.LFSUsage), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opLFSUsage, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opLFSUsage, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opLFSUsage, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opLFSUsage, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/lfs/usage", opLFSUsage)
+
 	opServiceAccounts := openapi3.Operation{}
 	opServiceAccounts.WithTags("repository")
 	opServiceAccounts.WithMapOfAnything(map[string]interface{}{"operationId": "listRepositoryServiceAccounts"})
@@ -489,6 +735,34 @@ func repoOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opGetRaw, new(usererror.Error), http.StatusNotFound)
 	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/raw/{path}", opGetRaw)
 
+	opGetArchive := openapi3.Operation{}
+	opGetArchive.WithTags("repository")
+	opGetArchive.WithMapOfAnything(map[string]interface{}{"operationId": "getArchive"})
+	opGetArchive.WithParameters(queryParameterArchivePrefix, queryParameterArchivePaths)
+	_ = reflector.SetRequest(&opGetArchive, new(getArchiveRequest), http.MethodGet)
+	_ = reflector.SetStringResponse(&opGetArchive, http.StatusOK, "application/octet-stream")
+	_ = reflector.SetJSONResponse(&opGetArchive, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opGetArchive, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opGetArchive, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opGetArchive, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opGetArchive, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/archive/{archive}", opGetArchive)
+
+	opSearch := openapi3.Operation{}
+	opSearch.WithTags("repository")
+	opSearch.WithMapOfAnything(map[string]interface{}{"operationId": "searchRepo"})
+	opSearch.WithParameters(queryParameterCodeSearchQuery, queryParameterCodeSearchMode,
+		queryParameterCodeSearchCaseSensitive, queryParameterCodeSearchPath, queryParameterCodeSearchLanguage,
+		queryParameterCodeSearchContext, queryParameterLimit)
+	_ = reflector.SetRequest(&opSearch, new(repoRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opSearch, []types.CodeSearchResult{}, http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/search", opSearch)
+
 	opGetBlame := openapi3.Operation{}
 	opGetBlame.WithTags("repository")
 	opGetBlame.WithMapOfAnything(map[string]interface{}{"operationId": "getBlame"})
@@ -502,6 +776,19 @@ func repoOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opGetBlame, new(usererror.Error), http.StatusNotFound)
 	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/blame/{path}", opGetBlame)
 
+	opGetCodeOwners := openapi3.Operation{}
+	opGetCodeOwners.WithTags("repository")
+	opGetCodeOwners.WithMapOfAnything(map[string]interface{}{"operationId": "getCodeOwners"})
+	opGetCodeOwners.WithParameters(queryParameterGitRef)
+	_ = reflector.SetRequest(&opGetCodeOwners, new(getCodeOwnersRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opGetCodeOwners, new(repo.CodeOwnersOutput), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opGetCodeOwners, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opGetCodeOwners, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opGetCodeOwners, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opGetCodeOwners, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opGetCodeOwners, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/codeowners/{path}", opGetCodeOwners)
+
 	opListCommits := openapi3.Operation{}
 	opListCommits.WithTags("repository")
 	opListCommits.WithMapOfAnything(map[string]interface{}{"operationId": "listCommits"})
@@ -670,6 +957,7 @@ func repoOperations(reflector *openapi3.Reflector) {
 	opMergeCheck := openapi3.Operation{}
 	opMergeCheck.WithTags("repository")
 	opMergeCheck.WithMapOfAnything(map[string]interface{}{"operationId": "mergeCheck"})
+	opMergeCheck.WithParameters(queryParameterMergeMethod)
 	_ = reflector.SetRequest(&opMergeCheck, new(getRawDiffRequest), http.MethodPost)
 	_ = reflector.SetJSONResponse(&opMergeCheck, new(repo.MergeCheck), http.StatusOK)
 	_ = reflector.SetJSONResponse(&opMergeCheck, new(usererror.Error), http.StatusInternalServerError)
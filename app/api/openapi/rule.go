@@ -0,0 +1,217 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/app/api/controller/rule"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/gotidy/ptr"
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type createRepoRuleRequest struct {
+	repoRequest
+	rule.CreateInput
+}
+
+type listRepoRulesRequest struct {
+	repoRequest
+}
+
+type repoRuleRequest struct {
+	repoRequest
+	UID string `path:"rule_uid"`
+}
+
+type updateRepoRuleRequest struct {
+	repoRuleRequest
+	rule.UpdateInput
+}
+
+type createSpaceRuleRequest struct {
+	spaceRequest
+	rule.CreateInput
+}
+
+type listSpaceRulesRequest struct {
+	spaceRequest
+}
+
+type spaceRuleRequest struct {
+	spaceRequest
+	UID string `path:"rule_uid"`
+}
+
+type updateSpaceRuleRequest struct {
+	spaceRuleRequest
+	rule.UpdateInput
+}
+
+var queryParameterQueryRule = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamQuery,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The substring by which the protection rules are filtered."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
+var queryParameterSortRule = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamSort,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The data by which the protection rules are sorted."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type:    ptrSchemaType(openapi3.SchemaTypeString),
+				Default: ptrptr(enum.RuleAttrCreated.String()),
+				Enum: []interface{}{
+					ptr.String(enum.RuleAttrUID.String()),
+					ptr.String(enum.RuleAttrCreated.String()),
+					ptr.String(enum.RuleAttrUpdated.String()),
+				},
+			},
+		},
+	},
+}
+
+//nolint:funlen // api spec generation no need for checking func complexity
+func ruleOperations(reflector *openapi3.Reflector) {
+	createRepoRule := openapi3.Operation{}
+	createRepoRule.WithTags("rule")
+	createRepoRule.WithMapOfAnything(map[string]interface{}{"operationId": "createRepoRule"})
+	_ = reflector.SetRequest(&createRepoRule, new(createRepoRuleRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&createRepoRule, new(types.Rule), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&createRepoRule, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&createRepoRule, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&createRepoRule, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&createRepoRule, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/rules", createRepoRule)
+
+	listRepoRules := openapi3.Operation{}
+	listRepoRules.WithTags("rule")
+	listRepoRules.WithMapOfAnything(map[string]interface{}{"operationId": "listRepoRules"})
+	listRepoRules.WithParameters(queryParameterQueryRule, queryParameterSortRule, queryParameterOrder,
+		queryParameterPage, queryParameterLimit)
+	_ = reflector.SetRequest(&listRepoRules, new(listRepoRulesRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&listRepoRules, new([]types.Rule), http.StatusOK)
+	_ = reflector.SetJSONResponse(&listRepoRules, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&listRepoRules, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&listRepoRules, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&listRepoRules, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/rules", listRepoRules)
+
+	getRepoRule := openapi3.Operation{}
+	getRepoRule.WithTags("rule")
+	getRepoRule.WithMapOfAnything(map[string]interface{}{"operationId": "getRepoRule"})
+	_ = reflector.SetRequest(&getRepoRule, new(repoRuleRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&getRepoRule, new(types.Rule), http.StatusOK)
+	_ = reflector.SetJSONResponse(&getRepoRule, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&getRepoRule, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&getRepoRule, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&getRepoRule, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/rules/{rule_uid}", getRepoRule)
+
+	updateRepoRule := openapi3.Operation{}
+	updateRepoRule.WithTags("rule")
+	updateRepoRule.WithMapOfAnything(map[string]interface{}{"operationId": "updateRepoRule"})
+	_ = reflector.SetRequest(&updateRepoRule, new(updateRepoRuleRequest), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&updateRepoRule, new(types.Rule), http.StatusOK)
+	_ = reflector.SetJSONResponse(&updateRepoRule, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&updateRepoRule, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&updateRepoRule, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&updateRepoRule, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPatch, "/repos/{repo_ref}/rules/{rule_uid}", updateRepoRule)
+
+	deleteRepoRule := openapi3.Operation{}
+	deleteRepoRule.WithTags("rule")
+	deleteRepoRule.WithMapOfAnything(map[string]interface{}{"operationId": "deleteRepoRule"})
+	_ = reflector.SetRequest(&deleteRepoRule, new(repoRuleRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&deleteRepoRule, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&deleteRepoRule, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&deleteRepoRule, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&deleteRepoRule, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&deleteRepoRule, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/repos/{repo_ref}/rules/{rule_uid}", deleteRepoRule)
+
+	createSpaceRule := openapi3.Operation{}
+	createSpaceRule.WithTags("rule")
+	createSpaceRule.WithMapOfAnything(map[string]interface{}{"operationId": "createSpaceRule"})
+	_ = reflector.SetRequest(&createSpaceRule, new(createSpaceRuleRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&createSpaceRule, new(types.Rule), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&createSpaceRule, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&createSpaceRule, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&createSpaceRule, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&createSpaceRule, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/spaces/{space_ref}/rules", createSpaceRule)
+
+	listSpaceRules := openapi3.Operation{}
+	listSpaceRules.WithTags("rule")
+	listSpaceRules.WithMapOfAnything(map[string]interface{}{"operationId": "listSpaceRules"})
+	listSpaceRules.WithParameters(queryParameterQueryRule, queryParameterSortRule, queryParameterOrder,
+		queryParameterPage, queryParameterLimit)
+	_ = reflector.SetRequest(&listSpaceRules, new(listSpaceRulesRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&listSpaceRules, new([]types.Rule), http.StatusOK)
+	_ = reflector.SetJSONResponse(&listSpaceRules, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&listSpaceRules, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&listSpaceRules, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&listSpaceRules, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/rules", listSpaceRules)
+
+	getSpaceRule := openapi3.Operation{}
+	getSpaceRule.WithTags("rule")
+	getSpaceRule.WithMapOfAnything(map[string]interface{}{"operationId": "getSpaceRule"})
+	_ = reflector.SetRequest(&getSpaceRule, new(spaceRuleRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&getSpaceRule, new(types.Rule), http.StatusOK)
+	_ = reflector.SetJSONResponse(&getSpaceRule, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&getSpaceRule, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&getSpaceRule, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&getSpaceRule, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/rules/{rule_uid}", getSpaceRule)
+
+	updateSpaceRule := openapi3.Operation{}
+	updateSpaceRule.WithTags("rule")
+	updateSpaceRule.WithMapOfAnything(map[string]interface{}{"operationId": "updateSpaceRule"})
+	_ = reflector.SetRequest(&updateSpaceRule, new(updateSpaceRuleRequest), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&updateSpaceRule, new(types.Rule), http.StatusOK)
+	_ = reflector.SetJSONResponse(&updateSpaceRule, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&updateSpaceRule, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&updateSpaceRule, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&updateSpaceRule, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPatch, "/spaces/{space_ref}/rules/{rule_uid}", updateSpaceRule)
+
+	deleteSpaceRule := openapi3.Operation{}
+	deleteSpaceRule.WithTags("rule")
+	deleteSpaceRule.WithMapOfAnything(map[string]interface{}{"operationId": "deleteSpaceRule"})
+	_ = reflector.SetRequest(&deleteSpaceRule, new(spaceRuleRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&deleteSpaceRule, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&deleteSpaceRule, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&deleteSpaceRule, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&deleteSpaceRule, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&deleteSpaceRule, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/spaces/{space_ref}/rules/{rule_uid}", deleteSpaceRule)
+}
@@ -266,6 +266,21 @@ func spaceOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opRepos, new(usererror.Error), http.StatusNotFound)
 	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/repos", opRepos)
 
+	opSearch := openapi3.Operation{}
+	opSearch.WithTags("space")
+	opSearch.WithMapOfAnything(map[string]interface{}{"operationId": "searchSpace"})
+	opSearch.WithParameters(queryParameterCodeSearchQuery, queryParameterCodeSearchMode,
+		queryParameterCodeSearchCaseSensitive, queryParameterCodeSearchPath, queryParameterCodeSearchLanguage,
+		queryParameterCodeSearchContext, queryParameterLimit)
+	_ = reflector.SetRequest(&opSearch, new(spaceRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opSearch, []types.CodeSearchResult{}, http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opSearch, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/search", opSearch)
+
 	opTemplates := openapi3.Operation{}
 	opTemplates.WithTags("space")
 	opTemplates.WithMapOfAnything(map[string]interface{}{"operationId": "listTemplates"})
@@ -31,6 +31,26 @@ type createTokenRequest struct {
 	user.CreateTokenInput
 }
 
+type createPublicKeyRequest struct {
+	user.CreatePublicKeyInput
+}
+
+type publicKeyRequest struct {
+	UID string `path:"public_key_uid"`
+}
+
+type createSigningKeyRequest struct {
+	user.CreateSigningKeyInput
+}
+
+type signingKeyRequest struct {
+	UID string `path:"signing_key_uid"`
+}
+
+type totpCodeRequest struct {
+	user.TOTPCodeInput
+}
+
 var queryParameterMembershipSpaces = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        request.QueryParamQuery,
@@ -88,6 +108,107 @@ func buildUser(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opToken, new(usererror.Error), http.StatusInternalServerError)
 	_ = reflector.Spec.AddOperation(http.MethodPost, "/user/token", opToken)
 
+	opListKeys := openapi3.Operation{}
+	opListKeys.WithTags("user")
+	opListKeys.WithMapOfAnything(map[string]interface{}{"operationId": "listPublicKeys"})
+	_ = reflector.SetRequest(&opListKeys, nil, http.MethodGet)
+	_ = reflector.SetJSONResponse(&opListKeys, new([]types.PublicKey), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opListKeys, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/user/keys", opListKeys)
+
+	opCreateKey := openapi3.Operation{}
+	opCreateKey.WithTags("user")
+	opCreateKey.WithMapOfAnything(map[string]interface{}{"operationId": "createPublicKey"})
+	_ = reflector.SetRequest(&opCreateKey, new(createPublicKeyRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opCreateKey, new(types.PublicKey), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opCreateKey, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opCreateKey, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opCreateKey, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/user/keys", opCreateKey)
+
+	opDeleteKey := openapi3.Operation{}
+	opDeleteKey.WithTags("user")
+	opDeleteKey.WithMapOfAnything(map[string]interface{}{"operationId": "deletePublicKey"})
+	_ = reflector.SetRequest(&opDeleteKey, new(publicKeyRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&opDeleteKey, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&opDeleteKey, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opDeleteKey, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/user/keys/{public_key_uid}", opDeleteKey)
+
+	opListSigningKeys := openapi3.Operation{}
+	opListSigningKeys.WithTags("user")
+	opListSigningKeys.WithMapOfAnything(map[string]interface{}{"operationId": "listSigningKeys"})
+	_ = reflector.SetRequest(&opListSigningKeys, nil, http.MethodGet)
+	_ = reflector.SetJSONResponse(&opListSigningKeys, new([]types.SigningKey), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opListSigningKeys, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/user/signing-keys", opListSigningKeys)
+
+	opCreateSigningKey := openapi3.Operation{}
+	opCreateSigningKey.WithTags("user")
+	opCreateSigningKey.WithMapOfAnything(map[string]interface{}{"operationId": "createSigningKey"})
+	_ = reflector.SetRequest(&opCreateSigningKey, new(createSigningKeyRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opCreateSigningKey, new(types.SigningKey), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opCreateSigningKey, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opCreateSigningKey, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opCreateSigningKey, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/user/signing-keys", opCreateSigningKey)
+
+	opDeleteSigningKey := openapi3.Operation{}
+	opDeleteSigningKey.WithTags("user")
+	opDeleteSigningKey.WithMapOfAnything(map[string]interface{}{"operationId": "deleteSigningKey"})
+	_ = reflector.SetRequest(&opDeleteSigningKey, new(signingKeyRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&opDeleteSigningKey, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&opDeleteSigningKey, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opDeleteSigningKey, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/user/signing-keys/{signing_key_uid}", opDeleteSigningKey)
+
+	opFindTOTP := openapi3.Operation{}
+	opFindTOTP.WithTags("user")
+	opFindTOTP.WithMapOfAnything(map[string]interface{}{"operationId": "findTOTP"})
+	_ = reflector.SetRequest(&opFindTOTP, nil, http.MethodGet)
+	_ = reflector.SetJSONResponse(&opFindTOTP, new(types.TOTPStatus), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opFindTOTP, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/user/totp", opFindTOTP)
+
+	opEnrollTOTP := openapi3.Operation{}
+	opEnrollTOTP.WithTags("user")
+	opEnrollTOTP.WithMapOfAnything(map[string]interface{}{"operationId": "enrollTOTP"})
+	_ = reflector.SetRequest(&opEnrollTOTP, nil, http.MethodPost)
+	_ = reflector.SetJSONResponse(&opEnrollTOTP, new(types.TOTPEnrollment), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opEnrollTOTP, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opEnrollTOTP, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/user/totp", opEnrollTOTP)
+
+	opEnableTOTP := openapi3.Operation{}
+	opEnableTOTP.WithTags("user")
+	opEnableTOTP.WithMapOfAnything(map[string]interface{}{"operationId": "enableTOTP"})
+	_ = reflector.SetRequest(&opEnableTOTP, new(totpCodeRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opEnableTOTP, new(types.TOTPRecoveryCodes), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opEnableTOTP, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opEnableTOTP, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opEnableTOTP, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/user/totp/enable", opEnableTOTP)
+
+	opRegenerateTOTPRecoveryCodes := openapi3.Operation{}
+	opRegenerateTOTPRecoveryCodes.WithTags("user")
+	opRegenerateTOTPRecoveryCodes.WithMapOfAnything(
+		map[string]interface{}{"operationId": "regenerateTOTPRecoveryCodes"})
+	_ = reflector.SetRequest(&opRegenerateTOTPRecoveryCodes, new(totpCodeRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opRegenerateTOTPRecoveryCodes, new(types.TOTPRecoveryCodes), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opRegenerateTOTPRecoveryCodes, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opRegenerateTOTPRecoveryCodes, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/user/totp/recovery-codes", opRegenerateTOTPRecoveryCodes)
+
+	opDisableTOTP := openapi3.Operation{}
+	opDisableTOTP.WithTags("user")
+	opDisableTOTP.WithMapOfAnything(map[string]interface{}{"operationId": "disableTOTP"})
+	_ = reflector.SetRequest(&opDisableTOTP, new(totpCodeRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&opDisableTOTP, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&opDisableTOTP, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opDisableTOTP, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opDisableTOTP, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/user/totp", opDisableTOTP)
+
 	opMemberSpaces := openapi3.Operation{}
 	opMemberSpaces.WithTags("user")
 	opMemberSpaces.WithMapOfAnything(map[string]interface{}{"operationId": "membershipSpaces"})
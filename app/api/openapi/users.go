@@ -117,4 +117,14 @@ func buildAdmin(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opDelete, new(usererror.Error), http.StatusInternalServerError)
 	_ = reflector.SetJSONResponse(&opDelete, new(usererror.Error), http.StatusNotFound)
 	_ = reflector.Spec.AddOperation(http.MethodDelete, "/admin/users/{user_uid}", opDelete)
+
+	opResetTOTP := openapi3.Operation{}
+	opResetTOTP.WithTags("admin")
+	opResetTOTP.WithMapOfAnything(map[string]interface{}{"operationId": "adminResetUserTOTP"})
+	_ = reflector.SetRequest(&opResetTOTP, new(adminUsersRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&opResetTOTP, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&opResetTOTP, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opResetTOTP, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opResetTOTP, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodDelete, "/admin/users/{user_uid}/totp", opResetTOTP)
 }
@@ -126,6 +126,54 @@ func Reader(ctx context.Context, w http.ResponseWriter, code int, reader io.Read
 	}
 }
 
+// JSONLinesDynamic outputs JSON lines (one json-encoded element per line) streamed from a channel.
+// Due to the dynamic nature (unknown number of elements) the function will use
+// chunked transfer encoding for large files.
+func JSONLinesDynamic[T any](ctx context.Context, w http.ResponseWriter, stream types.Stream[T]) {
+	count := 0
+	enc := json.NewEncoder(w)
+
+	for {
+		data, err := stream.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			// User canceled the request - no need to do anything
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+
+			if count == 0 {
+				// Write the error only if no data has been streamed yet.
+				TranslatedUserError(w, err)
+				return
+			}
+
+			// Data has been already streamed, it's too late for the output - so just log and quit.
+			log.Ctx(ctx).Warn().Msgf("Failed to write JSON lines response body: %v", err)
+			return
+		}
+
+		if count == 0 {
+			w.Header().Set("Content-Type", "application/x-ndjson; charset=utf-8")
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+			w.WriteHeader(http.StatusOK)
+		}
+
+		count++
+
+		_ = enc.Encode(data)
+	}
+
+	if count == 0 {
+		w.Header().Set("Content-Type", "application/x-ndjson; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
 // JSONArrayDynamic outputs an JSON array whose elements are streamed from a channel.
 // Due to the dynamic nature (unknown number of elements) the function will use
 // chunked transfer encoding for large files.
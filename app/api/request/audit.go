@@ -0,0 +1,93 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	QueryParamActor        = "actor"
+	QueryParamAction       = "action"
+	QueryParamResourceType = "resource_type"
+)
+
+// ParseAuditFilter extracts the audit event filter from the url.
+func ParseAuditFilter(r *http.Request) (*types.AuditFilter, error) {
+	resourceTypes, err := parseAuditResourceTypes(r)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := parseAuditActions(r)
+	if err != nil {
+		return nil, err
+	}
+	// after is optional, skipped if set to 0
+	after, err := QueryParamAsPositiveInt64OrDefault(r, QueryParamAfter, 0)
+	if err != nil {
+		return nil, err
+	}
+	// before is optional, skipped if set to 0
+	before, err := QueryParamAsPositiveInt64OrDefault(r, QueryParamBefore, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AuditFilter{
+		Page:          ParsePage(r),
+		Size:          ParseLimit(r),
+		ActorUID:      QueryParamOrDefault(r, QueryParamActor, ""),
+		ResourceTypes: resourceTypes,
+		Actions:       actions,
+		After:         after,
+		Before:        before,
+		Order:         ParseOrder(r),
+	}, nil
+}
+
+// parseAuditResourceTypes extracts the audit resource types from the url.
+// Unknown resource types are rejected, as ignoring them would widen the filter.
+func parseAuditResourceTypes(r *http.Request) ([]enum.AuditResourceType, error) {
+	strTypes, _ := QueryParamList(r, QueryParamResourceType)
+	res := make([]enum.AuditResourceType, 0, len(strTypes))
+	for _, s := range strTypes {
+		resourceType, ok := enum.AuditResourceType(s).Sanitize()
+		if !ok {
+			return nil, usererror.BadRequestf("Audit resource type %q is not supported", s)
+		}
+		res = append(res, resourceType)
+	}
+
+	return res, nil
+}
+
+// parseAuditActions extracts the audit actions from the url.
+// Unknown actions are rejected, as ignoring them would widen the filter.
+func parseAuditActions(r *http.Request) ([]enum.AuditAction, error) {
+	strActions, _ := QueryParamList(r, QueryParamAction)
+	res := make([]enum.AuditAction, 0, len(strActions))
+	for _, s := range strActions {
+		action, ok := enum.AuditAction(s).Sanitize()
+		if !ok {
+			return nil, usererror.BadRequestf("Audit action %q is not supported", s)
+		}
+		res = append(res, action)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestParseAuditFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		resourceTypes []enum.AuditResourceType
+		actions       []enum.AuditAction
+		wantErr       bool
+	}{
+		{
+			name:          "no filter",
+			query:         "",
+			resourceTypes: []enum.AuditResourceType{},
+			actions:       []enum.AuditAction{},
+		},
+		{
+			name:          "multiple values",
+			query:         "resource_type=repo&resource_type=token&action=delete",
+			resourceTypes: []enum.AuditResourceType{enum.AuditResourceTypeRepo, enum.AuditResourceTypeToken},
+			actions:       []enum.AuditAction{enum.AuditActionDelete},
+		},
+		{
+			name:    "unknown resource type",
+			query:   "resource_type=repo&resource_type=bogus",
+			wantErr: true,
+		},
+		{
+			name:    "unknown action",
+			query:   "action=bogus",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/audit-events?"+test.query, nil)
+
+			filter, err := ParseAuditFilter(r)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got filter %+v", filter)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(filter.ResourceTypes, test.resourceTypes) {
+				t.Errorf("resource types: want %v, got %v", test.resourceTypes, filter.ResourceTypes)
+			}
+			if !reflect.DeepEqual(filter.Actions, test.actions) {
+				t.Errorf("actions: want %v, got %v", test.actions, filter.Actions)
+			}
+		})
+	}
+}
@@ -20,6 +20,14 @@ import (
 	"github.com/harness/gitness/types"
 )
 
+const (
+	PathParamReqCheckID = "reqcheck_id"
+)
+
+func GetReqCheckIDFromPath(r *http.Request) (int64, error) {
+	return PathParamAsPositiveInt64(r, PathParamReqCheckID)
+}
+
 // ParseCheckListOptions extracts the status check list API options from the url.
 func ParseCheckListOptions(r *http.Request) types.CheckListOptions {
 	return types.CheckListOptions{
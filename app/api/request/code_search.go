@@ -0,0 +1,52 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	QueryParamSearchMode    = "mode"
+	QueryParamCaseSensitive = "case_sensitive"
+	QueryParamLanguage      = "language"
+	QueryParamContext       = "context"
+)
+
+// ParseCodeSearchFilter extracts the code search filter from the url.
+func ParseCodeSearchFilter(r *http.Request) (*types.CodeSearchFilter, error) {
+	caseSensitive, err := QueryParamAsBoolOrDefault(r, QueryParamCaseSensitive, false)
+	if err != nil {
+		return nil, err
+	}
+
+	contextLines, err := QueryParamAsPositiveInt64OrDefault(r, QueryParamContext, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CodeSearchFilter{
+		Query:         ParseQuery(r),
+		Mode:          enum.CodeSearchMode(r.URL.Query().Get(QueryParamSearchMode)),
+		CaseSensitive: caseSensitive,
+		Path:          r.URL.Query().Get(QueryParamPath),
+		Language:      r.URL.Query().Get(QueryParamLanguage),
+		Context:       int(contextLines),
+		Size:          ParseLimit(r),
+	}, nil
+}
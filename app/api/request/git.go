@@ -17,6 +17,7 @@ package request
 import (
 	"net/http"
 
+	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
@@ -31,6 +32,7 @@ const (
 	QueryParamSince         = "since"
 	QueryParamUntil         = "until"
 	QueryParamCommitter     = "committer"
+	QueryParamPrefix        = "prefix"
 )
 
 func GetGitRefFromQueryOrDefault(r *http.Request, deflt string) string {
@@ -105,3 +107,13 @@ func ParseCommitFilter(r *http.Request) (*types.CommitFilter, error) {
 		Committer: QueryParamOrDefault(r, QueryParamCommitter, ""),
 	}, nil
 }
+
+// GetArchiveRefAndFormatFromPath extracts the git ref and archive format from the remainder of the path.
+func GetArchiveRefAndFormatFromPath(r *http.Request) (string, enum.ArchiveFormat, error) {
+	gitRef, format, ok := enum.ParseArchiveName(GetOptionalRemainderFromPath(r))
+	if !ok {
+		return "", "", usererror.BadRequest("Archive name must be of the form '{git_ref}.{zip|tar.gz|tar}'.")
+	}
+
+	return gitRef, format, nil
+}
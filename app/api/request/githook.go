@@ -12,18 +12,17 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package pullreq
+package request
 
 import (
-	"strconv"
+	"net/http"
+)
 
-	"github.com/harness/gitness/lock"
+const (
+	QueryParamInternal = "internal"
 )
 
-func (c *Controller) newMutexForPR(repoUID string, pr int64, options ...lock.Option) (lock.Mutex, error) {
-	key := repoUID + "/pulls"
-	if pr != 0 {
-		key += "/" + strconv.FormatInt(pr, 10)
-	}
-	return c.mtxManager.NewMutex(key, append(options, lock.WithNamespace("repo"))...)
+// GetInternalFromQuery returns whether the git operation was executed by gitness itself.
+func GetInternalFromQuery(r *http.Request) (bool, error) {
+	return QueryParamAsBoolOrDefault(r, QueryParamInternal, false)
 }
@@ -0,0 +1,67 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/types"
+)
+
+const (
+	PathParamLabelID = "label_id"
+
+	QueryParamLabelID   = "label_id"
+	QueryParamInherited = "inherited"
+)
+
+func GetLabelIDFromPath(r *http.Request) (int64, error) {
+	return PathParamAsPositiveInt64(r, PathParamLabelID)
+}
+
+// ParseLabelFilter extracts the label query parameters for listing from the url.
+func ParseLabelFilter(r *http.Request) (*types.LabelFilter, error) {
+	inherited, err := QueryParamAsBoolOrDefault(r, QueryParamInherited, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LabelFilter{
+		Query:     ParseQuery(r),
+		Page:      ParsePage(r),
+		Size:      ParseLimit(r),
+		Inherited: inherited,
+	}, nil
+}
+
+// parseLabelIDs extracts the unique label ids from the url.
+func parseLabelIDs(r *http.Request) ([]int64, error) {
+	ids, err := QueryParamListAsPositiveInt64(r, QueryParamLabelID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int64]struct{}, len(ids))
+	labelIDs := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		labelIDs = append(labelIDs, id)
+	}
+
+	return labelIDs, nil
+}
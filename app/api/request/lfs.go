@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/app/api/usererror"
+)
+
+const (
+	PathParamLFSOID   = "lfs_oid"
+	QueryParamLFSSize = "size"
+)
+
+func GetLFSOIDFromPath(r *http.Request) (string, error) {
+	return PathParamOrError(r, PathParamLFSOID)
+}
+
+// GetLFSSizeFromQuery extracts the size of the LFS object, which is part of the upload href returned by the batch API.
+func GetLFSSizeFromQuery(r *http.Request) (int64, error) {
+	value, err := QueryParamOrError(r, QueryParamLFSSize)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		return 0, usererror.BadRequestf("Parameter '%s' must be a non-negative integer.", QueryParamLFSSize)
+	}
+
+	return size, nil
+}
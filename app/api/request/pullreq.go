@@ -70,6 +70,11 @@ func ParsePullReqFilter(r *http.Request) (*types.PullReqFilter, error) {
 	if err != nil {
 		return nil, err
 	}
+	// label_id is optional, the pull requests must have all the provided labels
+	labelIDs, err := parseLabelIDs(r)
+	if err != nil {
+		return nil, err
+	}
 	return &types.PullReqFilter{
 		Page:          ParsePage(r),
 		Size:          ParseLimit(r),
@@ -79,6 +84,7 @@ func ParsePullReqFilter(r *http.Request) (*types.PullReqFilter, error) {
 		SourceBranch:  r.URL.Query().Get("source_branch"),
 		TargetBranch:  r.URL.Query().Get("target_branch"),
 		States:        parsePullReqStates(r),
+		LabelIDs:      labelIDs,
 		Sort:          ParseSortPullReq(r),
 		Order:         ParseOrder(r),
 	}, nil
@@ -23,8 +23,9 @@ import (
 )
 
 const (
-	PathParamRepoRef = "repo_ref"
-	QueryParamRepoID = "repo_id"
+	PathParamRepoRef      = "repo_ref"
+	QueryParamRepoID      = "repo_id"
+	QueryParamMergeMethod = "merge_method"
 )
 
 func GetRepoRefFromPath(r *http.Request) (string, error) {
@@ -59,3 +60,8 @@ func ParseRepoFilter(r *http.Request) *types.RepoFilter {
 		Size:  ParseLimit(r),
 	}
 }
+
+// ParseMergeMethod extracts the merge method from the url. It returns an empty string if not provided.
+func ParseMergeMethod(r *http.Request) enum.MergeMethod {
+	return enum.MergeMethod(r.URL.Query().Get(QueryParamMergeMethod))
+}
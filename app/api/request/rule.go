@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	PathParamRuleUID = "rule_uid"
+)
+
+func GetRuleUIDFromPath(r *http.Request) (string, error) {
+	return PathParamOrError(r, PathParamRuleUID)
+}
+
+// ParseRuleFilter extracts the protection rule query parameters for listing from the url.
+func ParseRuleFilter(r *http.Request) *types.RuleFilter {
+	return &types.RuleFilter{
+		Query: ParseQuery(r),
+		Page:  ParsePage(r),
+		Size:  ParseLimit(r),
+		Sort:  ParseSortRule(r),
+		Order: ParseOrder(r),
+	}
+}
+
+// ParseSortRule extracts the protection rule sort parameter from the url.
+func ParseSortRule(r *http.Request) enum.RuleAttr {
+	return enum.ParseRuleAttr(
+		r.URL.Query().Get(QueryParamSort),
+	)
+}
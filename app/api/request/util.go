@@ -166,6 +166,24 @@ func QueryParamAsPositiveInt64(r *http.Request, paramName string) (int64, error)
 	return valueInt, nil
 }
 
+// QueryParamListAsPositiveInt64 extracts a list of integer parameters from the request query.
+// If the parameter doesn't exist an empty list is returned.
+func QueryParamListAsPositiveInt64(r *http.Request, paramName string) ([]int64, error) {
+	values, _ := QueryParamList(r, paramName)
+
+	valuesInt := make([]int64, 0, len(values))
+	for _, value := range values {
+		valueInt, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || valueInt <= 0 {
+			return nil, usererror.BadRequestf("Parameter '%s' must be a list of positive integers.", paramName)
+		}
+
+		valuesInt = append(valuesInt, valueInt)
+	}
+
+	return valuesInt, nil
+}
+
 // PathParamAsPositiveInt64 extracts an integer parameter from the request path.
 func PathParamAsPositiveInt64(r *http.Request, paramName string) (int64, error) {
 	rawValue, err := PathParamOrError(r, paramName)
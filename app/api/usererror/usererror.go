@@ -64,9 +64,23 @@ var (
 	ErrSpaceWithChildsCantBeDeleted = New(http.StatusBadRequest,
 		"Space can't be deleted as it still contains child resources")
 
+	// ErrRepoWithForksCantBeDeleted is returned if the principal is trying to delete a repository
+	// that still has forks.
+	ErrRepoWithForksCantBeDeleted = New(http.StatusBadRequest,
+		"Repository can't be deleted as it still has forks")
+
+	// ErrSpaceWithExternalForksCantBeDeleted is returned if the principal is trying to delete a space
+	// containing repositories that have forks outside of the space.
+	ErrSpaceWithExternalForksCantBeDeleted = New(http.StatusBadRequest,
+		"Space can't be deleted as it contains repositories with forks outside of the space")
+
 	// ErrDefaultBranchCantBeDeleted is returned if the user tries to delete the default branch of a repository.
 	ErrDefaultBranchCantBeDeleted = New(http.StatusBadRequest, "The default branch of a repository can't be deleted")
 
+	// ErrRepoIsMirror is returned if the user tries to change branches or tags of a mirror repository.
+	ErrRepoIsMirror = New(http.StatusBadRequest,
+		"Branches and tags of a mirror repository can't be changed, they are synced from the remote")
+
 	// ErrRequestTooLarge is returned if the request it too large.
 	ErrRequestTooLarge = New(http.StatusRequestEntityTooLarge, "The request is too large")
 
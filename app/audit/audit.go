@@ -0,0 +1,61 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Recorder records audit events of security-relevant and administrative actions.
+type Recorder interface {
+	// Record records an action performed by the principal of the session.
+	// The action already happened, so a failure to record it is logged rather than returned.
+	Record(ctx context.Context, session *auth.Session, event types.AuditEvent)
+}
+
+type storeRecorder struct {
+	auditStore store.AuditStore
+}
+
+func NewRecorder(auditStore store.AuditStore) Recorder {
+	return &storeRecorder{
+		auditStore: auditStore,
+	}
+}
+
+func (r *storeRecorder) Record(ctx context.Context, session *auth.Session, event types.AuditEvent) {
+	if session != nil {
+		event.ActorID = session.Principal.ID
+		event.ActorUID = session.Principal.UID
+	}
+
+	event.Created = time.Now().UnixMilli()
+
+	if err := r.auditStore.Create(ctx, &event); err != nil {
+		log.Ctx(ctx).Error().Err(err).
+			Str("audit.action", string(event.Action)).
+			Str("audit.resource_type", string(event.ResourceType)).
+			Str("audit.resource_name", event.ResourceName).
+			Int64("audit.actor_id", event.ActorID).
+			Msg("failed to record audit event")
+	}
+}
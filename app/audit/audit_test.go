@@ -0,0 +1,105 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type fakeAuditStore struct {
+	store.AuditStore
+	events []types.AuditEvent
+	err    error
+}
+
+func (f *fakeAuditStore) Create(_ context.Context, event *types.AuditEvent) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.events = append(f.events, *event)
+	return nil
+}
+
+func TestRecord(t *testing.T) {
+	auditStore := &fakeAuditStore{}
+	r := NewRecorder(auditStore)
+
+	session := &auth.Session{Principal: types.Principal{ID: 7, UID: "admin"}}
+	before := time.Now().UnixMilli()
+
+	r.Record(context.Background(), session, types.AuditEvent{
+		// the actor is always taken from the session.
+		ActorID:      1,
+		ActorUID:     "someone",
+		Action:       enum.AuditActionDelete,
+		ResourceType: enum.AuditResourceTypeRepo,
+		ResourceName: "space/repo",
+		SpaceID:      3,
+	})
+
+	if len(auditStore.events) != 1 {
+		t.Fatalf("expected one audit event, got %d", len(auditStore.events))
+	}
+
+	event := auditStore.events[0]
+	if event.ActorID != 7 || event.ActorUID != "admin" {
+		t.Errorf("expected actor 7/admin, got %d/%s", event.ActorID, event.ActorUID)
+	}
+	if event.Created < before {
+		t.Errorf("expected creation time to be set, got %d", event.Created)
+	}
+	if event.Action != enum.AuditActionDelete || event.ResourceType != enum.AuditResourceTypeRepo ||
+		event.ResourceName != "space/repo" || event.SpaceID != 3 {
+		t.Errorf("unexpected audit event: %+v", event)
+	}
+}
+
+func TestRecordWithoutSession(t *testing.T) {
+	auditStore := &fakeAuditStore{}
+	r := NewRecorder(auditStore)
+
+	r.Record(context.Background(), nil, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeUser,
+		ResourceName: "user",
+	})
+
+	if len(auditStore.events) != 1 {
+		t.Fatalf("expected one audit event, got %d", len(auditStore.events))
+	}
+	if event := auditStore.events[0]; event.ActorID != 0 || event.ActorUID != "" {
+		t.Errorf("expected no actor, got %d/%s", event.ActorID, event.ActorUID)
+	}
+}
+
+func TestRecordStoreFailure(t *testing.T) {
+	auditStore := &fakeAuditStore{err: errors.New("database is locked")}
+	r := NewRecorder(auditStore)
+
+	// the failure is only logged, the action already happened.
+	r.Record(context.Background(), &auth.Session{}, types.AuditEvent{
+		Action:       enum.AuditActionCreate,
+		ResourceType: enum.AuditResourceTypeUser,
+		ResourceName: "user",
+	})
+}
@@ -83,6 +83,8 @@ func (a *JWTAuthenticator) Authenticate(r *http.Request, sourceRouter SourceRout
 
 	var metadata auth.Metadata
 	switch {
+	case claims.TwoFactor != nil:
+		return nil, errors.New("jwt of two-factor authentication challenge can't be used for authentication")
 	case claims.Token != nil:
 		metadata, err = a.metadataFromTokenClaims(ctx, principal, claims.Token)
 		if err != nil {
@@ -118,8 +120,9 @@ func (a *JWTAuthenticator) metadataFromTokenClaims(
 	}
 
 	return &auth.TokenMetadata{
-		TokenType: tkn.Type,
-		TokenID:   tkn.ID,
+		TokenType:    tkn.Type,
+		TokenID:      tkn.ID,
+		Restrictions: tkn.TokenRestrictions,
 	}, nil
 }
 
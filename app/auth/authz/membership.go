@@ -16,11 +16,13 @@ package authz
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/harness/gitness/app/auth"
 	"github.com/harness/gitness/app/paths"
 	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -32,15 +34,18 @@ var _ Authorizer = (*MembershipAuthorizer)(nil)
 type MembershipAuthorizer struct {
 	permissionCache PermissionCache
 	spaceStore      store.SpaceStore
+	repoStore       store.RepoStore
 }
 
 func NewMembershipAuthorizer(
 	permissionCache PermissionCache,
 	spaceStore store.SpaceStore,
+	repoStore store.RepoStore,
 ) *MembershipAuthorizer {
 	return &MembershipAuthorizer{
 		permissionCache: permissionCache,
 		spaceStore:      spaceStore,
+		repoStore:       repoStore,
 	}
 }
 
@@ -72,6 +77,15 @@ func (a *MembershipAuthorizer) Check(
 		session.Metadata,
 	)
 
+	// restricted tokens only get the intersection of their restrictions and the permissions of the principal
+	tokenMetadata, isToken := session.Metadata.(*auth.TokenMetadata)
+	if isToken && tokenMetadata.Restrictions.IsRestricted() {
+		allowed, err := a.checkTokenRestrictions(ctx, &tokenMetadata.Restrictions, scope, resource, permission)
+		if err != nil || !allowed {
+			return false, err
+		}
+	}
+
 	if session.Principal.Admin {
 		return true, nil // system admin can call any API
 	}
@@ -125,7 +139,7 @@ func (a *MembershipAuthorizer) Check(
 	}
 
 	// ensure we aren't bypassing unknown metadata with impact on authorization
-	if session.Metadata != nil && session.Metadata.ImpactsAuthorization() {
+	if session.Metadata != nil && !isToken && session.Metadata.ImpactsAuthorization() {
 		return false, fmt.Errorf("session contains unknown metadata that impacts authorization: %T", session.Metadata)
 	}
 
@@ -178,3 +192,65 @@ func (a *MembershipAuthorizer) checkWithMembershipMetadata(
 	// access is granted by ephemeral membership
 	return true, nil
 }
+
+// checkTokenRestrictions checks whether the restrictions of the token used for auth allow the requested permission.
+func (a *MembershipAuthorizer) checkTokenRestrictions(
+	ctx context.Context,
+	restrictions *types.TokenRestrictions,
+	scope *types.Scope,
+	resource *types.Resource,
+	permission enum.Permission,
+) (bool, error) {
+	if !restrictions.Grants(permission) {
+		log.Ctx(ctx).Debug().Msgf("permission '%s' isn't granted by token scopes %v", permission, restrictions.Scopes)
+		return false, nil
+	}
+
+	if !restrictions.IsResourceRestricted() {
+		return true, nil
+	}
+
+	var requestedPath string
+
+	//nolint:exhaustive // users and services aren't part of any space or repository
+	switch resource.Type {
+	case enum.ResourceTypeSpace, enum.ResourceTypeRepo:
+		requestedPath = paths.Concatinate(scope.SpacePath, resource.Name)
+	case enum.ResourceTypeUser, enum.ResourceTypeService:
+		return false, nil
+	default:
+		requestedPath = paths.Concatinate(scope.SpacePath, scope.Repo)
+	}
+
+	for _, spaceID := range restrictions.SpaceIDs {
+		space, err := a.spaceStore.Find(ctx, spaceID)
+		if errors.Is(err, gitness_store.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to find space %d of token restrictions: %w", spaceID, err)
+		}
+
+		if paths.IsAncesterOf(space.Path, requestedPath) {
+			return true, nil
+		}
+	}
+
+	for _, repoID := range restrictions.RepoIDs {
+		repo, err := a.repoStore.Find(ctx, repoID)
+		if errors.Is(err, gitness_store.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to find repo %d of token restrictions: %w", repoID, err)
+		}
+
+		if paths.IsAncesterOf(repo.Path, requestedPath) {
+			return true, nil
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("'%s' is outside of the spaces and repos the token is restricted to", requestedPath)
+
+	return false, nil
+}
@@ -28,8 +28,12 @@ var WireSet = wire.NewSet(
 	ProvidePermissionCache,
 )
 
-func ProvideAuthorizer(pCache PermissionCache, spaceStore store.SpaceStore) Authorizer {
-	return NewMembershipAuthorizer(pCache, spaceStore)
+func ProvideAuthorizer(
+	pCache PermissionCache,
+	spaceStore store.SpaceStore,
+	repoStore store.RepoStore,
+) Authorizer {
+	return NewMembershipAuthorizer(pCache, spaceStore, repoStore)
 }
 
 func ProvidePermissionCache(
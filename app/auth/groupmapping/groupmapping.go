@@ -0,0 +1,85 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupmapping
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+// Mapping maps a group of an external identity provider or directory to a space membership.
+type Mapping struct {
+	Group     string
+	SpacePath string
+	Role      enum.MembershipRole
+}
+
+// Parse parses group mappings of the format "{group}={space path}:{role}".
+func Parse(raw []string) ([]Mapping, error) {
+	mappings := make([]Mapping, 0, len(raw))
+	for _, s := range raw {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		idxGroup := strings.LastIndex(s, "=")
+		idxRole := strings.LastIndex(s, ":")
+		if idxGroup <= 0 || idxRole < idxGroup+2 {
+			return nil, fmt.Errorf("group mapping %q doesn't have the format {group}={space path}:{role}", s)
+		}
+
+		role, ok := enum.MembershipRole(s[idxRole+1:]).Sanitize()
+		if !ok {
+			return nil, fmt.Errorf("group mapping %q has an invalid role", s)
+		}
+
+		mappings = append(mappings, Mapping{
+			Group:     s[:idxGroup],
+			SpacePath: strings.Trim(s[idxGroup+1:idxRole], "/"),
+			Role:      role,
+		})
+	}
+
+	return mappings, nil
+}
+
+// Match returns the mappings of the provided groups.
+// If several groups map to the same space, the mapping that is configured first wins.
+func Match(mappings []Mapping, groups []string) []Mapping {
+	groupSet := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		groupSet[group] = struct{}{}
+	}
+
+	spaces := make(map[string]struct{})
+	var matched []Mapping
+	for _, mapping := range mappings {
+		if _, ok := groupSet[mapping.Group]; !ok {
+			continue
+		}
+
+		if _, ok := spaces[mapping.SpacePath]; ok {
+			continue
+		}
+
+		spaces[mapping.SpacePath] = struct{}{}
+		matched = append(matched, mapping)
+	}
+
+	return matched
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupmapping
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    []string
+		exp    []Mapping
+		expErr bool
+	}{
+		{
+			name: "empty",
+			raw:  []string{""},
+			exp:  []Mapping{},
+		},
+		{
+			name: "valid",
+			raw:  []string{"developers=acme/backend:contributor", "cn=admins=/acme/:space_owner"},
+			exp: []Mapping{
+				{Group: "developers", SpacePath: "acme/backend", Role: enum.MembershipRoleContributor},
+				{Group: "cn=admins", SpacePath: "acme", Role: enum.MembershipRoleSpaceOwner},
+			},
+		},
+		{
+			name:   "no space",
+			raw:    []string{"developers=:reader"},
+			expErr: true,
+		},
+		{
+			name:   "no group",
+			raw:    []string{"=acme:reader"},
+			expErr: true,
+		},
+		{
+			name:   "invalid role",
+			raw:    []string{"developers=acme:maintainer"},
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mappings, err := Parse(test.raw)
+			if test.expErr {
+				if err == nil {
+					t.Errorf("expected an error, got %v", mappings)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(mappings, test.exp) {
+				t.Errorf("expected %v, got %v", test.exp, mappings)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	mappings := []Mapping{
+		{Group: "admins", SpacePath: "acme", Role: enum.MembershipRoleSpaceOwner},
+		{Group: "developers", SpacePath: "acme", Role: enum.MembershipRoleContributor},
+		{Group: "developers", SpacePath: "tools", Role: enum.MembershipRoleReader},
+	}
+
+	matched := Match(mappings, []string{"developers", "admins"})
+	exp := []Mapping{mappings[0], mappings[2]}
+	if !reflect.DeepEqual(matched, exp) {
+		t.Errorf("expected %v, got %v", exp, matched)
+	}
+
+	if matched = Match(mappings, []string{"others"}); matched != nil {
+		t.Errorf("expected no mappings, got %v", matched)
+	}
+}
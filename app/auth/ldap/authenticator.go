@@ -0,0 +1,278 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/harness/gitness/app/auth/groupmapping"
+	"github.com/harness/gitness/types"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+var (
+	// ErrNotEnabled is returned if the LDAP authentication isn't configured.
+	ErrNotEnabled = errors.New("LDAP authentication is not enabled")
+
+	// ErrInvalidCredentials is returned if the directory rejects the login identifier or password.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Entry is a user entry of the directory.
+type Entry struct {
+	DN          string
+	UID         string
+	Email       string
+	DisplayName string
+}
+
+// Authenticator authenticates users against the directory configured in types.Config
+// and provides the members of the groups of the directory.
+type Authenticator struct {
+	config        *types.Config
+	groupMappings []groupmapping.Mapping
+	userBaseDN    *goldap.DN
+
+	// dial opens a new connection to the directory, it's replaced in tests.
+	dial func(url string, opts ...goldap.DialOpt) (goldap.Client, error)
+}
+
+func NewAuthenticator(config *types.Config) (*Authenticator, error) {
+	if !config.LDAP.Enabled {
+		return &Authenticator{config: config, dial: dialURL}, nil
+	}
+
+	if config.LDAP.URL == "" || config.LDAP.UserBaseDN == "" {
+		return nil, errors.New("LDAP authentication requires a url and a user base DN")
+	}
+
+	if strings.Count(config.LDAP.UserFilter, "%s") != 1 {
+		return nil, errors.New("LDAP user filter has to contain the placeholder %s exactly once")
+	}
+
+	groupMappings, err := groupmapping.Parse(config.LDAP.GroupMappings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP group mappings: %w", err)
+	}
+
+	if len(groupMappings) > 0 && config.LDAP.GroupBaseDN == "" {
+		return nil, errors.New("LDAP group mappings require a group base DN")
+	}
+
+	userBaseDN, err := goldap.ParseDN(config.LDAP.UserBaseDN)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP user base DN: %w", err)
+	}
+
+	return &Authenticator{
+		config:        config,
+		groupMappings: groupMappings,
+		userBaseDN:    userBaseDN,
+		dial:          dialURL,
+	}, nil
+}
+
+func dialURL(url string, opts ...goldap.DialOpt) (goldap.Client, error) {
+	return goldap.DialURL(url, opts...)
+}
+
+// Enabled returns true if the LDAP authentication is configured.
+func (a *Authenticator) Enabled() bool {
+	return a.config.LDAP.Enabled
+}
+
+// GroupMappings returns the configured mappings of directory groups to space memberships.
+func (a *Authenticator) GroupMappings() []groupmapping.Mapping {
+	return a.groupMappings
+}
+
+// Authenticate verifies the credentials by binding as the user and returns the user entry.
+func (a *Authenticator) Authenticate(ctx context.Context, login string, password string) (*Entry, error) {
+	if !a.Enabled() {
+		return nil, ErrNotEnabled
+	}
+
+	// an empty password would result in an unauthenticated bind, which always succeeds.
+	if login == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := a.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	filter := fmt.Sprintf(a.config.LDAP.UserFilter, goldap.EscapeFilter(login))
+	entries, err := a.search(conn, a.config.LDAP.UserBaseDN, goldap.ScopeWholeSubtree, filter, 2)
+	if goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search user: %w", err)
+	}
+
+	if len(entries) != 1 {
+		// the login identifier is unknown or ambiguous.
+		return nil, ErrInvalidCredentials
+	}
+
+	err = conn.Bind(entries[0].DN, password)
+	if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind as user: %w", err)
+	}
+
+	return entries[0], nil
+}
+
+// GroupMembers returns the user entries of the members of the group with the provided name.
+// Members that aren't user entries (e.g. nested groups) or are outside of the user base DN are ignored.
+func (a *Authenticator) GroupMembers(ctx context.Context, group string) ([]*Entry, error) {
+	if !a.Enabled() {
+		return nil, ErrNotEnabled
+	}
+
+	conn, err := a.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	filter := fmt.Sprintf("(%s=%s)", a.config.LDAP.GroupNameAttribute, goldap.EscapeFilter(group))
+	request := goldap.NewSearchRequest(a.config.LDAP.GroupBaseDN, goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases, 0, 0, false, filter, []string{a.config.LDAP.GroupMemberAttribute}, nil)
+
+	result, err := conn.Search(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search group %q: %w", group, err)
+	}
+
+	var members []*Entry
+	for _, groupEntry := range result.Entries {
+		for _, memberDN := range groupEntry.GetAttributeValues(a.config.LDAP.GroupMemberAttribute) {
+			if !a.isUserDN(memberDN) {
+				continue
+			}
+
+			entries, err := a.search(conn, memberDN, goldap.ScopeBaseObject, "(objectClass=*)", 1)
+			if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to read member %q of group %q: %w", memberDN, group, err)
+			}
+
+			if len(entries) == 1 && entries[0].UID != "" {
+				members = append(members, entries[0])
+			}
+		}
+	}
+
+	return members, nil
+}
+
+// isUserDN returns true if the DN is within the user base DN, the only entries logins are accepted from.
+func (a *Authenticator) isUserDN(dn string) bool {
+	parsedDN, err := goldap.ParseDN(dn)
+	if err != nil {
+		return false
+	}
+
+	return a.userBaseDN.AncestorOfFold(parsedDN)
+}
+
+// connect opens a connection to the directory that is bound with the search credentials.
+func (a *Authenticator) connect(ctx context.Context) (goldap.Client, error) {
+	tlsConfig := &tls.Config{
+		//nolint:gosec // explicitly configured by the admin, e.g. for directories with self-signed certificates.
+		InsecureSkipVerify: a.config.LDAP.InsecureSkipVerify,
+	}
+
+	dialer := &net.Dialer{Timeout: a.config.LDAP.Timeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+
+	conn, err := a.dial(a.config.LDAP.URL,
+		goldap.DialWithDialer(dialer),
+		goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to directory: %w", err)
+	}
+
+	conn.SetTimeout(a.config.LDAP.Timeout)
+
+	if a.config.LDAP.StartTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if a.config.LDAP.BindDN != "" {
+		err = conn.Bind(a.config.LDAP.BindDN, a.config.LDAP.BindPassword)
+	} else {
+		err = conn.UnauthenticatedBind("")
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to bind with search credentials: %w", err)
+	}
+
+	return conn, nil
+}
+
+func (a *Authenticator) search(
+	conn goldap.Client,
+	baseDN string,
+	scope int,
+	filter string,
+	sizeLimit int,
+) ([]*Entry, error) {
+	attributes := []string{
+		a.config.LDAP.UIDAttribute,
+		a.config.LDAP.EmailAttribute,
+		a.config.LDAP.DisplayNameAttribute,
+	}
+
+	request := goldap.NewSearchRequest(baseDN, scope, goldap.NeverDerefAliases, sizeLimit, 0, false,
+		filter, attributes, nil)
+
+	result, err := conn.Search(request)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*Entry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = &Entry{
+			DN:          entry.DN,
+			UID:         entry.GetAttributeValue(a.config.LDAP.UIDAttribute),
+			Email:       entry.GetAttributeValue(a.config.LDAP.EmailAttribute),
+			DisplayName: entry.GetAttributeValue(a.config.LDAP.DisplayNameAttribute),
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,357 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/types"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+const (
+	testBindDN   = "cn=search,dc=example,dc=com"
+	testBindPass = "search-secret"
+)
+
+func TestNewAuthenticator(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(config *types.Config)
+		expErr bool
+	}{
+		{
+			name:   "valid",
+			modify: func(*types.Config) {},
+		},
+		{
+			name:   "disabled without configuration",
+			modify: func(config *types.Config) { *config = types.Config{} },
+		},
+		{
+			name:   "missing url",
+			modify: func(config *types.Config) { config.LDAP.URL = "" },
+			expErr: true,
+		},
+		{
+			name:   "user filter without placeholder",
+			modify: func(config *types.Config) { config.LDAP.UserFilter = "(uid=alice)" },
+			expErr: true,
+		},
+		{
+			name:   "invalid user base DN",
+			modify: func(config *types.Config) { config.LDAP.UserBaseDN = "example" },
+			expErr: true,
+		},
+		{
+			name:   "group mappings without group base DN",
+			modify: func(config *types.Config) { config.LDAP.GroupBaseDN = "" },
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := testConfig()
+			test.modify(config)
+
+			_, err := NewAuthenticator(config)
+			if test.expErr && err == nil {
+				t.Errorf("expected an error but got none")
+			}
+			if !test.expErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		name     string
+		login    string
+		password string
+		expUID   string
+		expErr   error
+	}{
+		{
+			name:     "valid credentials",
+			login:    "alice",
+			password: "alice-secret",
+			expUID:   "alice",
+		},
+		{
+			name:     "wrong password",
+			login:    "alice",
+			password: "bob-secret",
+			expErr:   ErrInvalidCredentials,
+		},
+		{
+			name:     "empty password",
+			login:    "alice",
+			password: "",
+			expErr:   ErrInvalidCredentials,
+		},
+		{
+			name:     "unknown user",
+			login:    "mallory",
+			password: "mallory-secret",
+			expErr:   ErrInvalidCredentials,
+		},
+		{
+			name:     "wildcard login is escaped",
+			login:    "*",
+			password: "alice-secret",
+			expErr:   ErrInvalidCredentials,
+		},
+		{
+			name:     "ambiguous login",
+			login:    "twin",
+			password: "twin-secret",
+			expErr:   ErrInvalidCredentials,
+		},
+		{
+			name:     "user outside of the user base DN",
+			login:    "outsider",
+			password: "outsider-secret",
+			expErr:   ErrInvalidCredentials,
+		},
+	}
+
+	a := testAuthenticator(t, testDirectory())
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entry, err := a.Authenticate(context.Background(), test.login, test.password)
+			if test.expErr != nil {
+				if !errors.Is(err, test.expErr) {
+					t.Errorf("expected error %v, got entry %+v and error %v", test.expErr, entry, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if entry.UID != test.expUID {
+				t.Errorf("expected uid %q, got %q", test.expUID, entry.UID)
+			}
+		})
+	}
+}
+
+func TestAuthenticateDirectoryUnavailable(t *testing.T) {
+	a := testAuthenticator(t, testDirectory())
+	a.dial = func(string, ...goldap.DialOpt) (goldap.Client, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	_, err := a.Authenticate(context.Background(), "alice", "alice-secret")
+	if err == nil || errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected connection error, got %v", err)
+	}
+}
+
+func TestGroupMembers(t *testing.T) {
+	a := testAuthenticator(t, testDirectory())
+
+	members, err := a.GroupMembers(context.Background(), "developers")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// nested groups, deleted entries and entries outside of the user base DN are skipped.
+	var uids []string
+	for _, member := range members {
+		uids = append(uids, member.UID)
+	}
+	if exp := []string{"alice", "bob"}; !reflect.DeepEqual(uids, exp) {
+		t.Errorf("expected members %v, got %v", exp, uids)
+	}
+
+	members, err = a.GroupMembers(context.Background(), "unknown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(members) != 0 {
+		t.Errorf("expected no members of unknown group, got %d", len(members))
+	}
+}
+
+func testConfig() *types.Config {
+	config := &types.Config{}
+	config.LDAP.Enabled = true
+	config.LDAP.URL = "ldap://ldap.example.com"
+	config.LDAP.Timeout = time.Second
+	config.LDAP.BindDN = testBindDN
+	config.LDAP.BindPassword = testBindPass
+	config.LDAP.UserBaseDN = "ou=people,dc=example,dc=com"
+	config.LDAP.UserFilter = "(&(objectClass=person)(uid=%s))"
+	config.LDAP.UIDAttribute = "uid"
+	config.LDAP.EmailAttribute = "mail"
+	config.LDAP.DisplayNameAttribute = "cn"
+	config.LDAP.GroupBaseDN = "ou=groups,dc=example,dc=com"
+	config.LDAP.GroupNameAttribute = "cn"
+	config.LDAP.GroupMemberAttribute = "member"
+	config.LDAP.GroupMappings = []string{"developers=acme:contributor"}
+	return config
+}
+
+func testAuthenticator(t *testing.T, dir *fakeDirectory) *Authenticator {
+	a, err := NewAuthenticator(testConfig())
+	if err != nil {
+		t.Fatalf("failed to create authenticator: %v", err)
+	}
+
+	a.dial = func(string, ...goldap.DialOpt) (goldap.Client, error) {
+		return &fakeConn{dir: dir}, nil
+	}
+
+	return a
+}
+
+func testDirectory() *fakeDirectory {
+	person := func(uid string) fakeEntry {
+		return fakeEntry{
+			password: uid + "-secret",
+			attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {uid},
+				"mail":        {uid + "@example.com"},
+				"cn":          {strings.ToUpper(uid)},
+			},
+		}
+	}
+
+	return &fakeDirectory{entries: map[string]fakeEntry{
+		testBindDN:                                 {password: testBindPass},
+		"uid=alice,ou=people,dc=example,dc=com":    person("alice"),
+		"uid=bob,ou=people,dc=example,dc=com":      person("bob"),
+		"cn=twin1,ou=people,dc=example,dc=com":     person("twin"),
+		"cn=twin2,ou=people,dc=example,dc=com":     person("twin"),
+		"uid=outsider,ou=robots,dc=example,dc=com": person("outsider"),
+		"cn=developers,ou=groups,dc=example,dc=com": {attributes: map[string][]string{
+			"cn": {"developers"},
+			"member": {
+				"uid=alice,ou=people,dc=example,dc=com",
+				"UID=Bob,OU=People,DC=Example,DC=Com",
+				"uid=deleted,ou=people,dc=example,dc=com",
+				"cn=admins,ou=groups,dc=example,dc=com",
+				"uid=outsider,ou=robots,dc=example,dc=com",
+			},
+		}},
+		"cn=admins,ou=groups,dc=example,dc=com": {attributes: map[string][]string{
+			"cn": {"admins"},
+		}},
+	}}
+}
+
+type fakeEntry struct {
+	password   string
+	attributes map[string][]string
+}
+
+// fakeDirectory is an in-memory directory supporting the searches and binds used by the authenticator.
+type fakeDirectory struct {
+	entries map[string]fakeEntry
+}
+
+// fakeConn is a connection to the fake directory, all other operations of the client are unused.
+type fakeConn struct {
+	goldap.Client
+	dir *fakeDirectory
+}
+
+func (c *fakeConn) SetTimeout(time.Duration) {}
+
+func (c *fakeConn) StartTLS(*tls.Config) error { return nil }
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) UnauthenticatedBind(string) error {
+	return goldap.NewError(goldap.LDAPResultInappropriateAuthentication, errors.New("anonymous bind disabled"))
+}
+
+func (c *fakeConn) Bind(dn, password string) error {
+	entry, ok := c.dir.entries[dn]
+	if !ok || entry.password == "" || entry.password != password {
+		return goldap.NewError(goldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+	}
+	return nil
+}
+
+var fakeFilterTerm = regexp.MustCompile(`\(([^()&|!=]+)=([^()]*)\)`)
+
+func (c *fakeConn) Search(request *goldap.SearchRequest) (*goldap.SearchResult, error) {
+	result := &goldap.SearchResult{}
+
+	if request.Scope == goldap.ScopeBaseObject {
+		for dn, entry := range c.dir.entries {
+			if strings.EqualFold(dn, request.BaseDN) {
+				result.Entries = append(result.Entries, toLDAPEntry(dn, entry))
+				return result, nil
+			}
+		}
+		return nil, goldap.NewError(goldap.LDAPResultNoSuchObject, errors.New("no such object"))
+	}
+
+	// only conjunctions of equality and presence filters are supported.
+	terms := fakeFilterTerm.FindAllStringSubmatch(request.Filter, -1)
+	for dn, entry := range c.dir.entries {
+		if !strings.HasSuffix(strings.ToLower(dn), ","+strings.ToLower(request.BaseDN)) {
+			continue
+		}
+		if !matchesTerms(entry, terms) {
+			continue
+		}
+
+		result.Entries = append(result.Entries, toLDAPEntry(dn, entry))
+		if request.SizeLimit > 0 && len(result.Entries) > request.SizeLimit {
+			return nil, goldap.NewError(goldap.LDAPResultSizeLimitExceeded, errors.New("size limit exceeded"))
+		}
+	}
+
+	return result, nil
+}
+
+func matchesTerms(entry fakeEntry, terms [][]string) bool {
+	for _, term := range terms {
+		values := entry.attributes[term[1]]
+		if term[2] == "*" && len(values) > 0 {
+			continue
+		}
+
+		matched := false
+		for _, value := range values {
+			matched = matched || value == term[2]
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toLDAPEntry(dn string, entry fakeEntry) *goldap.Entry {
+	return goldap.NewEntry(dn, entry.attributes)
+}
@@ -14,7 +14,10 @@
 
 package auth
 
-import "github.com/harness/gitness/types/enum"
+import (
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
 
 type Metadata interface {
 	ImpactsAuthorization() bool
@@ -29,11 +32,21 @@ func (m *EmptyMetadata) ImpactsAuthorization() bool {
 
 // TokenMetadata contains information about the token that was used during auth.
 type TokenMetadata struct {
-	TokenType enum.TokenType
-	TokenID   int64
+	TokenType    enum.TokenType
+	TokenID      int64
+	Restrictions types.TokenRestrictions
 }
 
 func (m *TokenMetadata) ImpactsAuthorization() bool {
+	return m.Restrictions.IsRestricted()
+}
+
+// PublicKeyMetadata contains information about the ssh public key that was used during auth.
+type PublicKeyMetadata struct {
+	PublicKeyID int64
+}
+
+func (m *PublicKeyMetadata) ImpactsAuthorization() bool {
 	return false
 }
 
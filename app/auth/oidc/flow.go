@@ -0,0 +1,121 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// codeChallengeMethod is the PKCE code challenge method, see RFC 7636.
+	codeChallengeMethod = "S256"
+
+	// randomLength is the number of random bytes used for the state, nonce and code verifier.
+	randomLength = 32
+)
+
+// Flow contains the data of a pending login that has to survive the round trip to the identity provider.
+// It's stored client side (e.g. in a cookie) and has to be provided again once the user is redirected back.
+type Flow struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	ReturnTo string `json:"return_to,omitempty"`
+}
+
+// NewFlow starts a new login flow.
+// The user is sent to returnTo after a successful login, it has to be a path (links to other sites are ignored).
+func NewFlow(returnTo string) (*Flow, error) {
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Flow{
+		State:    state,
+		Nonce:    nonce,
+		Verifier: verifier,
+		ReturnTo: sanitizeReturnTo(returnTo),
+	}, nil
+}
+
+// Encode returns the flow in a form that can be stored in a cookie.
+func (f *Flow) Encode() (string, error) {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal login flow: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeFlow decodes a flow previously encoded with Encode.
+func DecodeFlow(s string) (*Flow, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode login flow: %w", err)
+	}
+
+	f := &Flow{}
+	if err = json.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal login flow: %w", err)
+	}
+
+	if f.State == "" || f.Nonce == "" || f.Verifier == "" {
+		return nil, fmt.Errorf("login flow is incomplete")
+	}
+
+	f.ReturnTo = sanitizeReturnTo(f.ReturnTo)
+
+	return f, nil
+}
+
+func (f *Flow) codeChallenge() string {
+	sum := sha256.Sum256([]byte(f.Verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// sanitizeReturnTo prevents open redirects by only allowing local paths.
+func sanitizeReturnTo(returnTo string) string {
+	if !strings.HasPrefix(returnTo, "/") || strings.HasPrefix(returnTo, "//") || strings.Contains(returnTo, "\\") {
+		return ""
+	}
+
+	return returnTo
+}
+
+func randomString() (string, error) {
+	b := make([]byte, randomLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlow(t *testing.T) {
+	flow, err := NewFlow("//evil.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if flow.ReturnTo != "" {
+		t.Errorf("expected links to other sites to be ignored, got %q", flow.ReturnTo)
+	}
+
+	flow.ReturnTo = "/acme/repo"
+	encoded, err := flow.Encode()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decoded, err := DecodeFlow(encoded)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, flow) {
+		t.Errorf("expected %v, got %v", flow, decoded)
+	}
+
+	if _, err = DecodeFlow("e30"); err == nil { // {}
+		t.Errorf("expected an error for an incomplete flow")
+	}
+}
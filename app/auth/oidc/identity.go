@@ -0,0 +1,87 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Identity is the user identity asserted by the identity provider.
+type Identity struct {
+	Issuer      string
+	Subject     string
+	UID         string
+	Email       string
+	DisplayName string
+	Groups      []string
+}
+
+func (p *Provider) identityFromClaims(claims map[string]interface{}) (*Identity, error) {
+	identity := &Identity{
+		Issuer:      claimString(claims, "iss"),
+		Subject:     claimString(claims, "sub"),
+		Email:       claimString(claims, "email"),
+		DisplayName: claimString(claims, "name"),
+		UID:         claimString(claims, p.uidClaim),
+		Groups:      claimStrings(claims, p.groupsClaim),
+	}
+
+	if identity.Issuer == "" || identity.Subject == "" {
+		return nil, fmt.Errorf("identity provider didn't return an issuer and a subject")
+	}
+
+	if identity.Email == "" {
+		return nil, fmt.Errorf("identity provider didn't return an email address for subject %q", identity.Subject)
+	}
+
+	if identity.UID == "" {
+		identity.UID, _, _ = strings.Cut(identity.Email, "@")
+	}
+
+	if identity.DisplayName == "" {
+		identity.DisplayName = identity.UID
+	}
+
+	return identity, nil
+}
+
+// ExternalID returns the identifier of the identity across identity providers.
+// The subject is only unique for the issuer, issuer urls can't contain a fragment.
+func (i *Identity) ExternalID() string {
+	return i.Issuer + "#" + i.Subject
+}
+
+func claimString(claims map[string]interface{}, name string) string {
+	s, _ := claims[name].(string)
+	return strings.TrimSpace(s)
+}
+
+func claimStrings(claims map[string]interface{}, name string) []string {
+	switch v := claims[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
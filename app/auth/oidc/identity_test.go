@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"testing"
+)
+
+func TestIdentityFromClaims(t *testing.T) {
+	p := &Provider{uidClaim: "preferred_username", groupsClaim: "groups"}
+
+	identity, err := p.identityFromClaims(map[string]interface{}{
+		"iss":   "https://idp.example.com",
+		"sub":   "1234",
+		"email": "jane@example.com",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if identity.UID != "jane" || identity.DisplayName != "jane" {
+		t.Errorf("expected uid and display name derived from the email, got %q and %q",
+			identity.UID, identity.DisplayName)
+	}
+	if identity.ExternalID() != "https://idp.example.com#1234" {
+		t.Errorf("expected external id of issuer and subject, got %q", identity.ExternalID())
+	}
+
+	// identities are linked to users by issuer and subject, they are required.
+	_, err = p.identityFromClaims(map[string]interface{}{
+		"iss":   "https://idp.example.com",
+		"email": "jane@example.com",
+	})
+	if err == nil {
+		t.Errorf("expected error for identity without subject")
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/app/auth/groupmapping"
+	"github.com/harness/gitness/types"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// callbackPath is the path of the callback endpoint relative to the api url.
+	callbackPath = "/v1/login/oidc/callback"
+
+	// httpTimeout is the timeout of requests sent to the identity provider.
+	httpTimeout = 30 * time.Second
+)
+
+var (
+	// ErrNotEnabled is returned if the OpenID Connect login isn't configured.
+	ErrNotEnabled = errors.New("OpenID Connect login is not enabled")
+
+	// ErrAuthenticationFailed is returned if the identity provider didn't authenticate the user.
+	ErrAuthenticationFailed = errors.New("authentication failed")
+
+	// ErrInvalidIDToken is returned if the ID token returned by the identity provider can't be verified.
+	ErrInvalidIDToken = fmt.Errorf("%w: invalid ID token", ErrAuthenticationFailed)
+)
+
+// Provider implements the OpenID Connect authorization code flow (with PKCE)
+// against the identity provider configured in types.Config.
+type Provider struct {
+	enabled              bool
+	disablePasswordLogin bool
+
+	issuer        string
+	clientID      string
+	clientSecret  string
+	redirectURL   string
+	scopes        []string
+	uidClaim      string
+	groupsClaim   string
+	groupMappings []groupmapping.Mapping
+
+	client *http.Client
+
+	mx       sync.Mutex
+	metadata *providerMetadata
+	keys     *keySet
+}
+
+// providerMetadata contains the fields of the OpenID provider metadata used by gitness.
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func NewProvider(config *types.Config) (*Provider, error) {
+	if !config.OIDC.Enabled {
+		return &Provider{}, nil
+	}
+
+	if config.OIDC.Issuer == "" || config.OIDC.ClientID == "" {
+		return nil, errors.New("OpenID Connect login requires an issuer and a client id")
+	}
+
+	groupMappings, err := groupmapping.Parse(config.OIDC.GroupMappings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenID Connect group mappings: %w", err)
+	}
+
+	redirectURL := config.OIDC.RedirectURL
+	if redirectURL == "" {
+		redirectURL = strings.TrimRight(config.URL.API, "/") + callbackPath
+	}
+
+	return &Provider{
+		enabled:              true,
+		disablePasswordLogin: config.OIDC.DisablePasswordLogin,
+		issuer:               strings.TrimRight(config.OIDC.Issuer, "/"),
+		clientID:             config.OIDC.ClientID,
+		clientSecret:         config.OIDC.ClientSecret,
+		redirectURL:          redirectURL,
+		scopes:               config.OIDC.Scopes,
+		uidClaim:             config.OIDC.UIDClaim,
+		groupsClaim:          config.OIDC.GroupsClaim,
+		groupMappings:        groupMappings,
+		client:               &http.Client{Timeout: httpTimeout},
+	}, nil
+}
+
+// Enabled returns true if the OpenID Connect login is configured.
+func (p *Provider) Enabled() bool {
+	return p.enabled
+}
+
+// PasswordLoginDisabled returns true if users (other than admins) have to use the OpenID Connect login.
+func (p *Provider) PasswordLoginDisabled() bool {
+	return p.enabled && p.disablePasswordLogin
+}
+
+// GroupMappings returns the space memberships the provided groups of the identity provider map to.
+func (p *Provider) GroupMappings(groups []string) []groupmapping.Mapping {
+	return groupmapping.Match(p.groupMappings, groups)
+}
+
+// AuthCodeURL returns the url of the identity provider the user has to be redirected to in order to log in.
+func (p *Provider) AuthCodeURL(ctx context.Context, flow *Flow) (string, error) {
+	if !p.enabled {
+		return "", ErrNotEnabled
+	}
+
+	metadata, err := p.getMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return p.oauth2Config(metadata).AuthCodeURL(flow.State,
+		oauth2.SetAuthURLParam("nonce", flow.Nonce),
+		oauth2.SetAuthURLParam("code_challenge", flow.codeChallenge()),
+		oauth2.SetAuthURLParam("code_challenge_method", codeChallengeMethod),
+	), nil
+}
+
+// Exchange exchanges the authorization code for tokens, verifies the returned ID token
+// and returns the identity of the logged-in user.
+func (p *Provider) Exchange(ctx context.Context, flow *Flow, code string) (*Identity, error) {
+	if !p.enabled {
+		return nil, ErrNotEnabled
+	}
+
+	metadata, err := p.getMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+
+	token, err := p.oauth2Config(metadata).Exchange(ctx, code,
+		oauth2.SetAuthURLParam("code_verifier", flow.Verifier))
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		// the authorization code is invalid or expired, or the client credentials are wrong.
+		return nil, fmt.Errorf("%w: %s", ErrAuthenticationFailed, retrieveErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("%w: token response doesn't contain an ID token", ErrInvalidIDToken)
+	}
+
+	claims, err := p.verifyIDToken(ctx, metadata, rawIDToken, flow.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata.UserInfoEndpoint != "" {
+		err = p.mergeUserInfo(ctx, metadata, token, claims)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p.identityFromClaims(claims)
+}
+
+func (p *Provider) oauth2Config(metadata *providerMetadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.clientID,
+		ClientSecret: p.clientSecret,
+		RedirectURL:  p.redirectURL,
+		Scopes:       p.scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  metadata.AuthorizationEndpoint,
+			TokenURL: metadata.TokenEndpoint,
+		},
+	}
+}
+
+// getMetadata returns the provider metadata, discovery is done lazily to not block the server start.
+func (p *Provider) getMetadata(ctx context.Context) (*providerMetadata, error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	metadata := &providerMetadata{}
+	err := p.getJSON(ctx, p.issuer+"/.well-known/openid-configuration", "", metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OpenID provider: %w", err)
+	}
+
+	if strings.TrimRight(metadata.Issuer, "/") != p.issuer {
+		return nil, fmt.Errorf("discovered issuer %q doesn't match the configured issuer %q",
+			metadata.Issuer, p.issuer)
+	}
+
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, errors.New("OpenID provider metadata is incomplete")
+	}
+
+	p.metadata = metadata
+	p.keys = newKeySet(metadata.JWKSURI, p.getJSON)
+
+	return metadata, nil
+}
+
+// mergeUserInfo adds the claims of the userinfo endpoint that aren't part of the ID token.
+// Some identity providers only return the groups of the user via the userinfo endpoint.
+func (p *Provider) mergeUserInfo(
+	ctx context.Context,
+	metadata *providerMetadata,
+	token *oauth2.Token,
+	claims map[string]interface{},
+) error {
+	userInfo := map[string]interface{}{}
+	err := p.getJSON(ctx, metadata.UserInfoEndpoint, token.AccessToken, &userInfo)
+	if err != nil {
+		return fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	if userInfo["sub"] != claims["sub"] {
+		return errors.New("subject of user info doesn't match the subject of the ID token")
+	}
+
+	for k, v := range userInfo {
+		if _, ok := claims[k]; !ok {
+			claims[k] = v
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, accessToken string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %s failed with status %d", url, resp.StatusCode)
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the base32 encoded secret of the RFC 6238 test vectors ("12345678901234567890").
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		time     int64
+		lastStep int64
+		wantOK   bool
+		wantStep int64
+	}{
+		{name: "rfc vector 59", code: "287082", time: 59, wantOK: true, wantStep: 1},
+		{name: "rfc vector 1111111109", code: "081804", time: 1111111109, wantOK: true, wantStep: 37037036},
+		{name: "rfc vector 1234567890", code: "005924", time: 1234567890, wantOK: true, wantStep: 41152263},
+		{name: "previous period", code: "287082", time: 89, wantOK: true, wantStep: 1},
+		{name: "too old", code: "287082", time: 119, wantOK: false},
+		{name: "replayed", code: "287082", time: 59, lastStep: 1, wantOK: false},
+		{name: "surrounding whitespace", code: " 287082 ", time: 59, wantOK: true, wantStep: 1},
+		{name: "wrong code", code: "287083", time: 59, wantOK: false},
+		{name: "wrong length", code: "94287082", time: 59, wantOK: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			step, ok := Validate(rfcSecret, test.code, time.Unix(test.time, 0), test.lastStep)
+			if ok != test.wantOK {
+				t.Fatalf("expected ok=%t, got %t", test.wantOK, ok)
+			}
+			if ok && step != test.wantStep {
+				t.Errorf("expected step %d, got %d", test.wantStep, step)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	now := time.Now()
+	key, _ := encoding.DecodeString(secret)
+	if _, ok := Validate(secret, generate(key, now.Unix()/period), now, 0); !ok {
+		t.Errorf("expected code of generated secret to be valid")
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Gitness", "jane@example.com", rfcSecret)
+	want := "otpauth://totp/Gitness:jane@example.com?algorithm=SHA1&digits=6&issuer=Gitness&period=30&secret=" + rfcSecret
+	if uri != want {
+		t.Errorf("expected uri %q, got %q", want, uri)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(codes) != RecoveryCodeCount || len(hashes) != RecoveryCodeCount {
+		t.Fatalf("expected %d codes, got %d codes and %d hashes", RecoveryCodeCount, len(codes), len(hashes))
+	}
+
+	code := codes[0]
+	if !IsRecoveryCode(code) {
+		t.Errorf("expected %q to be a recovery code", code)
+	}
+	if HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))) != hashes[0] {
+		t.Errorf("expected hash of normalized recovery code to match")
+	}
+	if IsRecoveryCode("287082") {
+		t.Errorf("expected one-time password not to be a recovery code")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ExecutionPayload contains the details of a pipeline execution that are shared by all execution events.
+type ExecutionPayload struct {
+	RepoID       int64         `json:"repo_id"`
+	PipelineID   int64         `json:"pipeline_id"`
+	ExecutionID  int64         `json:"execution_id"`
+	ExecutionNum int64         `json:"execution_number"`
+	Status       enum.CIStatus `json:"status"`
+}
+
+// NewExecutionPayload returns the payload shared by all execution events for the execution.
+func NewExecutionPayload(execution *types.Execution) ExecutionPayload {
+	return ExecutionPayload{
+		RepoID:       execution.RepoID,
+		PipelineID:   execution.PipelineID,
+		ExecutionID:  execution.ID,
+		ExecutionNum: execution.Number,
+		Status:       execution.Status,
+	}
+}
+
+const ExecutionStartedEvent events.EventType = "execution-started"
+
+type ExecutionStartedPayload struct {
+	ExecutionPayload
+}
+
+func (r *Reporter) ExecutionStarted(ctx context.Context, payload *ExecutionStartedPayload) {
+	if payload == nil {
+		return
+	}
+
+	eventID, err := events.ReporterSendEvent(r.innerReporter, ctx, ExecutionStartedEvent, payload)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msgf("failed to send execution started event")
+		return
+	}
+
+	log.Ctx(ctx).Debug().Msgf("reported execution started event with id '%s'", eventID)
+}
+
+func (r *Reader) RegisterExecutionStarted(fn events.HandlerFunc[*ExecutionStartedPayload],
+	opts ...events.HandlerOption) error {
+	return events.ReaderRegisterEvent(r.innerReader, ExecutionStartedEvent, fn, opts...)
+}
+
+const ExecutionFinishedEvent events.EventType = "execution-finished"
+
+type ExecutionFinishedPayload struct {
+	ExecutionPayload
+}
+
+func (r *Reporter) ExecutionFinished(ctx context.Context, payload *ExecutionFinishedPayload) {
+	if payload == nil {
+		return
+	}
+
+	eventID, err := events.ReporterSendEvent(r.innerReporter, ctx, ExecutionFinishedEvent, payload)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msgf("failed to send execution finished event")
+		return
+	}
+
+	log.Ctx(ctx).Debug().Msgf("reported execution finished event with id '%s'", eventID)
+}
+
+func (r *Reader) RegisterExecutionFinished(fn events.HandlerFunc[*ExecutionFinishedPayload],
+	opts ...events.HandlerOption) error {
+	return events.ReaderRegisterEvent(r.innerReader, ExecutionFinishedEvent, fn, opts...)
+}
@@ -42,6 +42,7 @@ func GenerateEnvironmentVariables(
 	repoID int64,
 	principalID int64,
 	disabled bool,
+	internal bool,
 ) (map[string]string, error) {
 	// best effort retrieving of requestID - log in case we can't find it but don't fail operation.
 	requestID, ok := request.RequestIDFrom(ctx)
@@ -58,6 +59,7 @@ func GenerateEnvironmentVariables(
 		PrincipalID: principalID,
 		RequestID:   requestID,
 		Disabled:    disabled,
+		Internal:    internal,
 	}
 
 	if err := payload.Validate(); err != nil {
@@ -92,6 +94,9 @@ func LoadFromEnvironment() (*githook.CLICore, error) {
 				query := r.URL.Query()
 				query.Add(request.QueryParamRepoID, fmt.Sprint(payload.RepoID))
 				query.Add(request.QueryParamPrincipalID, fmt.Sprint(payload.PrincipalID))
+				if payload.Internal {
+					query.Add(request.QueryParamInternal, "true")
+				}
 
 				r.URL.RawQuery = query.Encode()
 
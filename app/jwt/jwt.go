@@ -36,6 +36,7 @@ type Claims struct {
 
 	Token      *SubClaimsToken      `json:"tkn,omitempty"`
 	Membership *SubClaimsMembership `json:"ms,omitempty"`
+	TwoFactor  *SubClaimsTwoFactor  `json:"2fa,omitempty"`
 }
 
 // SubClaimsToken contains information about the token the JWT was created for.
@@ -50,6 +51,12 @@ type SubClaimsMembership struct {
 	SpaceID int64               `json:"sid,omitempty"`
 }
 
+// SubClaimsTwoFactor contains the login challenge that has to be completed with a one-time password.
+// JWTs with this sub-claim can't be used for authentication.
+type SubClaimsTwoFactor struct {
+	Challenge string `json:"chl,omitempty"`
+}
+
 // GenerateForToken generates a jwt for a given token.
 func GenerateForToken(token *types.Token, secret string) (string, error) {
 	var expiresAt int64
@@ -111,3 +118,31 @@ func GenerateWithMembership(
 
 	return res, nil
 }
+
+// GenerateForTwoFactorChallenge generates a jwt for the two-factor authentication challenge of a login.
+func GenerateForTwoFactorChallenge(
+	principalID int64,
+	challenge string,
+	expiresAt time.Time,
+	secret string,
+) (string, error) {
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+		StandardClaims: jwt.StandardClaims{
+			Issuer: issuer,
+			// times required to be in sec
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+		PrincipalID: principalID,
+		TwoFactor: &SubClaimsTwoFactor{
+			Challenge: challenge,
+		},
+	})
+
+	res, err := jwtToken.SignedString([]byte(secret))
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to sign token")
+	}
+
+	return res, nil
+}
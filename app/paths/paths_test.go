@@ -0,0 +1,42 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paths
+
+import "testing"
+
+func TestIsAncesterOf(t *testing.T) {
+	tests := []struct {
+		path  string
+		other string
+		want  bool
+	}{
+		{"a", "a", true},
+		{"a", "a/b", true},
+		{"/a/", "a/b/c", true},
+		{"a/b", "a/b", true},
+		{"a/b", "a", false},
+		{"a/b", "a/bc", false},
+		{"a/b", "x/a/b", false},
+		{"a/b", "x/a/b/c", false},
+		{"b", "a/b", false},
+	}
+
+	for _, test := range tests {
+		got, want := IsAncesterOf(test.path, test.other), test.want
+		if got != want {
+			t.Errorf("Want %q being an ancestor of %q to be %t, got %t", test.path, test.other, want, got)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	"github.com/harness/gitness/app/pipeline/scheduler"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
@@ -35,6 +36,7 @@ type service struct {
 	scheduler      scheduler.Scheduler
 	stageStore     store.StageStore
 	stepStore      store.StepStore
+	pipelineEvents *pipelineevents.Reporter
 }
 
 // Canceler cancels a build.
@@ -52,6 +54,7 @@ func New(
 	scheduler scheduler.Scheduler,
 	stageStore store.StageStore,
 	stepStore store.StepStore,
+	pipelineEvents *pipelineevents.Reporter,
 ) Canceler {
 	return &service{
 		executionStore: executionStore,
@@ -60,6 +63,7 @@ func New(
 		scheduler:      scheduler,
 		stageStore:     stageStore,
 		stepStore:      stepStore,
+		pipelineEvents: pipelineEvents,
 	}
 }
 
@@ -146,6 +150,11 @@ func (s *service) Cancel(ctx context.Context, repo *types.Repository, execution
 	execution.Stages = stages
 	log.Info().Msg("canceler: successfully cancelled build")
 
+	// the stages aren't torn down by the runner in case they didn't start yet.
+	s.pipelineEvents.ExecutionFinished(ctx, &pipelineevents.ExecutionFinishedPayload{
+		ExecutionPayload: pipelineevents.NewExecutionPayload(execution),
+	})
+
 	// trigger a SSE to notify subscribers that
 	// the execution was cancelled.
 	err = s.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypeExecutionCanceled, execution)
@@ -0,0 +1,124 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package canceler
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"strings"
+	"testing"
+
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestCancelReportsExecutionFinished(t *testing.T) {
+	producer := &fakeProducer{}
+	system, err := events.NewSystem(func(string, string) (events.StreamConsumer, error) {
+		return nil, nil
+	}, producer)
+	if err != nil {
+		t.Fatalf("failed to create event system: %v", err)
+	}
+
+	reporter, err := pipelineevents.NewReporter(system)
+	if err != nil {
+		t.Fatalf("failed to create reporter: %v", err)
+	}
+
+	// the stage is still pending, the runner never tears it down.
+	stages := &fakeStageStore{stages: []*types.Stage{{Number: 1, Status: enum.CIStatusPending}}}
+	c := New(&fakeExecutionStore{}, fakeStreamer{}, nil, nil, stages, nil, reporter)
+
+	execution := &types.Execution{ID: 3, RepoID: 1, PipelineID: 2, Number: 4, Status: enum.CIStatusPending}
+	if err = c.Cancel(context.Background(), &types.Repository{ID: 1}, execution); err != nil {
+		t.Fatalf("failed to cancel execution: %v", err)
+	}
+
+	if len(producer.streamIDs) != 1 || !strings.HasSuffix(producer.streamIDs[0], ":execution-finished") {
+		t.Fatalf("expected a single execution finished event, got %v", producer.streamIDs)
+	}
+
+	var event events.Event[*pipelineevents.ExecutionFinishedPayload]
+	if err = gob.NewDecoder(bytes.NewReader(producer.payloads[0])).Decode(&event); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+
+	want := pipelineevents.ExecutionPayload{
+		RepoID:       1,
+		PipelineID:   2,
+		ExecutionID:  3,
+		ExecutionNum: 4,
+		Status:       enum.CIStatusKilled,
+	}
+	if event.Payload.ExecutionPayload != want {
+		t.Errorf("expected payload %+v, got %+v", want, event.Payload.ExecutionPayload)
+	}
+
+	// finished executions can't be cancelled, nothing is reported again.
+	if err = c.Cancel(context.Background(), &types.Repository{ID: 1}, execution); err != nil {
+		t.Fatalf("failed to cancel execution: %v", err)
+	}
+	if len(producer.streamIDs) != 1 {
+		t.Errorf("expected no further events, got %v", producer.streamIDs)
+	}
+}
+
+type fakeProducer struct {
+	streamIDs []string
+	payloads  [][]byte
+}
+
+func (f *fakeProducer) Send(_ context.Context, streamID string, payload map[string]interface{}) (string, error) {
+	f.streamIDs = append(f.streamIDs, streamID)
+	for _, value := range payload {
+		f.payloads = append(f.payloads, value.([]byte))
+	}
+	return "1", nil
+}
+
+type fakeExecutionStore struct {
+	store.ExecutionStore
+}
+
+func (fakeExecutionStore) Update(context.Context, *types.Execution) error {
+	return nil
+}
+
+type fakeStageStore struct {
+	store.StageStore
+	stages []*types.Stage
+}
+
+func (f *fakeStageStore) ListWithSteps(context.Context, int64) ([]*types.Stage, error) {
+	return f.stages, nil
+}
+
+func (f *fakeStageStore) Update(context.Context, *types.Stage) error {
+	return nil
+}
+
+type fakeStreamer struct {
+	sse.Streamer
+}
+
+func (fakeStreamer) Publish(context.Context, int64, enum.SSEType, any) error {
+	return nil
+}
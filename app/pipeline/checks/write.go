@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	checkevents "github.com/harness/gitness/app/events/check"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
@@ -30,6 +31,7 @@ import (
 func Write(
 	ctx context.Context,
 	checkStore store.CheckStore,
+	checkReporter *checkevents.Reporter,
 	execution *types.Execution,
 	pipeline *types.Pipeline,
 ) error {
@@ -67,5 +69,14 @@ func Write(
 	if err != nil {
 		return fmt.Errorf("could not upsert to check store: %w", err)
 	}
+
+	checkReporter.Reported(ctx, &checkevents.ReportedPayload{
+		RepoID:      check.RepoID,
+		PrincipalID: check.CreatedBy,
+		CommitSHA:   check.CommitSHA,
+		CheckUID:    check.UID,
+		Status:      check.Status,
+	})
+
 	return nil
 }
@@ -23,6 +23,8 @@ import (
 	"time"
 
 	"github.com/harness/gitness/app/bootstrap"
+	checkevents "github.com/harness/gitness/app/events/check"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	"github.com/harness/gitness/app/jwt"
 	"github.com/harness/gitness/app/pipeline/file"
 	"github.com/harness/gitness/app/pipeline/scheduler"
@@ -131,6 +133,9 @@ type Manager struct {
 	Pipelines   store.PipelineStore
 	urlProvider urlprovider.Provider
 	Checks      store.CheckStore
+	CheckEvents *checkevents.Reporter
+	// PipelineEvents reports execution started and finished events.
+	PipelineEvents *pipelineevents.Reporter
 	// Converter  store.ConvertService
 	SSEStreamer sse.Streamer
 	// Globals    store.GlobalSecretStore
@@ -158,6 +163,8 @@ func New(
 	logStore store.LogStore,
 	logStream livelog.LogStream,
 	checkStore store.CheckStore,
+	checkReporter *checkevents.Reporter,
+	pipelineReporter *pipelineevents.Reporter,
 	repoStore store.RepoStore,
 	scheduler scheduler.Scheduler,
 	secretStore store.SecretStore,
@@ -166,21 +173,23 @@ func New(
 	userStore store.PrincipalStore,
 ) *Manager {
 	return &Manager{
-		Config:      config,
-		Executions:  executionStore,
-		Pipelines:   pipelineStore,
-		urlProvider: urlProvider,
-		SSEStreamer: sseStreamer,
-		FileService: fileService,
-		Logs:        logStore,
-		Logz:        logStream,
-		Checks:      checkStore,
-		Repos:       repoStore,
-		Scheduler:   scheduler,
-		Secrets:     secretStore,
-		Stages:      stageStore,
-		Steps:       stepStore,
-		Users:       userStore,
+		Config:         config,
+		Executions:     executionStore,
+		Pipelines:      pipelineStore,
+		urlProvider:    urlProvider,
+		SSEStreamer:    sseStreamer,
+		FileService:    fileService,
+		Logs:           logStore,
+		Logz:           logStream,
+		Checks:         checkStore,
+		CheckEvents:    checkReporter,
+		PipelineEvents: pipelineReporter,
+		Repos:          repoStore,
+		Scheduler:      scheduler,
+		Secrets:        secretStore,
+		Stages:         stageStore,
+		Steps:          stepStore,
+		Users:          userStore,
 	}
 }
 
@@ -423,14 +432,16 @@ func (m *Manager) AfterStep(ctx context.Context, step *types.Step) error {
 // BeforeAll signals the build stage is about to start.
 func (m *Manager) BeforeStage(ctx context.Context, stage *types.Stage) error {
 	s := &setup{
-		Executions:  m.Executions,
-		Checks:      m.Checks,
-		Pipelines:   m.Pipelines,
-		SSEStreamer: m.SSEStreamer,
-		Repos:       m.Repos,
-		Steps:       m.Steps,
-		Stages:      m.Stages,
-		Users:       m.Users,
+		Executions:     m.Executions,
+		Checks:         m.Checks,
+		CheckEvents:    m.CheckEvents,
+		PipelineEvents: m.PipelineEvents,
+		Pipelines:      m.Pipelines,
+		SSEStreamer:    m.SSEStreamer,
+		Repos:          m.Repos,
+		Steps:          m.Steps,
+		Stages:         m.Stages,
+		Users:          m.Users,
 	}
 
 	return s.do(noContext, stage)
@@ -439,15 +450,17 @@ func (m *Manager) BeforeStage(ctx context.Context, stage *types.Stage) error {
 // AfterAll signals the build stage is complete.
 func (m *Manager) AfterStage(ctx context.Context, stage *types.Stage) error {
 	t := &teardown{
-		Executions:  m.Executions,
-		Pipelines:   m.Pipelines,
-		Checks:      m.Checks,
-		SSEStreamer: m.SSEStreamer,
-		Logs:        m.Logz,
-		Repos:       m.Repos,
-		Scheduler:   m.Scheduler,
-		Steps:       m.Steps,
-		Stages:      m.Stages,
+		Executions:     m.Executions,
+		Pipelines:      m.Pipelines,
+		Checks:         m.Checks,
+		CheckEvents:    m.CheckEvents,
+		PipelineEvents: m.PipelineEvents,
+		SSEStreamer:    m.SSEStreamer,
+		Logs:           m.Logz,
+		Repos:          m.Repos,
+		Scheduler:      m.Scheduler,
+		Steps:          m.Steps,
+		Stages:         m.Stages,
 	}
 	return t.do(noContext, stage)
 }
@@ -19,6 +19,8 @@ import (
 	"errors"
 	"time"
 
+	checkevents "github.com/harness/gitness/app/events/check"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	"github.com/harness/gitness/app/pipeline/checks"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
@@ -30,14 +32,16 @@ import (
 )
 
 type setup struct {
-	Executions  store.ExecutionStore
-	Checks      store.CheckStore
-	SSEStreamer sse.Streamer
-	Pipelines   store.PipelineStore
-	Repos       store.RepoStore
-	Steps       store.StepStore
-	Stages      store.StageStore
-	Users       store.PrincipalStore
+	Executions     store.ExecutionStore
+	Checks         store.CheckStore
+	CheckEvents    *checkevents.Reporter
+	PipelineEvents *pipelineevents.Reporter
+	SSEStreamer    sse.Streamer
+	Pipelines      store.PipelineStore
+	Repos          store.RepoStore
+	Steps          store.StepStore
+	Stages         store.StageStore
+	Users          store.PrincipalStore
 }
 
 func (s *setup) do(ctx context.Context, stage *types.Stage) error {
@@ -87,18 +91,24 @@ func (s *setup) do(ctx context.Context, stage *types.Stage) error {
 		}
 	}
 
-	_, err = s.updateExecution(noContext, execution)
+	started, err := s.updateExecution(noContext, execution)
 	if err != nil {
 		log.Error().Err(err).Msg("manager: cannot update the execution")
 		return err
 	}
+	// only report the start of the execution once (for the stage that moved it to running)
+	if started {
+		s.PipelineEvents.ExecutionStarted(ctx, &pipelineevents.ExecutionStartedPayload{
+			ExecutionPayload: pipelineevents.NewExecutionPayload(execution),
+		})
+	}
 	pipeline, err := s.Pipelines.Find(ctx, execution.PipelineID)
 	if err != nil {
 		log.Error().Err(err).Msg("manager: cannot find pipeline")
 		return err
 	}
 	// try to write to the checks store - if not, log an error and continue
-	err = checks.Write(ctx, s.Checks, execution, pipeline)
+	err = checks.Write(ctx, s.Checks, s.CheckEvents, execution, pipeline)
 	if err != nil {
 		log.Error().Err(err).Msg("manager: could not write to checks store")
 	}
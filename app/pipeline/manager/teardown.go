@@ -20,6 +20,8 @@ import (
 	"strings"
 	"time"
 
+	checkevents "github.com/harness/gitness/app/events/check"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	"github.com/harness/gitness/app/pipeline/checks"
 	"github.com/harness/gitness/app/pipeline/scheduler"
 	"github.com/harness/gitness/app/sse"
@@ -34,15 +36,17 @@ import (
 )
 
 type teardown struct {
-	Executions  store.ExecutionStore
-	Checks      store.CheckStore
-	Pipelines   store.PipelineStore
-	SSEStreamer sse.Streamer
-	Logs        livelog.LogStream
-	Scheduler   scheduler.Scheduler
-	Repos       store.RepoStore
-	Steps       store.StepStore
-	Stages      store.StageStore
+	Executions     store.ExecutionStore
+	Checks         store.CheckStore
+	CheckEvents    *checkevents.Reporter
+	PipelineEvents *pipelineevents.Reporter
+	Pipelines      store.PipelineStore
+	SSEStreamer    sse.Streamer
+	Logs           livelog.LogStream
+	Scheduler      scheduler.Scheduler
+	Repos          store.RepoStore
+	Steps          store.StepStore
+	Stages         store.StageStore
 }
 
 //nolint:gocognit // refactor if needed.
@@ -135,6 +139,9 @@ func (t *teardown) do(ctx context.Context, stage *types.Stage) error {
 
 	log.Info().Msg("manager: execution is finished, teardown")
 
+	// the finish of cancelled executions has been reported by the canceler already.
+	reported := execution.Status.IsDone()
+
 	execution.Status = enum.CIStatusSuccess
 	execution.Finished = time.Now().UnixMilli()
 	for _, sibling := range stages {
@@ -167,6 +174,12 @@ func (t *teardown) do(ctx context.Context, stage *types.Stage) error {
 		return err
 	}
 
+	if !reported {
+		t.PipelineEvents.ExecutionFinished(ctx, &pipelineevents.ExecutionFinishedPayload{
+			ExecutionPayload: pipelineevents.NewExecutionPayload(execution),
+		})
+	}
+
 	execution.Stages = stages
 	err = t.SSEStreamer.Publish(noContext, repo.ParentID, enum.SSETypeExecutionCompleted, execution)
 	if err != nil {
@@ -180,7 +193,7 @@ func (t *teardown) do(ctx context.Context, stage *types.Stage) error {
 		return err
 	}
 	// try to write to the checks store - if not, log an error and continue
-	err = checks.Write(ctx, t.Checks, execution, pipeline)
+	err = checks.Write(ctx, t.Checks, t.CheckEvents, execution, pipeline)
 	if err != nil {
 		log.Error().Err(err).Msg("manager: could not write to checks store")
 	}
@@ -15,6 +15,8 @@
 package manager
 
 import (
+	checkevents "github.com/harness/gitness/app/events/check"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	"github.com/harness/gitness/app/pipeline/file"
 	"github.com/harness/gitness/app/pipeline/scheduler"
 	"github.com/harness/gitness/app/sse"
@@ -44,6 +46,8 @@ func ProvideExecutionManager(
 	logStore store.LogStore,
 	logStream livelog.LogStream,
 	checkStore store.CheckStore,
+	checkReporter *checkevents.Reporter,
+	pipelineReporter *pipelineevents.Reporter,
 	repoStore store.RepoStore,
 	scheduler scheduler.Scheduler,
 	secretStore store.SecretStore,
@@ -51,7 +55,8 @@ func ProvideExecutionManager(
 	stepStore store.StepStore,
 	userStore store.PrincipalStore) ExecutionManager {
 	return New(config, executionStore, pipelineStore, urlProvider, sseStreamer, fileService, logStore,
-		logStream, checkStore, repoStore, scheduler, secretStore, stageStore, stepStore, userStore)
+		logStream, checkStore, checkReporter, pipelineReporter, repoStore, scheduler, secretStore, stageStore, stepStore,
+		userStore)
 }
 
 // ProvideExecutionClient provides a client implementation to interact with the execution manager.
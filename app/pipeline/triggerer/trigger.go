@@ -21,6 +21,8 @@ import (
 	"runtime/debug"
 	"time"
 
+	checkevents "github.com/harness/gitness/app/events/check"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	"github.com/harness/gitness/app/pipeline/checks"
 	"github.com/harness/gitness/app/pipeline/file"
 	"github.com/harness/gitness/app/pipeline/manager"
@@ -78,33 +80,39 @@ type Triggerer interface {
 type triggerer struct {
 	executionStore store.ExecutionStore
 	checkStore     store.CheckStore
+	checkReporter  *checkevents.Reporter
 	stageStore     store.StageStore
 	tx             dbtx.Transactor
 	pipelineStore  store.PipelineStore
 	fileService    file.Service
 	scheduler      scheduler.Scheduler
 	repoStore      store.RepoStore
+	pipelineEvents *pipelineevents.Reporter
 }
 
 func New(
 	executionStore store.ExecutionStore,
 	checkStore store.CheckStore,
+	checkReporter *checkevents.Reporter,
 	stageStore store.StageStore,
 	pipelineStore store.PipelineStore,
 	tx dbtx.Transactor,
 	repoStore store.RepoStore,
 	scheduler scheduler.Scheduler,
 	fileService file.Service,
+	pipelineEvents *pipelineevents.Reporter,
 ) Triggerer {
 	return &triggerer{
 		executionStore: executionStore,
 		checkStore:     checkStore,
+		checkReporter:  checkReporter,
 		stageStore:     stageStore,
 		scheduler:      scheduler,
 		tx:             tx,
 		pipelineStore:  pipelineStore,
 		fileService:    fileService,
 		repoStore:      repoStore,
+		pipelineEvents: pipelineEvents,
 	}
 }
 
@@ -130,7 +138,7 @@ func (t *triggerer) Trigger(
 		}
 	}()
 
-	event := string(base.Action.GetTriggerEvent())
+	event := triggerEvent(base)
 
 	repo, err := t.repoStore.Find(ctx, pipeline.RepoID)
 	if err != nil {
@@ -324,7 +332,7 @@ func (t *triggerer) Trigger(
 	}
 
 	// try to write to check store. log on failure but don't error out the execution
-	err = checks.Write(ctx, t.checkStore, execution, pipeline)
+	err = checks.Write(ctx, t.checkStore, t.checkReporter, execution, pipeline)
 	if err != nil {
 		log.Error().Err(err).Msg("trigger: could not write to check store")
 	}
@@ -343,6 +351,14 @@ func (t *triggerer) Trigger(
 	return execution, nil
 }
 
+// triggerEvent returns the event of the execution triggered by the hook.
+func triggerEvent(base *Hook) string {
+	if base.Trigger == enum.TriggerCron {
+		return enum.TriggerEventCron
+	}
+	return string(base.Action.GetTriggerEvent())
+}
+
 func trunc(s string, i int) string {
 	runes := []rune(s)
 	if len(runes) > i {
@@ -492,7 +508,7 @@ func (t *triggerer) createExecutionWithError(
 		Parent:       base.Parent,
 		Status:       enum.CIStatusError,
 		Error:        message,
-		Event:        string(base.Action.GetTriggerEvent()),
+		Event:        triggerEvent(base),
 		Action:       string(base.Action),
 		Link:         base.Link,
 		Title:        base.Title,
@@ -523,10 +539,15 @@ func (t *triggerer) createExecutionWithError(
 	}
 
 	// try to write to check store, log on failure
-	err = checks.Write(ctx, t.checkStore, execution, pipeline)
+	err = checks.Write(ctx, t.checkStore, t.checkReporter, execution, pipeline)
 	if err != nil {
 		log.Error().Err(err).Msg("trigger: failed to update check")
 	}
 
+	// the execution finished before any stage ran, it's never torn down by the runner.
+	t.pipelineEvents.ExecutionFinished(ctx, &pipelineevents.ExecutionFinishedPayload{
+		ExecutionPayload: pipelineevents.NewExecutionPayload(execution),
+	})
+
 	return execution, nil
 }
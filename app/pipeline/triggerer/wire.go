@@ -15,6 +15,8 @@
 package triggerer
 
 import (
+	checkevents "github.com/harness/gitness/app/events/check"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	"github.com/harness/gitness/app/pipeline/file"
 	"github.com/harness/gitness/app/pipeline/scheduler"
 	"github.com/harness/gitness/app/store"
@@ -32,13 +34,15 @@ var WireSet = wire.NewSet(
 func ProvideTriggerer(
 	executionStore store.ExecutionStore,
 	checkStore store.CheckStore,
+	checkReporter *checkevents.Reporter,
 	stageStore store.StageStore,
 	tx dbtx.Transactor,
 	pipelineStore store.PipelineStore,
 	fileService file.Service,
 	scheduler scheduler.Scheduler,
 	repoStore store.RepoStore,
+	pipelineEvents *pipelineevents.Reporter,
 ) Triggerer {
-	return New(executionStore, checkStore, stageStore, pipelineStore,
-		tx, repoStore, scheduler, fileService)
+	return New(executionStore, checkStore, checkReporter, stageStore, pipelineStore,
+		tx, repoStore, scheduler, fileService, pipelineEvents)
 }
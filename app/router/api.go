@@ -18,16 +18,20 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/harness/gitness/app/api/controller/audit"
 	"github.com/harness/gitness/app/api/controller/check"
 	"github.com/harness/gitness/app/api/controller/connector"
 	"github.com/harness/gitness/app/api/controller/execution"
 	controllergithook "github.com/harness/gitness/app/api/controller/githook"
+	"github.com/harness/gitness/app/api/controller/issue"
+	"github.com/harness/gitness/app/api/controller/label"
 	"github.com/harness/gitness/app/api/controller/logs"
 	"github.com/harness/gitness/app/api/controller/pipeline"
 	"github.com/harness/gitness/app/api/controller/plugin"
 	"github.com/harness/gitness/app/api/controller/principal"
 	"github.com/harness/gitness/app/api/controller/pullreq"
 	"github.com/harness/gitness/app/api/controller/repo"
+	"github.com/harness/gitness/app/api/controller/rule"
 	"github.com/harness/gitness/app/api/controller/secret"
 	"github.com/harness/gitness/app/api/controller/serviceaccount"
 	"github.com/harness/gitness/app/api/controller/space"
@@ -37,10 +41,13 @@ import (
 	"github.com/harness/gitness/app/api/controller/user"
 	"github.com/harness/gitness/app/api/controller/webhook"
 	"github.com/harness/gitness/app/api/handler/account"
+	handleraudit "github.com/harness/gitness/app/api/handler/audit"
 	handlercheck "github.com/harness/gitness/app/api/handler/check"
 	handlerconnector "github.com/harness/gitness/app/api/handler/connector"
 	handlerexecution "github.com/harness/gitness/app/api/handler/execution"
 	handlergithook "github.com/harness/gitness/app/api/handler/githook"
+	handlerissue "github.com/harness/gitness/app/api/handler/issue"
+	handlerlabel "github.com/harness/gitness/app/api/handler/label"
 	handlerlogs "github.com/harness/gitness/app/api/handler/logs"
 	handlerpipeline "github.com/harness/gitness/app/api/handler/pipeline"
 	handlerplugin "github.com/harness/gitness/app/api/handler/plugin"
@@ -48,6 +55,7 @@ import (
 	handlerpullreq "github.com/harness/gitness/app/api/handler/pullreq"
 	handlerrepo "github.com/harness/gitness/app/api/handler/repo"
 	"github.com/harness/gitness/app/api/handler/resource"
+	handlerrule "github.com/harness/gitness/app/api/handler/rule"
 	handlersecret "github.com/harness/gitness/app/api/handler/secret"
 	handlerserviceaccount "github.com/harness/gitness/app/api/handler/serviceaccount"
 	handlerspace "github.com/harness/gitness/app/api/handler/space"
@@ -106,6 +114,10 @@ func NewAPIHandler(
 	userCtrl *user.Controller,
 	principalCtrl principal.Controller,
 	checkCtrl *check.Controller,
+	ruleCtrl *rule.Controller,
+	labelCtrl *label.Controller,
+	issueCtrl *issue.Controller,
+	auditCtrl *audit.Controller,
 	sysCtrl *system.Controller,
 ) APIHandler {
 	// Use go-chi router for inner routing.
@@ -131,7 +143,8 @@ func NewAPIHandler(
 	r.Route("/v1", func(r chi.Router) {
 		setupRoutesV1(r, config, repoCtrl, executionCtrl, triggerCtrl, logCtrl, pipelineCtrl,
 			connectorCtrl, templateCtrl, pluginCtrl, secretCtrl, spaceCtrl, pullreqCtrl,
-			webhookCtrl, githookCtrl, saCtrl, userCtrl, principalCtrl, checkCtrl, sysCtrl)
+			webhookCtrl, githookCtrl, saCtrl, userCtrl, principalCtrl, checkCtrl, ruleCtrl, labelCtrl,
+			issueCtrl, auditCtrl, sysCtrl)
 	})
 
 	// wrap router in terminatedPath encoder.
@@ -170,10 +183,15 @@ func setupRoutesV1(r chi.Router,
 	userCtrl *user.Controller,
 	principalCtrl principal.Controller,
 	checkCtrl *check.Controller,
+	ruleCtrl *rule.Controller,
+	labelCtrl *label.Controller,
+	issueCtrl *issue.Controller,
+	auditCtrl *audit.Controller,
 	sysCtrl *system.Controller,
 ) {
-	setupSpaces(r, spaceCtrl)
-	setupRepos(r, repoCtrl, pipelineCtrl, executionCtrl, triggerCtrl, logCtrl, pullreqCtrl, webhookCtrl, checkCtrl)
+	setupSpaces(r, spaceCtrl, ruleCtrl, labelCtrl, auditCtrl)
+	setupRepos(r, repoCtrl, pipelineCtrl, executionCtrl, triggerCtrl, logCtrl, pullreqCtrl, webhookCtrl, checkCtrl,
+		ruleCtrl, labelCtrl, issueCtrl)
 	setupConnectors(r, connectorCtrl)
 	setupTemplates(r, templateCtrl)
 	setupSecrets(r, secretCtrl)
@@ -181,14 +199,20 @@ func setupRoutesV1(r chi.Router,
 	setupServiceAccounts(r, saCtrl)
 	setupPrincipals(r, principalCtrl)
 	setupInternal(r, githookCtrl)
-	setupAdmin(r, userCtrl)
+	setupAdmin(r, userCtrl, auditCtrl)
 	setupAccount(r, userCtrl, sysCtrl, config)
 	setupSystem(r, sysCtrl)
 	setupResources(r)
 	setupPlugins(r, pluginCtrl)
 }
 
-func setupSpaces(r chi.Router, spaceCtrl *space.Controller) {
+func setupSpaces(
+	r chi.Router,
+	spaceCtrl *space.Controller,
+	ruleCtrl *rule.Controller,
+	labelCtrl *label.Controller,
+	auditCtrl *audit.Controller,
+) {
 	r.Route("/spaces", func(r chi.Router) {
 		// Create takes path and parentId via body, not uri
 		r.Post("/", handlerspace.HandleCreate(spaceCtrl))
@@ -205,6 +229,7 @@ func setupSpaces(r chi.Router, spaceCtrl *space.Controller) {
 			r.Post("/move", handlerspace.HandleMove(spaceCtrl))
 			r.Get("/spaces", handlerspace.HandleListSpaces(spaceCtrl))
 			r.Get("/repos", handlerspace.HandleListRepos(spaceCtrl))
+			r.Get("/search", handlerspace.HandleSearch(spaceCtrl))
 			r.Get("/service-accounts", handlerspace.HandleListServiceAccounts(spaceCtrl))
 			r.Get("/secrets", handlerspace.HandleListSecrets(spaceCtrl))
 			r.Get("/connectors", handlerspace.HandleListConnectors(spaceCtrl))
@@ -212,6 +237,11 @@ func setupSpaces(r chi.Router, spaceCtrl *space.Controller) {
 			r.Post("/export", handlerspace.HandleExport(spaceCtrl))
 			r.Get("/export-progress", handlerspace.HandleExportProgress(spaceCtrl))
 
+			r.Route("/audit-events", func(r chi.Router) {
+				r.Get("/", handleraudit.HandleListSpace(auditCtrl))
+				r.Get("/export", handleraudit.HandleExportSpace(auditCtrl))
+			})
+
 			r.Route("/members", func(r chi.Router) {
 				r.Get("/", handlerspace.HandleMembershipList(spaceCtrl))
 				r.Post("/", handlerspace.HandleMembershipAdd(spaceCtrl))
@@ -220,6 +250,10 @@ func setupSpaces(r chi.Router, spaceCtrl *space.Controller) {
 					r.Patch("/", handlerspace.HandleMembershipUpdate(spaceCtrl))
 				})
 			})
+
+			setupRules(r, ruleCtrl, enum.RuleParentSpace)
+
+			setupLabels(r, labelCtrl, enum.LabelParentSpace)
 		})
 	})
 }
@@ -233,11 +267,15 @@ func setupRepos(r chi.Router,
 	pullreqCtrl *pullreq.Controller,
 	webhookCtrl *webhook.Controller,
 	checkCtrl *check.Controller,
+	ruleCtrl *rule.Controller,
+	labelCtrl *label.Controller,
+	issueCtrl *issue.Controller,
 ) {
 	r.Route("/repos", func(r chi.Router) {
 		// Create takes path and parentId via body, not uri
 		r.Post("/", handlerrepo.HandleCreate(repoCtrl))
 		r.Post("/import", handlerrepo.HandleImport(repoCtrl))
+		r.Post("/mirror", handlerrepo.HandleCreateMirror(repoCtrl))
 		r.Route(fmt.Sprintf("/{%s}", request.PathParamRepoRef), func(r chi.Router) {
 			// repo level operations
 			r.Get("/", handlerrepo.HandleFind(repoCtrl))
@@ -245,10 +283,31 @@ func setupRepos(r chi.Router,
 			r.Delete("/", handlerrepo.HandleDelete(repoCtrl))
 
 			r.Post("/move", handlerrepo.HandleMove(repoCtrl))
+			r.Post("/fork", handlerrepo.HandleFork(repoCtrl))
+			r.Post("/fork/sync", handlerrepo.HandleSyncFork(repoCtrl))
 			r.Get("/service-accounts", handlerrepo.HandleListServiceAccounts(repoCtrl))
 
 			r.Get("/import-progress", handlerrepo.HandleImportProgress(repoCtrl))
 
+			r.Route("/mirror", func(r chi.Router) {
+				r.Get("/", handlerrepo.HandleFindMirror(repoCtrl))
+				r.Patch("/", handlerrepo.HandleUpdateMirror(repoCtrl))
+				r.Post("/sync", handlerrepo.HandleSyncMirror(repoCtrl))
+			})
+
+			r.Route("/push-mirrors", func(r chi.Router) {
+				r.Post("/", handlerrepo.HandleCreatePushMirror(repoCtrl))
+				r.Get("/", handlerrepo.HandleListPushMirrors(repoCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamPushMirrorUID), func(r chi.Router) {
+					r.Get("/", handlerrepo.HandleFindPushMirror(repoCtrl))
+					r.Patch("/", handlerrepo.HandleUpdatePushMirror(repoCtrl))
+					r.Delete("/", handlerrepo.HandleDeletePushMirror(repoCtrl))
+					r.Post("/push", handlerrepo.HandlePushToMirror(repoCtrl))
+				})
+			})
+
+			r.Get("/lfs/usage", handlerrepo.HandleLFSUsage(repoCtrl))
+
 			// content operations
 			// NOTE: this allows /content and /content/ to both be valid (without any other tricks.)
 			// We don't expect there to be any other operations in that route (as that could overlap with file names)
@@ -266,6 +325,16 @@ func setupRepos(r chi.Router,
 				r.Get("/*", handlerrepo.HandleRaw(repoCtrl))
 			})
 
+			r.Route("/archive", func(r chi.Router) {
+				r.Get("/*", handlerrepo.HandleArchive(repoCtrl))
+			})
+
+			r.Get("/search", handlerrepo.HandleSearch(repoCtrl))
+
+			r.Route("/codeowners", func(r chi.Router) {
+				r.Get("/*", handlerrepo.HandleCodeOwners(repoCtrl))
+			})
+
 			// commit operations
 			r.Route("/commits", func(r chi.Router) {
 				r.Get("/", handlerrepo.HandleListCommits(repoCtrl))
@@ -310,11 +379,17 @@ func setupRepos(r chi.Router,
 
 			SetupPullReq(r, pullreqCtrl)
 
+			setupIssues(r, issueCtrl)
+
 			setupWebhook(r, webhookCtrl)
 
 			setupPipelines(r, repoCtrl, pipelineCtrl, executionCtrl, triggerCtrl, logCtrl)
 
 			SetupChecks(r, checkCtrl)
+
+			setupRules(r, ruleCtrl, enum.RuleParentRepo)
+
+			setupLabels(r, labelCtrl, enum.LabelParentRepo)
 		})
 	})
 }
@@ -471,10 +546,21 @@ func SetupPullReq(r chi.Router, pullreqCtrl *pullreq.Controller) {
 					r.Delete("/", handlerpullreq.HandleReviewerDelete(pullreqCtrl))
 				})
 			})
+			r.Route("/labels", func(r chi.Router) {
+				r.Get("/", handlerpullreq.HandleLabelList(pullreqCtrl))
+				r.Put("/", handlerpullreq.HandleLabelAssign(pullreqCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamLabelID), func(r chi.Router) {
+					r.Delete("/", handlerpullreq.HandleLabelUnassign(pullreqCtrl))
+				})
+			})
 			r.Route("/reviews", func(r chi.Router) {
 				r.Post("/", handlerpullreq.HandleReviewSubmit(pullreqCtrl))
 			})
 			r.Post("/merge", handlerpullreq.HandleMerge(pullreqCtrl))
+			r.Route("/auto-merge", func(r chi.Router) {
+				r.Post("/", handlerpullreq.HandleAutoMergeEnable(pullreqCtrl))
+				r.Delete("/", handlerpullreq.HandleAutoMergeCancel(pullreqCtrl))
+			})
 			r.Get("/commits", handlerpullreq.HandleCommits(pullreqCtrl))
 			r.Get("/metadata", handlerpullreq.HandleMetadata(pullreqCtrl))
 
@@ -509,12 +595,76 @@ func setupWebhook(r chi.Router, webhookCtrl *webhook.Controller) {
 	})
 }
 
+func setupRules(r chi.Router, ruleCtrl *rule.Controller, parentType enum.RuleParent) {
+	r.Route("/rules", func(r chi.Router) {
+		r.Post("/", handlerrule.HandleCreate(ruleCtrl, parentType))
+		r.Get("/", handlerrule.HandleList(ruleCtrl, parentType))
+
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamRuleUID), func(r chi.Router) {
+			r.Get("/", handlerrule.HandleFind(ruleCtrl, parentType))
+			r.Patch("/", handlerrule.HandleUpdate(ruleCtrl, parentType))
+			r.Delete("/", handlerrule.HandleDelete(ruleCtrl, parentType))
+		})
+	})
+}
+
+func setupIssues(r chi.Router, issueCtrl *issue.Controller) {
+	r.Route("/issues", func(r chi.Router) {
+		r.Post("/", handlerissue.HandleCreate(issueCtrl))
+		r.Get("/", handlerissue.HandleList(issueCtrl))
+
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamIssueNumber), func(r chi.Router) {
+			r.Get("/", handlerissue.HandleFind(issueCtrl))
+			r.Patch("/", handlerissue.HandleUpdate(issueCtrl))
+			r.Post("/state", handlerissue.HandleState(issueCtrl))
+			r.Get("/activities", handlerissue.HandleListActivities(issueCtrl))
+			r.Route("/comments", func(r chi.Router) {
+				r.Post("/", handlerissue.HandleCommentCreate(issueCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamIssueCommentID), func(r chi.Router) {
+					r.Patch("/", handlerissue.HandleCommentUpdate(issueCtrl))
+					r.Delete("/", handlerissue.HandleCommentDelete(issueCtrl))
+				})
+			})
+			r.Route("/assignees", func(r chi.Router) {
+				r.Put("/", handlerissue.HandleAssigneeAdd(issueCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamIssueAssigneeID), func(r chi.Router) {
+					r.Delete("/", handlerissue.HandleAssigneeRemove(issueCtrl))
+				})
+			})
+			r.Route("/labels", func(r chi.Router) {
+				r.Put("/", handlerissue.HandleLabelAssign(issueCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamLabelID), func(r chi.Router) {
+					r.Delete("/", handlerissue.HandleLabelUnassign(issueCtrl))
+				})
+			})
+		})
+	})
+}
+
+func setupLabels(r chi.Router, labelCtrl *label.Controller, parentType enum.LabelParent) {
+	r.Route("/labels", func(r chi.Router) {
+		r.Post("/", handlerlabel.HandleCreate(labelCtrl, parentType))
+		r.Get("/", handlerlabel.HandleList(labelCtrl, parentType))
+
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamLabelID), func(r chi.Router) {
+			r.Get("/", handlerlabel.HandleFind(labelCtrl, parentType))
+			r.Patch("/", handlerlabel.HandleUpdate(labelCtrl, parentType))
+			r.Delete("/", handlerlabel.HandleDelete(labelCtrl, parentType))
+		})
+	})
+}
+
 func SetupChecks(r chi.Router, checkCtrl *check.Controller) {
 	r.Route("/checks", func(r chi.Router) {
 		r.Route(fmt.Sprintf("/commits/{%s}", request.PathParamCommitSHA), func(r chi.Router) {
 			r.Put("/", handlercheck.HandleCheckReport(checkCtrl))
 			r.Get("/", handlercheck.HandleCheckList(checkCtrl))
 		})
+		r.Route("/required", func(r chi.Router) {
+			r.Post("/", handlercheck.HandleReqCheckCreate(checkCtrl))
+			r.Get("/", handlercheck.HandleReqCheckList(checkCtrl))
+			r.Delete(fmt.Sprintf("/{%s}", request.PathParamReqCheckID), handlercheck.HandleReqCheckDelete(checkCtrl))
+		})
 	})
 }
 
@@ -537,6 +687,37 @@ func setupUser(r chi.Router, userCtrl *user.Controller) {
 			})
 		})
 
+		// TWO-FACTOR AUTHENTICATION
+		r.Route("/totp", func(r chi.Router) {
+			r.Get("/", handleruser.HandleFindTOTP(userCtrl))
+			r.Post("/", handleruser.HandleEnrollTOTP(userCtrl))
+			r.Delete("/", handleruser.HandleDisableTOTP(userCtrl))
+			r.Post("/enable", handleruser.HandleEnableTOTP(userCtrl))
+			r.Post("/recovery-codes", handleruser.HandleRegenerateTOTPRecoveryCodes(userCtrl))
+		})
+
+		// SSH PUBLIC KEYS
+		r.Route("/keys", func(r chi.Router) {
+			r.Get("/", handleruser.HandleListPublicKeys(userCtrl))
+			r.Post("/", handleruser.HandleCreatePublicKey(userCtrl))
+
+			// per key operations
+			r.Route(fmt.Sprintf("/{%s}", request.PathParamPublicKeyUID), func(r chi.Router) {
+				r.Delete("/", handleruser.HandleDeletePublicKey(userCtrl))
+			})
+		})
+
+		// GPG AND SSH SIGNING KEYS
+		r.Route("/signing-keys", func(r chi.Router) {
+			r.Get("/", handleruser.HandleListSigningKeys(userCtrl))
+			r.Post("/", handleruser.HandleCreateSigningKey(userCtrl))
+
+			// per key operations
+			r.Route(fmt.Sprintf("/{%s}", request.PathParamSigningKeyUID), func(r chi.Router) {
+				r.Delete("/", handleruser.HandleDeleteSigningKey(userCtrl))
+			})
+		})
+
 		// SESSION TOKENS
 		r.Route("/sessions", func(r chi.Router) {
 			r.Get("/", handleruser.HandleListTokens(userCtrl, enum.TokenTypeSession))
@@ -593,7 +774,7 @@ func setupPrincipals(r chi.Router, principalCtrl principal.Controller) {
 	})
 }
 
-func setupAdmin(r chi.Router, userCtrl *user.Controller) {
+func setupAdmin(r chi.Router, userCtrl *user.Controller, auditCtrl *audit.Controller) {
 	r.Route("/admin", func(r chi.Router) {
 		r.Use(middlewareprincipal.RestrictToAdmin())
 		r.Route("/users", func(r chi.Router) {
@@ -605,14 +786,23 @@ func setupAdmin(r chi.Router, userCtrl *user.Controller) {
 				r.Patch("/", users.HandleUpdate(userCtrl))
 				r.Delete("/", users.HandleDelete(userCtrl))
 				r.Patch("/admin", handleruser.HandleUpdateAdmin(userCtrl))
+				r.Delete("/totp", users.HandleResetTOTP(userCtrl))
 			})
 		})
+
+		r.Route("/audit-events", func(r chi.Router) {
+			r.Get("/", handleraudit.HandleList(auditCtrl))
+			r.Get("/export", handleraudit.HandleExport(auditCtrl))
+		})
 	})
 }
 
 func setupAccount(r chi.Router, userCtrl *user.Controller, sysCtrl *system.Controller, config *types.Config) {
 	cookieName := config.Token.CookieName
 	r.Post("/login", account.HandleLogin(userCtrl, cookieName))
+	r.Post("/login/totp", account.HandleLoginTOTP(userCtrl, cookieName))
+	r.Get("/login/oidc", account.HandleLoginOIDC(userCtrl))
+	r.Get("/login/oidc/callback", account.HandleLoginOIDCCallback(userCtrl, cookieName, config.URL.UI))
 	r.Post("/register", account.HandleRegister(userCtrl, sysCtrl, cookieName))
 	r.Post("/logout", account.HandleLogout(userCtrl, cookieName))
 }
@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/harness/gitness/app/api/controller/lfs"
+	handlerlfs "github.com/harness/gitness/app/api/handler/lfs"
 	handlerrepo "github.com/harness/gitness/app/api/handler/repo"
 	middlewareauthn "github.com/harness/gitness/app/api/middleware/authn"
 	"github.com/harness/gitness/app/api/middleware/encode"
@@ -48,6 +50,7 @@ func NewGitHandler(
 	authenticator authn.Authenticator,
 	authorizer authz.Authorizer,
 	client gitrpc.Interface,
+	lfsCtrl *lfs.Controller,
 ) GitHandler {
 	// Use go-chi router for inner routing.
 	r := chi.NewRouter()
@@ -70,6 +73,13 @@ func NewGitHandler(
 		r.Post("/git-receive-pack", handlerrepo.PostReceivePack(client, urlProvider, repoStore, authorizer))
 		r.Get("/info/refs", handlerrepo.GetInfoRefs(client, repoStore, authorizer))
 
+		// git lfs
+		r.Route("/info/lfs/objects", func(r chi.Router) {
+			r.Post("/batch", handlerlfs.HandleBatch(lfsCtrl))
+			r.Get(fmt.Sprintf("/{%s}", request.PathParamLFSOID), handlerlfs.HandleDownload(lfsCtrl))
+			r.Put(fmt.Sprintf("/{%s}", request.PathParamLFSOID), handlerlfs.HandleUpload(lfsCtrl))
+		})
+
 		// dumb protocol
 		r.Get("/HEAD", stubGitHandler(repoStore))
 		r.Get("/objects/info/alternates", stubGitHandler(repoStore))
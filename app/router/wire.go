@@ -17,16 +17,21 @@ package router
 import (
 	"strings"
 
+	"github.com/harness/gitness/app/api/controller/audit"
 	"github.com/harness/gitness/app/api/controller/check"
 	"github.com/harness/gitness/app/api/controller/connector"
 	"github.com/harness/gitness/app/api/controller/execution"
 	"github.com/harness/gitness/app/api/controller/githook"
+	"github.com/harness/gitness/app/api/controller/issue"
+	"github.com/harness/gitness/app/api/controller/label"
+	"github.com/harness/gitness/app/api/controller/lfs"
 	"github.com/harness/gitness/app/api/controller/logs"
 	"github.com/harness/gitness/app/api/controller/pipeline"
 	"github.com/harness/gitness/app/api/controller/plugin"
 	"github.com/harness/gitness/app/api/controller/principal"
 	"github.com/harness/gitness/app/api/controller/pullreq"
 	"github.com/harness/gitness/app/api/controller/repo"
+	"github.com/harness/gitness/app/api/controller/rule"
 	"github.com/harness/gitness/app/api/controller/secret"
 	"github.com/harness/gitness/app/api/controller/serviceaccount"
 	"github.com/harness/gitness/app/api/controller/space"
@@ -81,8 +86,9 @@ func ProvideGitHandler(
 	authenticator authn.Authenticator,
 	authorizer authz.Authorizer,
 	client gitrpc.Interface,
+	lfsCtrl *lfs.Controller,
 ) GitHandler {
-	return NewGitHandler(config, urlProvider, repoStore, authenticator, authorizer, client)
+	return NewGitHandler(config, urlProvider, repoStore, authenticator, authorizer, client, lfsCtrl)
 }
 
 func ProvideAPIHandler(
@@ -105,11 +111,15 @@ func ProvideAPIHandler(
 	userCtrl *user.Controller,
 	principalCtrl principal.Controller,
 	checkCtrl *check.Controller,
+	ruleCtrl *rule.Controller,
+	labelCtrl *label.Controller,
+	issueCtrl *issue.Controller,
+	auditCtrl *audit.Controller,
 	sysCtrl *system.Controller,
 ) APIHandler {
 	return NewAPIHandler(config, authenticator, repoCtrl, executionCtrl, logCtrl, spaceCtrl, pipelineCtrl,
 		secretCtrl, triggerCtrl, connectorCtrl, templateCtrl, pluginCtrl, pullreqCtrl, webhookCtrl,
-		githookCtrl, saCtrl, userCtrl, principalCtrl, checkCtrl, sysCtrl)
+		githookCtrl, saCtrl, userCtrl, principalCtrl, checkCtrl, ruleCtrl, labelCtrl, issueCtrl, auditCtrl, sysCtrl)
 }
 
 func ProvideWebHandler(config *types.Config) WebHandler {
@@ -0,0 +1,117 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bmatcuk/doublestar"
+)
+
+// candidatePaths are the locations of the CODEOWNERS file in the order of precedence.
+// It's a combination of the locations supported by GitHub and GitLab.
+var candidatePaths = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+	".gitlab/CODEOWNERS",
+}
+
+// CodeOwners represents a parsed CODEOWNERS file.
+type CodeOwners struct {
+	FilePath string
+	Entries  []Entry
+}
+
+// Entry is a single rule of a CODEOWNERS file.
+type Entry struct {
+	Pattern string
+	Owners  []string
+}
+
+// Find returns the entry that applies to the path. As with git and GitHub, the last matching entry wins.
+func (co *CodeOwners) Find(path string) (Entry, bool) {
+	for i := len(co.Entries) - 1; i >= 0; i-- {
+		if Match(co.Entries[i].Pattern, path) {
+			return co.Entries[i], true
+		}
+	}
+
+	return Entry{}, false
+}
+
+// Parse parses the content of a CODEOWNERS file.
+// GitLab section headers are accepted, but the sections are ignored.
+func Parse(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 && (idx == 0 || line[idx-1] != '\\') {
+			line = line[:idx]
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "[") || strings.HasPrefix(line, "^[") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		entries = append(entries, Entry{
+			Pattern: strings.ReplaceAll(fields[0], `\#`, "#"),
+			Owners:  fields[1:],
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read CODEOWNERS file: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Match returns true if the path matches the CODEOWNERS pattern. The pattern follows the gitignore rules:
+// A pattern without a slash (other than a trailing one) matches at any level, otherwise it's relative
+// to the repository root. A pattern that matches a directory matches all files within it.
+// Negation and character escapes of gitignore patterns aren't supported.
+func Match(pattern, path string) bool {
+	path = strings.TrimPrefix(path, "/")
+
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+
+	if trimmed := strings.TrimPrefix(pattern, "/"); trimmed != pattern {
+		pattern = trimmed
+	} else if !strings.Contains(strings.TrimSuffix(pattern, "/**"), "/") {
+		pattern = "**/" + pattern
+	}
+
+	if ok, _ := doublestar.Match(pattern, path); ok {
+		return true
+	}
+
+	// a pattern ending with a wildcard (e.g. "docs/*") doesn't match the content of subdirectories.
+	if strings.HasSuffix(pattern, "*") {
+		return false
+	}
+
+	ok, _ := doublestar.Match(pattern+"/**", path)
+	return ok
+}
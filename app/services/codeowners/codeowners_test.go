@@ -0,0 +1,112 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeowners
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	const content = `# comment line
+*       @global-owner
+
+[Backend]
+*.go    @gopher dev@example.com # trailing comment
+/docs/  @writer
+build/logs/
+
+\#file  @hash
+`
+
+	entries, err := Parse(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	exp := []Entry{
+		{Pattern: "*", Owners: []string{"@global-owner"}},
+		{Pattern: "*.go", Owners: []string{"@gopher", "dev@example.com"}},
+		{Pattern: "/docs/", Owners: []string{"@writer"}},
+		{Pattern: "build/logs/", Owners: []string{}},
+		{Pattern: "#file", Owners: []string{"@hash"}},
+	}
+
+	if !reflect.DeepEqual(exp, entries) {
+		t.Errorf("entries mismatch; want=%v got=%v", exp, entries)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		exp     bool
+	}{
+		{pattern: "*", path: "README.md", exp: true},
+		{pattern: "*", path: "a/b/c.go", exp: true},
+		{pattern: "*.js", path: "web/src/index.js", exp: true},
+		{pattern: "*.js", path: "web/src/index.ts", exp: false},
+		{pattern: "/build/logs/", path: "build/logs/a/b.log", exp: true},
+		{pattern: "/build/logs/", path: "src/build/logs/a.log", exp: false},
+		{pattern: "docs/*", path: "docs/getting-started.md", exp: true},
+		{pattern: "docs/*", path: "docs/build/troubleshooting.md", exp: false},
+		{pattern: "apps/", path: "apps/a.go", exp: true},
+		{pattern: "apps/", path: "src/apps/a.go", exp: true},
+		{pattern: "/docs", path: "docs/a/b.md", exp: true},
+		{pattern: "**/logs", path: "deeply/nested/logs/a.log", exp: true},
+		{pattern: "/scripts/", path: "scripts-old/a.sh", exp: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+"@"+test.path, func(t *testing.T) {
+			if want, got := test.exp, Match(test.pattern, test.path); want != got {
+				t.Errorf("want=%t got=%t", want, got)
+			}
+		})
+	}
+}
+
+func TestFindLastMatchWins(t *testing.T) {
+	co := &CodeOwners{
+		Entries: []Entry{
+			{Pattern: "*", Owners: []string{"@all"}},
+			{Pattern: "*.go", Owners: []string{"@gopher"}},
+			{Pattern: "/vendor/"},
+		},
+	}
+
+	tests := []struct {
+		path      string
+		expOwners []string
+	}{
+		{path: "README.md", expOwners: []string{"@all"}},
+		{path: "app/main.go", expOwners: []string{"@gopher"}},
+		{path: "vendor/lib/lib.go", expOwners: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			entry, ok := co.Find(test.path)
+			if !ok {
+				t.Fatalf("no entry found")
+			}
+			if want, got := test.expOwners, entry.Owners; !reflect.DeepEqual(want, got) {
+				t.Errorf("owners mismatch; want=%v got=%v", want, got)
+			}
+		})
+	}
+}
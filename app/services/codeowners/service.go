@@ -0,0 +1,189 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeowners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/gitrpc"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxFileSize is the maximum size of a CODEOWNERS file that is processed.
+	maxFileSize = 3 * 1024 * 1024
+)
+
+var ErrNotFound = errors.New("CODEOWNERS file not found")
+
+// Service reads the CODEOWNERS files of repositories and resolves code owners to principals.
+type Service struct {
+	gitRPCClient   gitrpc.Interface
+	principalStore store.PrincipalStore
+}
+
+func NewService(gitRPCClient gitrpc.Interface, principalStore store.PrincipalStore) *Service {
+	return &Service{
+		gitRPCClient:   gitRPCClient,
+		principalStore: principalStore,
+	}
+}
+
+// Get reads and parses the CODEOWNERS file of the repository at the provided git reference.
+// It returns ErrNotFound in case the repository doesn't contain a CODEOWNERS file.
+func (s *Service) Get(ctx context.Context, repo *types.Repository, gitRef string) (*CodeOwners, error) {
+	readParams := gitrpc.CreateRPCReadParams(repo)
+
+	for _, filePath := range candidatePaths {
+		node, err := s.gitRPCClient.GetTreeNode(ctx, &gitrpc.GetTreeNodeParams{
+			ReadParams: readParams,
+			GitREF:     gitRef,
+			Path:       filePath,
+		})
+		if gitrpc.ErrorStatus(err) == gitrpc.StatusPathNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tree node of %s: %w", filePath, err)
+		}
+
+		if node.Node.Type != gitrpc.TreeNodeTypeBlob {
+			continue
+		}
+
+		blob, err := s.gitRPCClient.GetBlob(ctx, &gitrpc.GetBlobParams{
+			ReadParams: readParams,
+			SHA:        node.Node.SHA,
+			SizeLimit:  maxFileSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read blob of %s: %w", filePath, err)
+		}
+
+		entries, err := Parse(blob.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", filePath, err)
+		}
+
+		return &CodeOwners{
+			FilePath: filePath,
+			Entries:  entries,
+		}, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+// ChangedPaths returns the paths of all files changed between the base and the head commit.
+// For renamed files both, the old and the new path, are returned.
+func (s *Service) ChangedPaths(
+	ctx context.Context,
+	repo *types.Repository,
+	baseRef string,
+	headRef string,
+) ([]string, error) {
+	files, errs := s.gitRPCClient.Diff(ctx, &gitrpc.DiffParams{
+		ReadParams:   gitrpc.CreateRPCReadParams(repo),
+		BaseRef:      baseRef,
+		HeadRef:      headRef,
+		MergeBase:    true,
+		IncludePatch: false,
+	})
+
+	var paths []string
+	for file := range files {
+		paths = append(paths, file.Path)
+		if file.OldPath != "" && file.OldPath != file.Path {
+			paths = append(paths, file.OldPath)
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return nil, fmt.Errorf("failed to get list of changed files: %w", err)
+	}
+
+	return paths, nil
+}
+
+// Resolver resolves code owners (user names prefixed with '@' or emails) to principals.
+// It caches the results, so it should be used only within a single operation.
+type Resolver struct {
+	principalStore store.PrincipalStore
+	cache          map[string]*types.PrincipalInfo
+}
+
+func (s *Service) NewResolver() *Resolver {
+	return &Resolver{
+		principalStore: s.principalStore,
+		cache:          make(map[string]*types.PrincipalInfo),
+	}
+}
+
+// Resolve returns the principals of the provided code owners.
+// Owners that don't exist and groups (e.g. "@org/team") are skipped.
+func (r *Resolver) Resolve(ctx context.Context, owners []string) ([]types.PrincipalInfo, error) {
+	principals := make([]types.PrincipalInfo, 0, len(owners))
+	for _, owner := range owners {
+		info, ok := r.cache[owner]
+		if !ok {
+			var err error
+			info, err = r.find(ctx, owner)
+			if err != nil {
+				return nil, err
+			}
+
+			r.cache[owner] = info
+		}
+
+		if info != nil {
+			principals = append(principals, *info)
+		}
+	}
+
+	return principals, nil
+}
+
+func (r *Resolver) find(ctx context.Context, owner string) (*types.PrincipalInfo, error) {
+	var (
+		principal *types.Principal
+		err       error
+	)
+
+	switch {
+	case strings.HasPrefix(owner, "@") && !strings.Contains(owner, "/"):
+		principal, err = r.principalStore.FindByUID(ctx, owner[1:])
+	case strings.Contains(owner, "@"):
+		principal, err = r.principalStore.FindByEmail(ctx, owner)
+	default:
+		log.Ctx(ctx).Debug().Msgf("code owner %q isn't supported", owner)
+		return nil, nil //nolint:nilnil // the owner is skipped
+	}
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		log.Ctx(ctx).Debug().Msgf("code owner %q not found", owner)
+		return nil, nil //nolint:nilnil // the owner is skipped
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find code owner %q: %w", owner, err)
+	}
+
+	return principal.ToPrincipalInfo(), nil
+}
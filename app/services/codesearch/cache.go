@@ -0,0 +1,103 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesearch
+
+import (
+	"container/list"
+	"sync"
+)
+
+// indexCache keeps the most recently used indexes in memory, bounded by their total size.
+type indexCache struct {
+	maxSize int64
+
+	mx      sync.Mutex
+	size    int64
+	entries map[int64]*list.Element
+	lru     *list.List // front is the most recently used index
+}
+
+type cacheEntry struct {
+	repoID int64
+	idx    *index
+	size   int64
+}
+
+func newIndexCache(maxSize int64) *indexCache {
+	return &indexCache{
+		maxSize: maxSize,
+		entries: map[int64]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// get returns the cached index of the repository or nil if it isn't cached.
+func (c *indexCache) get(repoID int64) *index {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	elem, ok := c.entries[repoID]
+	if !ok {
+		return nil
+	}
+
+	c.lru.MoveToFront(elem)
+
+	return entryOf(elem).idx
+}
+
+// put caches the index of the repository and evicts the least recently used indexes to stay within the size limit.
+// Indexes larger than the limit aren't cached at all.
+func (c *indexCache) put(repoID int64, idx *index) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.remove(repoID)
+
+	size := idx.size()
+	if size > c.maxSize {
+		return
+	}
+
+	for c.size+size > c.maxSize {
+		c.remove(entryOf(c.lru.Back()).repoID)
+	}
+
+	c.entries[repoID] = c.lru.PushFront(&cacheEntry{repoID: repoID, idx: idx, size: size})
+	c.size += size
+}
+
+// delete removes the index of the repository from the cache.
+func (c *indexCache) delete(repoID int64) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.remove(repoID)
+}
+
+func (c *indexCache) remove(repoID int64) {
+	elem, ok := c.entries[repoID]
+	if !ok {
+		return
+	}
+
+	c.lru.Remove(elem)
+	delete(c.entries, repoID)
+	c.size -= entryOf(elem).size
+}
+
+func entryOf(elem *list.Element) *cacheEntry {
+	return elem.Value.(*cacheEntry) //nolint:errcheck // the list only contains cache entries
+}
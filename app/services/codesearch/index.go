@@ -0,0 +1,149 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesearch
+
+import (
+	"sort"
+)
+
+// trigram is a sequence of three bytes packed into an integer.
+type trigram uint32
+
+// document is a single file of the indexed tree.
+type document struct {
+	Path     string
+	Language string
+	Content  []byte
+}
+
+// index is the search index of the tree of a single commit.
+type index struct {
+	// Branch is the name of the branch the index was built from.
+	Branch    string
+	CommitSHA string
+	Documents []document
+
+	// Postings maps each trigram to the sorted positions of the documents containing it.
+	// The trigrams are case insensitive (ASCII letters are lowered before indexing).
+	Postings map[trigram][]uint32
+}
+
+func newIndex(branch, commitSHA string) *index {
+	return &index{
+		Branch:    branch,
+		CommitSHA: commitSHA,
+		Postings:  map[trigram][]uint32{},
+	}
+}
+
+// add adds the document to the index.
+func (idx *index) add(doc document) {
+	pos := uint32(len(idx.Documents))
+	idx.Documents = append(idx.Documents, doc)
+
+	for t := range trigramSet(doc.Content) {
+		idx.Postings[t] = append(idx.Postings[t], pos)
+	}
+}
+
+// size returns the approximate number of bytes the index occupies in memory.
+func (idx *index) size() int64 {
+	// postingOverhead approximates the memory used by a map entry and slice header of a posting list.
+	const postingOverhead = 48
+
+	size := int64(len(idx.Branch) + len(idx.CommitSHA))
+	for i := range idx.Documents {
+		doc := &idx.Documents[i]
+		size += int64(len(doc.Path) + len(doc.Language) + len(doc.Content))
+	}
+	for _, list := range idx.Postings {
+		size += postingOverhead + 4*int64(len(list))
+	}
+
+	return size
+}
+
+// candidates returns the positions of the documents containing all provided trigrams.
+// Without trigrams all documents are candidates.
+func (idx *index) candidates(trigrams []trigram) []uint32 {
+	if len(trigrams) == 0 {
+		res := make([]uint32, len(idx.Documents))
+		for i := range res {
+			res[i] = uint32(i)
+		}
+		return res
+	}
+
+	lists := make([][]uint32, len(trigrams))
+	for i, t := range trigrams {
+		lists[i] = idx.Postings[t]
+		if len(lists[i]) == 0 {
+			return nil
+		}
+	}
+
+	// start with the shortest list to keep the intersections small.
+	sort.Slice(lists, func(i, j int) bool { return len(lists[i]) < len(lists[j]) })
+
+	res := lists[0]
+	for _, list := range lists[1:] {
+		res = intersect(res, list)
+		if len(res) == 0 {
+			return nil
+		}
+	}
+
+	return res
+}
+
+// intersect returns the values present in both sorted lists.
+func intersect(a, b []uint32) []uint32 {
+	res := make([]uint32, 0, len(a))
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			res = append(res, a[i])
+			i++
+			j++
+		}
+	}
+
+	return res
+}
+
+// trigramSet returns all distinct trigrams of the data.
+func trigramSet(data []byte) map[trigram]struct{} {
+	set := map[trigram]struct{}{}
+	for i := 0; i+3 <= len(data); i++ {
+		set[newTrigram(data[i:i+3])] = struct{}{}
+	}
+
+	return set
+}
+
+func newTrigram(b []byte) trigram {
+	return trigram(lowerASCII(b[0]))<<16 | trigram(lowerASCII(b[1]))<<8 | trigram(lowerASCII(b[2]))
+}
+
+func lowerASCII(b byte) byte {
+	if 'A' <= b && b <= 'Z' {
+		return b + 'a' - 'A'
+	}
+	return b
+}
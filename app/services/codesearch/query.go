@@ -0,0 +1,232 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesearch
+
+import (
+	"bytes"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/bmatcuk/doublestar"
+)
+
+const (
+	// maxMatchesPerFile is the maximum number of matching lines returned per file.
+	maxMatchesPerFile = 20
+
+	// maxContextLines is the maximum number of lines returned before and after a matching line.
+	maxContextLines = 10
+
+	// maxLineLength is the maximum length of a returned line, longer lines are cut off.
+	maxLineLength = 512
+)
+
+// query is a compiled code search query.
+type query struct {
+	re       *regexp.Regexp
+	trigrams []trigram
+	path     string
+	language string
+	context  int
+}
+
+func compileQuery(filter *types.CodeSearchFilter) (*query, error) {
+	if filter.Query == "" {
+		return nil, usererror.BadRequest("Search query can't be empty.")
+	}
+
+	mode, ok := filter.Mode.Sanitize()
+	if !ok {
+		return nil, usererror.BadRequestf("Unsupported search mode '%s'.", filter.Mode)
+	}
+
+	expr := filter.Query
+	if mode != enum.CodeSearchModeRegex {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if !filter.CaseSensitive {
+		expr = "(?i)" + expr
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, usererror.BadRequestf("Invalid regular expression: %s.", err)
+	}
+
+	parsed, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil, usererror.BadRequestf("Invalid regular expression: %s.", err)
+	}
+
+	var trigrams []trigram
+	for _, lit := range requiredLiterals(parsed.Simplify()) {
+		trigrams = append(trigrams, literalTrigrams(lit.text, lit.foldCase)...)
+	}
+
+	if filter.Path != "" {
+		if _, err = doublestar.Match(filter.Path, ""); err != nil {
+			return nil, usererror.BadRequestf("Invalid path pattern: %s.", err)
+		}
+	}
+
+	contextLines := filter.Context
+	if contextLines < 0 {
+		contextLines = 0
+	}
+	if contextLines > maxContextLines {
+		contextLines = maxContextLines
+	}
+
+	return &query{
+		re:       re,
+		trigrams: trigrams,
+		path:     filter.Path,
+		language: filter.Language,
+		context:  contextLines,
+	}, nil
+}
+
+// literal is a string every match of a regular expression contains.
+type literal struct {
+	text     string
+	foldCase bool
+}
+
+// requiredLiterals returns literals that are part of every match of the regular expression.
+// The result doesn't have to be complete, it's only used to narrow down the documents to inspect.
+func requiredLiterals(re *syntax.Regexp) []literal {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return []literal{{text: string(re.Rune), foldCase: re.Flags&syntax.FoldCase != 0}}
+
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredLiterals(re.Sub[0])
+
+	case syntax.OpRepeat:
+		if re.Min > 0 {
+			return requiredLiterals(re.Sub[0])
+		}
+
+	case syntax.OpConcat:
+		var res []literal
+		for _, sub := range re.Sub {
+			res = append(res, requiredLiterals(sub)...)
+		}
+		return res
+
+	default:
+	}
+
+	return nil
+}
+
+// literalTrigrams returns the trigrams of the literal.
+// For case insensitive literals the trigrams with non-ASCII characters are skipped,
+// as the index only normalizes the case of ASCII letters.
+func literalTrigrams(text string, foldCase bool) []trigram {
+	var res []trigram
+	for i := 0; i+3 <= len(text); i++ {
+		b := []byte(text[i : i+3])
+		if foldCase && (b[0]|b[1]|b[2])&0x80 != 0 {
+			continue
+		}
+		res = append(res, newTrigram(b))
+	}
+
+	return res
+}
+
+// matchDocument returns whether the document satisfies the path and language filters of the query.
+func (q *query) matchDocument(doc *document) bool {
+	if q.language != "" && !strings.EqualFold(q.language, doc.Language) {
+		return false
+	}
+
+	if q.path != "" {
+		ok, err := doublestar.Match(q.path, doc.Path)
+		if err != nil || !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchLines returns the matching lines of the document content.
+// The second return value is true in case there are more matches than returned.
+func (q *query) matchLines(content []byte) ([]types.CodeSearchMatch, bool) {
+	lines := bytes.Split(content, []byte{'\n'})
+
+	var matches []types.CodeSearchMatch
+	for i, line := range lines {
+		if !q.re.Match(line) {
+			continue
+		}
+
+		if len(matches) == maxMatchesPerFile {
+			return matches, true
+		}
+
+		matches = append(matches, types.CodeSearchMatch{
+			LineNumber: i + 1,
+			Line:       formatLine(line),
+			Before:     formatLines(lines[max(0, i-q.context):i]),
+			After:      formatLines(lines[i+1 : min(len(lines), i+1+q.context)]),
+		})
+	}
+
+	return matches, false
+}
+
+func formatLines(lines [][]byte) []string {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	res := make([]string, len(lines))
+	for i := range lines {
+		res[i] = formatLine(lines[i])
+	}
+
+	return res
+}
+
+func formatLine(line []byte) string {
+	line = bytes.TrimSuffix(line, []byte{'\r'})
+	if len(line) > maxLineLength {
+		line = line[:maxLineLength]
+	}
+
+	return strings.ToValidUTF8(string(line), "")
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,142 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesearch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestSearchIndex(t *testing.T) {
+	idx := newIndex("main", "sha")
+	idx.add(document{Path: "main.go", Language: "Go", Content: []byte("package main\n\nfunc Hello() {}\n")})
+	idx.add(document{Path: "docs/readme.md", Language: "Markdown", Content: []byte("# Hello World\nsay hello\n")})
+	idx.add(document{Path: "app/util.go", Language: "Go", Content: []byte("package app\n\nfunc helper() {}\n")})
+
+	tests := []struct {
+		name   string
+		filter types.CodeSearchFilter
+		exp    map[string][]int
+	}{
+		{
+			name:   "literal-case-insensitive",
+			filter: types.CodeSearchFilter{Query: "hello"},
+			exp:    map[string][]int{"main.go": {3}, "docs/readme.md": {1, 2}},
+		},
+		{
+			name:   "literal-case-sensitive",
+			filter: types.CodeSearchFilter{Query: "Hello", CaseSensitive: true},
+			exp:    map[string][]int{"main.go": {3}, "docs/readme.md": {1}},
+		},
+		{
+			name:   "literal-special-characters",
+			filter: types.CodeSearchFilter{Query: "() {}"},
+			exp:    map[string][]int{"main.go": {3}, "app/util.go": {3}},
+		},
+		{
+			name:   "regex",
+			filter: types.CodeSearchFilter{Query: `^func \w+per\(`, Mode: enum.CodeSearchModeRegex},
+			exp:    map[string][]int{"app/util.go": {3}},
+		},
+		{
+			name:   "regex-alternation",
+			filter: types.CodeSearchFilter{Query: `world|helper`, Mode: enum.CodeSearchModeRegex},
+			exp:    map[string][]int{"docs/readme.md": {1}, "app/util.go": {3}},
+		},
+		{
+			name:   "path-filter",
+			filter: types.CodeSearchFilter{Query: "package", Path: "app/**"},
+			exp:    map[string][]int{"app/util.go": {1}},
+		},
+		{
+			name:   "language-filter",
+			filter: types.CodeSearchFilter{Query: "hello", Language: "markdown"},
+			exp:    map[string][]int{"docs/readme.md": {1, 2}},
+		},
+		{
+			name:   "no-match",
+			filter: types.CodeSearchFilter{Query: "goodbye"},
+			exp:    map[string][]int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := compileQuery(&test.filter)
+			if err != nil {
+				t.Fatalf("failed to compile query: %s", err)
+			}
+
+			got := map[string][]int{}
+			for _, pos := range idx.candidates(q.trigrams) {
+				doc := &idx.Documents[pos]
+				if !q.matchDocument(doc) {
+					continue
+				}
+
+				matches, _ := q.matchLines(doc.Content)
+				for _, m := range matches {
+					got[doc.Path] = append(got[doc.Path], m.LineNumber)
+				}
+			}
+
+			if !reflect.DeepEqual(test.exp, got) {
+				t.Errorf("expected %v, got %v", test.exp, got)
+			}
+		})
+	}
+}
+
+func TestRequiredTrigrams(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter types.CodeSearchFilter
+		exp    int
+	}{
+		{name: "literal", filter: types.CodeSearchFilter{Query: "abcd"}, exp: 2},
+		{name: "short-literal", filter: types.CodeSearchFilter{Query: "ab"}, exp: 0},
+		{
+			name:   "regex-concat",
+			filter: types.CodeSearchFilter{Query: `abc.*def`, Mode: enum.CodeSearchModeRegex},
+			exp:    2,
+		},
+		{
+			name:   "regex-alternation",
+			filter: types.CodeSearchFilter{Query: `abc|def`, Mode: enum.CodeSearchModeRegex},
+			exp:    0,
+		},
+		{
+			name:   "non-ascii-case-insensitive",
+			filter: types.CodeSearchFilter{Query: "äbcd"},
+			exp:    1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := compileQuery(&test.filter)
+			if err != nil {
+				t.Fatalf("failed to compile query: %s", err)
+			}
+
+			if len(q.trigrams) != test.exp {
+				t.Errorf("expected %d trigrams, got %d", test.exp, len(q.trigrams))
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesearch
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/stream"
+	"github.com/harness/gitness/types"
+
+	"github.com/go-enry/go-enry/v2"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultResultSize = 20
+	maxResultSize     = 100
+
+	branchRefPrefix = "refs/heads/"
+)
+
+var errNotIndexed = usererror.New(http.StatusServiceUnavailable,
+	"The repository isn't indexed yet, please try again later.")
+
+// Service indexes the content of the default branches of repositories and searches it.
+// The indexes are stored on the local disk and updated in the background whenever the default branch
+// of a repository changes.
+type Service struct {
+	gitRPCClient gitrpc.Interface
+	repoStore    store.RepoStore
+	storage      *storage
+	maxFileSize  int64
+	cache        *indexCache
+
+	// queue contains the repositories waiting to be indexed, queued maps them to the commit to index.
+	// An empty commit SHA stands for the head of the default branch.
+	queueMu sync.Mutex
+	queue   []int64
+	queued  map[int64]string
+	wake    chan struct{}
+}
+
+func New(ctx context.Context,
+	config *types.Config,
+	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
+	gitRPCClient gitrpc.Interface,
+	repoStore store.RepoStore,
+) (*Service, error) {
+	service := newService(config, gitRPCClient, repoStore)
+
+	// the index is local to the instance, hence every instance consumes the events in its own group.
+	groupCodeSearch := "gitness:codesearch:" + config.InstanceID
+	_, err := gitReaderFactory.Launch(ctx, groupCodeSearch, config.InstanceID,
+		func(r *gitevents.Reader) error {
+			const idleTimeout = 5 * time.Minute
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(2),
+				))
+
+			_ = r.RegisterBranchCreated(service.indexOnBranchCreated)
+			_ = r.RegisterBranchUpdated(service.indexOnBranchUpdated)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	go service.indexWorker(ctx)
+
+	return service, nil
+}
+
+func newService(config *types.Config, gitRPCClient gitrpc.Interface, repoStore store.RepoStore) *Service {
+	return &Service{
+		gitRPCClient: gitRPCClient,
+		repoStore:    repoStore,
+		storage:      &storage{root: config.CodeSearch.IndexPath},
+		maxFileSize:  config.CodeSearch.MaxFileSize,
+		cache:        newIndexCache(config.CodeSearch.MaxCacheSize),
+		queued:       map[int64]string{},
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+func (s *Service) indexOnBranchCreated(ctx context.Context,
+	event *events.Event[*gitevents.BranchCreatedPayload],
+) error {
+	return s.indexOnBranchChange(ctx, event.Payload.RepoID, event.Payload.Ref, event.Payload.SHA)
+}
+
+func (s *Service) indexOnBranchUpdated(ctx context.Context,
+	event *events.Event[*gitevents.BranchUpdatedPayload],
+) error {
+	return s.indexOnBranchChange(ctx, event.Payload.RepoID, event.Payload.Ref, event.Payload.NewSHA)
+}
+
+// indexOnBranchChange schedules the indexing of the repository in case the default branch changed.
+func (s *Service) indexOnBranchChange(ctx context.Context, repoID int64, ref string, sha string) error {
+	repo, err := s.repoStore.Find(ctx, repoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repository: %w", err)
+	}
+
+	if ref != branchRefPrefix+repo.DefaultBranch {
+		return nil
+	}
+
+	s.Index(repo.ID, sha)
+
+	return nil
+}
+
+// Index schedules the building of the search index of the tree of the provided commit of the repository.
+// In case the commit SHA is empty, the head of the default branch of the repository is indexed.
+func (s *Service) Index(repoID int64, commitSHA string) {
+	s.queueMu.Lock()
+	if _, ok := s.queued[repoID]; !ok {
+		s.queue = append(s.queue, repoID)
+	}
+	s.queued[repoID] = commitSHA
+	s.queueMu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// indexWorker builds the scheduled indexes one at a time until the context is canceled.
+func (s *Service) indexWorker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		}
+
+		for ctx.Err() == nil {
+			repoID, commitSHA, ok := s.dequeue()
+			if !ok {
+				break
+			}
+
+			if err := s.updateIndex(ctx, repoID, commitSHA); err != nil {
+				log.Ctx(ctx).Warn().Err(err).Msgf("failed to index repo %d", repoID)
+			}
+		}
+	}
+}
+
+func (s *Service) dequeue() (int64, string, bool) {
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+
+	if len(s.queue) == 0 {
+		return 0, "", false
+	}
+
+	repoID := s.queue[0]
+	s.queue = s.queue[1:]
+
+	commitSHA := s.queued[repoID]
+	delete(s.queued, repoID)
+
+	return repoID, commitSHA, true
+}
+
+func (s *Service) updateIndex(ctx context.Context, repoID int64, commitSHA string) error {
+	repo, err := s.repoStore.Find(ctx, repoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repository: %w", err)
+	}
+
+	if commitSHA == "" {
+		ref, err := s.gitRPCClient.GetRef(ctx, gitrpc.GetRefParams{
+			ReadParams: gitrpc.ReadParams{RepoUID: repo.GitUID},
+			Name:       repo.DefaultBranch,
+			Type:       gitrpcenum.RefTypeBranch,
+		})
+		if err != nil && gitrpc.ErrorStatus(err) != gitrpc.StatusNotFound {
+			return fmt.Errorf("failed to get default branch: %w", err)
+		}
+
+		// in case the default branch doesn't exist yet (e.g. empty repository), the index stays empty.
+		commitSHA = ref.SHA
+	}
+
+	if idx := s.cache.get(repo.ID); idx != nil && idx.Branch == repo.DefaultBranch && idx.CommitSHA == commitSHA {
+		return nil
+	}
+
+	idx := newIndex(repo.DefaultBranch, commitSHA)
+	if commitSHA != "" {
+		if err = s.buildIndex(ctx, repo, idx); err != nil {
+			return err
+		}
+	}
+
+	if err = s.storage.save(repo.ID, idx); err != nil {
+		return err
+	}
+
+	s.cache.put(repo.ID, idx)
+
+	log.Ctx(ctx).Debug().Msgf("indexed %d files of repo %d at commit %s", len(idx.Documents), repo.ID, commitSHA)
+
+	return nil
+}
+
+func (s *Service) buildIndex(ctx context.Context, repo *types.Repository, idx *index) error {
+	r, err := s.gitRPCClient.GetArchive(ctx, &gitrpc.GetArchiveParams{
+		ReadParams: gitrpc.ReadParams{RepoUID: repo.GitUID},
+		GitRef:     idx.CommitSHA,
+		Format:     gitrpcenum.ArchiveFormatTar,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get archive of commit %s: %w", idx.CommitSHA, err)
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive of commit %s: %w", idx.CommitSHA, err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg || hdr.Size > s.maxFileSize {
+			continue
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return fmt.Errorf("failed to read file %q from archive: %w", hdr.Name, err)
+		}
+
+		if enry.IsBinary(content) {
+			continue
+		}
+
+		idx.add(document{
+			Path:     hdr.Name,
+			Language: enry.GetLanguage(path.Base(hdr.Name), content),
+			Content:  content,
+		})
+	}
+
+	return nil
+}
+
+// DeleteIndex removes the search index of the repository.
+func (s *Service) DeleteIndex(repoID int64) error {
+	s.queueMu.Lock()
+	delete(s.queued, repoID)
+	s.queueMu.Unlock()
+
+	s.cache.delete(repoID)
+
+	return s.storage.delete(repoID)
+}
+
+// Search searches the default branches of the repositories and returns the matching files.
+// Repositories that aren't indexed yet are skipped and scheduled for indexing. In case none of the
+// repositories is indexed yet, an error is returned.
+// NOTE: The caller is responsible for checking the permissions of the repositories.
+func (s *Service) Search(ctx context.Context,
+	repos []*types.Repository,
+	filter *types.CodeSearchFilter,
+) ([]types.CodeSearchResult, error) {
+	q, err := compileQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	size := filter.Size
+	if size <= 0 {
+		size = defaultResultSize
+	}
+	if size > maxResultSize {
+		size = maxResultSize
+	}
+
+	results := make([]types.CodeSearchResult, 0, size)
+	searched, pending := 0, 0
+	for _, repo := range repos {
+		if repo.Importing {
+			continue
+		}
+
+		idx, err := s.getIndex(repo)
+		if errors.Is(err, errNotIndexed) {
+			pending++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		searched++
+
+		for _, pos := range idx.candidates(q.trigrams) {
+			doc := &idx.Documents[pos]
+			if !q.matchDocument(doc) {
+				continue
+			}
+
+			matches, truncated := q.matchLines(doc.Content)
+			if len(matches) == 0 {
+				continue
+			}
+
+			results = append(results, types.CodeSearchResult{
+				RepoID:    repo.ID,
+				RepoPath:  repo.Path,
+				CommitSHA: idx.CommitSHA,
+				Path:      doc.Path,
+				Language:  doc.Language,
+				Matches:   matches,
+				Truncated: truncated,
+			})
+
+			if len(results) == size {
+				return results, nil
+			}
+		}
+	}
+
+	if searched == 0 && pending > 0 {
+		return nil, errNotIndexed
+	}
+
+	return results, nil
+}
+
+// getIndex returns the index of the default branch of the repository.
+// In case the repository isn't indexed yet or its default branch changed since it was indexed,
+// the indexing is scheduled and errNotIndexed is returned.
+func (s *Service) getIndex(repo *types.Repository) (*index, error) {
+	idx := s.cache.get(repo.ID)
+	if idx == nil {
+		var err error
+		idx, err = s.storage.load(repo.ID)
+		if errors.Is(err, errIndexNotFound) {
+			s.Index(repo.ID, "")
+			return nil, errNotIndexed
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load index of repo %d: %w", repo.ID, err)
+		}
+
+		s.cache.put(repo.ID, idx)
+	}
+
+	if idx.Branch != repo.DefaultBranch {
+		s.cache.delete(repo.ID)
+		s.Index(repo.ID, "")
+		return nil, errNotIndexed
+	}
+
+	return idx, nil
+}
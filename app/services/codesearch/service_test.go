@@ -0,0 +1,253 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesearch
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+)
+
+func TestSearchIndexesInBackground(t *testing.T) {
+	git := &fakeGitRPC{
+		branches: map[string]string{"main": "sha1", "dev": "sha2"},
+		trees: map[string]map[string]string{
+			"sha1": {"main.go": "package main\n\nfunc Hello() {}\n"},
+			"sha2": {"dev.go": "package dev\n\nfunc Hello() {}\n"},
+		},
+	}
+	repo := &types.Repository{ID: 1, GitUID: "repo", Path: "space/repo", DefaultBranch: "main"}
+	s := testService(t, git, repo)
+	ctx := context.Background()
+	filter := &types.CodeSearchFilter{Query: "hello"}
+
+	// the first search schedules the indexing instead of blocking on it.
+	if _, err := s.Search(ctx, []*types.Repository{repo}, filter); !errors.Is(err, errNotIndexed) {
+		t.Fatalf("expected repo not to be indexed yet, got %v", err)
+	}
+	if git.archives != 0 {
+		t.Fatalf("expected search not to build the index, got %d archive requests", git.archives)
+	}
+
+	runQueue(ctx, t, s)
+	expectPaths(t, s, repo, filter, "main.go")
+
+	// changing the default branch invalidates the index.
+	repo.DefaultBranch = "dev"
+	if _, err := s.Search(ctx, []*types.Repository{repo}, filter); !errors.Is(err, errNotIndexed) {
+		t.Fatalf("expected index of the previous default branch to be invalidated, got %v", err)
+	}
+
+	runQueue(ctx, t, s)
+	expectPaths(t, s, repo, filter, "dev.go")
+}
+
+func TestSearchSkipsReposNotIndexedYet(t *testing.T) {
+	git := &fakeGitRPC{
+		branches: map[string]string{"main": "sha1"},
+		trees:    map[string]map[string]string{"sha1": {"main.go": "func Hello() {}\n"}},
+	}
+	indexed := &types.Repository{ID: 1, GitUID: "repo1", Path: "space/repo1", DefaultBranch: "main"}
+	pending := &types.Repository{ID: 2, GitUID: "repo2", Path: "space/repo2", DefaultBranch: "main"}
+	s := testService(t, git, indexed, pending)
+	ctx := context.Background()
+
+	s.Index(indexed.ID, "")
+	runQueue(ctx, t, s)
+
+	results, err := s.Search(ctx, []*types.Repository{indexed, pending}, &types.CodeSearchFilter{Query: "hello"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || results[0].RepoID != indexed.ID {
+		t.Errorf("expected a single result from the indexed repo, got %+v", results)
+	}
+
+	if _, ok := s.queued[pending.ID]; !ok {
+		t.Errorf("expected repo that isn't indexed yet to be scheduled for indexing")
+	}
+}
+
+func TestSearchEmptyRepo(t *testing.T) {
+	git := &fakeGitRPC{}
+	repo := &types.Repository{ID: 1, GitUID: "repo", Path: "space/repo", DefaultBranch: "main"}
+	s := testService(t, git, repo)
+	ctx := context.Background()
+
+	s.Index(repo.ID, "")
+	runQueue(ctx, t, s)
+
+	results, err := s.Search(ctx, []*types.Repository{repo}, &types.CodeSearchFilter{Query: "hello"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected no results, got %+v", results)
+	}
+}
+
+func TestIndexCache(t *testing.T) {
+	newTestIndex := func(content string) *index {
+		idx := newIndex("main", "sha")
+		idx.add(document{Path: "file", Content: []byte(content)})
+		return idx
+	}
+
+	idx1 := newTestIndex("aaaa")
+	idx2 := newTestIndex("bbbb")
+	idx3 := newTestIndex("cccc")
+
+	c := newIndexCache(2*idx1.size() + 1)
+	c.put(1, idx1)
+	c.put(2, idx2)
+
+	// accessing the first index makes the second one the least recently used.
+	if c.get(1) != idx1 {
+		t.Fatalf("expected first index to be cached")
+	}
+
+	c.put(3, idx3)
+
+	if c.get(2) != nil {
+		t.Errorf("expected least recently used index to be evicted")
+	}
+	if c.get(1) != idx1 || c.get(3) != idx3 {
+		t.Errorf("expected recently used indexes to be cached")
+	}
+	if c.size > c.maxSize {
+		t.Errorf("expected cache size %d to be within limit %d", c.size, c.maxSize)
+	}
+
+	c.put(4, newTestIndex(string(make([]byte, c.maxSize))))
+	if c.get(4) != nil {
+		t.Errorf("expected index larger than the limit not to be cached")
+	}
+	if c.get(1) != idx1 || c.get(3) != idx3 {
+		t.Errorf("expected index larger than the limit not to evict other indexes")
+	}
+}
+
+func testService(t *testing.T, git *fakeGitRPC, repos ...*types.Repository) *Service {
+	config := &types.Config{}
+	config.CodeSearch.IndexPath = t.TempDir()
+	config.CodeSearch.MaxFileSize = 1 << 20
+	config.CodeSearch.MaxCacheSize = 1 << 20
+
+	repoStore := &fakeRepoStore{repos: map[int64]*types.Repository{}}
+	for _, repo := range repos {
+		repoStore.repos[repo.ID] = repo
+	}
+
+	return newService(config, git, repoStore)
+}
+
+// runQueue builds all scheduled indexes, as the index worker would.
+func runQueue(ctx context.Context, t *testing.T, s *Service) {
+	t.Helper()
+
+	for {
+		repoID, commitSHA, ok := s.dequeue()
+		if !ok {
+			return
+		}
+
+		if err := s.updateIndex(ctx, repoID, commitSHA); err != nil {
+			t.Fatalf("failed to index repo %d: %v", repoID, err)
+		}
+	}
+}
+
+func expectPaths(t *testing.T, s *Service, repo *types.Repository, filter *types.CodeSearchFilter, exp ...string) {
+	t.Helper()
+
+	results, err := s.Search(context.Background(), []*types.Repository{repo}, filter)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	paths := make([]string, len(results))
+	for i, res := range results {
+		paths[i] = res.Path
+	}
+
+	if len(paths) != len(exp) {
+		t.Fatalf("expected paths %v, got %v", exp, paths)
+	}
+	for i := range exp {
+		if paths[i] != exp[i] {
+			t.Errorf("expected paths %v, got %v", exp, paths)
+		}
+	}
+}
+
+type fakeRepoStore struct {
+	store.RepoStore
+	repos map[int64]*types.Repository
+}
+
+func (f *fakeRepoStore) Find(_ context.Context, id int64) (*types.Repository, error) {
+	repo, ok := f.repos[id]
+	if !ok {
+		return nil, errors.New("repo not found")
+	}
+
+	// return a copy, as the store would.
+	clone := *repo
+	return &clone, nil
+}
+
+type fakeGitRPC struct {
+	gitrpc.Interface
+	branches map[string]string
+	trees    map[string]map[string]string
+	archives int
+}
+
+func (f *fakeGitRPC) GetRef(_ context.Context, params gitrpc.GetRefParams) (gitrpc.GetRefResponse, error) {
+	sha, ok := f.branches[params.Name]
+	if !ok {
+		return gitrpc.GetRefResponse{}, &gitrpc.Error{Status: gitrpc.StatusNotFound, Message: "not found"}
+	}
+
+	return gitrpc.GetRefResponse{SHA: sha}, nil
+}
+
+func (f *fakeGitRPC) GetArchive(_ context.Context, params *gitrpc.GetArchiveParams) (io.Reader, error) {
+	f.archives++
+
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for name, content := range f.trees[params.GitRef] {
+		err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(content))})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tw.Write([]byte(content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
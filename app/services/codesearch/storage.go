@@ -0,0 +1,100 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesearch
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+var errIndexNotFound = errors.New("index not found")
+
+// storage persists the search indexes of the repositories on the local disk.
+type storage struct {
+	root string
+}
+
+func (s *storage) load(repoID int64) (*index, error) {
+	f, err := os.Open(s.path(repoID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errIndexNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open index file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read index file: %w", err)
+	}
+
+	idx := &index{}
+	if err = gob.NewDecoder(zr).Decode(idx); err != nil {
+		return nil, fmt.Errorf("failed to decode index: %w", err)
+	}
+
+	return idx, nil
+}
+
+func (s *storage) save(repoID int64, idx *index) error {
+	if err := os.MkdirAll(s.root, 0o700); err != nil {
+		return fmt.Errorf("failed to create index directory: %w", err)
+	}
+
+	// write to a temporary file first so a partially written index is never loaded.
+	tmp, err := os.CreateTemp(s.root, strconv.FormatInt(repoID, 10)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary index file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	zw := gzip.NewWriter(tmp)
+	err = gob.NewEncoder(zw).Encode(idx)
+	if zErr := zw.Close(); err == nil {
+		err = zErr
+	}
+	if cErr := tmp.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), s.path(repoID)); err != nil {
+		return fmt.Errorf("failed to move index file into place: %w", err)
+	}
+
+	return nil
+}
+
+func (s *storage) delete(repoID int64) error {
+	err := os.Remove(s.path(repoID))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete index file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *storage) path(repoID int64) string {
+	return filepath.Join(s.root, strconv.FormatInt(repoID, 10)+".idx")
+}
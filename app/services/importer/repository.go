@@ -196,7 +196,9 @@ func (r *Repository) Handle(ctx context.Context, data string, _ job.ProgressRepo
 		return "", fmt.Errorf("failed to parse git clone URL: %w", err)
 	}
 
-	repoURL.User = url.UserPassword(input.GitUser, input.GitPass)
+	if input.GitUser != "" || input.GitPass != "" {
+		repoURL.User = url.UserPassword(input.GitUser, input.GitPass)
+	}
 	cloneURLWithAuth := repoURL.String()
 
 	repo, err := r.repoStore.Find(ctx, input.RepoID)
@@ -255,10 +257,13 @@ func (r *Repository) Handle(ctx context.Context, data string, _ job.ProgressRepo
 			return fmt.Errorf("failed to update repository after import: %w", err)
 		}
 
-		const convertPipelinesCommitMessage = "autoconvert pipeline"
-		err = r.processPipelines(ctx, &systemPrincipal, repo, convertPipelinesCommitMessage)
-		if err != nil {
-			log.Warn().Err(err).Msg("failed to convert pipelines")
+		// mirrors must stay identical to their remote, so pipelines aren't converted for them.
+		if !repo.IsMirror {
+			const convertPipelinesCommitMessage = "autoconvert pipeline"
+			err = r.processPipelines(ctx, &systemPrincipal, repo, convertPipelinesCommitMessage)
+			if err != nil {
+				log.Warn().Err(err).Msg("failed to convert pipelines")
+			}
 		}
 
 		return nil
@@ -453,6 +458,7 @@ func (r *Repository) createEnvVars(ctx context.Context,
 		repoID,
 		principal.ID,
 		false,
+		false,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate git hook environment variables: %w", err)
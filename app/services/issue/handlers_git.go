@@ -0,0 +1,129 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	gitReferenceNamePrefixBranch = "refs/heads/"
+
+	// maxReferenceCommits is the maximum number of pushed commits scanned for issue references.
+	maxReferenceCommits = 100
+)
+
+// referenceIssuesOnBranchCreated adds references to the issues mentioned in the messages of the commits
+// that were pushed with the new branch and that aren't part of the default branch.
+func (s *Service) referenceIssuesOnBranchCreated(ctx context.Context,
+	event *events.Event[*gitevents.BranchCreatedPayload],
+) error {
+	repo, err := s.repoStore.Find(ctx, event.Payload.RepoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repository: %w", err)
+	}
+
+	after := repo.DefaultBranch
+	if strings.TrimPrefix(event.Payload.Ref, gitReferenceNamePrefixBranch) == repo.DefaultBranch {
+		after = ""
+	}
+
+	return s.referenceIssuesFromCommits(ctx, repo, event.Payload.PrincipalID, event.Payload.SHA, after)
+}
+
+// referenceIssuesOnBranchUpdated adds references to the issues mentioned in the messages of the pushed commits.
+func (s *Service) referenceIssuesOnBranchUpdated(ctx context.Context,
+	event *events.Event[*gitevents.BranchUpdatedPayload],
+) error {
+	repo, err := s.repoStore.Find(ctx, event.Payload.RepoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repository: %w", err)
+	}
+
+	return s.referenceIssuesFromCommits(ctx, repo, event.Payload.PrincipalID,
+		event.Payload.NewSHA, event.Payload.OldSHA)
+}
+
+func (s *Service) referenceIssuesFromCommits(
+	ctx context.Context,
+	repo *types.Repository,
+	principalID int64,
+	ref string,
+	after string,
+) error {
+	commits, err := s.listCommits(ctx, repo, ref, after)
+	if err != nil {
+		return err
+	}
+
+	for i := range commits {
+		commit := &commits[i]
+		for _, reference := range ParseReferences(commit.Message) {
+			issue, err := s.findIssue(ctx, repo.ID, reference.Number)
+			if err != nil {
+				return err
+			}
+			if issue == nil {
+				continue
+			}
+
+			err = s.addReference(ctx, repo, issue, principalID, &types.IssueActivityPayloadReference{
+				Type:      enum.IssueReferenceTypeCommit,
+				CommitSHA: commit.SHA,
+				Title:     commit.Title,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add commit reference to issue %d: %w", issue.Number, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// listCommits returns the commits reachable from ref but not from after, oldest first.
+func (s *Service) listCommits(
+	ctx context.Context,
+	repo *types.Repository,
+	ref string,
+	after string,
+) ([]gitrpc.Commit, error) {
+	output, err := s.gitRPCClient.ListCommits(ctx, &gitrpc.ListCommitsParams{
+		ReadParams: gitrpc.CreateRPCReadParams(repo),
+		GitREF:     ref,
+		After:      after,
+		Page:       1,
+		Limit:      maxReferenceCommits,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits: %w", err)
+	}
+
+	// commits are listed newest first
+	commits := output.Commits
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+
+	return commits, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// referenceIssuesOnPullReqCreated adds references to the issues mentioned in the pull request title or description.
+func (s *Service) referenceIssuesOnPullReqCreated(ctx context.Context,
+	event *events.Event[*pullreqevents.CreatedPayload],
+) error {
+	repo, err := s.repoStore.Find(ctx, event.Payload.TargetRepoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repository: %w", err)
+	}
+
+	pr, err := s.pullreqStore.Find(ctx, event.Payload.PullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to find pull request: %w", err)
+	}
+
+	for _, reference := range ParseReferences(pr.Title + "\n" + pr.Description) {
+		issue, err := s.findIssue(ctx, repo.ID, reference.Number)
+		if err != nil {
+			return err
+		}
+		if issue == nil {
+			continue
+		}
+
+		err = s.addReference(ctx, repo, issue, event.Payload.PrincipalID, &types.IssueActivityPayloadReference{
+			Type:          enum.IssueReferenceTypePullReq,
+			PullReqNumber: pr.Number,
+			Title:         pr.Title,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add pull request reference to issue %d: %w", issue.Number, err)
+		}
+	}
+
+	return nil
+}
+
+// closeIssuesOnPullReqMerged closes the issues referenced with a closing keyword ("fixes #123")
+// in the pull request title, description or commit messages.
+// Only pull requests merged into the default branch of the repository close issues.
+func (s *Service) closeIssuesOnPullReqMerged(ctx context.Context,
+	event *events.Event[*pullreqevents.MergedPayload],
+) error {
+	repo, err := s.repoStore.Find(ctx, event.Payload.TargetRepoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repository: %w", err)
+	}
+
+	pr, err := s.pullreqStore.Find(ctx, event.Payload.PullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to find pull request: %w", err)
+	}
+
+	if pr.TargetBranch != repo.DefaultBranch {
+		return nil
+	}
+
+	references := ParseReferences(pr.Title + "\n" + pr.Description)
+
+	// the source commits are available in the target repository after the merge.
+	commits, err := s.listCommits(ctx, repo, event.Payload.SourceSHA, pr.MergeBaseSHA)
+	if err != nil {
+		return err
+	}
+
+	for i := range commits {
+		references = append(references, ParseReferences(commits[i].Message)...)
+	}
+
+	closed := make(map[int64]struct{})
+	for _, reference := range references {
+		if _, ok := closed[reference.Number]; ok || !reference.Closing {
+			continue
+		}
+
+		closed[reference.Number] = struct{}{}
+
+		if err = s.closeIssue(ctx, repo, pr, event.Payload.PrincipalID, reference.Number); err != nil {
+			return fmt.Errorf("failed to close issue %d: %w", reference.Number, err)
+		}
+	}
+
+	return nil
+}
+
+// errIssueClosed is returned when the issue that should be closed is closed already.
+var errIssueClosed = errors.New("issue is closed already")
+
+// closeIssue closes the issue, adds the closing reference of the pull request and writes the state change.
+// All changes are written in a single transaction, so a retried event never leaves a partially closed issue.
+func (s *Service) closeIssue(
+	ctx context.Context,
+	repo *types.Repository,
+	pr *types.PullReq,
+	principalID int64,
+	number int64,
+) error {
+	issue, err := s.findIssue(ctx, repo.ID, number)
+	if err != nil {
+		return err
+	}
+	if issue == nil {
+		return nil
+	}
+
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		issue, err = s.issueStore.UpdateOptLock(ctx, issue, func(issue *types.Issue) error {
+			if issue.State == enum.IssueStateClosed {
+				return errIssueClosed
+			}
+
+			now := time.Now().UnixMilli()
+			issue.State = enum.IssueStateClosed
+			issue.Edited = now
+			issue.ClosedBy = &principalID
+			issue.Closed = &now
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		issue, err = s.createReference(ctx, issue, principalID, &types.IssueActivityPayloadReference{
+			Type:          enum.IssueReferenceTypePullReq,
+			PullReqNumber: pr.Number,
+			Title:         pr.Title,
+			Closing:       true,
+		})
+		if err != nil && !errors.Is(err, errReferenceExists) {
+			return err
+		}
+
+		issue, err = s.issueStore.UpdateActivitySeq(ctx, issue)
+		if err != nil {
+			return fmt.Errorf("failed to update activity sequence of issue: %w", err)
+		}
+
+		payload := &types.IssueActivityPayloadStateChange{
+			Old:     enum.IssueStateOpen,
+			New:     enum.IssueStateClosed,
+			Message: fmt.Sprintf("Closed by pull request #%d", pr.Number),
+		}
+		_, err = s.activityStore.CreateWithPayloadForIssue(ctx, issue, principalID, payload)
+		return err
+	})
+	if errors.Is(err, errIssueClosed) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.publishUpdate(ctx, repo, issue)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// referenceRegexp matches issue references like "#123" optionally preceded by a closing keyword ("fixes #123").
+// The reference must not be preceded by a word character to avoid matching URL fragments or HTML entities.
+var referenceRegexp = regexp.MustCompile(
+	`(?i)(?:^|[^\w&/#])(?:(close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+)?#(\d+)\b`)
+
+// Reference is a reference to an issue found in a text.
+type Reference struct {
+	Number  int64
+	Closing bool
+}
+
+// ParseReferences returns all issue references found in the text in order of their first appearance.
+// A reference is closing if any of its occurrences is preceded by a closing keyword.
+func ParseReferences(text string) []Reference {
+	matches := referenceRegexp.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	var refs []Reference
+	idx := make(map[int64]int, len(matches))
+
+	for _, match := range matches {
+		number, err := strconv.ParseInt(match[2], 10, 64)
+		if err != nil || number <= 0 {
+			continue
+		}
+
+		closing := match[1] != ""
+
+		if i, ok := idx[number]; ok {
+			refs[i].Closing = refs[i].Closing || closing
+			continue
+		}
+
+		idx[number] = len(refs)
+		refs = append(refs, Reference{Number: number, Closing: closing})
+	}
+
+	return refs
+}
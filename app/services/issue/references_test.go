@@ -0,0 +1,68 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseReferences(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		exp  []Reference
+	}{
+		{
+			name: "none",
+			text: "add feature",
+			exp:  nil,
+		},
+		{
+			name: "plain",
+			text: "see #12 and #3",
+			exp:  []Reference{{Number: 12}, {Number: 3}},
+		},
+		{
+			name: "closing",
+			text: "Fixes #1, closes #2\nresolved: #3",
+			exp:  []Reference{{Number: 1, Closing: true}, {Number: 2, Closing: true}, {Number: 3, Closing: true}},
+		},
+		{
+			name: "keyword applies only to the next reference",
+			text: "fix #4 #5",
+			exp:  []Reference{{Number: 4, Closing: true}, {Number: 5}},
+		},
+		{
+			name: "duplicates",
+			text: "#7 is related, this fixes #7",
+			exp:  []Reference{{Number: 7, Closing: true}},
+		},
+		{
+			name: "ignored",
+			text: "http://host/page#8 &#9; abc#10 ##11 #0 #12abc",
+			exp:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			refs := ParseReferences(test.text)
+			if !reflect.DeepEqual(test.exp, refs) {
+				t.Errorf("references mismatch; want=%+v got=%+v", test.exp, refs)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gitevents "github.com/harness/gitness/app/events/git"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/gitrpc"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/stream"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Service links issues with the commits and the pull requests that reference them
+// and closes the issues referenced with a closing keyword once the pull request is merged.
+type Service struct {
+	tx            dbtx.Transactor
+	gitRPCClient  gitrpc.Interface
+	repoStore     store.RepoStore
+	pullreqStore  store.PullReqStore
+	issueStore    store.IssueStore
+	activityStore store.PullReqActivityStore
+	sseStreamer   sse.Streamer
+}
+
+func New(ctx context.Context,
+	config *types.Config,
+	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
+	pullreqEvReaderFactory *events.ReaderFactory[*pullreqevents.Reader],
+	tx dbtx.Transactor,
+	gitRPCClient gitrpc.Interface,
+	repoStore store.RepoStore,
+	pullreqStore store.PullReqStore,
+	issueStore store.IssueStore,
+	activityStore store.PullReqActivityStore,
+	sseStreamer sse.Streamer,
+) (*Service, error) {
+	service := &Service{
+		tx:            tx,
+		gitRPCClient:  gitRPCClient,
+		repoStore:     repoStore,
+		pullreqStore:  pullreqStore,
+		issueStore:    issueStore,
+		activityStore: activityStore,
+		sseStreamer:   sseStreamer,
+	}
+
+	const groupGit = "gitness:issue:git"
+	_, err := gitReaderFactory.Launch(ctx, groupGit, config.InstanceID,
+		func(r *gitevents.Reader) error {
+			const idleTimeout = 15 * time.Second
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(3),
+				))
+
+			_ = r.RegisterBranchCreated(service.referenceIssuesOnBranchCreated)
+			_ = r.RegisterBranchUpdated(service.referenceIssuesOnBranchUpdated)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	const groupPullReq = "gitness:issue:pullreq"
+	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReq, config.InstanceID,
+		func(r *pullreqevents.Reader) error {
+			const idleTimeout = 15 * time.Second
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(3),
+				))
+
+			_ = r.RegisterCreated(service.referenceIssuesOnPullReqCreated)
+			_ = r.RegisterMerged(service.closeIssuesOnPullReqMerged)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// findIssue returns the issue of the repository with the provided number or nil if the issue doesn't exist.
+func (s *Service) findIssue(ctx context.Context, repoID, number int64) (*types.Issue, error) {
+	issue, err := s.issueStore.FindByNumber(ctx, repoID, number)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return nil, nil //nolint:nilnil // a reference to a non-existing issue is ignored
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find issue %d: %w", number, err)
+	}
+
+	return issue, nil
+}
+
+// errReferenceExists is returned when the issue already has the reference that should be added.
+var errReferenceExists = errors.New("issue already has the reference")
+
+// addReference writes the reference activity to the issue, unless the issue already has the same reference.
+// The event handlers are retried, hence adding the same reference again must not create another activity.
+func (s *Service) addReference(
+	ctx context.Context,
+	repo *types.Repository,
+	issue *types.Issue,
+	principalID int64,
+	ref *types.IssueActivityPayloadReference,
+) error {
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		issue, err = s.createReference(ctx, issue, principalID, ref)
+		return err
+	})
+	if errors.Is(err, errReferenceExists) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.publishUpdate(ctx, repo, issue)
+
+	return nil
+}
+
+// createReference writes the reference activity to the issue and returns the updated issue.
+// In case the issue already has the same reference, errReferenceExists is returned.
+// It must be called within a transaction.
+func (s *Service) createReference(
+	ctx context.Context,
+	issue *types.Issue,
+	principalID int64,
+	ref *types.IssueActivityPayloadReference,
+) (*types.Issue, error) {
+	// the activity sequence is updated first: the update locks the issue until the transaction ends,
+	// so concurrent handlers can't both see the reference missing and add it twice.
+	issue, err := s.issueStore.UpdateActivitySeq(ctx, issue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update activity sequence of issue: %w", err)
+	}
+
+	activities, err := s.activityStore.ListForIssue(ctx, issue.ID, &types.PullReqActivityFilter{
+		Types: []enum.PullReqActivityType{enum.PullReqActivityTypeReference},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue references: %w", err)
+	}
+
+	for _, act := range activities {
+		payload, err := act.GetPayload()
+		if err != nil {
+			continue
+		}
+
+		existing, ok := payload.(*types.IssueActivityPayloadReference)
+		if ok && existing.Type == ref.Type && existing.CommitSHA == ref.CommitSHA &&
+			existing.PullReqNumber == ref.PullReqNumber && existing.Closing == ref.Closing {
+			return issue, errReferenceExists
+		}
+	}
+
+	if _, err = s.activityStore.CreateWithPayloadForIssue(ctx, issue, principalID, ref); err != nil {
+		return nil, err
+	}
+
+	return issue, nil
+}
+
+func (s *Service) publishUpdate(ctx context.Context, repo *types.Repository, issue *types.Issue) {
+	if err := s.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypeIssueUpdated, issue); err != nil {
+		log.Ctx(ctx).Warn().Msg("failed to publish issue changed event")
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"testing"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/events"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestReferenceIssuesOnPullReqCreated(t *testing.T) {
+	s, _, activities := testService()
+
+	event := &events.Event[*pullreqevents.CreatedPayload]{
+		Payload: &pullreqevents.CreatedPayload{
+			Base: pullreqevents.Base{PullReqID: 1, TargetRepoID: 1, PrincipalID: 1},
+		},
+	}
+
+	// the event handlers are retried, handling the same event again must not add another reference.
+	for i := 0; i < 2; i++ {
+		if err := s.referenceIssuesOnPullReqCreated(context.Background(), event); err != nil {
+			t.Fatalf("failed to handle event: %v", err)
+		}
+	}
+
+	refs := activities.ofType(enum.PullReqActivityTypeReference)
+	if len(refs) != 1 {
+		t.Fatalf("expected one reference, got %d", len(refs))
+	}
+	if refs[0].IssueID != 1 || refs[0].PullReqID != 0 {
+		t.Errorf("unexpected reference owner: issue=%d pullreq=%d", refs[0].IssueID, refs[0].PullReqID)
+	}
+}
+
+func TestCloseIssue(t *testing.T) {
+	s, issues, activities := testService()
+	repo := &types.Repository{ID: 1}
+	pr := &types.PullReq{ID: 1, Number: 7, Title: "fixes #1"}
+
+	for i := 0; i < 2; i++ {
+		if err := s.closeIssue(context.Background(), repo, pr, 1, 1); err != nil {
+			t.Fatalf("failed to close issue: %v", err)
+		}
+	}
+
+	issue := issues.issue
+	if issue.State != enum.IssueStateClosed || issue.ClosedBy == nil || *issue.ClosedBy != 1 {
+		t.Errorf("expected issue closed by principal 1, got state %s", issue.State)
+	}
+
+	refs := activities.ofType(enum.PullReqActivityTypeReference)
+	if len(refs) != 1 {
+		t.Fatalf("expected one reference, got %d", len(refs))
+	}
+
+	payload, err := refs[0].GetPayload()
+	if err != nil {
+		t.Fatalf("failed to get reference payload: %v", err)
+	}
+	if ref, ok := payload.(*types.IssueActivityPayloadReference); !ok || !ref.Closing || ref.PullReqNumber != 7 {
+		t.Errorf("unexpected reference payload: %#v", payload)
+	}
+
+	if n := len(activities.ofType(enum.PullReqActivityTypeIssueStateChange)); n != 1 {
+		t.Errorf("expected one state change, got %d", n)
+	}
+}
+
+func testService() (*Service, *fakeIssueStore, *fakeActivityStore) {
+	issues := &fakeIssueStore{issue: &types.Issue{ID: 1, Number: 1, RepoID: 1, State: enum.IssueStateOpen}}
+	activities := &fakeActivityStore{}
+
+	s := &Service{
+		tx:        fakeTransactor{},
+		repoStore: &fakeRepoStore{repo: &types.Repository{ID: 1}},
+		pullreqStore: &fakePullReqStore{pr: &types.PullReq{ID: 1, Number: 7, TargetRepoID: 1,
+			Title: "fixes #1"}},
+		issueStore:    issues,
+		activityStore: activities,
+		sseStreamer:   fakeStreamer{},
+	}
+
+	return s, issues, activities
+}
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...interface{}) error {
+	return txFn(ctx)
+}
+
+type fakeRepoStore struct {
+	store.RepoStore
+	repo *types.Repository
+}
+
+func (f *fakeRepoStore) Find(_ context.Context, id int64) (*types.Repository, error) {
+	if id != f.repo.ID {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return f.repo, nil
+}
+
+type fakePullReqStore struct {
+	store.PullReqStore
+	pr *types.PullReq
+}
+
+func (f *fakePullReqStore) Find(_ context.Context, id int64) (*types.PullReq, error) {
+	if id != f.pr.ID {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return f.pr, nil
+}
+
+type fakeIssueStore struct {
+	store.IssueStore
+	issue *types.Issue
+}
+
+func (f *fakeIssueStore) FindByNumber(_ context.Context, repoID int64, num int64) (*types.Issue, error) {
+	if repoID != f.issue.RepoID || num != f.issue.Number {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	issue := *f.issue
+	return &issue, nil
+}
+
+// UpdateOptLock applies the mutation to the latest version of the issue, as the real store does on conflicts.
+func (f *fakeIssueStore) UpdateOptLock(_ context.Context,
+	_ *types.Issue, mutateFn func(issue *types.Issue) error,
+) (*types.Issue, error) {
+	updated := *f.issue
+	if err := mutateFn(&updated); err != nil {
+		return nil, err
+	}
+	*f.issue = updated
+	return &updated, nil
+}
+
+func (f *fakeIssueStore) UpdateActivitySeq(_ context.Context, issue *types.Issue) (*types.Issue, error) {
+	f.issue.ActivitySeq++
+	updated := *issue
+	updated.ActivitySeq = f.issue.ActivitySeq
+	return &updated, nil
+}
+
+type fakeActivityStore struct {
+	store.PullReqActivityStore
+	acts []*types.PullReqActivity
+}
+
+func (f *fakeActivityStore) ofType(actType enum.PullReqActivityType) []*types.PullReqActivity {
+	var acts []*types.PullReqActivity
+	for _, act := range f.acts {
+		if act.Type == actType {
+			acts = append(acts, act)
+		}
+	}
+	return acts
+}
+
+func (f *fakeActivityStore) ListForIssue(_ context.Context,
+	issueID int64, filter *types.PullReqActivityFilter,
+) ([]*types.PullReqActivity, error) {
+	var acts []*types.PullReqActivity
+	for _, act := range f.acts {
+		if act.IssueID != issueID {
+			continue
+		}
+		for _, actType := range filter.Types {
+			if act.Type == actType {
+				acts = append(acts, act)
+			}
+		}
+	}
+	return acts, nil
+}
+
+func (f *fakeActivityStore) CreateWithPayloadForIssue(_ context.Context,
+	issue *types.Issue, principalID int64, payload types.PullReqActivityPayload,
+) (*types.PullReqActivity, error) {
+	act := &types.PullReqActivity{
+		ID:        int64(len(f.acts) + 1),
+		CreatedBy: principalID,
+		RepoID:    issue.RepoID,
+		IssueID:   issue.ID,
+		Order:     issue.ActivitySeq,
+		Type:      payload.ActivityType(),
+		Kind:      enum.PullReqActivityKindSystem,
+	}
+	_ = act.SetPayload(payload)
+	f.acts = append(f.acts, act)
+	return act, nil
+}
+
+type fakeStreamer struct {
+	sse.Streamer
+}
+
+func (fakeStreamer) Publish(context.Context, int64, enum.SSEType, any) error {
+	return nil
+}
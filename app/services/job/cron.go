@@ -0,0 +1,71 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gorhill/cronexpr"
+)
+
+// cronTimezonePrefix is an optional prefix of a cron definition that specifies
+// the time zone in which the cron expression is evaluated, e.g. "CRON_TZ=Europe/Berlin 0 2 * * *".
+// Cron definitions without the prefix are evaluated in the local time zone of the server.
+const cronTimezonePrefix = "CRON_TZ="
+
+// CronDefinition returns a cron definition for the provided cron expression and time zone.
+func CronDefinition(cronExp, timezone string) string {
+	if timezone == "" {
+		return cronExp
+	}
+
+	return cronTimezonePrefix + timezone + " " + cronExp
+}
+
+// ParseCron parses the cron definition that can optionally specify the time zone.
+func ParseCron(cronDef string) (*cronexpr.Expression, *time.Location, error) {
+	loc := time.Local
+
+	if strings.HasPrefix(cronDef, cronTimezonePrefix) {
+		tz, exp, _ := strings.Cut(strings.TrimPrefix(cronDef, cronTimezonePrefix), " ")
+
+		var err error
+		loc, err = time.LoadLocation(tz)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid time zone: %w", err)
+		}
+
+		cronDef = exp
+	}
+
+	cronExp, err := cronexpr.Parse(strings.TrimSpace(cronDef))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cronExp, loc, nil
+}
+
+// nextCronTime returns the first time after now at which the cron definition is satisfied.
+func nextCronTime(cronDef string, now time.Time) (time.Time, error) {
+	cronExp, loc, err := ParseCron(cronDef)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cronExp.Next(now.In(loc)), nil
+}
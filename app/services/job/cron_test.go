@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextCronTime(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		cronDef string
+		exp     time.Time
+		expErr  bool
+	}{
+		{
+			name:    "utc",
+			cronDef: CronDefinition("0 2 * * *", "UTC"),
+			exp:     time.Date(2023, 10, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "timezone",
+			cronDef: CronDefinition("0 2 * * *", "Europe/Berlin"),
+			exp:     time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), // CEST is UTC+2
+		},
+		{
+			name:    "invalid-timezone",
+			cronDef: CronDefinition("0 2 * * *", "Mars/Olympus"),
+			expErr:  true,
+		},
+		{
+			name:    "invalid-expression",
+			cronDef: CronDefinition("0 25 * * *", "UTC"),
+			expErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := nextCronTime(test.cronDef, now)
+			if test.expErr {
+				if err == nil {
+					t.Errorf("expected an error, got next time %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Equal(test.exp) {
+				t.Errorf("want: %s, got: %s", test.exp, got)
+			}
+		})
+	}
+}
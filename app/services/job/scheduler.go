@@ -25,10 +25,10 @@ import (
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/lock"
 	"github.com/harness/gitness/pubsub"
+	gitness_store "github.com/harness/gitness/store"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
-	"github.com/gorhill/cronexpr"
 	"github.com/rs/zerolog/log"
 )
 
@@ -75,6 +75,8 @@ func NewScheduler(
 		maxRunning:     maxRunning,
 		purgeMinOldAge: purgeMinOldAge,
 
+		signal: make(chan time.Time, 1),
+
 		cancelJobMap: map[string]context.CancelFunc{},
 	}, nil
 }
@@ -112,8 +114,6 @@ func (s *Scheduler) Run(ctx context.Context) error {
 	s.done = make(chan struct{})
 	defer close(s.done)
 
-	s.signal = make(chan time.Time, 1)
-
 	timer := newSchedulerTimer()
 	defer timer.Stop()
 
@@ -447,6 +447,12 @@ func (s *Scheduler) runJob(ctx context.Context, j *types.Job) {
 		}
 
 		job, err := s.store.Find(backgroundCtx, jobUID)
+		if errors.Is(err, gitness_store.ErrResourceNotFound) {
+			// recurring jobs can be removed while running, e.g. by their own handler.
+			log.Ctx(ctx).Info().Msg("job removed during execution")
+			s.scheduleIfHaveMoreJobs()
+			return
+		}
 		if err != nil {
 			log.Ctx(ctx).Err(err).Msg("failed to find job after execution")
 			return
@@ -595,7 +601,7 @@ func postExec(job *types.Job, resultData, resultErr string) {
 			job.ConsecutiveFailures = 0
 		}
 
-		exp, err := cronexpr.Parse(job.RecurringCron)
+		nextExec, err := nextCronTime(job.RecurringCron, now)
 		if err != nil {
 			job.State = enum.JobStateFailed
 
@@ -607,7 +613,7 @@ func postExec(job *types.Job, resultData, resultErr string) {
 			job.LastFailureError = messages
 		} else {
 			job.State = enum.JobStateScheduled
-			job.Scheduled = exp.Next(now).UnixMilli()
+			job.Scheduled = nextExec.UnixMilli()
 		}
 
 		return
@@ -674,15 +680,27 @@ func (s *Scheduler) AddRecurring(
 	cronDef string,
 	maxDur time.Duration,
 ) error {
-	cronExp, err := cronexpr.Parse(cronDef)
-	if err != nil {
-		return fmt.Errorf("invalid cron definition string for job type=%s: %w", jobType, err)
-	}
+	return s.AddRecurringWithData(ctx, jobUID, jobType, cronDef, "", maxDur)
+}
 
+// AddRecurringWithData adds or updates a recurring job. The provided data is passed
+// to the job handler on every execution. The cron definition can optionally start
+// with a time zone prefix, see CronDefinition.
+func (s *Scheduler) AddRecurringWithData(
+	ctx context.Context,
+	jobUID,
+	jobType,
+	cronDef,
+	data string,
+	maxDur time.Duration,
+) error {
 	now := time.Now()
 	nowMilli := now.UnixMilli()
 
-	nextExec := cronExp.Next(now)
+	nextExec, err := nextCronTime(cronDef, now)
+	if err != nil {
+		return fmt.Errorf("invalid cron definition string for job type=%s: %w", jobType, err)
+	}
 
 	job := &types.Job{
 		UID:                 jobUID,
@@ -690,7 +708,7 @@ func (s *Scheduler) AddRecurring(
 		Updated:             nowMilli,
 		Type:                jobType,
 		Priority:            enum.JobPriorityElevated,
-		Data:                "",
+		Data:                data,
 		Result:              "",
 		MaxDurationSeconds:  int(maxDur / time.Second),
 		MaxRetries:          0,
@@ -712,6 +730,18 @@ func (s *Scheduler) AddRecurring(
 		return fmt.Errorf("failed to upsert job id=%s type=%s: %w", jobUID, jobType, err)
 	}
 
+	s.scheduleProcessing(nextExec)
+
+	return nil
+}
+
+// RemoveRecurring removes a recurring job. Removing a job that doesn't exist is not an error.
+func (s *Scheduler) RemoveRecurring(ctx context.Context, jobUID string) error {
+	err := s.store.Delete(ctx, jobUID)
+	if err != nil {
+		return fmt.Errorf("failed to delete job id=%s: %w", jobUID, err)
+	}
+
 	return nil
 }
 
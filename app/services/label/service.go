@@ -0,0 +1,157 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Service provides the labels available to repositories and spaces.
+// Labels defined in a space are inherited by all repositories and child spaces of the space.
+type Service struct {
+	labelStore store.LabelStore
+	repoStore  store.RepoStore
+	spaceStore store.SpaceStore
+}
+
+func NewService(
+	labelStore store.LabelStore,
+	repoStore store.RepoStore,
+	spaceStore store.SpaceStore,
+) *Service {
+	return &Service{
+		labelStore: labelStore,
+		repoStore:  repoStore,
+		spaceStore: spaceStore,
+	}
+}
+
+// List returns the labels of the repo or space together with the total count.
+// If requested by the filter, the labels of all ancestor spaces are included.
+func (s *Service) List(ctx context.Context,
+	parentType enum.LabelParent,
+	parentID int64,
+	filter *types.LabelFilter,
+) ([]*types.Label, int64, error) {
+	repoID, spaceIDs, err := s.scope(ctx, parentType, parentID, filter.Inherited)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.labelStore.Count(ctx, repoID, spaceIDs, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count labels: %w", err)
+	}
+
+	labels, err := s.labelStore.List(ctx, repoID, spaceIDs, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	return labels, count, nil
+}
+
+// FindForRepo returns the label with the provided id if it's available in the repository,
+// meaning it's defined in the repository itself or in any of its ancestor spaces.
+func (s *Service) FindForRepo(ctx context.Context, repo *types.Repository, labelID int64) (*types.Label, error) {
+	label, err := s.labelStore.Find(ctx, labelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find label: %w", err)
+	}
+
+	switch label.ParentType {
+	case enum.LabelParentRepo:
+		if label.ParentID == repo.ID {
+			return label, nil
+		}
+	case enum.LabelParentSpace:
+		spaceIDs, err := s.ancestorSpaceIDs(ctx, repo.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, spaceID := range spaceIDs {
+			if label.ParentID == spaceID {
+				return label, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("label %d isn't available in repo %d: %w", labelID, repo.ID,
+		gitness_store.ErrResourceNotFound)
+}
+
+// scope returns the repo and spaces whose labels belong to the provided parent.
+func (s *Service) scope(ctx context.Context,
+	parentType enum.LabelParent,
+	parentID int64,
+	inherited bool,
+) (int64, []int64, error) {
+	switch parentType {
+	case enum.LabelParentRepo:
+		if !inherited {
+			return parentID, nil, nil
+		}
+
+		repo, err := s.repoStore.Find(ctx, parentID)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to find repo: %w", err)
+		}
+
+		spaceIDs, err := s.ancestorSpaceIDs(ctx, repo.ParentID)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return repo.ID, spaceIDs, nil
+
+	case enum.LabelParentSpace:
+		if !inherited {
+			return 0, []int64{parentID}, nil
+		}
+
+		spaceIDs, err := s.ancestorSpaceIDs(ctx, parentID)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return 0, spaceIDs, nil
+
+	default:
+		return 0, nil, fmt.Errorf("label parent type '%s' is not supported", parentType)
+	}
+}
+
+// ancestorSpaceIDs returns the id of the provided space followed by the ids of all its ancestors.
+func (s *Service) ancestorSpaceIDs(ctx context.Context, spaceID int64) ([]int64, error) {
+	var spaceIDs []int64
+	for spaceID > 0 {
+		space, err := s.spaceStore.Find(ctx, spaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find space %d: %w", spaceID, err)
+		}
+
+		spaceIDs = append(spaceIDs, space.ID)
+		spaceID = space.ParentID
+	}
+
+	return spaceIDs, nil
+}
@@ -0,0 +1,337 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldapsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/audit"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/groupmapping"
+	"github.com/harness/gitness/app/auth/ldap"
+	"github.com/harness/gitness/app/bootstrap"
+	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType        = "gitness:ldap:group-sync"
+	jobMaxDuration = 30 * time.Minute
+
+	// membershipPageSize is the page size used to list the existing memberships of a space.
+	membershipPageSize = 100
+)
+
+var _ job.Handler = (*Service)(nil)
+
+// directory provides the group members of the directory, it's implemented by ldap.Authenticator.
+type directory interface {
+	Enabled() bool
+	GroupMappings() []groupmapping.Mapping
+	GroupMembers(ctx context.Context, group string) ([]*ldap.Entry, error)
+}
+
+// Service syncs the groups of the directory into space memberships.
+// Only memberships managed by the sync (source ldap) are ever updated or removed,
+// memberships that were granted manually are left untouched.
+type Service struct {
+	config          *types.Config
+	authenticator   directory
+	principalStore  store.PrincipalStore
+	spaceStore      store.SpaceStore
+	membershipStore store.MembershipStore
+	scheduler       *job.Scheduler
+	auditRecorder   audit.Recorder
+
+	// systemSession returns the session the sync acts with, it's replaced in tests.
+	systemSession func() *auth.Session
+}
+
+func New(
+	config *types.Config,
+	authenticator directory,
+	principalStore store.PrincipalStore,
+	spaceStore store.SpaceStore,
+	membershipStore store.MembershipStore,
+	scheduler *job.Scheduler,
+	auditRecorder audit.Recorder,
+) *Service {
+	return &Service{
+		config:          config,
+		authenticator:   authenticator,
+		principalStore:  principalStore,
+		spaceStore:      spaceStore,
+		membershipStore: membershipStore,
+		scheduler:       scheduler,
+		auditRecorder:   auditRecorder,
+		systemSession:   bootstrap.NewSystemServiceSession,
+	}
+}
+
+// Register registers the recurring job that syncs the groups of the directory.
+func (s *Service) Register(ctx context.Context) error {
+	if !s.authenticator.Enabled() || len(s.authenticator.GroupMappings()) == 0 {
+		return nil
+	}
+
+	err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.config.LDAP.GroupSyncCron, jobMaxDuration)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for LDAP group sync: %w", err)
+	}
+
+	return nil
+}
+
+// Handle is the LDAP group sync background job handler.
+func (s *Service) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	if !s.authenticator.Enabled() {
+		return "", nil
+	}
+
+	desired, err := s.desiredMemberships(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.addUnmappedSpaces(ctx, desired)
+	if err != nil {
+		return "", err
+	}
+
+	session := s.systemSession()
+
+	var created, updated, deleted int
+	for _, spaceMembers := range desired {
+		c, u, d, err := s.syncSpace(ctx, session, spaceMembers.space, spaceMembers.members)
+		if err != nil {
+			return "", fmt.Errorf("failed to sync memberships of space %d: %w", spaceMembers.space.ID, err)
+		}
+
+		created += c
+		updated += u
+		deleted += d
+	}
+
+	return fmt.Sprintf("created %d, updated %d and deleted %d memberships", created, updated, deleted), nil
+}
+
+// desiredSpace contains the members a mapped space should have.
+type desiredSpace struct {
+	space *types.Space
+	// members are the desired members of the space by principal ID.
+	members map[int64]desiredMember
+}
+
+type desiredMember struct {
+	uid  string
+	role enum.MembershipRole
+}
+
+// desiredMemberships returns the roles the members of the mapped groups should have in the mapped spaces.
+// Directory members without a user provisioned from the directory (they never logged in) are skipped.
+// If a user is a member of several groups mapping to the same space, the mapping that is configured first wins.
+func (s *Service) desiredMemberships(ctx context.Context) (map[int64]*desiredSpace, error) {
+	desired := make(map[int64]*desiredSpace)
+
+	for _, mapping := range s.authenticator.GroupMappings() {
+		space, err := s.spaceStore.FindByRef(ctx, mapping.SpacePath)
+		if errors.Is(err, gitness_store.ErrResourceNotFound) {
+			log.Ctx(ctx).Warn().
+				Str("group", mapping.Group).
+				Str("space_path", mapping.SpacePath).
+				Msg("space of LDAP group mapping doesn't exist")
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find space %q: %w", mapping.SpacePath, err)
+		}
+
+		// an error aborts the whole sync, otherwise memberships would be removed if the directory isn't available.
+		members, err := s.authenticator.GroupMembers(ctx, mapping.Group)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get members of group %q: %w", mapping.Group, err)
+		}
+
+		spaceMembers, ok := desired[space.ID]
+		if !ok {
+			spaceMembers = &desiredSpace{space: space, members: make(map[int64]desiredMember)}
+			desired[space.ID] = spaceMembers
+		}
+
+		for _, member := range members {
+			// the uid of the user might differ from the directory uid (sanitized), hence the link is used.
+			user, err := s.principalStore.FindUserByExternalID(ctx, enum.UserSourceLDAP, member.UID)
+			if errors.Is(err, gitness_store.ErrResourceNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to find user %q: %w", member.UID, err)
+			}
+
+			if _, ok := spaceMembers.members[user.ID]; !ok {
+				spaceMembers.members[user.ID] = desiredMember{uid: user.UID, role: mapping.Role}
+			}
+		}
+	}
+
+	return desired, nil
+}
+
+// addUnmappedSpaces adds all spaces with memberships managed by the sync that aren't mapped (anymore)
+// without any desired members, so that the memberships granted through removed mappings are revoked.
+func (s *Service) addUnmappedSpaces(ctx context.Context, desired map[int64]*desiredSpace) error {
+	spaceIDs, err := s.membershipStore.ListSpaceIDsBySource(ctx, enum.MembershipSourceLDAP)
+	if err != nil {
+		return fmt.Errorf("failed to list spaces with LDAP memberships: %w", err)
+	}
+
+	for _, spaceID := range spaceIDs {
+		if _, ok := desired[spaceID]; ok {
+			continue
+		}
+
+		space, err := s.spaceStore.Find(ctx, spaceID)
+		if err != nil {
+			return fmt.Errorf("failed to find space %d: %w", spaceID, err)
+		}
+
+		desired[spaceID] = &desiredSpace{space: space, members: make(map[int64]desiredMember)}
+	}
+
+	return nil
+}
+
+// syncSpace creates, updates and removes the memberships of a space managed by the sync.
+func (s *Service) syncSpace(
+	ctx context.Context,
+	session *auth.Session,
+	space *types.Space,
+	members map[int64]desiredMember,
+) (int, int, int, error) {
+	existing, err := s.listMemberships(ctx, space.ID)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	now := time.Now().UnixMilli()
+	var created, updated, deleted int
+
+	for i := range existing {
+		membership := &existing[i].Membership
+		member, isDesired := members[membership.PrincipalID]
+		delete(members, membership.PrincipalID)
+
+		if membership.Source != enum.MembershipSourceLDAP {
+			continue
+		}
+
+		if !isDesired {
+			// the user left all groups mapped to the space.
+			err = s.membershipStore.Delete(ctx, membership.MembershipKey)
+			if err != nil {
+				return 0, 0, 0, fmt.Errorf("failed to delete membership: %w", err)
+			}
+			deleted++
+
+			s.auditRecorder.Record(ctx, session,
+				membershipAuditEvent(enum.AuditActionDelete, space, existing[i].Principal.UID, membership.Role))
+
+			continue
+		}
+
+		if membership.Role == member.role {
+			continue
+		}
+
+		membership.Role = member.role
+		membership.Updated = now
+
+		err = s.membershipStore.Update(ctx, membership)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("failed to update membership: %w", err)
+		}
+		updated++
+
+		s.auditRecorder.Record(ctx, session,
+			membershipAuditEvent(enum.AuditActionUpdate, space, member.uid, member.role))
+	}
+
+	for principalID, member := range members {
+		err = s.membershipStore.Create(ctx, &types.Membership{
+			MembershipKey: types.MembershipKey{SpaceID: space.ID, PrincipalID: principalID},
+			CreatedBy:     session.Principal.ID,
+			Created:       now,
+			Updated:       now,
+			Role:          member.role,
+			Source:        enum.MembershipSourceLDAP,
+		})
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("failed to create membership: %w", err)
+		}
+		created++
+
+		s.auditRecorder.Record(ctx, session,
+			membershipAuditEvent(enum.AuditActionCreate, space, member.uid, member.role))
+	}
+
+	return created, updated, deleted, nil
+}
+
+func membershipAuditEvent(
+	action enum.AuditAction,
+	space *types.Space,
+	userUID string,
+	role enum.MembershipRole,
+) types.AuditEvent {
+	return types.AuditEvent{
+		Action:       action,
+		ResourceType: enum.AuditResourceTypeMembership,
+		ResourceName: userUID,
+		SpaceID:      space.ID,
+		Data: map[string]string{
+			"space":  space.Path,
+			"role":   string(role),
+			"source": string(enum.MembershipSourceLDAP),
+		},
+	}
+}
+
+func (s *Service) listMemberships(ctx context.Context, spaceID int64) ([]types.MembershipUser, error) {
+	var memberships []types.MembershipUser
+	for page := 1; ; page++ {
+		list, err := s.membershipStore.ListUsers(ctx, spaceID, types.MembershipUserFilter{
+			ListQueryFilter: types.ListQueryFilter{
+				Pagination: types.Pagination{Page: page, Size: membershipPageSize},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list memberships: %w", err)
+		}
+
+		memberships = append(memberships, list...)
+
+		if len(list) < membershipPageSize {
+			return memberships, nil
+		}
+	}
+}
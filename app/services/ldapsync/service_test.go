@@ -0,0 +1,400 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldapsync
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/groupmapping"
+	"github.com/harness/gitness/app/auth/ldap"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	testSpaceID       = 1
+	testUnmappedSpace = 2
+	testSystemID      = 100
+	principalAlice    = 1
+	principalBob      = 2
+	principalCarol    = 3
+	principalDave     = 4
+	principalErin     = 5
+	principalFrank    = 6
+	principalMallory  = 7
+	testSpacePath     = "acme"
+	testUnmappedPath  = "legacy"
+	testMissingSpace  = "missing"
+	testUnknownMember = "carol"
+)
+
+// principalUIDs are the uids of the users, the uid of bob was sanitized during provisioning.
+var principalUIDs = map[int64]string{
+	principalAlice:   "alice",
+	principalBob:     "bob.smith",
+	principalCarol:   "carol",
+	principalDave:    "dave",
+	principalErin:    "erin",
+	principalFrank:   "frank",
+	principalMallory: "mallory",
+}
+
+func TestHandle(t *testing.T) {
+	dir := &fakeDirectory{
+		mappings: []groupmapping.Mapping{
+			{Group: "developers", SpacePath: testSpacePath, Role: enum.MembershipRoleContributor},
+			{Group: "admins", SpacePath: testSpacePath, Role: enum.MembershipRoleSpaceOwner},
+			{Group: "ops", SpacePath: testMissingSpace, Role: enum.MembershipRoleReader},
+		},
+		members: map[string][]*ldap.Entry{
+			// bob was provisioned under a sanitized uid, carol never logged in,
+			// and the directory user mallory collides with a local user that must not be touched.
+			"developers": {{UID: "alice"}, {UID: "Bob.Smith"}, {UID: testUnknownMember}, {UID: "frank"}},
+			"admins":     {{UID: "alice"}, {UID: "mallory"}},
+			"ops":        {{UID: "alice"}},
+		},
+	}
+
+	memberships := newFakeMembershipStore(
+		types.Membership{MembershipKey: key(principalAlice), Role: enum.MembershipRoleReader,
+			Source: enum.MembershipSourceLDAP},
+		types.Membership{MembershipKey: key(principalDave), Role: enum.MembershipRoleContributor,
+			Source: enum.MembershipSourceLDAP},
+		types.Membership{MembershipKey: key(principalErin), Role: enum.MembershipRoleReader,
+			Source: enum.MembershipSourceManual},
+		types.Membership{MembershipKey: key(principalFrank), Role: enum.MembershipRoleReader,
+			Source: enum.MembershipSourceManual},
+	)
+
+	recorder := &fakeRecorder{}
+	s := testService(dir, memberships, recorder)
+
+	result, err := s.Handle(context.Background(), "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exp := "created 1, updated 1 and deleted 1 memberships"; result != exp {
+		t.Errorf("expected result %q, got %q", exp, result)
+	}
+
+	exp := map[int64]types.Membership{
+		// the first mapping of a space wins.
+		principalAlice: {Role: enum.MembershipRoleContributor, Source: enum.MembershipSourceLDAP},
+		principalBob: {Role: enum.MembershipRoleContributor, Source: enum.MembershipSourceLDAP,
+			CreatedBy: testSystemID},
+		// manually granted memberships are left untouched.
+		principalErin:  {Role: enum.MembershipRoleReader, Source: enum.MembershipSourceManual},
+		principalFrank: {Role: enum.MembershipRoleReader, Source: enum.MembershipSourceManual},
+	}
+
+	got := map[int64]types.Membership{}
+	for k, membership := range memberships.memberships {
+		if k.SpaceID != testSpaceID {
+			continue
+		}
+		got[k.PrincipalID] = types.Membership{
+			Role:      membership.Role,
+			Source:    membership.Source,
+			CreatedBy: membership.CreatedBy,
+		}
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("expected memberships %+v, got %+v", exp, got)
+	}
+
+	expEvents := []types.AuditEvent{
+		membershipEvent(enum.AuditActionUpdate, "alice", enum.MembershipRoleContributor),
+		membershipEvent(enum.AuditActionCreate, "bob.smith", enum.MembershipRoleContributor),
+		membershipEvent(enum.AuditActionDelete, "dave", enum.MembershipRoleContributor),
+	}
+
+	sort.Slice(recorder.events, func(i, j int) bool {
+		return recorder.events[i].ResourceName < recorder.events[j].ResourceName
+	})
+	if !reflect.DeepEqual(recorder.events, expEvents) {
+		t.Errorf("expected audit events %+v, got %+v", expEvents, recorder.events)
+	}
+	for _, actorID := range recorder.actorIDs {
+		if actorID != testSystemID {
+			t.Errorf("expected audit events of the system, got actor %d", actorID)
+		}
+	}
+}
+
+func membershipEvent(action enum.AuditAction, userUID string, role enum.MembershipRole) types.AuditEvent {
+	return types.AuditEvent{
+		Action:       action,
+		ResourceType: enum.AuditResourceTypeMembership,
+		ResourceName: userUID,
+		SpaceID:      testSpaceID,
+		Data: map[string]string{
+			"space":  testSpacePath,
+			"role":   string(role),
+			"source": string(enum.MembershipSourceLDAP),
+		},
+	}
+}
+
+func TestHandleDirectoryUnavailable(t *testing.T) {
+	dir := &fakeDirectory{
+		mappings: []groupmapping.Mapping{
+			{Group: "developers", SpacePath: testSpacePath, Role: enum.MembershipRoleContributor},
+		},
+		err: errors.New("connection refused"),
+	}
+
+	memberships := newFakeMembershipStore(
+		types.Membership{MembershipKey: key(principalAlice), Role: enum.MembershipRoleReader,
+			Source: enum.MembershipSourceLDAP},
+	)
+
+	recorder := &fakeRecorder{}
+	s := testService(dir, memberships, recorder)
+
+	if _, err := s.Handle(context.Background(), "", nil); err == nil {
+		t.Fatalf("expected an error but got none")
+	}
+
+	// memberships must not be removed if the directory isn't available.
+	if len(memberships.memberships) != 1 {
+		t.Errorf("expected membership to be kept, got %d memberships", len(memberships.memberships))
+	}
+	if len(recorder.events) != 0 {
+		t.Errorf("expected no audit events, got %+v", recorder.events)
+	}
+}
+
+func TestHandleRemovedMapping(t *testing.T) {
+	dir := &fakeDirectory{
+		mappings: []groupmapping.Mapping{
+			{Group: "developers", SpacePath: testSpacePath, Role: enum.MembershipRoleContributor},
+		},
+		members: map[string][]*ldap.Entry{
+			"developers": {{UID: "alice"}},
+		},
+	}
+
+	// the memberships of the unmapped space were granted through a mapping that has been removed since.
+	unmapped := func(principalID int64) types.MembershipKey {
+		return types.MembershipKey{SpaceID: testUnmappedSpace, PrincipalID: principalID}
+	}
+	memberships := newFakeMembershipStore(
+		types.Membership{MembershipKey: key(principalAlice), Role: enum.MembershipRoleContributor,
+			Source: enum.MembershipSourceLDAP},
+		types.Membership{MembershipKey: unmapped(principalAlice), Role: enum.MembershipRoleReader,
+			Source: enum.MembershipSourceLDAP},
+		types.Membership{MembershipKey: unmapped(principalErin), Role: enum.MembershipRoleReader,
+			Source: enum.MembershipSourceManual},
+	)
+
+	recorder := &fakeRecorder{}
+	s := testService(dir, memberships, recorder)
+
+	result, err := s.Handle(context.Background(), "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exp := "created 0, updated 0 and deleted 1 memberships"; result != exp {
+		t.Errorf("expected result %q, got %q", exp, result)
+	}
+
+	if _, ok := memberships.memberships[unmapped(principalAlice)]; ok {
+		t.Errorf("expected membership granted through the removed mapping to be deleted")
+	}
+	if _, ok := memberships.memberships[unmapped(principalErin)]; !ok {
+		t.Errorf("expected manually granted membership to be kept")
+	}
+	if _, ok := memberships.memberships[key(principalAlice)]; !ok {
+		t.Errorf("expected membership of the mapped space to be kept")
+	}
+
+	if len(recorder.events) != 1 || recorder.events[0].SpaceID != testUnmappedSpace ||
+		recorder.events[0].Action != enum.AuditActionDelete {
+		t.Errorf("expected a single delete audit event of the unmapped space, got %+v", recorder.events)
+	}
+}
+
+func testService(dir *fakeDirectory, memberships *fakeMembershipStore, recorder *fakeRecorder) *Service {
+	s := New(&types.Config{}, dir,
+		&fakePrincipalStore{
+			ldapUsers: map[string]int64{
+				"alice":     principalAlice,
+				"Bob.Smith": principalBob,
+				"frank":     principalFrank,
+			},
+			localUsers: map[string]int64{
+				testUnknownMember: principalCarol,
+				"mallory":         principalMallory,
+			},
+		},
+		&fakeSpaceStore{spaces: map[string]int64{testSpacePath: testSpaceID, testUnmappedPath: testUnmappedSpace}},
+		memberships,
+		nil,
+		recorder,
+	)
+	s.systemSession = func() *auth.Session {
+		return &auth.Session{Principal: types.Principal{ID: testSystemID}}
+	}
+
+	return s
+}
+
+func key(principalID int64) types.MembershipKey {
+	return types.MembershipKey{SpaceID: testSpaceID, PrincipalID: principalID}
+}
+
+type fakeDirectory struct {
+	mappings []groupmapping.Mapping
+	members  map[string][]*ldap.Entry
+	err      error
+}
+
+func (f *fakeDirectory) Enabled() bool { return true }
+
+func (f *fakeDirectory) GroupMappings() []groupmapping.Mapping { return f.mappings }
+
+func (f *fakeDirectory) GroupMembers(_ context.Context, group string) ([]*ldap.Entry, error) {
+	return f.members[group], f.err
+}
+
+type fakePrincipalStore struct {
+	store.PrincipalStore
+	ldapUsers  map[string]int64
+	localUsers map[string]int64
+}
+
+func (f *fakePrincipalStore) FindUserByExternalID(
+	_ context.Context,
+	source enum.UserSource,
+	externalID string,
+) (*types.User, error) {
+	id, ok := f.ldapUsers[externalID]
+	if !ok || source != enum.UserSourceLDAP {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return &types.User{ID: id, UID: principalUIDs[id], Source: source, ExternalID: externalID}, nil
+}
+
+func (f *fakePrincipalStore) FindUserByUID(_ context.Context, uid string) (*types.User, error) {
+	if id, ok := f.localUsers[uid]; ok {
+		return &types.User{ID: id, UID: uid}, nil
+	}
+	if id, ok := f.ldapUsers[uid]; ok {
+		return &types.User{ID: id, UID: uid}, nil
+	}
+	return nil, gitness_store.ErrResourceNotFound
+}
+
+type fakeSpaceStore struct {
+	store.SpaceStore
+	spaces map[string]int64
+}
+
+func (f *fakeSpaceStore) FindByRef(_ context.Context, spaceRef string) (*types.Space, error) {
+	id, ok := f.spaces[spaceRef]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return &types.Space{ID: id, Path: spaceRef}, nil
+}
+
+func (f *fakeSpaceStore) Find(_ context.Context, id int64) (*types.Space, error) {
+	for path, spaceID := range f.spaces {
+		if spaceID == id {
+			return &types.Space{ID: id, Path: path}, nil
+		}
+	}
+	return nil, gitness_store.ErrResourceNotFound
+}
+
+type fakeMembershipStore struct {
+	store.MembershipStore
+	memberships map[types.MembershipKey]*types.Membership
+}
+
+func newFakeMembershipStore(memberships ...types.Membership) *fakeMembershipStore {
+	f := &fakeMembershipStore{memberships: map[types.MembershipKey]*types.Membership{}}
+	for i := range memberships {
+		f.memberships[memberships[i].MembershipKey] = &memberships[i]
+	}
+	return f
+}
+
+func (f *fakeMembershipStore) ListUsers(
+	_ context.Context,
+	spaceID int64,
+	filter types.MembershipUserFilter,
+) ([]types.MembershipUser, error) {
+	if filter.Page > 1 {
+		return nil, nil
+	}
+
+	list := make([]types.MembershipUser, 0, len(f.memberships))
+	for k, membership := range f.memberships {
+		if k.SpaceID != spaceID {
+			continue
+		}
+		list = append(list, types.MembershipUser{
+			Membership: *membership,
+			Principal:  types.PrincipalInfo{ID: membership.PrincipalID, UID: principalUIDs[membership.PrincipalID]},
+		})
+	}
+	return list, nil
+}
+
+func (f *fakeMembershipStore) ListSpaceIDsBySource(_ context.Context, source enum.MembershipSource) ([]int64, error) {
+	seen := map[int64]struct{}{}
+	var spaceIDs []int64
+	for k, membership := range f.memberships {
+		if _, ok := seen[k.SpaceID]; ok || membership.Source != source {
+			continue
+		}
+		seen[k.SpaceID] = struct{}{}
+		spaceIDs = append(spaceIDs, k.SpaceID)
+	}
+	return spaceIDs, nil
+}
+
+func (f *fakeMembershipStore) Create(_ context.Context, membership *types.Membership) error {
+	f.memberships[membership.MembershipKey] = membership
+	return nil
+}
+
+func (f *fakeMembershipStore) Update(_ context.Context, membership *types.Membership) error {
+	f.memberships[membership.MembershipKey] = membership
+	return nil
+}
+
+func (f *fakeMembershipStore) Delete(_ context.Context, key types.MembershipKey) error {
+	delete(f.memberships, key)
+	return nil
+}
+
+type fakeRecorder struct {
+	events   []types.AuditEvent
+	actorIDs []int64
+}
+
+func (f *fakeRecorder) Record(_ context.Context, session *auth.Session, event types.AuditEvent) {
+	f.events = append(f.events, event)
+	f.actorIDs = append(f.actorIDs, session.Principal.ID)
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldapsync
+
+import (
+	"github.com/harness/gitness/app/audit"
+	"github.com/harness/gitness/app/auth/ldap"
+	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	authenticator *ldap.Authenticator,
+	principalStore store.PrincipalStore,
+	spaceStore store.SpaceStore,
+	membershipStore store.MembershipStore,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	auditRecorder audit.Recorder,
+) (*Service, error) {
+	s := New(config, authenticator, principalStore, spaceStore, membershipStore, scheduler, auditRecorder)
+
+	err := executor.Register(jobType, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
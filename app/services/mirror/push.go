@@ -0,0 +1,492 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/encrypt"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/lock"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/stream"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pushJobType        = "gitness:mirror:push"
+	pushJobMaxRetries  = 3
+	pushJobMaxDuration = 15 * time.Minute
+)
+
+// PushService replicates repositories to their push mirrors after every change of their branches or tags.
+// The pushes are executed as background jobs, so failed pushes are retried by the job scheduler.
+type PushService struct {
+	git               gitrpc.Interface
+	repoStore         store.RepoStore
+	pushMirrorStore   store.PushMirrorStore
+	protectionManager *protection.Manager
+	encrypter         encrypt.Encrypter
+	scheduler         *job.Scheduler
+	mxManager         lock.MutexManager
+}
+
+func NewPushService(
+	ctx context.Context,
+	config *types.Config,
+	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
+	git gitrpc.Interface,
+	repoStore store.RepoStore,
+	pushMirrorStore store.PushMirrorStore,
+	protectionManager *protection.Manager,
+	encrypter encrypt.Encrypter,
+	scheduler *job.Scheduler,
+	mxManager lock.MutexManager,
+) (*PushService, error) {
+	service := &PushService{
+		git:               git,
+		repoStore:         repoStore,
+		pushMirrorStore:   pushMirrorStore,
+		protectionManager: protectionManager,
+		encrypter:         encrypter,
+		scheduler:         scheduler,
+		mxManager:         mxManager,
+	}
+
+	const groupPushMirror = "gitness:mirror:push"
+	_, err := gitReaderFactory.Launch(ctx, groupPushMirror, config.InstanceID,
+		func(r *gitevents.Reader) error {
+			const idleTimeout = 1 * time.Minute
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(3),
+				))
+
+			_ = r.RegisterBranchCreated(service.pushOnBranchCreated)
+			_ = r.RegisterBranchUpdated(service.pushOnBranchUpdated)
+			_ = r.RegisterBranchDeleted(service.pushOnBranchDeleted)
+			_ = r.RegisterTagCreated(service.pushOnTagCreated)
+			_ = r.RegisterTagUpdated(service.pushOnTagUpdated)
+			_ = r.RegisterTagDeleted(service.pushOnTagDeleted)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// PushSettings contains the user configurable details of a push mirror.
+type PushSettings struct {
+	UID                   string
+	RemoteURL             string
+	Credentials           Credentials
+	ProtectedBranchesOnly bool
+}
+
+// Create adds a new push mirror to the repository and schedules the initial push to it.
+func (s *PushService) Create(ctx context.Context,
+	repo *types.Repository,
+	principal *types.Principal,
+	in PushSettings,
+) (*types.PushMirror, error) {
+	remoteURL, err := sanitizeRemoteURL(in.RemoteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := encryptCredentials(s.encrypter, in.Credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	mirror := &types.PushMirror{
+		RepoID:                repo.ID,
+		UID:                   in.UID,
+		CreatedBy:             principal.ID,
+		Created:               now,
+		Updated:               now,
+		RemoteURL:             remoteURL,
+		Credentials:           credentials,
+		ProtectedBranchesOnly: in.ProtectedBranchesOnly,
+		LastPushStatus:        enum.MirrorSyncStatusNone,
+	}
+
+	err = s.pushMirrorStore.Create(ctx, mirror)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create push mirror: %w", err)
+	}
+
+	err = s.SchedulePush(ctx, mirror)
+	if err != nil {
+		return nil, err
+	}
+
+	return mirror, nil
+}
+
+// PushUpdateSettings contains the push mirror details that should be changed, nil fields are left untouched.
+type PushUpdateSettings struct {
+	UID                   *string
+	RemoteURL             *string
+	Username              *string
+	Password              *string
+	ProtectedBranchesOnly *bool
+}
+
+// Update changes the details of a push mirror.
+// The stored credentials are dropped if the remote URL changes.
+func (s *PushService) Update(ctx context.Context,
+	mirror *types.PushMirror,
+	in PushUpdateSettings,
+) (*types.PushMirror, error) {
+	var err error
+
+	if in.UID != nil {
+		mirror.UID = *in.UID
+	}
+
+	remoteURLChanged := false
+	if in.RemoteURL != nil {
+		var remoteURL string
+		remoteURL, err = sanitizeRemoteURL(*in.RemoteURL)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteURLChanged = remoteURL != mirror.RemoteURL
+		mirror.RemoteURL = remoteURL
+	}
+
+	if in.ProtectedBranchesOnly != nil {
+		mirror.ProtectedBranchesOnly = *in.ProtectedBranchesOnly
+	}
+
+	mirror.Credentials, err = updateCredentials(s.encrypter, mirror.Credentials, remoteURLChanged,
+		in.Username, in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.pushMirrorStore.Update(ctx, mirror)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update push mirror: %w", err)
+	}
+
+	return mirror, nil
+}
+
+// FindByUID returns the push mirror of a repository with the provided UID.
+func (s *PushService) FindByUID(ctx context.Context, repoID int64, uid string) (*types.PushMirror, error) {
+	mirror, err := s.pushMirrorStore.FindByUID(ctx, repoID, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find push mirror: %w", err)
+	}
+
+	return mirror, nil
+}
+
+// List returns all push mirrors of a repository.
+func (s *PushService) List(ctx context.Context, repoID int64) ([]*types.PushMirror, error) {
+	mirrors, err := s.pushMirrorStore.List(ctx, repoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list push mirrors: %w", err)
+	}
+
+	return mirrors, nil
+}
+
+// Delete removes the push mirror, pushes that are already scheduled are skipped.
+func (s *PushService) Delete(ctx context.Context, mirror *types.PushMirror) error {
+	err := s.pushMirrorStore.Delete(ctx, mirror.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete push mirror: %w", err)
+	}
+
+	return nil
+}
+
+type pushJobInput struct {
+	PushMirrorID int64 `json:"push_mirror_id"`
+}
+
+// SchedulePush starts a background job that pushes the repository to the push mirror.
+func (s *PushService) SchedulePush(ctx context.Context, mirror *types.PushMirror) error {
+	return s.schedulePush(ctx, mirror, fmt.Sprintf("manual-%d", time.Now().UnixNano()))
+}
+
+func (s *PushService) schedulePush(ctx context.Context, mirror *types.PushMirror, trigger string) error {
+	data, err := json.Marshal(pushJobInput{PushMirrorID: mirror.ID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal push mirror job input json: %w", err)
+	}
+
+	err = s.scheduler.RunJob(ctx, job.Definition{
+		UID:        fmt.Sprintf("push-mirror-%d-%s", mirror.ID, trigger),
+		Type:       pushJobType,
+		MaxRetries: pushJobMaxRetries,
+		Timeout:    pushJobMaxDuration,
+		Data:       string(data),
+	})
+	if errors.Is(err, gitness_store.ErrDuplicate) {
+		// the push has already been scheduled (e.g. an event was delivered more than once)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to schedule push mirror job: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PushService) pushOnBranchCreated(ctx context.Context,
+	event *events.Event[*gitevents.BranchCreatedPayload],
+) error {
+	return s.pushOnRefChange(ctx, event.ID, event.Payload.RepoID, event.Payload.Ref)
+}
+
+func (s *PushService) pushOnBranchUpdated(ctx context.Context,
+	event *events.Event[*gitevents.BranchUpdatedPayload],
+) error {
+	return s.pushOnRefChange(ctx, event.ID, event.Payload.RepoID, event.Payload.Ref)
+}
+
+func (s *PushService) pushOnBranchDeleted(ctx context.Context,
+	event *events.Event[*gitevents.BranchDeletedPayload],
+) error {
+	return s.pushOnRefChange(ctx, event.ID, event.Payload.RepoID, event.Payload.Ref)
+}
+
+func (s *PushService) pushOnTagCreated(ctx context.Context,
+	event *events.Event[*gitevents.TagCreatedPayload],
+) error {
+	return s.pushOnRefChange(ctx, event.ID, event.Payload.RepoID, event.Payload.Ref)
+}
+
+func (s *PushService) pushOnTagUpdated(ctx context.Context,
+	event *events.Event[*gitevents.TagUpdatedPayload],
+) error {
+	return s.pushOnRefChange(ctx, event.ID, event.Payload.RepoID, event.Payload.Ref)
+}
+
+func (s *PushService) pushOnTagDeleted(ctx context.Context,
+	event *events.Event[*gitevents.TagDeletedPayload],
+) error {
+	return s.pushOnRefChange(ctx, event.ID, event.Payload.RepoID, event.Payload.Ref)
+}
+
+// pushOnRefChange schedules a push to all push mirrors of the repository that are affected by the reference change.
+func (s *PushService) pushOnRefChange(ctx context.Context, eventID string, repoID int64, ref string) error {
+	mirrors, err := s.pushMirrorStore.List(ctx, repoID)
+	if err != nil {
+		return fmt.Errorf("failed to list push mirrors: %w", err)
+	}
+
+	var rules protection.RuleSet
+	for _, mirror := range mirrors {
+		if mirror.ProtectedBranchesOnly {
+			if !strings.HasPrefix(ref, gitReferenceNamePrefixBranch) {
+				continue
+			}
+
+			if rules == nil {
+				rules, err = s.listProtectionRules(ctx, repoID)
+				if err != nil {
+					return err
+				}
+			}
+
+			if !rules.IsProtected(strings.TrimPrefix(ref, gitReferenceNamePrefixBranch)) {
+				continue
+			}
+		}
+
+		err = s.schedulePush(ctx, mirror, "event-"+eventID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *PushService) listProtectionRules(ctx context.Context, repoID int64) (protection.RuleSet, error) {
+	repo, err := s.repoStore.Find(ctx, repoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find repo: %w", err)
+	}
+
+	rules, err := s.protectionManager.ListForRepo(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list protection rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+var _ job.Handler = (*PushService)(nil)
+
+// Handle is the push mirror background job handler.
+// It pushes the repository to the push mirror and records the outcome.
+// An error is returned if the push failed, so the push is retried by the job scheduler.
+func (s *PushService) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	var input pushJobInput
+	if err := json.Unmarshal([]byte(data), &input); err != nil {
+		return "", fmt.Errorf("failed to unmarshal push mirror job input json: %w", err)
+	}
+
+	mirror, err := s.pushMirrorStore.Find(ctx, input.PushMirrorID)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		// the push mirror has been deleted in the meantime
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find push mirror: %w", err)
+	}
+
+	repo, err := s.repoStore.Find(ctx, mirror.RepoID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find repo: %w", err)
+	}
+
+	// pushes to the same mirror are serialized, otherwise an older state could overwrite a newer one.
+	mx, err := s.mxManager.NewMutex(fmt.Sprintf("push-mirror-%d", mirror.ID), lock.WithExpiry(pushJobMaxDuration))
+	if err != nil {
+		return "", fmt.Errorf("failed to create push mirror lock: %w", err)
+	}
+
+	if err = mx.Lock(ctx); err != nil {
+		return "", fmt.Errorf("failed to acquire push mirror lock: %w", err)
+	}
+	defer func() {
+		if errUnlock := mx.Unlock(ctx); errUnlock != nil {
+			log.Ctx(ctx).Warn().Err(errUnlock).Msg("failed to release push mirror lock")
+		}
+	}()
+
+	pushErr := s.push(ctx, repo, mirror)
+
+	mirror.LastPush = time.Now().UnixMilli()
+	mirror.LastPushStatus = enum.MirrorSyncStatusSuccess
+	mirror.LastPushError = ""
+
+	if pushErr != nil {
+		mirror.LastPushStatus = enum.MirrorSyncStatusFailure
+		mirror.LastPushError = pushErr.Error()
+	}
+
+	err = s.pushMirrorStore.UpdatePushStatus(ctx, mirror)
+	if err != nil {
+		return "", fmt.Errorf("failed to update push mirror status: %w", err)
+	}
+
+	return "", pushErr
+}
+
+// push pushes the branches and tags of the repository to the push mirror.
+// Mirrors restricted to protected branches only receive the protected branches,
+// the deletion of a protected branch isn't replicated to them.
+// The returned error is safe to be shown to users (credentials are redacted).
+func (s *PushService) push(ctx context.Context, repo *types.Repository, mirror *types.PushMirror) error {
+	credentials, err := decryptCredentials(s.encrypter, mirror.Credentials)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Int64("push_mirror.id", mirror.ID).Msg("failed to get push mirror credentials")
+		return errors.New("failed to read the credentials of the push mirror")
+	}
+
+	remoteURL, err := remoteURLWithCredentials(mirror.RemoteURL, credentials)
+	if err != nil {
+		return fmt.Errorf("invalid remote URL: %w", err)
+	}
+
+	refSpecs := mirrorRefSpecs
+	prune := true
+
+	if mirror.ProtectedBranchesOnly {
+		refSpecs, err = s.protectedBranchRefSpecs(ctx, repo)
+		if err != nil {
+			return err
+		}
+		if len(refSpecs) == 0 {
+			// nothing to push
+			return nil
+		}
+
+		prune = false
+	}
+
+	err = s.git.PushRemote(ctx, &gitrpc.PushRemoteParams{
+		ReadParams: gitrpc.ReadParams{RepoUID: repo.GitUID},
+		RemoteURL:  remoteURL,
+		RefSpecs:   refSpecs,
+		Prune:      prune,
+	})
+	if err != nil {
+		return errors.New(redactCredentials(
+			fmt.Sprintf("failed to push to remote: %s", err.Error()), remoteURL, mirror.RemoteURL, credentials))
+	}
+
+	return nil
+}
+
+// protectedBranchRefSpecs returns the ref specs for all branches of the repository that are protected.
+func (s *PushService) protectedBranchRefSpecs(ctx context.Context, repo *types.Repository) ([]string, error) {
+	rules, err := s.protectionManager.ListForRepo(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list protection rules: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	out, err := s.git.ListBranches(ctx, &gitrpc.ListBranchesParams{
+		ReadParams:    gitrpc.ReadParams{RepoUID: repo.GitUID},
+		IncludeCommit: false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	var refSpecs []string
+	for _, branch := range out.Branches {
+		if !rules.IsProtected(branch.Name) {
+			continue
+		}
+
+		ref := gitReferenceNamePrefixBranch + branch.Name
+		refSpecs = append(refSpecs, "+"+ref+":"+ref)
+	}
+
+	return refSpecs, nil
+}
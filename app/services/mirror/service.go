@@ -0,0 +1,317 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/app/store"
+	gitnessurl "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/encrypt"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Service keeps mirror repositories in sync with their remotes.
+type Service struct {
+	defaultInterval time.Duration
+	minInterval     time.Duration
+
+	git         gitrpc.Interface
+	urlProvider gitnessurl.Provider
+	repoStore   store.RepoStore
+	mirrorStore store.RepoMirrorStore
+	encrypter   encrypt.Encrypter
+	gitReporter *gitevents.Reporter
+	scheduler   *job.Scheduler
+}
+
+func New(
+	config *types.Config,
+	git gitrpc.Interface,
+	urlProvider gitnessurl.Provider,
+	repoStore store.RepoStore,
+	mirrorStore store.RepoMirrorStore,
+	encrypter encrypt.Encrypter,
+	gitReporter *gitevents.Reporter,
+	scheduler *job.Scheduler,
+) *Service {
+	return &Service{
+		defaultInterval: config.Mirror.DefaultInterval,
+		minInterval:     config.Mirror.MinInterval,
+		git:             git,
+		urlProvider:     urlProvider,
+		repoStore:       repoStore,
+		mirrorStore:     mirrorStore,
+		encrypter:       encrypter,
+		gitReporter:     gitReporter,
+		scheduler:       scheduler,
+	}
+}
+
+// Credentials are used to authenticate against the remote of a mirror.
+type Credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Settings contains the user configurable details of a mirror.
+type Settings struct {
+	RemoteURL   string
+	Credentials Credentials
+	// Interval is the time between two syncs in seconds, the default interval is used if zero.
+	Interval int64
+}
+
+// Create stores the mirror details of a repository. The first sync is scheduled after the provided interval,
+// as the initial content of the repository is expected to be fetched by the importer.
+func (s *Service) Create(ctx context.Context,
+	repo *types.Repository,
+	principal *types.Principal,
+	in Settings,
+) (*types.RepoMirror, error) {
+	remoteURL, err := sanitizeRemoteURL(in.RemoteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := s.sanitizeInterval(in.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := encryptCredentials(s.encrypter, in.Credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	mirror := &types.RepoMirror{
+		RepoID:         repo.ID,
+		CreatedBy:      principal.ID,
+		Created:        now,
+		Updated:        now,
+		RemoteURL:      remoteURL,
+		Credentials:    credentials,
+		Interval:       interval,
+		NextSync:       now + interval*1000,
+		LastSync:       0,
+		LastSyncStatus: enum.MirrorSyncStatusNone,
+		LastSyncError:  "",
+	}
+
+	err = s.mirrorStore.Create(ctx, mirror)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repo mirror: %w", err)
+	}
+
+	return mirror, nil
+}
+
+// Find returns the mirror details of a repository.
+func (s *Service) Find(ctx context.Context, repoID int64) (*types.RepoMirror, error) {
+	mirror, err := s.mirrorStore.Find(ctx, repoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find repo mirror: %w", err)
+	}
+
+	return mirror, nil
+}
+
+// UpdateSettings contains the mirror details that should be changed, nil fields are left untouched.
+type UpdateSettings struct {
+	RemoteURL *string
+	Username  *string
+	Password  *string
+	Interval  *int64
+}
+
+// Update changes the mirror details of a repository.
+// The stored credentials are dropped if the remote URL changes.
+func (s *Service) Update(ctx context.Context, repoID int64, in UpdateSettings) (*types.RepoMirror, error) {
+	mirror, err := s.mirrorStore.Find(ctx, repoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find repo mirror: %w", err)
+	}
+
+	remoteURLChanged := false
+	if in.RemoteURL != nil {
+		var remoteURL string
+		remoteURL, err = sanitizeRemoteURL(*in.RemoteURL)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteURLChanged = remoteURL != mirror.RemoteURL
+		mirror.RemoteURL = remoteURL
+	}
+
+	if in.Interval != nil {
+		mirror.Interval, err = s.sanitizeInterval(*in.Interval)
+		if err != nil {
+			return nil, err
+		}
+		mirror.NextSync = time.Now().UnixMilli() + mirror.Interval*1000
+	}
+
+	mirror.Credentials, err = updateCredentials(s.encrypter, mirror.Credentials, remoteURLChanged,
+		in.Username, in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.mirrorStore.Update(ctx, mirror)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update repo mirror: %w", err)
+	}
+
+	return mirror, nil
+}
+
+// ScheduleSync marks the mirror as due, it is synced with the next run of the sync job.
+func (s *Service) ScheduleSync(ctx context.Context, repoID int64) (*types.RepoMirror, error) {
+	mirror, err := s.mirrorStore.Find(ctx, repoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find repo mirror: %w", err)
+	}
+
+	mirror.NextSync = time.Now().UnixMilli()
+
+	err = s.mirrorStore.UpdateSyncStatus(ctx, mirror)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update repo mirror: %w", err)
+	}
+
+	return mirror, nil
+}
+
+// sanitizeRemoteURL verifies that the remote URL is an absolute http(s) URL without credentials.
+func sanitizeRemoteURL(remoteURL string) (string, error) {
+	remoteURL = strings.TrimSpace(remoteURL)
+	if remoteURL == "" {
+		return "", usererror.BadRequest("The remote URL of the mirror is required.")
+	}
+
+	u, err := url.Parse(remoteURL)
+	if err != nil {
+		return "", usererror.BadRequest("The remote URL of the mirror is invalid.")
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", usererror.BadRequest("The remote URL of the mirror must be an http or https URL.")
+	}
+
+	if u.Host == "" {
+		return "", usererror.BadRequest("The remote URL of the mirror must contain a host.")
+	}
+
+	if u.User != nil {
+		return "", usererror.BadRequest("The remote URL of the mirror must not contain credentials.")
+	}
+
+	return u.String(), nil
+}
+
+// sanitizeInterval verifies the sync interval (in seconds) and falls back to the default interval if it's zero.
+func (s *Service) sanitizeInterval(interval int64) (int64, error) {
+	if interval == 0 {
+		return int64(s.defaultInterval / time.Second), nil
+	}
+
+	if minInterval := int64(s.minInterval / time.Second); interval < minInterval {
+		return 0, usererror.BadRequestf("The sync interval of the mirror must be at least %d seconds.", minInterval)
+	}
+
+	return interval, nil
+}
+
+// updateCredentials applies the provided username and password to the encrypted credentials.
+// If the remote URL changed, the stored credentials are dropped and only the provided ones are kept,
+// otherwise anyone allowed to edit the mirror could send the credentials to a host of their choice.
+func updateCredentials(
+	encrypter encrypt.Encrypter,
+	encrypted []byte,
+	remoteURLChanged bool,
+	username *string,
+	password *string,
+) ([]byte, error) {
+	if !remoteURLChanged && username == nil && password == nil {
+		return encrypted, nil
+	}
+
+	var credentials Credentials
+	if !remoteURLChanged {
+		var err error
+		credentials, err = decryptCredentials(encrypter, encrypted)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if username != nil {
+		credentials.Username = *username
+	}
+	if password != nil {
+		credentials.Password = *password
+	}
+
+	return encryptCredentials(encrypter, credentials)
+}
+
+func encryptCredentials(encrypter encrypt.Encrypter, credentials Credentials) ([]byte, error) {
+	if credentials.Username == "" && credentials.Password == "" {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mirror credentials: %w", err)
+	}
+
+	encrypted, err := encrypter.Encrypt(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt mirror credentials: %w", err)
+	}
+
+	return encrypted, nil
+}
+
+func decryptCredentials(encrypter encrypt.Encrypter, encrypted []byte) (Credentials, error) {
+	if len(encrypted) == 0 {
+		return Credentials{}, nil
+	}
+
+	decrypted, err := encrypter.Decrypt(encrypted)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to decrypt mirror credentials: %w", err)
+	}
+
+	var credentials Credentials
+	if err = json.Unmarshal([]byte(decrypted), &credentials); err != nil {
+		return Credentials{}, errors.New("failed to unmarshal mirror credentials")
+	}
+
+	return credentials, nil
+}
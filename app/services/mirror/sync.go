@@ -0,0 +1,312 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/bootstrap"
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/githook"
+	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType        = "gitness:mirror:sync"
+	jobCron        = "* * * * *"
+	jobMaxDuration = 30 * time.Minute
+
+	// syncBudget is the time after which the sync job stops picking up due mirrors,
+	// the remaining mirrors are synced with the next run of the job.
+	syncBudget = 5 * time.Minute
+
+	// syncBatchSize is the maximum number of due mirrors that are synced by one run of the sync job.
+	syncBatchSize = 100
+
+	gitReferenceNamePrefixBranch = "refs/heads/"
+	gitReferenceNamePrefixTag    = "refs/tags/"
+)
+
+// mirrorRefSpecs are the ref specs used to sync mirrors, only branches and tags are mirrored.
+var mirrorRefSpecs = []string{
+	"+" + gitReferenceNamePrefixBranch + "*:" + gitReferenceNamePrefixBranch + "*",
+	"+" + gitReferenceNamePrefixTag + "*:" + gitReferenceNamePrefixTag + "*",
+}
+
+var _ job.Handler = (*Service)(nil)
+
+// Register registers the recurring job that syncs all due mirrors.
+func (s *Service) Register(ctx context.Context) error {
+	err := s.scheduler.AddRecurring(ctx, jobType, jobType, jobCron, jobMaxDuration)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for mirror sync: %w", err)
+	}
+
+	return nil
+}
+
+// Handle is the mirror sync background job handler. It syncs all mirrors that are due.
+func (s *Service) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	started := time.Now()
+
+	mirrors, err := s.mirrorStore.ListDue(ctx, started.UnixMilli(), syncBatchSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to list due mirrors: %w", err)
+	}
+
+	synced := 0
+	for _, mirror := range mirrors {
+		if time.Since(started) > syncBudget {
+			break
+		}
+
+		if err = s.sync(ctx, mirror); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Int64("repo.id", mirror.RepoID).Msg("failed to sync mirror")
+			continue
+		}
+
+		synced++
+	}
+
+	return fmt.Sprintf("synced %d of %d due mirrors", synced, len(mirrors)), nil
+}
+
+// sync fetches the branches and tags of a mirror from its remote and records the outcome.
+func (s *Service) sync(ctx context.Context, mirror *types.RepoMirror) error {
+	repo, err := s.repoStore.Find(ctx, mirror.RepoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repo: %w", err)
+	}
+
+	if repo.Importing {
+		// the initial content of the mirror is still being imported, try again with the next run.
+		return nil
+	}
+
+	syncErr := s.fetch(ctx, repo, mirror)
+
+	now := time.Now().UnixMilli()
+	mirror.LastSync = now
+	mirror.NextSync = now + mirror.Interval*1000
+	mirror.LastSyncStatus = enum.MirrorSyncStatusSuccess
+	mirror.LastSyncError = ""
+
+	if syncErr != nil {
+		mirror.LastSyncStatus = enum.MirrorSyncStatusFailure
+		mirror.LastSyncError = syncErr.Error()
+	}
+
+	err = s.mirrorStore.UpdateSyncStatus(ctx, mirror)
+	if err != nil {
+		return fmt.Errorf("failed to update mirror sync status: %w", err)
+	}
+
+	return syncErr
+}
+
+// fetch syncs the git repository of the mirror with its remote.
+// The returned error is safe to be shown to users (credentials are redacted).
+func (s *Service) fetch(ctx context.Context, repo *types.Repository, mirror *types.RepoMirror) error {
+	systemPrincipal := bootstrap.NewSystemServiceSession().Principal
+
+	credentials, err := decryptCredentials(s.encrypter, mirror.Credentials)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Int64("repo.id", repo.ID).Msg("failed to get mirror credentials")
+		return errors.New("failed to read the credentials of the mirror")
+	}
+
+	remoteURL, err := remoteURLWithCredentials(mirror.RemoteURL, credentials)
+	if err != nil {
+		return fmt.Errorf("invalid remote URL: %w", err)
+	}
+
+	envVars, err := githook.GenerateEnvironmentVariables(
+		ctx,
+		s.urlProvider.GetInternalAPIURL(),
+		repo.ID,
+		systemPrincipal.ID,
+		false,
+		true,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to generate git hook environment variables: %w", err)
+	}
+
+	out, err := s.git.SyncRepository(ctx, &gitrpc.SyncRepositoryParams{
+		WriteParams: gitrpc.WriteParams{
+			Actor: gitrpc.Identity{
+				Name:  systemPrincipal.DisplayName,
+				Email: systemPrincipal.Email,
+			},
+			RepoUID: repo.GitUID,
+			EnvVars: envVars,
+		},
+		Source:            remoteURL,
+		CreateIfNotExists: false,
+		RefSpecs:          mirrorRefSpecs,
+	})
+	if err != nil {
+		return errors.New(redactCredentials(
+			fmt.Sprintf("failed to sync from remote: %s", err.Error()), remoteURL, mirror.RemoteURL, credentials))
+	}
+
+	if out.DefaultBranch != "" && out.DefaultBranch != repo.DefaultBranch {
+		_, err = s.repoStore.UpdateOptLock(ctx, repo, func(r *types.Repository) error {
+			r.DefaultBranch = out.DefaultBranch
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update default branch of the repository: %w", err)
+		}
+	}
+
+	s.reportRefUpdates(ctx, repo.ID, systemPrincipal.ID, out.RefUpdates)
+
+	return nil
+}
+
+// reportRefUpdates reports the branch and tag events for the references updated by a sync,
+// so mirrored changes are processed the same way as pushed changes (e.g. by triggers and webhooks).
+func (s *Service) reportRefUpdates(ctx context.Context,
+	repoID int64,
+	principalID int64,
+	refUpdates []gitrpc.SyncReferenceUpdate,
+) {
+	for _, refUpdate := range refUpdates {
+		oldSHA := refUpdate.OldSHA
+		if oldSHA == "" {
+			oldSHA = types.NilSHA
+		}
+
+		newSHA := refUpdate.NewSHA
+		if newSHA == "" {
+			newSHA = types.NilSHA
+		}
+
+		switch {
+		case strings.HasPrefix(refUpdate.Ref, gitReferenceNamePrefixBranch):
+			s.reportBranchEvent(ctx, repoID, principalID, refUpdate.Ref, oldSHA, newSHA)
+		case strings.HasPrefix(refUpdate.Ref, gitReferenceNamePrefixTag):
+			s.reportTagEvent(ctx, repoID, principalID, refUpdate.Ref, oldSHA, newSHA)
+		default:
+			// only branches and tags are mirrored
+		}
+	}
+}
+
+func (s *Service) reportBranchEvent(ctx context.Context,
+	repoID int64,
+	principalID int64,
+	ref, oldSHA, newSHA string,
+) {
+	switch {
+	case oldSHA == types.NilSHA:
+		s.gitReporter.BranchCreated(ctx, &gitevents.BranchCreatedPayload{
+			RepoID:      repoID,
+			PrincipalID: principalID,
+			Ref:         ref,
+			SHA:         newSHA,
+		})
+	case newSHA == types.NilSHA:
+		s.gitReporter.BranchDeleted(ctx, &gitevents.BranchDeletedPayload{
+			RepoID:      repoID,
+			PrincipalID: principalID,
+			Ref:         ref,
+			SHA:         oldSHA,
+		})
+	default:
+		s.gitReporter.BranchUpdated(ctx, &gitevents.BranchUpdatedPayload{
+			RepoID:      repoID,
+			PrincipalID: principalID,
+			Ref:         ref,
+			OldSHA:      oldSHA,
+			NewSHA:      newSHA,
+			Forced:      false, // TODO: data not available yet
+		})
+	}
+}
+
+func (s *Service) reportTagEvent(ctx context.Context,
+	repoID int64,
+	principalID int64,
+	ref, oldSHA, newSHA string,
+) {
+	switch {
+	case oldSHA == types.NilSHA:
+		s.gitReporter.TagCreated(ctx, &gitevents.TagCreatedPayload{
+			RepoID:      repoID,
+			PrincipalID: principalID,
+			Ref:         ref,
+			SHA:         newSHA,
+		})
+	case newSHA == types.NilSHA:
+		s.gitReporter.TagDeleted(ctx, &gitevents.TagDeletedPayload{
+			RepoID:      repoID,
+			PrincipalID: principalID,
+			Ref:         ref,
+			SHA:         oldSHA,
+		})
+	default:
+		s.gitReporter.TagUpdated(ctx, &gitevents.TagUpdatedPayload{
+			RepoID:      repoID,
+			PrincipalID: principalID,
+			Ref:         ref,
+			OldSHA:      oldSHA,
+			NewSHA:      newSHA,
+			// tags can only be force updated!
+			Forced: true,
+		})
+	}
+}
+
+// remoteURLWithCredentials returns the remote URL with the credentials added as user info.
+func remoteURLWithCredentials(remoteURL string, credentials Credentials) (string, error) {
+	if credentials.Username == "" && credentials.Password == "" {
+		return remoteURL, nil
+	}
+
+	u, err := url.Parse(remoteURL)
+	if err != nil {
+		return "", err
+	}
+
+	u.User = url.UserPassword(credentials.Username, credentials.Password)
+
+	return u.String(), nil
+}
+
+// redactCredentials removes the credentials of the mirror from the message.
+func redactCredentials(msg string, remoteURLWithAuth string, remoteURL string, credentials Credentials) string {
+	if remoteURLWithAuth != remoteURL {
+		msg = strings.ReplaceAll(msg, remoteURLWithAuth, remoteURL)
+	}
+
+	if credentials.Password != "" {
+		msg = strings.ReplaceAll(msg, url.PathEscape(credentials.Password), "*****")
+		msg = strings.ReplaceAll(msg, credentials.Password, "*****")
+	}
+
+	return msg
+}
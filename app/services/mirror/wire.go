@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+
+	gitevents "github.com/harness/gitness/app/events/git"
+	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/encrypt"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/lock"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideService,
+	ProvidePushService,
+)
+
+func ProvideService(
+	config *types.Config,
+	git gitrpc.Interface,
+	urlProvider url.Provider,
+	repoStore store.RepoStore,
+	mirrorStore store.RepoMirrorStore,
+	encrypter encrypt.Encrypter,
+	gitReporter *gitevents.Reporter,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+) (*Service, error) {
+	s := New(config, git, urlProvider, repoStore, mirrorStore, encrypter, gitReporter, scheduler)
+
+	err := executor.Register(jobType, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func ProvidePushService(
+	ctx context.Context,
+	config *types.Config,
+	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
+	git gitrpc.Interface,
+	repoStore store.RepoStore,
+	pushMirrorStore store.PushMirrorStore,
+	protectionManager *protection.Manager,
+	encrypter encrypt.Encrypter,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	mxManager lock.MutexManager,
+) (*PushService, error) {
+	s, err := NewPushService(ctx, config, gitReaderFactory, git, repoStore, pushMirrorStore,
+		protectionManager, encrypter, scheduler, mxManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = executor.Register(pushJobType, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
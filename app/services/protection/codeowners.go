@@ -0,0 +1,105 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/types"
+)
+
+// codeOwnersCacheDuration is the duration the code owners of the changed files of a pull request are cached.
+// The owners are resolved to principals, so it's also the time it takes until changed users are taken into account.
+const codeOwnersCacheDuration = 10 * time.Minute
+
+// codeOwnersKey identifies the changes of a pull request, the source and the target commit determine
+// both, the CODEOWNERS file and the changed files.
+type codeOwnersKey struct {
+	repoID     int64
+	repoGitUID string
+	targetSHA  string
+	sourceSHA  string
+}
+
+// ownedPath is a changed file of a pull request together with the IDs of its code owners.
+type ownedPath struct {
+	path     string
+	ownerIDs []int64
+}
+
+type codeOwnersGetter struct {
+	codeOwners *codeowners.Service
+}
+
+func (g codeOwnersGetter) Find(ctx context.Context, key codeOwnersKey) ([]ownedPath, error) {
+	repo := &types.Repository{ID: key.repoID, GitUID: key.repoGitUID}
+	return findOwnedPaths(ctx, g.codeOwners, repo, key.targetSHA, key.targetSHA, key.sourceSHA)
+}
+
+// findOwnedPaths returns the files changed between the base and the head commit that have code owners
+// according to the CODEOWNERS file at codeOwnersRef.
+func findOwnedPaths(
+	ctx context.Context,
+	codeOwnersService *codeowners.Service,
+	repo *types.Repository,
+	codeOwnersRef string,
+	baseRef string,
+	headRef string,
+) ([]ownedPath, error) {
+	codeOwners, err := codeOwnersService.Get(ctx, repo, codeOwnersRef)
+	if errors.Is(err, codeowners.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code owners: %w", err)
+	}
+
+	paths, err := codeOwnersService.ChangedPaths(ctx, repo, baseRef, headRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changed paths: %w", err)
+	}
+
+	resolver := codeOwnersService.NewResolver()
+
+	var owned []ownedPath
+	for _, path := range paths {
+		entry, ok := codeOwners.Find(path)
+		if !ok {
+			continue
+		}
+
+		owners, err := resolver.Resolve(ctx, entry.Owners)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve code owners of %s: %w", path, err)
+		}
+
+		if len(owners) == 0 {
+			continue
+		}
+
+		ownerIDs := make([]int64, len(owners))
+		for i, owner := range owners {
+			ownerIDs[i] = owner.ID
+		}
+
+		owned = append(owned, ownedPath{path: path, ownerIDs: ownerIDs})
+	}
+
+	return owned, nil
+}
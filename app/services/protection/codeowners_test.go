@@ -0,0 +1,122 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protection
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type fakeGitRPC struct {
+	gitrpc.Interface
+	changed  []string
+	diffs    int
+	unsigned string
+}
+
+func (f *fakeGitRPC) GetTreeNode(context.Context, *gitrpc.GetTreeNodeParams) (*gitrpc.GetTreeNodeOutput, error) {
+	return &gitrpc.GetTreeNodeOutput{Node: gitrpc.TreeNode{Type: gitrpc.TreeNodeTypeBlob, SHA: "blob"}}, nil
+}
+
+func (f *fakeGitRPC) GetBlob(context.Context, *gitrpc.GetBlobParams) (*gitrpc.GetBlobOutput, error) {
+	return &gitrpc.GetBlobOutput{Content: strings.NewReader("*.go @owner\n")}, nil
+}
+
+func (f *fakeGitRPC) Diff(context.Context, *gitrpc.DiffParams) (<-chan *gitrpc.FileDiff, <-chan error) {
+	f.diffs++
+
+	files := make(chan *gitrpc.FileDiff, len(f.changed))
+	errs := make(chan error, 1)
+	for _, path := range f.changed {
+		files <- &gitrpc.FileDiff{Path: path}
+	}
+	close(files)
+	close(errs)
+
+	return files, errs
+}
+
+func (f *fakeGitRPC) FindUnsignedCommit(context.Context,
+	gitrpc.FindUnsignedCommitParams,
+) (gitrpc.FindUnsignedCommitOutput, error) {
+	return gitrpc.FindUnsignedCommitOutput{UnsignedCommitSHA: f.unsigned}, nil
+}
+
+type fakePrincipalStore struct {
+	store.PrincipalStore
+}
+
+func (fakePrincipalStore) FindByUID(_ context.Context, uid string) (*types.Principal, error) {
+	return &types.Principal{ID: 1, UID: uid}, nil
+}
+
+func TestVerifyCodeOwnersCached(t *testing.T) {
+	const ownerID = 1
+
+	git := &fakeGitRPC{changed: []string{"main.go", "README.md"}}
+	m := NewManager(nil, nil, nil, nil, codeowners.NewService(git, fakePrincipalStore{}), git)
+
+	targetSHA := "target"
+	repo := &types.Repository{ID: 1, GitUID: "repo"}
+	pr := &types.PullReq{SourceSHA: "source", MergeTargetSHA: &targetSHA}
+
+	approval := []*types.PullReqReviewer{{
+		PrincipalID:    ownerID,
+		ReviewDecision: enum.PullReqReviewDecisionApproved,
+		SHA:            pr.SourceSHA,
+	}}
+
+	unmet, err := m.verifyCodeOwners(context.Background(), repo, pr, nil)
+	if err != nil {
+		t.Fatalf("failed to verify code owners: %s", err.Error())
+	}
+	if len(unmet) != 1 || !strings.Contains(unmet[0], "main.go") {
+		t.Errorf("expected the unapproved main.go, got %v", unmet)
+	}
+
+	// the approvals are checked each time, but the code owners of the same commits are read only once
+	unmet, err = m.verifyCodeOwners(context.Background(), repo, pr, approval)
+	if err != nil {
+		t.Fatalf("failed to verify code owners: %s", err.Error())
+	}
+	if len(unmet) != 0 {
+		t.Errorf("expected no unmet requirements, got %v", unmet)
+	}
+	if git.diffs != 1 {
+		t.Errorf("expected the changes to be read once, got %d", git.diffs)
+	}
+
+	// a new commit requires reading the code owners again
+	git.changed = []string{"main.go", "other.go"}
+	pr.SourceSHA = "newer"
+
+	unmet, err = m.verifyCodeOwners(context.Background(), repo, pr, approval)
+	if err != nil {
+		t.Fatalf("failed to verify code owners: %s", err.Error())
+	}
+	if len(unmet) != 0 {
+		t.Errorf("expected no unmet requirements, got %v", unmet)
+	}
+	if git.diffs != 2 {
+		t.Errorf("expected the changes to be read twice, got %d", git.diffs)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protection
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/cache"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+)
+
+// Manager provides the branch protection rules and the merge requirements that apply to a repository.
+type Manager struct {
+	ruleStore     store.RuleStore
+	spaceStore    store.SpaceStore
+	checkStore    store.CheckStore
+	reqCheckStore store.ReqCheckStore
+	codeOwners    *codeowners.Service
+	gitRPCClient  gitrpc.Interface
+
+	// codeOwnersCache caches the code owners of the changed files of pull requests,
+	// as the merge requirements are verified each time a pull request is viewed.
+	codeOwnersCache cache.Cache[codeOwnersKey, []ownedPath]
+}
+
+func NewManager(
+	ruleStore store.RuleStore,
+	spaceStore store.SpaceStore,
+	checkStore store.CheckStore,
+	reqCheckStore store.ReqCheckStore,
+	codeOwners *codeowners.Service,
+	gitRPCClient gitrpc.Interface,
+) *Manager {
+	return &Manager{
+		ruleStore:     ruleStore,
+		spaceStore:    spaceStore,
+		checkStore:    checkStore,
+		reqCheckStore: reqCheckStore,
+		codeOwners:    codeOwners,
+		gitRPCClient:  gitRPCClient,
+		codeOwnersCache: cache.New[codeOwnersKey, []ownedPath](
+			codeOwnersGetter{codeOwners: codeOwners}, codeOwnersCacheDuration),
+	}
+}
+
+// ListForRepo returns all protection rules that apply to the repository.
+// This includes the rules of the repository itself as well as the rules of all its ancestor spaces.
+func (m *Manager) ListForRepo(ctx context.Context, repo *types.Repository) (RuleSet, error) {
+	var spaceIDs []int64
+	for spaceID := repo.ParentID; spaceID > 0; {
+		space, err := m.spaceStore.Find(ctx, spaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find space %d: %w", spaceID, err)
+		}
+
+		spaceIDs = append(spaceIDs, space.ID)
+		spaceID = space.ParentID
+	}
+
+	rules, err := m.ruleStore.ListAllForRepo(ctx, repo.ID, spaceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list protection rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// ForBranch returns the protection of a branch of the repository for the provided principal.
+func (m *Manager) ForBranch(ctx context.Context, repo *types.Repository,
+	principalID int64, branch string) (Protection, error) {
+	rules, err := m.ListForRepo(ctx, repo)
+	if err != nil {
+		return Protection{}, err
+	}
+
+	return rules.ForBranch(principalID, branch), nil
+}
+
+// ValidatePattern returns an error in case the provided branch pattern is invalid.
+func ValidatePattern(pattern string) error {
+	if pattern == "" {
+		return ErrPatternEmpty
+	}
+
+	// NOTE: path.Match validates the whole pattern independent of the name (doublestar stops at the first mismatch).
+	if _, err := path.Match(pattern, ""); err != nil {
+		return ErrPatternInvalid
+	}
+
+	return nil
+}
@@ -0,0 +1,280 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// VerifyMerge returns the list of requirements that the pull request doesn't fulfill yet
+// for a merge by the principal. The pull request can be merged only if the returned list is empty.
+func (m *Manager) VerifyMerge(
+	ctx context.Context,
+	repo *types.Repository,
+	principalID int64,
+	pr *types.PullReq,
+	reviewers []*types.PullReqReviewer,
+) ([]string, error) {
+	unmet := verifyReviews(repo, pr, reviewers)
+
+	if repo.PullReqRequireCodeOwnerApproval {
+		unmetCodeOwners, err := m.verifyCodeOwners(ctx, repo, pr, reviewers)
+		if err != nil {
+			return nil, err
+		}
+
+		unmet = append(unmet, unmetCodeOwners...)
+	}
+
+	unmetChecks, err := m.verifyStatusChecks(ctx, repo, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	unmet = append(unmet, unmetChecks...)
+
+	branchProtection, err := m.ForBranch(ctx, repo, principalID, pr.TargetBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get protection of the target branch: %w", err)
+	}
+
+	unmetSignatures, err := m.verifySignedCommits(ctx, repo, pr, branchProtection)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(unmet, unmetSignatures...), nil
+}
+
+// verifyReviews verifies the review decisions of the pull request against the repository approval settings.
+func verifyReviews(repo *types.Repository, pr *types.PullReq, reviewers []*types.PullReqReviewer) []string {
+	var changeRequested bool
+	for _, reviewer := range reviewers {
+		if reviewer.ReviewDecision == enum.PullReqReviewDecisionChangeReq {
+			changeRequested = true
+			break
+		}
+	}
+
+	approvers := approvedBy(repo, pr, reviewers)
+	approvals := len(approvers)
+
+	_, pusherApproved := approvers[pr.SourcePusher]
+	peerApproved := approvals > 1 || (approvals == 1 && !pusherApproved)
+
+	var unmet []string
+
+	// TODO: Repository admin users should be able to override this and proceed with the merge.
+	if changeRequested {
+		unmet = append(unmet, "At least one reviewer still requests changes")
+	}
+
+	if approvals < repo.PullReqMinApprovals {
+		unmet = append(unmet, fmt.Sprintf("Insufficient number of approvals: required %d, got %d",
+			repo.PullReqMinApprovals, approvals))
+	}
+
+	if repo.PullReqRequirePeerApproval && !peerApproved {
+		unmet = append(unmet, "Approval of a reviewer other than the latest pusher is required")
+	}
+
+	return unmet
+}
+
+// approvedBy returns the IDs of the principals that approved the pull request.
+// Approvals of older commits are ignored if the repository is configured to dismiss stale approvals.
+func approvedBy(repo *types.Repository, pr *types.PullReq, reviewers []*types.PullReqReviewer) map[int64]struct{} {
+	approvers := make(map[int64]struct{})
+	for _, reviewer := range reviewers {
+		if reviewer.ReviewDecision != enum.PullReqReviewDecisionApproved {
+			continue
+		}
+		if repo.PullReqDismissStaleApprovals && reviewer.SHA != pr.SourceSHA {
+			continue
+		}
+
+		approvers[reviewer.PrincipalID] = struct{}{}
+	}
+
+	return approvers
+}
+
+// verifyCodeOwners verifies that every changed file owned by someone according to
+// the CODEOWNERS file of the target branch has been approved by at least one of its owners.
+func (m *Manager) verifyCodeOwners(
+	ctx context.Context,
+	repo *types.Repository,
+	pr *types.PullReq,
+	reviewers []*types.PullReqReviewer,
+) ([]string, error) {
+	var (
+		owned []ownedPath
+		err   error
+	)
+
+	if pr.MergeTargetSHA != nil {
+		owned, err = m.codeOwnersCache.Get(ctx, codeOwnersKey{
+			repoID:     repo.ID,
+			repoGitUID: repo.GitUID,
+			targetSHA:  *pr.MergeTargetSHA,
+			sourceSHA:  pr.SourceSHA,
+		})
+	} else {
+		// the pull request hasn't been checked for mergeability yet, the target commit isn't known.
+		owned, err = findOwnedPaths(ctx, m.codeOwners, repo, pr.TargetBranch, pr.MergeBaseSHA, pr.SourceSHA)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := approvedBy(repo, pr, reviewers)
+
+	var unapproved []string
+	for _, p := range owned {
+		if !isApprovedByAny(approvers, p.ownerIDs) {
+			unapproved = append(unapproved, p.path)
+		}
+	}
+
+	if len(unapproved) == 0 {
+		return nil, nil
+	}
+
+	const maxListed = 3
+	listed := unapproved
+	if len(listed) > maxListed {
+		listed = listed[:maxListed]
+	}
+
+	msg := fmt.Sprintf("Approval of a code owner is required for %d file(s): %s",
+		len(unapproved), strings.Join(listed, ", "))
+	if len(unapproved) > maxListed {
+		msg += ", ..."
+	}
+
+	return []string{msg}, nil
+}
+
+func isApprovedByAny(approvers map[int64]struct{}, ownerIDs []int64) bool {
+	for _, ownerID := range ownerIDs {
+		if _, ok := approvers[ownerID]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verifyStatusChecks verifies that all required status checks configured for the target branch
+// of the pull request have succeeded for the latest commit of the source branch.
+func (m *Manager) verifyStatusChecks(
+	ctx context.Context,
+	repo *types.Repository,
+	pr *types.PullReq,
+) ([]string, error) {
+	reqChecks, err := m.reqCheckStore.List(ctx, repo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list required status checks: %w", err)
+	}
+
+	// the same check can be required by multiple branch patterns matching the target branch.
+	uids := make([]string, 0, len(reqChecks))
+	required := make(map[string]struct{}, len(reqChecks))
+	for _, reqCheck := range reqChecks {
+		if !MatchBranch(reqCheck.BranchPattern, pr.TargetBranch) {
+			continue
+		}
+		if _, ok := required[reqCheck.CheckUID]; ok {
+			continue
+		}
+
+		required[reqCheck.CheckUID] = struct{}{}
+		uids = append(uids, reqCheck.CheckUID)
+	}
+
+	if len(uids) == 0 {
+		return nil, nil
+	}
+
+	checks, err := m.checkStore.ListByUIDs(ctx, repo.ID, pr.SourceSHA, uids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list status check results: %w", err)
+	}
+
+	statuses := make(map[string]enum.CheckStatus, len(checks))
+	for _, check := range checks {
+		statuses[check.UID] = check.Status
+	}
+
+	var unmet []string
+	for _, uid := range uids {
+		status, ok := statuses[uid]
+		if !ok {
+			unmet = append(unmet, fmt.Sprintf("Required status check %q hasn't been reported for commit %s",
+				uid, pr.SourceSHA))
+			continue
+		}
+		if status != enum.CheckStatusSuccess {
+			unmet = append(unmet, fmt.Sprintf("Required status check %q hasn't succeeded for commit %s (status: %s)",
+				uid, pr.SourceSHA, status))
+		}
+	}
+
+	return unmet, nil
+}
+
+// verifySignedCommits verifies that all commits the pull request adds to the target branch are signed
+// in case the branch requires signed commits. Merges are written without the pre-receive hook checks,
+// so the source commits have to be verified here.
+func (m *Manager) verifySignedCommits(
+	ctx context.Context,
+	repo *types.Repository,
+	pr *types.PullReq,
+	branchProtection Protection,
+) ([]string, error) {
+	if branchProtection.RequireSignedCommits == "" {
+		return nil, nil
+	}
+
+	// without a merge base all commits not referenced by the repository would be inspected,
+	// which doesn't include the commits of the source branch.
+	if pr.MergeBaseSHA == "" {
+		return []string{fmt.Sprintf("Branch %q requires signed commits, the commits of the pull request "+
+			"haven't been inspected yet (protection rule %q)",
+			pr.TargetBranch, branchProtection.RequireSignedCommits)}, nil
+	}
+
+	// the source commits of pull requests from forks are available in the target repository via the head ref.
+	output, err := m.gitRPCClient.FindUnsignedCommit(ctx, gitrpc.FindUnsignedCommitParams{
+		ReadParams: gitrpc.CreateRPCReadParams(repo),
+		BaseSHA:    pr.MergeBaseSHA,
+		HeadSHA:    pr.SourceSHA,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unsigned commits of the pull request: %w", err)
+	}
+
+	if output.UnsignedCommitSHA == "" {
+		return nil, nil
+	}
+
+	return []string{branchProtection.ViolationUnsignedCommit(pr.TargetBranch, output.UnsignedCommitSHA)}, nil
+}
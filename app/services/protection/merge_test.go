@@ -0,0 +1,219 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestVerifyReviews(t *testing.T) {
+	const (
+		pusherID = 1
+		peerID   = 2
+		otherID  = 3
+		latest   = "latest"
+		older    = "older"
+	)
+
+	pr := &types.PullReq{SourceSHA: latest, SourcePusher: pusherID}
+
+	approved := func(principalID int64, sha string) *types.PullReqReviewer {
+		return &types.PullReqReviewer{
+			PrincipalID:    principalID,
+			ReviewDecision: enum.PullReqReviewDecisionApproved,
+			SHA:            sha,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		repo      types.Repository
+		reviewers []*types.PullReqReviewer
+		expUnmet  int
+	}{
+		{
+			name:     "no-requirements",
+			expUnmet: 0,
+		},
+		{
+			name: "change-requested",
+			reviewers: []*types.PullReqReviewer{
+				approved(peerID, latest),
+				{PrincipalID: otherID, ReviewDecision: enum.PullReqReviewDecisionChangeReq, SHA: latest},
+			},
+			expUnmet: 1,
+		},
+		{
+			name:      "min-approvals-met",
+			repo:      types.Repository{PullReqMinApprovals: 2},
+			reviewers: []*types.PullReqReviewer{approved(peerID, latest), approved(otherID, older)},
+			expUnmet:  0,
+		},
+		{
+			name:      "min-approvals-not-met",
+			repo:      types.Repository{PullReqMinApprovals: 2},
+			reviewers: []*types.PullReqReviewer{approved(peerID, latest)},
+			expUnmet:  1,
+		},
+		{
+			name:      "stale-approval-dismissed",
+			repo:      types.Repository{PullReqMinApprovals: 2, PullReqDismissStaleApprovals: true},
+			reviewers: []*types.PullReqReviewer{approved(peerID, latest), approved(otherID, older)},
+			expUnmet:  1,
+		},
+		{
+			name:      "peer-approval-missing",
+			repo:      types.Repository{PullReqRequirePeerApproval: true},
+			reviewers: []*types.PullReqReviewer{approved(pusherID, latest)},
+			expUnmet:  1,
+		},
+		{
+			name:      "peer-approval-stale",
+			repo:      types.Repository{PullReqRequirePeerApproval: true, PullReqDismissStaleApprovals: true},
+			reviewers: []*types.PullReqReviewer{approved(pusherID, latest), approved(peerID, older)},
+			expUnmet:  1,
+		},
+		{
+			name:      "peer-approval-present",
+			repo:      types.Repository{PullReqRequirePeerApproval: true, PullReqMinApprovals: 1},
+			reviewers: []*types.PullReqReviewer{approved(pusherID, latest), approved(peerID, older)},
+			expUnmet:  0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			unmet := verifyReviews(&test.repo, pr, test.reviewers)
+			if want, got := test.expUnmet, len(unmet); want != got {
+				t.Errorf("unmet requirements mismatch; want=%d got=%d: %v", want, got, unmet)
+			}
+		})
+	}
+}
+
+type fakeReqCheckStore struct {
+	store.ReqCheckStore
+	reqChecks []*types.ReqCheck
+}
+
+func (f fakeReqCheckStore) List(context.Context, int64) ([]*types.ReqCheck, error) {
+	return f.reqChecks, nil
+}
+
+type fakeCheckStore struct {
+	store.CheckStore
+	checks []types.Check
+}
+
+func (f fakeCheckStore) ListByUIDs(context.Context, int64, string, []string) ([]types.Check, error) {
+	return f.checks, nil
+}
+
+func TestVerifyStatusChecks(t *testing.T) {
+	reqChecks := []*types.ReqCheck{
+		{BranchPattern: "main", CheckUID: "build"},
+		{BranchPattern: "*", CheckUID: "build"},
+		{BranchPattern: "*", CheckUID: "test"},
+		{BranchPattern: "release/*", CheckUID: "deploy"},
+	}
+
+	tests := []struct {
+		name     string
+		checks   []types.Check
+		expUnmet int
+	}{
+		{
+			name: "all-succeeded",
+			checks: []types.Check{
+				{UID: "build", Status: enum.CheckStatusSuccess},
+				{UID: "test", Status: enum.CheckStatusSuccess},
+			},
+			expUnmet: 0,
+		},
+		{
+			name:     "check-required-twice-missing",
+			checks:   []types.Check{{UID: "test", Status: enum.CheckStatusSuccess}},
+			expUnmet: 1,
+		},
+		{
+			name: "check-required-twice-failed",
+			checks: []types.Check{
+				{UID: "build", Status: enum.CheckStatusFailure},
+				{UID: "test", Status: enum.CheckStatusPending},
+			},
+			expUnmet: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &Manager{
+				reqCheckStore: fakeReqCheckStore{reqChecks: reqChecks},
+				checkStore:    fakeCheckStore{checks: test.checks},
+			}
+
+			pr := &types.PullReq{TargetBranch: "main", SourceSHA: "sha"}
+
+			unmet, err := m.verifyStatusChecks(context.Background(), &types.Repository{ID: 1}, pr)
+			if err != nil {
+				t.Fatalf("failed to verify status checks: %s", err.Error())
+			}
+
+			if len(unmet) != test.expUnmet {
+				t.Errorf("expected %d unmet requirements, got %d: %v", test.expUnmet, len(unmet), unmet)
+			}
+		})
+	}
+}
+
+func TestVerifySignedCommits(t *testing.T) {
+	required := Protection{RequireSignedCommits: "signed"}
+
+	tests := []struct {
+		name             string
+		branchProtection Protection
+		mergeBaseSHA     string
+		unsigned         string
+		expUnmet         int
+	}{
+		{name: "not-required", branchProtection: Protection{}, mergeBaseSHA: "base", unsigned: "sha"},
+		{name: "all-signed", branchProtection: required, mergeBaseSHA: "base"},
+		{name: "unsigned", branchProtection: required, mergeBaseSHA: "base", unsigned: "sha", expUnmet: 1},
+		{name: "unknown-merge-base", branchProtection: required, expUnmet: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &Manager{gitRPCClient: &fakeGitRPC{unsigned: test.unsigned}}
+
+			pr := &types.PullReq{TargetBranch: "main", SourceSHA: "sha", MergeBaseSHA: test.mergeBaseSHA}
+
+			unmet, err := m.verifySignedCommits(context.Background(), &types.Repository{ID: 1}, pr,
+				test.branchProtection)
+			if err != nil {
+				t.Fatalf("failed to verify signed commits: %s", err.Error())
+			}
+
+			if len(unmet) != test.expUnmet {
+				t.Errorf("expected %d unmet requirements, got %d: %v", test.expUnmet, len(unmet), unmet)
+			}
+		})
+	}
+}
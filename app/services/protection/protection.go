@@ -0,0 +1,138 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protection
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/bmatcuk/doublestar"
+)
+
+var (
+	ErrPatternEmpty   = errors.New("branch pattern can't be empty")
+	ErrPatternInvalid = errors.New("branch pattern is invalid")
+)
+
+// RuleSet is a list of protection rules that apply to a repository.
+type RuleSet []*types.Rule
+
+// Protection contains the restrictions that apply to a branch for a specific principal.
+// Each field contains the uid of the rule the restriction originates from (empty if it doesn't apply).
+type Protection struct {
+	BlockForcePush       string
+	BlockDeletion        string
+	BlockDirectPush      string
+	RequireLinearHistory string
+	RequireSignedCommits string
+}
+
+// ForBranch aggregates the restrictions of all rules matching the branch.
+// Rules the principal is allowed to bypass are ignored.
+func (s RuleSet) ForBranch(principalID int64, branch string) Protection {
+	var p Protection
+	for _, rule := range s {
+		if !MatchBranch(rule.Pattern, branch) || canBypass(rule, principalID) {
+			continue
+		}
+
+		def := rule.Definition
+		if def.BlockForcePush && p.BlockForcePush == "" {
+			p.BlockForcePush = rule.UID
+		}
+		if def.BlockDeletion && p.BlockDeletion == "" {
+			p.BlockDeletion = rule.UID
+		}
+		if def.BlockDirectPush && p.BlockDirectPush == "" {
+			p.BlockDirectPush = rule.UID
+		}
+		if def.RequireLinearHistory && p.RequireLinearHistory == "" {
+			p.RequireLinearHistory = rule.UID
+		}
+		if def.RequireSignedCommits && p.RequireSignedCommits == "" {
+			p.RequireSignedCommits = rule.UID
+		}
+	}
+
+	return p
+}
+
+// IsProtected returns true if any rule of the set matches the branch.
+func (s RuleSet) IsProtected(branch string) bool {
+	for _, rule := range s {
+		if MatchBranch(rule.Pattern, branch) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ViolationForcePush returns the message for a blocked force push.
+func (p Protection) ViolationForcePush(branch string) string {
+	return fmt.Sprintf("Force pushing to branch %q is not allowed (protection rule %q)",
+		branch, p.BlockForcePush)
+}
+
+// ViolationDeletion returns the message for a blocked deletion.
+func (p Protection) ViolationDeletion(branch string) string {
+	return fmt.Sprintf("Deleting branch %q is not allowed (protection rule %q)",
+		branch, p.BlockDeletion)
+}
+
+// ViolationDirectPush returns the message for a blocked direct push.
+func (p Protection) ViolationDirectPush(branch string) string {
+	return fmt.Sprintf("Pushing directly to branch %q is not allowed, changes have to be merged via pull request "+
+		"(protection rule %q)", branch, p.BlockDirectPush)
+}
+
+// ViolationLinearHistory returns the message for a blocked merge commit.
+func (p Protection) ViolationLinearHistory(branch string) string {
+	return fmt.Sprintf("Branch %q requires a linear history, merge commits are not allowed (protection rule %q)",
+		branch, p.RequireLinearHistory)
+}
+
+// ViolationUnsignedCommit returns the message for a blocked unsigned commit.
+func (p Protection) ViolationUnsignedCommit(branch string, sha string) string {
+	return fmt.Sprintf("Branch %q requires signed commits, commit %s isn't signed (protection rule %q)",
+		branch, sha, p.RequireSignedCommits)
+}
+
+// ViolationUnsignedMerge returns the message for a blocked merge writing commits that can't be signed.
+func (p Protection) ViolationUnsignedMerge(branch string, method enum.MergeMethod) string {
+	return fmt.Sprintf("Branch %q requires signed commits, pull requests can only be merged by fast-forward, "+
+		"merge method %q creates unsigned commits (protection rule %q)",
+		branch, method, p.RequireSignedCommits)
+}
+
+// MatchBranch returns whether the branch matches the glob pattern.
+// A single '*' doesn't match '/', while '**' matches any number of path segments.
+func MatchBranch(pattern string, branch string) bool {
+	ok, err := doublestar.Match(pattern, branch)
+	return err == nil && ok
+}
+
+func canBypass(rule *types.Rule, principalID int64) bool {
+	for _, id := range rule.Definition.BypassIDs {
+		if id == principalID {
+			return true
+		}
+	}
+
+	return false
+}
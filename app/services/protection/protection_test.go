@@ -0,0 +1,141 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protection
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/types"
+)
+
+func TestRuleSetForBranch(t *testing.T) {
+	rules := RuleSet{
+		{
+			UID:     "main",
+			Pattern: "main",
+			Definition: types.RuleDefinition{
+				BlockDeletion:   true,
+				BlockDirectPush: true,
+				BypassIDs:       []int64{42},
+			},
+		},
+		{
+			UID:     "release",
+			Pattern: "release/**",
+			Definition: types.RuleDefinition{
+				BlockForcePush:       true,
+				RequireLinearHistory: true,
+				RequireSignedCommits: true,
+			},
+		},
+		{
+			UID:     "all",
+			Pattern: "*",
+			Definition: types.RuleDefinition{
+				BlockForcePush: true,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		principalID int64
+		branch      string
+		exp         Protection
+	}{
+		{
+			name:        "exact-match",
+			principalID: 1,
+			branch:      "main",
+			exp:         Protection{BlockForcePush: "all", BlockDeletion: "main", BlockDirectPush: "main"},
+		},
+		{
+			name:        "exact-match-bypassed",
+			principalID: 42,
+			branch:      "main",
+			exp:         Protection{BlockForcePush: "all"},
+		},
+		{
+			name:        "double-star-nested",
+			principalID: 1,
+			branch:      "release/v1/hotfix",
+			exp: Protection{
+				BlockForcePush:       "release",
+				RequireLinearHistory: "release",
+				RequireSignedCommits: "release",
+			},
+		},
+		{
+			name:        "single-star-doesnt-match-nested",
+			principalID: 1,
+			branch:      "feature/abc",
+			exp:         Protection{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := rules.ForBranch(test.principalID, test.branch)
+			if got != test.exp {
+				t.Errorf("expected %+v, got %+v", test.exp, got)
+			}
+		})
+	}
+}
+
+func TestRuleSetIsProtected(t *testing.T) {
+	rules := RuleSet{
+		{UID: "main", Pattern: "main"},
+		{UID: "release", Pattern: "release/**"},
+	}
+
+	tests := []struct {
+		branch string
+		exp    bool
+	}{
+		{branch: "main", exp: true},
+		{branch: "release/v1", exp: true},
+		{branch: "release/v1/hotfix", exp: true},
+		{branch: "feature/main", exp: false},
+		{branch: "dev", exp: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.branch, func(t *testing.T) {
+			if got := rules.IsProtected(test.branch); got != test.exp {
+				t.Errorf("expected %t, got %t", test.exp, got)
+			}
+		})
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		exp     error
+	}{
+		{pattern: "main", exp: nil},
+		{pattern: "release/**", exp: nil},
+		{pattern: "feature-[a-z]*", exp: nil},
+		{pattern: "", exp: ErrPatternEmpty},
+		{pattern: "feature-[a-z", exp: ErrPatternInvalid},
+	}
+
+	for _, test := range tests {
+		if err := ValidatePattern(test.pattern); err != test.exp { //nolint:errorlint // sentinel errors
+			t.Errorf("pattern %q: expected error %v, got %v", test.pattern, test.exp, err)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// AutoMergeEnable enables auto-merge of the pull request. The pull request gets merged
+// with the requested merge method as soon as it's mergeable and all merge requirements are met.
+// The caller must check that the principal is allowed to merge the pull request with the merge method.
+func (s *Service) AutoMergeEnable(
+	ctx context.Context,
+	session *auth.Session,
+	targetRepo *types.Repository,
+	pr *types.PullReq,
+	method enum.MergeMethod,
+) (*types.PullReq, error) {
+	if pr.State != enum.PullReqStateOpen {
+		return nil, usererror.BadRequest("Auto-merge can be enabled only for open pull requests.")
+	}
+
+	if pr.AutoMergeMethod != nil && *pr.AutoMergeMethod == method &&
+		pr.AutoMergeBy != nil && *pr.AutoMergeBy == session.Principal.ID {
+		return pr, nil // no changes are necessary: auto-merge is already enabled
+	}
+
+	pr, err := s.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		pr.AutoMergeBy = &session.Principal.ID
+		pr.AutoMergeMethod = &method
+
+		pr.ActivitySeq++ // because we need to add the activity entry
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pull request: %w", err)
+	}
+
+	payload := &types.PullRequestActivityPayloadAutoMerge{
+		Enabled:     true,
+		MergeMethod: method,
+	}
+	if _, errAct := s.activityStore.CreateWithPayload(ctx, pr, session.Principal.ID, payload); errAct != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(errAct).Msgf("failed to write pull request activity after enabling auto-merge")
+	}
+
+	if err = s.sseStreamer.Publish(ctx, targetRepo.ParentID, enum.SSETypePullrequesUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Msg("failed to publish PR changed event")
+	}
+
+	// the pull request might already fulfill all merge requirements
+	if err = s.autoMerge(ctx, pr); err != nil {
+		// non-critical error, auto-merge is retried on the next check, review or merge check
+		log.Ctx(ctx).Err(err).Msgf("failed to auto-merge pull request after enabling auto-merge")
+	}
+
+	pr, err = s.pullreqStore.Find(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request: %w", err)
+	}
+
+	return pr, nil
+}
+
+// AutoMergeCancel cancels auto-merge of the pull request.
+func (s *Service) AutoMergeCancel(
+	ctx context.Context,
+	session *auth.Session,
+	targetRepo *types.Repository,
+	pr *types.PullReq,
+) (*types.PullReq, error) {
+	if pr.AutoMergeMethod == nil {
+		return pr, nil // no changes are necessary: auto-merge isn't enabled
+	}
+
+	pr, err := s.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		pr.AutoMergeBy = nil
+		pr.AutoMergeMethod = nil
+
+		pr.ActivitySeq++ // because we need to add the activity entry
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pull request: %w", err)
+	}
+
+	payload := &types.PullRequestActivityPayloadAutoMerge{
+		Enabled: false,
+	}
+	if _, errAct := s.activityStore.CreateWithPayload(ctx, pr, session.Principal.ID, payload); errAct != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(errAct).Msgf("failed to write pull request activity after canceling auto-merge")
+	}
+
+	if err = s.sseStreamer.Publish(ctx, targetRepo.ParentID, enum.SSETypePullrequesUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Msg("failed to publish PR changed event")
+	}
+
+	return pr, nil
+}
@@ -0,0 +1,286 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	checkevents "github.com/harness/gitness/app/events/check"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/events"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestAutoMergeEnable(t *testing.T) {
+	s, prs, activities := testAutoMergeService()
+	session := &auth.Session{Principal: types.Principal{ID: 1}}
+	repo := &types.Repository{ID: 1}
+
+	prs.add(&types.PullReq{ID: 1, Number: 1, State: enum.PullReqStateOpen, SourceRepoID: 1, TargetRepoID: 1})
+	prs.add(&types.PullReq{ID: 2, Number: 2, State: enum.PullReqStateClosed, SourceRepoID: 1, TargetRepoID: 1})
+
+	for i := 0; i < 2; i++ {
+		pr, err := s.AutoMergeEnable(context.Background(), session, repo, prs.get(1), mergeMethodSquash)
+		if err != nil {
+			t.Fatalf("failed to enable auto-merge: %v", err)
+		}
+		if pr.AutoMergeMethod == nil || *pr.AutoMergeMethod != mergeMethodSquash ||
+			pr.AutoMergeBy == nil || *pr.AutoMergeBy != 1 {
+			t.Errorf("expected auto-merge enabled by principal 1, got %v by %v", pr.AutoMergeMethod, pr.AutoMergeBy)
+		}
+	}
+
+	// enabling auto-merge again with the same method must not write another activity.
+	if !reflect.DeepEqual(activities.autoMerge, []bool{true}) {
+		t.Errorf("expected a single auto-merge enabled activity, got %v", activities.autoMerge)
+	}
+
+	_, err := s.AutoMergeEnable(context.Background(), session, repo, prs.get(2), mergeMethodSquash)
+	var uErr *usererror.Error
+	if !errors.As(err, &uErr) || uErr.Status != http.StatusBadRequest {
+		t.Errorf("expected bad request for closed pull request, got %v", err)
+	}
+}
+
+func TestAutoMergeCancel(t *testing.T) {
+	s, prs, activities := testAutoMergeService()
+	session := &auth.Session{Principal: types.Principal{ID: 1}}
+	repo := &types.Repository{ID: 1}
+
+	method := mergeMethodMerge
+	principalID := int64(2)
+	prs.add(&types.PullReq{ID: 1, Number: 1, State: enum.PullReqStateOpen,
+		AutoMergeMethod: &method, AutoMergeBy: &principalID})
+
+	for i := 0; i < 2; i++ {
+		pr, err := s.AutoMergeCancel(context.Background(), session, repo, prs.get(1))
+		if err != nil {
+			t.Fatalf("failed to cancel auto-merge: %v", err)
+		}
+		if pr.AutoMergeMethod != nil || pr.AutoMergeBy != nil {
+			t.Errorf("expected auto-merge canceled, got %v by %v", pr.AutoMergeMethod, pr.AutoMergeBy)
+		}
+	}
+
+	if !reflect.DeepEqual(activities.autoMerge, []bool{false}) {
+		t.Errorf("expected a single auto-merge canceled activity, got %v", activities.autoMerge)
+	}
+}
+
+func TestAutoMergeOnCheckReported(t *testing.T) {
+	const (
+		repoID = 1
+		forkID = 2
+		sha    = "abc"
+	)
+
+	method := mergeMethodMerge
+	autoMergePR := func(id, sourceRepoID, targetRepoID int64, sourceSHA string) *types.PullReq {
+		// every pull request has auto-merge enabled by a different principal to identify the merge attempts.
+		principalID := 100 + id
+		return &types.PullReq{
+			ID:               id,
+			Number:           id,
+			State:            enum.PullReqStateOpen,
+			SourceRepoID:     sourceRepoID,
+			TargetRepoID:     targetRepoID,
+			SourceSHA:        sourceSHA,
+			MergeCheckStatus: enum.MergeCheckStatusMergeable,
+			AutoMergeMethod:  &method,
+			AutoMergeBy:      &principalID,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		repoID int64
+		status enum.CheckStatus
+		exp    []int64
+	}{
+		{name: "repo", repoID: repoID, status: enum.CheckStatusSuccess, exp: []int64{101, 102, 103}},
+		{name: "fork", repoID: forkID, status: enum.CheckStatusSuccess, exp: []int64{103, 104, 102}},
+		{name: "failure", repoID: repoID, status: enum.CheckStatusFailure},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, prs, _ := testAutoMergeService()
+			principals := &fakePrincipalStore{}
+			s.principalStore = principals
+
+			prs.add(autoMergePR(1, repoID, repoID, sha))
+			prs.add(autoMergePR(2, repoID, forkID, sha))     // from the repository into the fork
+			prs.add(autoMergePR(3, forkID, repoID, sha))     // from the fork, reported in the target repository
+			prs.add(autoMergePR(4, forkID, forkID, sha))     // within the fork
+			prs.add(autoMergePR(5, repoID, repoID, "other")) // another commit
+
+			noAutoMerge := autoMergePR(6, repoID, repoID, sha)
+			noAutoMerge.AutoMergeMethod = nil
+			noAutoMerge.AutoMergeBy = nil
+			prs.add(noAutoMerge)
+
+			err := s.autoMergeOnCheckReported(context.Background(), &events.Event[*checkevents.ReportedPayload]{
+				Payload: &checkevents.ReportedPayload{RepoID: test.repoID, CommitSHA: sha, Status: test.status},
+			})
+			if err != nil {
+				t.Fatalf("failed to handle check reported event: %v", err)
+			}
+
+			if !reflect.DeepEqual(principals.found, test.exp) {
+				t.Errorf("expected auto-merge attempts by %v, got %v", test.exp, principals.found)
+			}
+		})
+	}
+}
+
+func testAutoMergeService() (*Service, *fakePullReqStore, *fakeActivityStore) {
+	prs := &fakePullReqStore{prs: map[int64]*types.PullReq{}}
+	activities := &fakeActivityStore{}
+
+	s := &Service{
+		repoStore:      &fakeRepoStore{},
+		pullreqStore:   prs,
+		activityStore:  activities,
+		principalStore: &fakePrincipalStore{},
+		authorizer:     &fakeAuthorizer{},
+		sseStreamer:    &fakeStreamer{},
+	}
+
+	return s, prs, activities
+}
+
+type fakePullReqStore struct {
+	store.PullReqStore
+	prs   map[int64]*types.PullReq
+	order []int64
+}
+
+func (f *fakePullReqStore) add(pr *types.PullReq) {
+	f.prs[pr.ID] = pr
+	f.order = append(f.order, pr.ID)
+}
+
+func (f *fakePullReqStore) get(id int64) *types.PullReq {
+	pr := *f.prs[id]
+	return &pr
+}
+
+func (f *fakePullReqStore) Find(_ context.Context, id int64) (*types.PullReq, error) {
+	if _, ok := f.prs[id]; !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return f.get(id), nil
+}
+
+func (f *fakePullReqStore) FindByNumber(_ context.Context, repoID int64, number int64) (*types.PullReq, error) {
+	for _, id := range f.order {
+		if pr := f.prs[id]; pr.TargetRepoID == repoID && pr.Number == number {
+			return f.get(id), nil
+		}
+	}
+	return nil, gitness_store.ErrResourceNotFound
+}
+
+func (f *fakePullReqStore) UpdateOptLock(_ context.Context,
+	pr *types.PullReq, mutateFn func(pr *types.PullReq) error,
+) (*types.PullReq, error) {
+	updated := *pr
+	if err := mutateFn(&updated); err != nil {
+		return nil, err
+	}
+	f.prs[updated.ID] = &updated
+	return f.get(updated.ID), nil
+}
+
+func (f *fakePullReqStore) List(_ context.Context, filter *types.PullReqFilter) ([]*types.PullReq, error) {
+	var prs []*types.PullReq
+	for _, id := range f.order {
+		pr := f.prs[id]
+		if (filter.SourceRepoID != 0 && pr.SourceRepoID != filter.SourceRepoID) ||
+			(filter.TargetRepoID != 0 && pr.TargetRepoID != filter.TargetRepoID) ||
+			(filter.SourceSHA != "" && pr.SourceSHA != filter.SourceSHA) ||
+			(filter.AutoMerge && pr.AutoMergeMethod == nil) {
+			continue
+		}
+		prs = append(prs, f.get(id))
+	}
+	return prs, nil
+}
+
+type fakeActivityStore struct {
+	store.PullReqActivityStore
+	autoMerge []bool
+}
+
+func (f *fakeActivityStore) CreateWithPayload(_ context.Context,
+	pr *types.PullReq, _ int64, payload types.PullReqActivityPayload,
+) (*types.PullReqActivity, error) {
+	if p, ok := payload.(*types.PullRequestActivityPayloadAutoMerge); ok {
+		f.autoMerge = append(f.autoMerge, p.Enabled)
+	}
+	return &types.PullReqActivity{PullReqID: pr.ID, Type: payload.ActivityType()}, nil
+}
+
+type fakeRepoStore struct {
+	store.RepoStore
+}
+
+func (f *fakeRepoStore) Find(_ context.Context, id int64) (*types.Repository, error) {
+	return &types.Repository{ID: id, Path: "space/repo"}, nil
+}
+
+// fakePrincipalStore records the principals that are looked up to auto-merge a pull request.
+type fakePrincipalStore struct {
+	store.PrincipalStore
+	found []int64
+}
+
+func (f *fakePrincipalStore) Find(_ context.Context, id int64) (*types.Principal, error) {
+	f.found = append(f.found, id)
+	return &types.Principal{ID: id}, nil
+}
+
+// fakeAuthorizer denies everything, so an auto-merge attempt ends before merging.
+type fakeAuthorizer struct {
+	authz.Authorizer
+}
+
+func (f *fakeAuthorizer) Check(
+	context.Context,
+	*auth.Session,
+	*types.Scope,
+	*types.Resource,
+	enum.Permission,
+) (bool, error) {
+	return false, nil
+}
+
+type fakeStreamer struct {
+	sse.Streamer
+}
+
+func (f *fakeStreamer) Publish(context.Context, int64, enum.SSEType, any) error {
+	return nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	checkevents "github.com/harness/gitness/app/events/check"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// autoMergeOnReviewSubmitted handles pull request ReviewSubmitted events.
+// It merges the pull request if auto-merge is enabled and the review fulfilled the last merge requirement.
+func (s *Service) autoMergeOnReviewSubmitted(ctx context.Context,
+	event *events.Event[*pullreqevents.ReviewSubmittedPayload],
+) error {
+	return s.AutoMerge(ctx, event.Payload.PullReqID)
+}
+
+// autoMergeOnCheckReported handles check Reported events.
+// It merges every pull request of the commit that has auto-merge enabled and fulfills all merge requirements.
+func (s *Service) autoMergeOnCheckReported(ctx context.Context,
+	event *events.Event[*checkevents.ReportedPayload],
+) error {
+	if event.Payload.Status != enum.CheckStatusSuccess {
+		return nil // only a successful check can fulfill a merge requirement
+	}
+
+	// the commit is the head of the source branch, so the check is reported in the source repository.
+	// The pull requests from forks are listed as well, in case the check is reported in the target repository.
+	prs, err := s.listAutoMergePullReqs(ctx, &types.PullReqFilter{
+		SourceRepoID: event.Payload.RepoID,
+		SourceSHA:    event.Payload.CommitSHA,
+	})
+	if err != nil {
+		return err
+	}
+
+	forkPRs, err := s.listAutoMergePullReqs(ctx, &types.PullReqFilter{
+		TargetRepoID: event.Payload.RepoID,
+		SourceSHA:    event.Payload.CommitSHA,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pr := range forkPRs {
+		if pr.SourceRepoID != pr.TargetRepoID {
+			prs = append(prs, pr)
+		}
+	}
+
+	for _, pr := range prs {
+		if err = s.autoMerge(ctx, pr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listAutoMergePullReqs lists the open pull requests with auto-merge enabled that match the filter.
+func (s *Service) listAutoMergePullReqs(ctx context.Context, filter *types.PullReqFilter) ([]*types.PullReq, error) {
+	filter.AutoMerge = true
+	filter.States = []enum.PullReqState{enum.PullReqStateOpen}
+	filter.Sort = enum.PullReqSortNumber
+	filter.Order = enum.OrderAsc
+
+	prs, err := s.pullreqStore.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests with auto-merge enabled: %w", err)
+	}
+
+	return prs, nil
+}
+
+// AutoMerge merges the pull request if auto-merge is enabled and all merge requirements are met.
+func (s *Service) AutoMerge(ctx context.Context, pullReqID int64) error {
+	pr, err := s.pullreqStore.Find(ctx, pullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to find pull request: %w", err)
+	}
+
+	return s.autoMerge(ctx, pr)
+}
+
+func (s *Service) autoMerge(ctx context.Context, pr *types.PullReq) error {
+	if pr.AutoMergeMethod == nil || pr.AutoMergeBy == nil ||
+		pr.State != enum.PullReqStateOpen || pr.IsDraft ||
+		pr.MergeCheckStatus != enum.MergeCheckStatusMergeable {
+		return nil
+	}
+
+	method := *pr.AutoMergeMethod
+
+	repo, err := s.repoStore.Find(ctx, pr.TargetRepoID)
+	if err != nil {
+		return fmt.Errorf("failed to find target repository: %w", err)
+	}
+
+	principal, err := s.principalStore.Find(ctx, *pr.AutoMergeBy)
+	if err != nil {
+		return fmt.Errorf("failed to find principal that enabled auto-merge: %w", err)
+	}
+
+	session := &auth.Session{Principal: *principal}
+
+	if err = apiauth.CheckRepo(ctx, s.authorizer, session, repo, enum.PermissionRepoEdit, false); err != nil {
+		log.Ctx(ctx).Info().Msgf("principal %s can't auto-merge PR %d: %s", principal.UID, pr.Number, err)
+		return nil
+	}
+
+	if !repo.IsMergeMethodAllowed(method) {
+		log.Ctx(ctx).Info().Msgf("merge method %s of PR %d is no longer allowed", method, pr.Number)
+		return nil
+	}
+
+	merged, output, err := s.Merge(ctx, session, repo, pr.Number, &MergeParams{
+		Method:    method,
+		SourceSHA: pr.SourceSHA,
+	})
+
+	var userErr *usererror.Error
+	if errors.As(err, &userErr) {
+		log.Ctx(ctx).Debug().Msgf("PR %d can't be auto-merged yet: %s", pr.Number, userErr.Message)
+		return nil
+	}
+	if gitrpc.ErrorStatus(err) == gitrpc.StatusPreconditionFailed {
+		log.Ctx(ctx).Debug().Msgf("source branch of PR %d has been updated, skipping auto-merge", pr.Number)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to auto-merge pull request: %w", err)
+	}
+
+	if len(output.ConflictFiles) > 0 {
+		log.Ctx(ctx).Info().Msgf("PR %d can't be auto-merged because of conflicts", pr.Number)
+		return nil
+	}
+
+	if err = s.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullrequesUpdated, merged); err != nil {
+		log.Ctx(ctx).Warn().Msg("failed to publish PR changed event")
+	}
+
+	return nil
+}
+
+func (s *Service) writeAutoMergeCanceledActivity(ctx context.Context, pr *types.PullReq, principalID int64) error {
+	pr, err := s.pullreqStore.UpdateActivitySeq(ctx, pr)
+	if err != nil {
+		return fmt.Errorf("failed to increment pull request activity sequence: %w", err)
+	}
+
+	_, err = s.activityStore.CreateWithPayload(ctx, pr, principalID,
+		&types.PullRequestActivityPayloadAutoMerge{Enabled: false})
+	return err
+}
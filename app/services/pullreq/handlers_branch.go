@@ -23,6 +23,7 @@ import (
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/events"
 	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -49,9 +50,6 @@ func (s *Service) triggerPREventOnBranchUpdate(ctx context.Context,
 		}
 	}
 
-	// TODO: This function is currently executed directly on branch update event.
-	// TODO: But it should be executed after the PR's head ref has been updated.
-	// TODO: This is to make sure the commit exists on the target repository for forked repositories.
 	s.forEveryOpenPR(ctx, event.Payload.RepoID, event.Payload.Ref, func(pr *types.PullReq) error {
 		// First check if the merge base has changed
 
@@ -60,21 +58,17 @@ func (s *Service) triggerPREventOnBranchUpdate(ctx context.Context,
 			return fmt.Errorf("failed to get repo git info: %w", err)
 		}
 
-		mergeBaseInfo, err := s.gitRPCClient.MergeBase(ctx, gitrpc.MergeBaseParams{
-			ReadParams: gitrpc.ReadParams{RepoUID: targetRepo.GitUID},
-			Ref1:       event.Payload.NewSHA,
-			Ref2:       pr.TargetBranch,
-		})
+		newMergeBase, err := s.mergeBase(ctx, pr, targetRepo, event.Payload.NewSHA)
 		if err != nil {
 			return fmt.Errorf("failed to get merge base after branch update to=%s for PR=%d: %w",
 				event.Payload.NewSHA, pr.Number, err)
 		}
 
 		oldMergeBase := pr.MergeBaseSHA
-		newMergeBase := mergeBaseInfo.MergeBaseSHA
 
 		// Update the database with the latest source commit SHA and the merge base SHA.
 
+		var autoMergeCanceled bool
 		pr, err = s.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
 			pr.ActivitySeq++
 			if pr.SourceSHA != event.Payload.OldSHA {
@@ -84,12 +78,21 @@ func (s *Service) triggerPREventOnBranchUpdate(ctx context.Context,
 			}
 
 			pr.SourceSHA = event.Payload.NewSHA
+			pr.SourcePusher = event.Payload.PrincipalID
 			pr.MergeBaseSHA = newMergeBase
 
 			// reset merge-check fields for new run
 			pr.MergeCheckStatus = enum.MergeCheckStatusUnchecked
 			pr.MergeSHA = nil
 			pr.MergeConflicts = nil
+
+			// pushing to the source branch cancels auto-merge
+			autoMergeCanceled = pr.AutoMergeMethod != nil
+			if autoMergeCanceled {
+				pr.AutoMergeBy = nil
+				pr.AutoMergeMethod = nil
+			}
+
 			return nil
 		})
 		if err != nil {
@@ -107,6 +110,13 @@ func (s *Service) triggerPREventOnBranchUpdate(ctx context.Context,
 			log.Ctx(ctx).Err(err).Msgf("failed to write pull request activity after branch update")
 		}
 
+		if autoMergeCanceled {
+			if err = s.writeAutoMergeCanceledActivity(ctx, pr, event.Payload.PrincipalID); err != nil {
+				// non-critical error
+				log.Ctx(ctx).Err(err).Msgf("failed to write pull request activity after auto-merge cancel")
+			}
+		}
+
 		s.pullreqEvReporter.BranchUpdated(ctx, &pullreqevents.BranchUpdatedPayload{
 			Base: pullreqevents.Base{
 				PullReqID:    pr.ID,
@@ -182,6 +192,49 @@ func (s *Service) closePullReqOnBranchDelete(ctx context.Context,
 	return nil
 }
 
+// mergeBase returns the merge base of the source commit and the target branch of the pull request.
+// The source commit of a pull request from a fork doesn't exist in the target repository yet,
+// hence the merge base is calculated in the fork, which has access to all objects of its upstream.
+func (s *Service) mergeBase(
+	ctx context.Context,
+	pr *types.PullReq,
+	targetRepo *types.RepositoryGitInfo,
+	sourceSHA string,
+) (string, error) {
+	repoUID := targetRepo.GitUID
+	targetRef := pr.TargetBranch
+
+	if pr.SourceRepoID != pr.TargetRepoID {
+		sourceRepo, err := s.repoGitInfoCache.Get(ctx, pr.SourceRepoID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get source repo git info: %w", err)
+		}
+
+		targetBranch, err := s.gitRPCClient.GetRef(ctx, gitrpc.GetRefParams{
+			ReadParams: gitrpc.ReadParams{RepoUID: targetRepo.GitUID},
+			Name:       pr.TargetBranch,
+			Type:       gitrpcenum.RefTypeBranch,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to get target branch: %w", err)
+		}
+
+		repoUID = sourceRepo.GitUID
+		targetRef = targetBranch.SHA
+	}
+
+	mergeBaseInfo, err := s.gitRPCClient.MergeBase(ctx, gitrpc.MergeBaseParams{
+		ReadParams: gitrpc.ReadParams{RepoUID: repoUID},
+		Ref1:       sourceSHA,
+		Ref2:       targetRef,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return mergeBaseInfo.MergeBaseSHA, nil
+}
+
 // forEveryOpenPR is utility function that executes the provided function
 // for every open pull request created with the source branch given as a git ref.
 func (s *Service) forEveryOpenPR(ctx context.Context,
@@ -0,0 +1,185 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/bootstrap"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// addCodeOwnersOnCreated handles pull request Created events.
+// It assigns the code owners of the changed files as reviewers of the pull request.
+func (s *Service) addCodeOwnersOnCreated(ctx context.Context,
+	event *events.Event[*pullreqevents.CreatedPayload],
+) error {
+	return s.addCodeOwners(ctx, event.Payload.PullReqID, event.Payload.SourceSHA)
+}
+
+// addCodeOwnersOnBranchUpdate handles pull request BranchUpdated events.
+// It assigns the code owners of the changed files as reviewers of the pull request.
+func (s *Service) addCodeOwnersOnBranchUpdate(ctx context.Context,
+	event *events.Event[*pullreqevents.BranchUpdatedPayload],
+) error {
+	return s.addCodeOwners(ctx, event.Payload.PullReqID, event.Payload.NewSHA)
+}
+
+func (s *Service) addCodeOwners(ctx context.Context, pullReqID int64, sourceSHA string) error {
+	pr, err := s.pullreqStore.Find(ctx, pullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to find pull request: %w", err)
+	}
+
+	if pr.State != enum.PullReqStateOpen || pr.SourceSHA != sourceSHA {
+		return nil // the pull request has been closed or updated in the meantime
+	}
+
+	repo, err := s.repoStore.Find(ctx, pr.TargetRepoID)
+	if err != nil {
+		return fmt.Errorf("failed to find target repository: %w", err)
+	}
+
+	codeOwners, err := s.codeOwners.Get(ctx, repo, pr.TargetBranch)
+	if errors.Is(err, codeowners.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get code owners: %w", err)
+	}
+
+	paths, err := s.codeOwners.ChangedPaths(ctx, repo, pr.MergeBaseSHA, pr.SourceSHA)
+	if err != nil {
+		return fmt.Errorf("failed to get changed paths: %w", err)
+	}
+
+	reviewers, err := s.reviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list reviewers: %w", err)
+	}
+
+	// the author can't be a reviewer, existing reviewers are left untouched.
+	skip := map[int64]struct{}{pr.CreatedBy: {}}
+	for _, reviewer := range reviewers {
+		skip[reviewer.PrincipalID] = struct{}{}
+	}
+
+	var added []int64
+
+	resolver := s.codeOwners.NewResolver()
+	for _, path := range paths {
+		entry, ok := codeOwners.Find(path)
+		if !ok {
+			continue
+		}
+
+		owners, err := resolver.Resolve(ctx, entry.Owners)
+		if err != nil {
+			return fmt.Errorf("failed to resolve code owners of %s: %w", path, err)
+		}
+
+		for i := range owners {
+			if _, ok := skip[owners[i].ID]; ok {
+				continue
+			}
+			skip[owners[i].ID] = struct{}{}
+
+			ok, err := s.addCodeOwnerReviewer(ctx, repo, pr, &owners[i])
+			if err != nil {
+				return err
+			}
+			if ok {
+				added = append(added, owners[i].ID)
+			}
+		}
+	}
+
+	if len(added) == 0 {
+		return nil
+	}
+
+	systemPrincipal := bootstrap.NewSystemServiceSession().Principal
+	payload := &types.PullRequestActivityPayloadReviewerAdd{
+		PrincipalIDs: added,
+		CodeOwners:   true,
+	}
+	if _, err = s.activityStore.CreateWithPayload(ctx, pr, systemPrincipal.ID, payload); err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msgf("failed to write pull request activity after adding code owners as reviewers")
+	}
+
+	return nil
+}
+
+// addCodeOwnerReviewer adds the code owner as reviewer of the pull request.
+// It returns false if the code owner can't be a reviewer as it doesn't have access to the repository.
+func (s *Service) addCodeOwnerReviewer(
+	ctx context.Context,
+	repo *types.Repository,
+	pr *types.PullReq,
+	owner *types.PrincipalInfo,
+) (bool, error) {
+	principal, err := s.principalStore.Find(ctx, owner.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find code owner principal: %w", err)
+	}
+
+	if err = apiauth.CheckRepo(ctx, s.authorizer, &auth.Session{
+		Principal: *principal,
+	}, repo, enum.PermissionRepoView, false); err != nil {
+		log.Ctx(ctx).Info().Msgf("code owner %s can't be added as reviewer of PR %d: %s",
+			owner.UID, pr.Number, err)
+		return false, nil
+	}
+
+	systemPrincipal := bootstrap.NewSystemServiceSession().Principal
+	now := time.Now().UnixMilli()
+	reviewer := &types.PullReqReviewer{
+		PullReqID:      pr.ID,
+		PrincipalID:    owner.ID,
+		CreatedBy:      systemPrincipal.ID,
+		Created:        now,
+		Updated:        now,
+		RepoID:         repo.ID,
+		Type:           enum.PullReqReviewerTypeAssigned,
+		LatestReviewID: nil,
+		ReviewDecision: enum.PullReqReviewDecisionPending,
+		SHA:            "",
+		Reviewer:       *owner,
+		AddedBy:        *systemPrincipal.ToPrincipalInfo(),
+	}
+
+	if err = s.reviewerStore.Create(ctx, reviewer); err != nil {
+		return false, fmt.Errorf("failed to add code owner %s as reviewer: %w", owner.UID, err)
+	}
+
+	s.pullreqEvReporter.ReviewerAdded(ctx, &pullreqevents.ReviewerAddedPayload{
+		Base:       eventBase(pr, &systemPrincipal),
+		ReviewerID: owner.ID,
+	})
+
+	return true, nil
+}
@@ -40,14 +40,19 @@ func (s *Service) createHeadRefOnCreated(ctx context.Context,
 		return fmt.Errorf("failed to generate rpc write params: %w", err)
 	}
 
-	// TODO: This doesn't work for forked repos (only works when sourceRepo==targetRepo).
-	// This is because commits from the source repository must be first pulled into the target repository.
+	sourceRepoUID, err := s.forkRepoGitUID(ctx, event.Payload.Base)
+	if err != nil {
+		return err
+	}
+
 	err = s.gitRPCClient.UpdateRef(ctx, gitrpc.UpdateRefParams{
 		WriteParams: writeParams,
 		Name:        strconv.Itoa(int(event.Payload.Number)),
 		Type:        gitrpcenum.RefTypePullReqHead,
 		NewValue:    event.Payload.SourceSHA,
 		OldValue:    "", // this is a new pull request, so we expect that the ref doesn't exist
+
+		SourceRepoUID: sourceRepoUID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update PR head ref: %w", err)
@@ -71,14 +76,19 @@ func (s *Service) updateHeadRefOnBranchUpdate(ctx context.Context,
 		return fmt.Errorf("failed to generate rpc write params: %w", err)
 	}
 
-	// TODO: This doesn't work for forked repos (only works when sourceRepo==targetRepo)
-	// This is because commits from the source repository must be first pulled into the target repository.
+	sourceRepoUID, err := s.forkRepoGitUID(ctx, event.Payload.Base)
+	if err != nil {
+		return err
+	}
+
 	err = s.gitRPCClient.UpdateRef(ctx, gitrpc.UpdateRefParams{
 		WriteParams: writeParams,
 		Name:        strconv.Itoa(int(event.Payload.Number)),
 		Type:        gitrpcenum.RefTypePullReqHead,
 		NewValue:    event.Payload.NewSHA,
 		OldValue:    event.Payload.OldSHA,
+
+		SourceRepoUID: sourceRepoUID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update PR head ref after new commit: %w", err)
@@ -102,14 +112,19 @@ func (s *Service) updateHeadRefOnReopen(ctx context.Context,
 		return fmt.Errorf("failed to generate rpc write params: %w", err)
 	}
 
-	// TODO: This doesn't work for forked repos (only works when sourceRepo==targetRepo)
-	// This is because commits from the source repository must be first pulled into the target repository.
+	sourceRepoUID, err := s.forkRepoGitUID(ctx, event.Payload.Base)
+	if err != nil {
+		return err
+	}
+
 	err = s.gitRPCClient.UpdateRef(ctx, gitrpc.UpdateRefParams{
 		WriteParams: writeParams,
 		Name:        strconv.Itoa(int(event.Payload.Number)),
 		Type:        gitrpcenum.RefTypePullReqHead,
 		NewValue:    event.Payload.SourceSHA,
 		OldValue:    "", // the request is re-opened, so anything can be the old value
+
+		SourceRepoUID: sourceRepoUID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update PR head ref after pull request reopen: %w", err)
@@ -117,3 +132,18 @@ func (s *Service) updateHeadRefOnReopen(ctx context.Context,
 
 	return nil
 }
+
+// forkRepoGitUID returns the git UID of the source repository of the pull request if it's a fork.
+// The commits of a fork have to be copied to the target repository along with the PR head ref.
+func (s *Service) forkRepoGitUID(ctx context.Context, base pullreqevents.Base) (string, error) {
+	if base.SourceRepoID == base.TargetRepoID {
+		return "", nil
+	}
+
+	sourceRepoGit, err := s.repoGitInfoCache.Get(ctx, base.SourceRepoID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get source repo git info: %w", err)
+	}
+
+	return sourceRepoGit.GitUID, nil
+}
@@ -82,14 +82,14 @@ func (s *Service) mergeCheckOnReopen(ctx context.Context,
 func (s *Service) mergeCheckOnClosed(ctx context.Context,
 	event *events.Event[*pullreqevents.ClosedPayload],
 ) error {
-	return s.deleteMergeRef(ctx, event.Payload.SourceRepoID, event.Payload.Number)
+	return s.deleteMergeRef(ctx, event.Payload.TargetRepoID, event.Payload.Number)
 }
 
 // mergeCheckOnMerged deletes the merge ref.
 func (s *Service) mergeCheckOnMerged(ctx context.Context,
 	event *events.Event[*pullreqevents.MergedPayload],
 ) error {
-	return s.deleteMergeRef(ctx, event.Payload.SourceRepoID, event.Payload.Number)
+	return s.deleteMergeRef(ctx, event.Payload.TargetRepoID, event.Payload.Number)
 }
 
 func (s *Service) deleteMergeRef(ctx context.Context, repoID int64, prNum int64) error {
@@ -103,7 +103,6 @@ func (s *Service) deleteMergeRef(ctx context.Context, repoID int64, prNum int64)
 		return fmt.Errorf("failed to generate rpc write params: %w", err)
 	}
 
-	// TODO: This doesn't work for forked repos
 	err = s.gitRPCClient.UpdateRef(ctx, gitrpc.UpdateRefParams{
 		WriteParams: writeParams,
 		Name:        strconv.Itoa(int(prNum)),
@@ -247,7 +246,7 @@ func (s *Service) updateMergeDataInner(
 	}
 
 	// Update DB in both cases (failure or success)
-	_, err = s.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+	pr, err = s.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
 		if pr.SourceSHA != newSHA {
 			return events.NewDiscardEventErrorf("PR SHA %s is newer than %s", pr.SourceSHA, newSHA)
 		}
@@ -275,5 +274,10 @@ func (s *Service) updateMergeDataInner(
 		log.Ctx(ctx).Warn().Msg("failed to publish PR changed event")
 	}
 
+	if err = s.autoMerge(ctx, pr); err != nil {
+		// non-critical error, the merge check itself has succeeded
+		log.Ctx(ctx).Err(err).Msgf("failed to auto-merge PR %d after merge check", pr.Number)
+	}
+
 	return nil
 }
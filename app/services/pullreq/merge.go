@@ -0,0 +1,271 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/controller"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/bootstrap"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/lock"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// MergeParams holds the parameters of a pull request merge.
+type MergeParams struct {
+	Method    enum.MergeMethod
+	SourceSHA string
+
+	// Title and Message of the merge commit, repository templates or defaults are used if empty.
+	Title   string
+	Message string
+}
+
+// Merge merges the pull request into its target branch if all merge requirements are met.
+// The caller must check that the principal is allowed to merge the pull request with the merge method.
+//
+//nolint:funlen // refactor if required.
+func (s *Service) Merge(
+	ctx context.Context,
+	session *auth.Session,
+	targetRepo *types.Repository,
+	pullreqNum int64,
+	params *MergeParams,
+) (*types.PullReq, types.MergeResponse, error) {
+	// if two requests for merging comes at the same time then mutex will lock
+	// first one and second one will wait, when first one is done then second one
+	// continue with latest data from db with state merged and return error that
+	// pr is already merged.
+	mutex, err := s.newMutexForPR(targetRepo.GitUID, 0) // 0 means locks all PRs for this repo
+	if err != nil {
+		return nil, types.MergeResponse{}, err
+	}
+	err = mutex.Lock(ctx)
+	if err != nil {
+		return nil, types.MergeResponse{}, err
+	}
+	defer func() {
+		_ = mutex.Unlock(ctx)
+	}()
+
+	pr, err := s.pullreqStore.FindByNumber(ctx, targetRepo.ID, pullreqNum)
+	if err != nil {
+		return nil, types.MergeResponse{}, fmt.Errorf("failed to get pull request by number: %w", err)
+	}
+
+	// the merge requirements are verified for the current source commit of the pull request,
+	// so it's the only commit that can be merged.
+	sourceSHA := params.SourceSHA
+	if sourceSHA == "" {
+		sourceSHA = pr.SourceSHA
+	}
+	if sourceSHA != pr.SourceSHA {
+		return nil, types.MergeResponse{}, usererror.BadRequestf(
+			"Source branch is not on the expected commit %s anymore, it's on %s.", sourceSHA, pr.SourceSHA)
+	}
+
+	if err = s.verifyMerge(ctx, session, targetRepo, pr, params.Method); err != nil {
+		return nil, types.MergeResponse{}, err
+	}
+
+	sourceRepo := targetRepo
+	if pr.SourceRepoID != pr.TargetRepoID {
+		sourceRepo, err = s.repoStore.Find(ctx, pr.SourceRepoID)
+		if err != nil {
+			return nil, types.MergeResponse{}, fmt.Errorf("failed to get source repository: %w", err)
+		}
+	}
+
+	var writeParams gitrpc.WriteParams
+	// protection rules are enforced above, the githooks don't have to verify them again.
+	writeParams, err = controller.CreateRPCInternalWriteParams(ctx, s.urlProvider, session, targetRepo)
+	if err != nil {
+		return nil, types.MergeResponse{}, fmt.Errorf("failed to create RPC write params: %w", err)
+	}
+
+	var mergeTitle, mergeMessage string
+	mergeTitle, mergeMessage, err = s.mergeCommitMessage(ctx, session.Principal, targetRepo, sourceRepo, pr, params)
+	if err != nil {
+		return nil, types.MergeResponse{}, fmt.Errorf("failed to create merge commit message: %w", err)
+	}
+
+	now := time.Now()
+	var mergeOutput gitrpc.MergeOutput
+	mergeOutput, err = s.gitRPCClient.Merge(ctx, &gitrpc.MergeParams{
+		WriteParams:     writeParams,
+		BaseBranch:      pr.TargetBranch,
+		HeadRepoUID:     sourceRepo.GitUID,
+		HeadBranch:      pr.SourceBranch,
+		Title:           mergeTitle,
+		Message:         mergeMessage,
+		Committer:       rpcIdentityFromPrincipal(bootstrap.NewSystemServiceSession().Principal),
+		CommitterDate:   &now,
+		Author:          rpcIdentityFromPrincipal(session.Principal),
+		AuthorDate:      &now,
+		RefType:         gitrpcenum.RefTypeBranch,
+		RefName:         pr.TargetBranch,
+		HeadExpectedSHA: sourceSHA,
+		Method:          gitrpcenum.MergeMethod(params.Method),
+	})
+	if err != nil {
+		if gitrpc.ErrorStatus(err) == gitrpc.StatusNotMergeable {
+			return nil, types.MergeResponse{
+				ConflictFiles: gitrpc.AsConflictFilesError(err),
+			}, nil
+		}
+		return nil, types.MergeResponse{}, fmt.Errorf("merge check execution failed: %w", err)
+	}
+
+	pr, err = s.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		pr.State = enum.PullReqStateMerged
+
+		now := time.Now().UnixMilli()
+		pr.Merged = &now
+		pr.MergedBy = &session.Principal.ID
+		pr.MergeMethod = &params.Method
+
+		// update all Merge specific information (might be empty if previous merge check failed)
+		pr.MergeCheckStatus = enum.MergeCheckStatusMergeable
+		pr.MergeTargetSHA = &mergeOutput.BaseSHA
+		pr.MergeBaseSHA = mergeOutput.MergeBaseSHA
+		pr.MergeSHA = &mergeOutput.MergeSHA
+		pr.MergeConflicts = nil
+
+		// the pull request is merged, auto-merge is no longer pending
+		pr.AutoMergeBy = nil
+		pr.AutoMergeMethod = nil
+
+		pr.ActivitySeq++ // because we need to write the activity entry
+		return nil
+	})
+	if err != nil {
+		return nil, types.MergeResponse{}, fmt.Errorf("failed to update pull request: %w", err)
+	}
+
+	activityPayload := &types.PullRequestActivityPayloadMerge{
+		MergeMethod: params.Method,
+		MergeSHA:    mergeOutput.MergeSHA,
+		TargetSHA:   mergeOutput.BaseSHA,
+		SourceSHA:   mergeOutput.HeadSHA,
+	}
+	if _, errAct := s.activityStore.CreateWithPayload(ctx, pr, session.Principal.ID, activityPayload); errAct != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(errAct).Msgf("failed to write pull req merge activity")
+	}
+
+	s.pullreqEvReporter.Merged(ctx, &pullreqevents.MergedPayload{
+		Base:        eventBase(pr, &session.Principal),
+		MergeMethod: params.Method,
+		MergeSHA:    mergeOutput.MergeSHA,
+		TargetSHA:   mergeOutput.BaseSHA,
+		SourceSHA:   mergeOutput.HeadSHA,
+	})
+
+	return pr, types.MergeResponse{SHA: mergeOutput.MergeSHA}, nil
+}
+
+// verifyMerge returns a user error if the pull request can't be merged with the merge method by the principal.
+func (s *Service) verifyMerge(
+	ctx context.Context,
+	session *auth.Session,
+	targetRepo *types.Repository,
+	pr *types.PullReq,
+	method enum.MergeMethod,
+) error {
+	if pr.Merged != nil {
+		return usererror.BadRequest("Pull request already merged")
+	}
+
+	if pr.State != enum.PullReqStateOpen {
+		return usererror.BadRequest("Pull request must be open")
+	}
+
+	if pr.IsDraft {
+		return usererror.BadRequest(
+			"Draft pull requests can't be merged. Clear the draft flag first.",
+		)
+	}
+
+	branchProtection, err := s.protectionManager.ForBranch(ctx, targetRepo, session.Principal.ID, pr.TargetBranch)
+	if err != nil {
+		return fmt.Errorf("failed to get protection of the target branch: %w", err)
+	}
+
+	if err = verifyMergeMethod(branchProtection, pr, method); err != nil {
+		return err
+	}
+
+	reviewers, err := s.reviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load list of reviwers: %w", err)
+	}
+
+	unmet, err := s.protectionManager.VerifyMerge(ctx, targetRepo, session.Principal.ID, pr, reviewers)
+	if err != nil {
+		return fmt.Errorf("failed to verify merge requirements: %w", err)
+	}
+
+	if len(unmet) > 0 {
+		return usererror.BadRequestf("Pull request can't be merged: %s.", strings.Join(unmet, "; "))
+	}
+
+	return nil
+}
+
+// verifyMergeMethod returns a user error if the protection of the target branch doesn't allow the merge method.
+// Merges aren't verified by the pre-receive hook, so the commits the merge writes are checked here.
+func verifyMergeMethod(branchProtection protection.Protection, pr *types.PullReq, method enum.MergeMethod) error {
+	if branchProtection.RequireLinearHistory != "" && method.CreatesMergeCommit() {
+		return usererror.BadRequest(branchProtection.ViolationLinearHistory(pr.TargetBranch))
+	}
+
+	// the server doesn't sign the commits it creates, the source commits are verified by the protection manager.
+	if branchProtection.RequireSignedCommits != "" && method.CreatesCommits() {
+		return usererror.BadRequest(branchProtection.ViolationUnsignedMerge(pr.TargetBranch, method))
+	}
+
+	return nil
+}
+
+func (s *Service) newMutexForPR(repoUID string, pr int64, options ...lock.Option) (lock.Mutex, error) {
+	key := repoUID + "/pulls"
+	if pr != 0 {
+		key += "/" + strconv.FormatInt(pr, 10)
+	}
+	return s.mtxManager.NewMutex(key, append(options, lock.WithNamespace("repo"))...)
+}
+
+func eventBase(pr *types.PullReq, principal *types.Principal) pullreqevents.Base {
+	return pullreqevents.Base{
+		PullReqID:    pr.ID,
+		SourceRepoID: pr.SourceRepoID,
+		TargetRepoID: pr.TargetRepoID,
+		Number:       pr.Number,
+		PrincipalID:  principal.ID,
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	defaultMergeTitleTemplate  = "Merge branch '%{source_branch}' of %{source_repo} (#%{number})"
+	defaultSquashTitleTemplate = "%{title} (#%{number})"
+
+	placeholderCoAuthoredBy = "%{co_authored_by}"
+
+	// defaultSquashMessageTemplate keeps the authors of the squashed commits as co-authors.
+	defaultSquashMessageTemplate = placeholderCoAuthoredBy
+
+	// coAuthorsMaxCommits is the maximum number of pull request commits inspected for co-authors.
+	coAuthorsMaxCommits = 1000
+)
+
+// mergeCommitMessage returns the title and the message of the commit created by the merge.
+// Title and message provided in the params take precedence over the repository templates.
+// They are used as they are, only the repository templates are expanded.
+func (s *Service) mergeCommitMessage(
+	ctx context.Context,
+	author types.Principal,
+	targetRepo *types.Repository,
+	sourceRepo *types.Repository,
+	pr *types.PullReq,
+	params *MergeParams,
+) (string, string, error) {
+	titleTemplate := targetRepo.PullReqMergeTitleTemplate
+	messageTemplate := targetRepo.PullReqMergeMessageTemplate
+	defaultTitleTemplate := defaultMergeTitleTemplate
+	if params.Method == enum.MergeMethod(gitrpcenum.MergeMethodSquash) {
+		titleTemplate = targetRepo.PullReqSquashTitleTemplate
+		messageTemplate = targetRepo.PullReqSquashMessageTemplate
+		defaultTitleTemplate = defaultSquashTitleTemplate
+		if messageTemplate == "" {
+			messageTemplate = defaultSquashMessageTemplate
+		}
+	}
+
+	if titleTemplate == "" {
+		titleTemplate = defaultTitleTemplate
+	}
+
+	// the templates aren't needed if the title and the message are provided.
+	if params.Title != "" {
+		titleTemplate = ""
+	}
+	if params.Message != "" {
+		messageTemplate = ""
+	}
+
+	var coAuthoredBy string
+	if strings.Contains(titleTemplate, placeholderCoAuthoredBy) ||
+		strings.Contains(messageTemplate, placeholderCoAuthoredBy) {
+		coAuthors, err := s.listCoAuthors(ctx, sourceRepo, pr, author)
+		if err != nil {
+			return "", "", err
+		}
+
+		lines := make([]string, len(coAuthors))
+		for i, coAuthor := range coAuthors {
+			lines[i] = fmt.Sprintf("Co-authored-by: %s <%s>", coAuthor.Name, coAuthor.Email)
+		}
+		coAuthoredBy = strings.Join(lines, "\n")
+	}
+
+	replacer := strings.NewReplacer(
+		"%{title}", pr.Title,
+		"%{number}", strconv.FormatInt(pr.Number, 10),
+		"%{description}", pr.Description,
+		"%{source_branch}", pr.SourceBranch,
+		"%{target_branch}", pr.TargetBranch,
+		"%{source_repo}", sourceRepo.Path,
+		placeholderCoAuthoredBy, coAuthoredBy,
+	)
+
+	title := params.Title
+	if title == "" {
+		title = replacer.Replace(titleTemplate)
+	}
+
+	title = singleLine(title)
+	if title == "" {
+		title = singleLine(replacer.Replace(defaultTitleTemplate))
+	}
+
+	message := params.Message
+	if message == "" {
+		message = replacer.Replace(messageTemplate)
+	}
+
+	message = strings.TrimSpace(message)
+
+	return title, message, nil
+}
+
+// singleLine joins the lines of the text, because the placeholders can expand to multiple lines,
+// but the commit title must remain a single line.
+func singleLine(text string) string {
+	return strings.TrimSpace(strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(text))
+}
+
+// listCoAuthors returns distinct authors of the pull request commits, in order of their first commit,
+// excluding the author of the merge commit.
+func (s *Service) listCoAuthors(
+	ctx context.Context,
+	sourceRepo *types.Repository,
+	pr *types.PullReq,
+	author types.Principal,
+) ([]gitrpc.Identity, error) {
+	output, err := s.gitRPCClient.ListCommits(ctx, &gitrpc.ListCommitsParams{
+		ReadParams: gitrpc.CreateRPCReadParams(sourceRepo),
+		GitREF:     pr.SourceSHA,
+		After:      pr.MergeBaseSHA,
+		Page:       1,
+		Limit:      coAuthorsMaxCommits,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request commits: %w", err)
+	}
+
+	seen := map[string]struct{}{
+		strings.ToLower(author.Email): {},
+	}
+	coAuthors := make([]gitrpc.Identity, 0)
+
+	// commits are listed newest first
+	for i := len(output.Commits) - 1; i >= 0; i-- {
+		identity := output.Commits[i].Author.Identity
+		email := strings.ToLower(identity.Email)
+		if _, ok := seen[email]; ok || email == "" {
+			continue
+		}
+
+		seen[email] = struct{}{}
+		coAuthors = append(coAuthors, identity)
+	}
+
+	return coAuthors, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/gitrpc"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	mergeMethodMerge  = enum.MergeMethod(gitrpcenum.MergeMethodMerge)
+	mergeMethodSquash = enum.MergeMethod(gitrpcenum.MergeMethodSquash)
+)
+
+func TestMergeCommitMessage(t *testing.T) {
+	author := types.Principal{Email: "author@example.com"}
+	sourceRepo := &types.Repository{Path: "space/fork"}
+	pr := &types.PullReq{
+		Number:       7,
+		Title:        "Add feature",
+		Description:  "Details of the feature.",
+		SourceBranch: "feature",
+		TargetBranch: "main",
+	}
+
+	tests := []struct {
+		name       string
+		targetRepo *types.Repository
+		params     *MergeParams
+		expTitle   string
+		expMessage string
+	}{
+		{
+			name:       "merge-default",
+			targetRepo: &types.Repository{},
+			params:     &MergeParams{Method: mergeMethodMerge},
+			expTitle:   "Merge branch 'feature' of space/fork (#7)",
+		},
+		{
+			name:       "squash-default",
+			targetRepo: &types.Repository{},
+			params:     &MergeParams{Method: mergeMethodSquash},
+			expTitle:   "Add feature (#7)",
+			expMessage: "Co-authored-by: Bob <bob@example.com>\nCo-authored-by: Alice <alice@example.com>",
+		},
+		{
+			name: "merge-templates",
+			targetRepo: &types.Repository{
+				PullReqMergeTitleTemplate:   "%{title} into %{target_branch}",
+				PullReqMergeMessageTemplate: "%{description}\n\n%{co_authored_by}",
+			},
+			params:   &MergeParams{Method: mergeMethodMerge},
+			expTitle: "Add feature into main",
+			expMessage: "Details of the feature.\n\n" +
+				"Co-authored-by: Bob <bob@example.com>\nCo-authored-by: Alice <alice@example.com>",
+		},
+		{
+			name: "squash-templates",
+			targetRepo: &types.Repository{
+				PullReqMergeTitleTemplate:    "merge",
+				PullReqSquashTitleTemplate:   "%{title}",
+				PullReqSquashMessageTemplate: "%{description}",
+			},
+			params:     &MergeParams{Method: mergeMethodSquash},
+			expTitle:   "Add feature",
+			expMessage: "Details of the feature.",
+		},
+		{
+			name:       "multi-line-title-template",
+			targetRepo: &types.Repository{PullReqMergeTitleTemplate: "%{title}: %{description}"},
+			params:     &MergeParams{Method: mergeMethodMerge},
+			expTitle:   "Add feature: Details of the feature.",
+		},
+		{
+			name:       "provided-title-and-message",
+			targetRepo: &types.Repository{PullReqSquashMessageTemplate: "%{description}"},
+			params: &MergeParams{
+				Method:  mergeMethodSquash,
+				Title:   "Fix %{title}",
+				Message: "Keep %{co_authored_by}",
+			},
+			expTitle:   "Fix %{title}",
+			expMessage: "Keep %{co_authored_by}",
+		},
+		{
+			name:       "provided-title",
+			targetRepo: &types.Repository{},
+			params:     &MergeParams{Method: mergeMethodSquash, Title: "Custom"},
+			expTitle:   "Custom",
+			expMessage: "Co-authored-by: Bob <bob@example.com>\nCo-authored-by: Alice <alice@example.com>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// commits are listed newest first, the co-authors are ordered by their first commit.
+			s := &Service{gitRPCClient: &fakeGitRPC{commits: []gitrpc.Commit{
+				commitBy("Bob", "bob@example.com"),
+				commitBy("Author", "AUTHOR@example.com"),
+				commitBy("Alice", "alice@example.com"),
+				commitBy("Bob", "bob@example.com"),
+			}}}
+
+			title, message, err := s.mergeCommitMessage(context.Background(),
+				author, test.targetRepo, sourceRepo, pr, test.params)
+			if err != nil {
+				t.Fatalf("failed to create merge commit message: %v", err)
+			}
+			if title != test.expTitle {
+				t.Errorf("expected title %q, got %q", test.expTitle, title)
+			}
+			if message != test.expMessage {
+				t.Errorf("expected message %q, got %q", test.expMessage, message)
+			}
+		})
+	}
+}
+
+func commitBy(name, email string) gitrpc.Commit {
+	return gitrpc.Commit{Author: gitrpc.Signature{Identity: gitrpc.Identity{Name: name, Email: email}}}
+}
+
+type fakeGitRPC struct {
+	gitrpc.Interface
+	commits []gitrpc.Commit
+}
+
+func (f *fakeGitRPC) ListCommits(context.Context, *gitrpc.ListCommitsParams) (*gitrpc.ListCommitsOutput, error) {
+	return &gitrpc.ListCommitsOutput{Commits: f.commits}, nil
+}
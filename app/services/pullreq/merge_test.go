@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/protection"
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/lock"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestMergeRejectsMovedSourceBranch(t *testing.T) {
+	s, prs, _ := testAutoMergeService()
+	s.mtxManager = lock.NewInMemory(lock.Config{Expiry: time.Minute, Tries: 1})
+
+	prs.add(&types.PullReq{ID: 1, Number: 1, State: enum.PullReqStateOpen,
+		SourceRepoID: 1, TargetRepoID: 1, SourceSHA: "new"})
+
+	// the pull request must be rejected before any merge requirement is verified or the merge is executed.
+	_, _, err := s.Merge(context.Background(), &auth.Session{Principal: types.Principal{ID: 1}},
+		&types.Repository{ID: 1}, 1, &MergeParams{Method: mergeMethodMerge, SourceSHA: "old"})
+
+	var uErr *usererror.Error
+	if !errors.As(err, &uErr) || uErr.Status != http.StatusBadRequest {
+		t.Errorf("expected bad request for outdated source sha, got %v", err)
+	}
+}
+
+func TestVerifyMergeMethod(t *testing.T) {
+	linear := protection.Protection{RequireLinearHistory: "linear"}
+	signed := protection.Protection{RequireSignedCommits: "signed"}
+
+	tests := []struct {
+		protection protection.Protection
+		method     gitrpcenum.MergeMethod
+		allowed    bool
+	}{
+		{protection.Protection{}, gitrpcenum.MergeMethodMerge, true},
+		{protection.Protection{}, gitrpcenum.MergeMethodSquash, true},
+		{linear, gitrpcenum.MergeMethodMerge, false},
+		{linear, gitrpcenum.MergeMethodRebaseMerge, false},
+		{linear, gitrpcenum.MergeMethodSquash, true},
+		{linear, gitrpcenum.MergeMethodRebase, true},
+		{linear, gitrpcenum.MergeMethodFastForward, true},
+		// the server doesn't sign the commits it creates, only fast-forward merges keep the source commits.
+		{signed, gitrpcenum.MergeMethodMerge, false},
+		{signed, gitrpcenum.MergeMethodSquash, false},
+		{signed, gitrpcenum.MergeMethodRebase, false},
+		{signed, gitrpcenum.MergeMethodRebaseMerge, false},
+		{signed, gitrpcenum.MergeMethodFastForward, true},
+	}
+
+	pr := &types.PullReq{TargetBranch: "main"}
+	for _, test := range tests {
+		err := verifyMergeMethod(test.protection, pr, enum.MergeMethod(test.method))
+		if test.allowed && err != nil {
+			t.Errorf("expected %q to be allowed with %+v, got %v", test.method, test.protection, err)
+		}
+
+		var uErr *usererror.Error
+		if !test.allowed && (!errors.As(err, &uErr) || uErr.Status != http.StatusBadRequest) {
+			t.Errorf("expected %q to be rejected with %+v, got %v", test.method, test.protection, err)
+		}
+	}
+}
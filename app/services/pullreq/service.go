@@ -20,16 +20,21 @@ import (
 	"sync"
 	"time"
 
+	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/bootstrap"
+	checkevents "github.com/harness/gitness/app/events/check"
 	gitevents "github.com/harness/gitness/app/events/git"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/githook"
 	"github.com/harness/gitness/app/services/codecomments"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/events"
 	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/lock"
 	"github.com/harness/gitness/pubsub"
 	"github.com/harness/gitness/stream"
 	"github.com/harness/gitness/types"
@@ -45,6 +50,12 @@ type Service struct {
 	codeCommentView     store.CodeCommentView
 	codeCommentMigrator *codecomments.Migrator
 	fileViewStore       store.PullReqFileViewStore
+	reviewerStore       store.PullReqReviewerStore
+	principalStore      store.PrincipalStore
+	codeOwners          *codeowners.Service
+	protectionManager   *protection.Manager
+	authorizer          authz.Authorizer
+	mtxManager          lock.MutexManager
 	sseStreamer         sse.Streamer
 	urlProvider         url.Provider
 
@@ -59,6 +70,7 @@ func New(ctx context.Context,
 	config *types.Config,
 	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
 	pullreqEvReaderFactory *events.ReaderFactory[*pullreqevents.Reader],
+	checkEvReaderFactory *events.ReaderFactory[*checkevents.Reader],
 	pullreqEvReporter *pullreqevents.Reporter,
 	gitRPCClient gitrpc.Interface,
 	repoGitInfoCache store.RepoGitInfoCache,
@@ -68,6 +80,12 @@ func New(ctx context.Context,
 	codeCommentView store.CodeCommentView,
 	codeCommentMigrator *codecomments.Migrator,
 	fileViewStore store.PullReqFileViewStore,
+	reviewerStore store.PullReqReviewerStore,
+	principalStore store.PrincipalStore,
+	codeOwners *codeowners.Service,
+	protectionManager *protection.Manager,
+	authorizer authz.Authorizer,
+	mtxManager lock.MutexManager,
 	bus pubsub.PubSub,
 	urlProvider url.Provider,
 	sseStreamer sse.Streamer,
@@ -83,6 +101,12 @@ func New(ctx context.Context,
 		urlProvider:         urlProvider,
 		codeCommentMigrator: codeCommentMigrator,
 		fileViewStore:       fileViewStore,
+		reviewerStore:       reviewerStore,
+		principalStore:      principalStore,
+		codeOwners:          codeOwners,
+		protectionManager:   protectionManager,
+		authorizer:          authorizer,
+		mtxManager:          mtxManager,
 		cancelMergeability:  make(map[string]context.CancelFunc),
 		pubsub:              bus,
 		sseStreamer:         sseStreamer,
@@ -244,6 +268,65 @@ func New(ctx context.Context,
 		return nil, err
 	}
 
+	// code owners
+	const groupPullReqCodeOwners = "gitness:pullreq:codeowners"
+	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqCodeOwners, config.InstanceID,
+		func(r *pullreqevents.Reader) error {
+			const idleTimeout = 10 * time.Second
+			r.Configure(
+				stream.WithConcurrency(3),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(2),
+				))
+
+			_ = r.RegisterCreated(service.addCodeOwnersOnCreated)
+			_ = r.RegisterBranchUpdated(service.addCodeOwnersOnBranchUpdate)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// auto-merge
+	const groupPullReqAutoMerge = "gitness:pullreq:automerge"
+	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqAutoMerge, config.InstanceID,
+		func(r *pullreqevents.Reader) error {
+			const idleTimeout = 30 * time.Second
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(2),
+				))
+
+			_ = r.RegisterReviewSubmitted(service.autoMergeOnReviewSubmitted)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = checkEvReaderFactory.Launch(ctx, groupPullReqAutoMerge, config.InstanceID,
+		func(r *checkevents.Reader) error {
+			const idleTimeout = 30 * time.Second
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(2),
+				))
+
+			_ = r.RegisterReported(service.autoMergeOnCheckReported)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
 	return service, nil
 }
 
@@ -263,6 +346,7 @@ func createSystemRPCWriteParams(
 		repoID,
 		principal.ID,
 		false,
+		false,
 	)
 	if err != nil {
 		return gitrpc.WriteParams{}, fmt.Errorf("failed to generate git hook environment variables: %w", err)
@@ -17,14 +17,19 @@ package pullreq
 import (
 	"context"
 
+	"github.com/harness/gitness/app/auth/authz"
+	checkevents "github.com/harness/gitness/app/events/check"
 	gitevents "github.com/harness/gitness/app/events/git"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/services/codecomments"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/events"
 	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/lock"
 	"github.com/harness/gitness/pubsub"
 	"github.com/harness/gitness/types"
 
@@ -39,6 +44,7 @@ func ProvideService(ctx context.Context,
 	config *types.Config,
 	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
 	pullReqEvFactory *events.ReaderFactory[*pullreqevents.Reader],
+	checkEvFactory *events.ReaderFactory[*checkevents.Reader],
 	pullReqEvReporter *pullreqevents.Reporter,
 	gitRPCClient gitrpc.Interface,
 	repoGitInfoCache store.RepoGitInfoCache,
@@ -48,11 +54,18 @@ func ProvideService(ctx context.Context,
 	codeCommentView store.CodeCommentView,
 	codeCommentMigrator *codecomments.Migrator,
 	fileViewStore store.PullReqFileViewStore,
+	reviewerStore store.PullReqReviewerStore,
+	principalStore store.PrincipalStore,
+	codeOwners *codeowners.Service,
+	protectionManager *protection.Manager,
+	authorizer authz.Authorizer,
+	mtxManager lock.MutexManager,
 	pubsub pubsub.PubSub,
 	urlProvider url.Provider,
 	sseStreamer sse.Streamer,
 ) (*Service, error) {
-	return New(ctx, config, gitReaderFactory, pullReqEvFactory, pullReqEvReporter, gitRPCClient,
+	return New(ctx, config, gitReaderFactory, pullReqEvFactory, checkEvFactory, pullReqEvReporter, gitRPCClient,
 		repoGitInfoCache, repoStore, pullreqStore, activityStore,
-		codeCommentView, codeCommentMigrator, fileViewStore, pubsub, urlProvider, sseStreamer)
+		codeCommentView, codeCommentMigrator, fileViewStore, reviewerStore, principalStore, codeOwners,
+		protectionManager, authorizer, mtxManager, pubsub, urlProvider, sseStreamer)
 }
@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	gpgPublicKeyBeginToken = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	gpgSignatureBeginToken = "-----BEGIN PGP SIGNATURE-----"
+	sshSignatureBeginToken = "-----BEGIN SSH SIGNATURE-----"
+)
+
+var (
+	ErrKeyInvalid       = errors.New("signing key is invalid")
+	ErrKeyMultipleGPG   = errors.New("signing key must contain exactly one gpg public key")
+	ErrKeyGPGCantSign   = errors.New("gpg key doesn't contain a key that can be used for signing")
+	ErrSignatureInvalid = errors.New("signature is invalid")
+)
+
+// Key contains the details of a parsed signing key.
+type Key struct {
+	Type        enum.SigningKeyType
+	Fingerprint string
+}
+
+// ParseKey parses an armored gpg public key or an ssh public key in authorized_keys format.
+func ParseKey(content string) (Key, error) {
+	content = strings.TrimSpace(content)
+
+	if strings.HasPrefix(content, gpgPublicKeyBeginToken) {
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(content))
+		if err != nil {
+			return Key{}, fmt.Errorf("%w: %s", ErrKeyInvalid, err.Error())
+		}
+
+		if len(entities) != 1 {
+			return Key{}, ErrKeyMultipleGPG
+		}
+
+		entity := entities[0]
+		if _, ok := entity.SigningKey(entity.PrimaryKey.CreationTime); !ok && !hasSigningSubkey(entity) {
+			return Key{}, ErrKeyGPGCantSign
+		}
+
+		return Key{
+			Type:        enum.SigningKeyTypeGPG,
+			Fingerprint: gpgFingerprint(entity),
+		}, nil
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(content))
+	if err != nil {
+		return Key{}, fmt.Errorf("%w: %s", ErrKeyInvalid, err.Error())
+	}
+
+	return Key{
+		Type:        enum.SigningKeyTypeSSH,
+		Fingerprint: ssh.FingerprintSHA256(publicKey),
+	}, nil
+}
+
+func hasSigningSubkey(entity *openpgp.Entity) bool {
+	for _, subkey := range entity.Subkeys {
+		if subkey.Sig != nil && subkey.Sig.FlagsValid && subkey.Sig.FlagSign {
+			return true
+		}
+	}
+
+	return false
+}
+
+// gpgFingerprint returns the fingerprint of the primary key of the entity as upper case hex string.
+func gpgFingerprint(entity *openpgp.Entity) string {
+	return fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint)
+}
+
+// signatureType returns the type of key required to verify the signature.
+func signatureType(signature []byte) (enum.SigningKeyType, bool) {
+	signature = bytes.TrimSpace(signature)
+	switch {
+	case bytes.HasPrefix(signature, []byte(gpgSignatureBeginToken)):
+		return enum.SigningKeyTypeGPG, true
+	case bytes.HasPrefix(signature, []byte(sshSignatureBeginToken)):
+		return enum.SigningKeyTypeSSH, true
+	default:
+		return "", false
+	}
+}
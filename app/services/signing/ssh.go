@@ -0,0 +1,120 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"fmt"
+	"hash"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// sshSigMagic is the preamble of ssh signatures (see PROTOCOL.sshsig of openssh).
+const sshSigMagic = "SSHSIG"
+
+// sshSigNamespaceGit is the namespace git uses when signing commits and tags with ssh keys.
+const sshSigNamespaceGit = "git"
+
+// sshSignature is a parsed armored ssh signature.
+type sshSignature struct {
+	PublicKey     ssh.PublicKey
+	Namespace     string
+	HashAlgorithm string
+	Signature     *ssh.Signature
+}
+
+// parseSSHSignature parses an armored ssh signature as created by ssh-keygen -Y sign.
+func parseSSHSignature(armored []byte) (*sshSignature, error) {
+	block, _ := pem.Decode(bytes.TrimSpace(armored))
+	if block == nil || block.Type != "SSH SIGNATURE" {
+		return nil, fmt.Errorf("%w: not an armored ssh signature", ErrSignatureInvalid)
+	}
+
+	if !bytes.HasPrefix(block.Bytes, []byte(sshSigMagic)) {
+		return nil, fmt.Errorf("%w: missing ssh signature preamble", ErrSignatureInvalid)
+	}
+
+	var blob struct {
+		Version       uint32
+		PublicKey     []byte
+		Namespace     string
+		Reserved      []byte
+		HashAlgorithm string
+		Signature     []byte
+	}
+	if err := ssh.Unmarshal(block.Bytes[len(sshSigMagic):], &blob); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSignatureInvalid, err.Error())
+	}
+
+	if blob.Version != 1 {
+		return nil, fmt.Errorf("%w: unsupported ssh signature version %d", ErrSignatureInvalid, blob.Version)
+	}
+
+	publicKey, err := ssh.ParsePublicKey(blob.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSignatureInvalid, err.Error())
+	}
+
+	signature := &ssh.Signature{}
+	if err = ssh.Unmarshal(blob.Signature, signature); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSignatureInvalid, err.Error())
+	}
+
+	return &sshSignature{
+		PublicKey:     publicKey,
+		Namespace:     blob.Namespace,
+		HashAlgorithm: blob.HashAlgorithm,
+		Signature:     signature,
+	}, nil
+}
+
+// Verify verifies that the signature was created for the message within the git namespace.
+func (s *sshSignature) Verify(message []byte) error {
+	if s.Namespace != sshSigNamespaceGit {
+		return fmt.Errorf("%w: unexpected namespace %q", ErrSignatureInvalid, s.Namespace)
+	}
+
+	var h hash.Hash
+	switch s.HashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("%w: unsupported hash algorithm %q", ErrSignatureInvalid, s.HashAlgorithm)
+	}
+	h.Write(message)
+
+	signedData := append([]byte(sshSigMagic), ssh.Marshal(struct {
+		Namespace     string
+		Reserved      []byte
+		HashAlgorithm string
+		Hash          []byte
+	}{
+		Namespace:     s.Namespace,
+		HashAlgorithm: s.HashAlgorithm,
+		Hash:          h.Sum(nil),
+	})...)
+
+	if err := s.PublicKey.Verify(signedData, s.Signature); err != nil {
+		return fmt.Errorf("%w: %s", ErrSignatureInvalid, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Verifier verifies commit and tag signatures using the signing keys of the principals.
+// An object is verified in case it was signed with a key of the principal with the email of the committer (tagger).
+type Verifier struct {
+	principalStore  store.PrincipalStore
+	signingKeyStore store.SigningKeyStore
+}
+
+func NewVerifier(
+	principalStore store.PrincipalStore,
+	signingKeyStore store.SigningKeyStore,
+) *Verifier {
+	return &Verifier{
+		principalStore:  principalStore,
+		signingKeyStore: signingKeyStore,
+	}
+}
+
+// signer contains the principal with a specific email together with its signing keys.
+type signer struct {
+	principal *types.Principal
+	keys      []*types.SigningKey
+}
+
+// signers caches the signers by email for the duration of a single verification call.
+type signers map[string]*signer
+
+// VerifyCommits verifies the signatures of the commits and sets the verification result of each commit.
+func (v *Verifier) VerifyCommits(ctx context.Context, commits ...*types.Commit) error {
+	cache := signers{}
+	for _, commit := range commits {
+		if commit == nil {
+			continue
+		}
+
+		verification, err := v.verify(ctx, cache, commit.Committer.Identity.Email, commit.Signature)
+		if err != nil {
+			return fmt.Errorf("failed to verify signature of commit %s: %w", commit.SHA, err)
+		}
+
+		commit.Verification = verification
+	}
+
+	return nil
+}
+
+// Verify verifies the signature of an object created by the signer with the provided email.
+func (v *Verifier) Verify(
+	ctx context.Context,
+	email string,
+	signature *types.ObjectSignature,
+) (*types.SignatureVerification, error) {
+	return v.verify(ctx, signers{}, email, signature)
+}
+
+func (v *Verifier) verify(
+	ctx context.Context,
+	cache signers,
+	email string,
+	signature *types.ObjectSignature,
+) (*types.SignatureVerification, error) {
+	if signature == nil || len(signature.Signature) == 0 {
+		return &types.SignatureVerification{Status: enum.SignatureVerificationStatusUnverified}, nil
+	}
+
+	s, err := v.findSigner(ctx, cache, email)
+	if err != nil {
+		return nil, err
+	}
+
+	res := verify(signature.Signature, signature.Payload, s.keys)
+
+	verification := &types.SignatureVerification{
+		Status:         res.Status,
+		KeyType:        res.KeyType,
+		KeyFingerprint: res.Fingerprint,
+	}
+	if res.Status == enum.SignatureVerificationStatusVerified {
+		verification.Signer = s.principal.ToPrincipalInfo()
+	}
+
+	return verification, nil
+}
+
+// findSigner returns the principal with the provided email and its signing keys.
+// In case there is no such principal, a signer without keys is returned.
+func (v *Verifier) findSigner(ctx context.Context, cache signers, email string) (*signer, error) {
+	if s, ok := cache[email]; ok {
+		return s, nil
+	}
+
+	s := &signer{}
+	cache[email] = s
+
+	if email == "" {
+		return s, nil
+	}
+
+	principal, err := v.principalStore.FindByEmail(ctx, email)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find principal by email: %w", err)
+	}
+
+	keys, err := v.signingKeyStore.List(ctx, principal.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signing keys of principal %d: %w", principal.ID, err)
+	}
+
+	s.principal = principal
+	s.keys = keys
+
+	return s, nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"golang.org/x/crypto/ssh"
+)
+
+// result is the outcome of the verification of a single signature.
+type result struct {
+	Status      enum.SignatureVerificationStatus
+	KeyType     enum.SigningKeyType
+	Fingerprint string
+	// Key is the signing key of the signer the signature was created with (nil if unknown).
+	Key *types.SigningKey
+}
+
+// verify verifies the signature of the payload with the signing keys of the signer.
+func verify(signature []byte, payload []byte, keys []*types.SigningKey) result {
+	keyType, ok := signatureType(signature)
+	if !ok {
+		return result{Status: enum.SignatureVerificationStatusUnverified}
+	}
+
+	switch keyType {
+	case enum.SigningKeyTypeGPG:
+		return verifyGPG(signature, payload, keys)
+	case enum.SigningKeyTypeSSH:
+		return verifySSH(signature, payload, keys)
+	default:
+		return result{Status: enum.SignatureVerificationStatusUnverified}
+	}
+}
+
+func verifyGPG(signature []byte, payload []byte, keys []*types.SigningKey) result {
+	res := result{
+		Status:  enum.SignatureVerificationStatusBadSignature,
+		KeyType: enum.SigningKeyTypeGPG,
+	}
+
+	issuer, err := gpgSignatureIssuer(signature)
+	if err != nil {
+		return res
+	}
+
+	res.Fingerprint = fmt.Sprintf("%016X", issuer)
+
+	var keyring openpgp.EntityList
+	owners := map[*openpgp.Entity]*types.SigningKey{}
+	for _, key := range keys {
+		if key.Type != enum.SigningKeyTypeGPG {
+			continue
+		}
+
+		entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader([]byte(key.Content)))
+		if err != nil {
+			continue
+		}
+
+		for _, entity := range entities {
+			keyring = append(keyring, entity)
+			owners[entity] = key
+		}
+	}
+
+	matches := keyring.KeysById(issuer)
+	if len(matches) == 0 {
+		res.Status = enum.SignatureVerificationStatusUnknownKey
+		return res
+	}
+
+	res.Key = owners[matches[0].Entity]
+	res.Fingerprint = gpgFingerprint(matches[0].Entity)
+
+	signer, err := openpgp.CheckArmoredDetachedSignature(keyring,
+		bytes.NewReader(payload), bytes.NewReader(signature), nil)
+	if err != nil {
+		return res
+	}
+
+	res.Status = enum.SignatureVerificationStatusVerified
+	res.Key = owners[signer]
+	res.Fingerprint = gpgFingerprint(signer)
+
+	return res
+}
+
+// gpgSignatureIssuer returns the id of the key the armored gpg signature was created with.
+func gpgSignatureIssuer(signature []byte) (uint64, error) {
+	block, err := armor.Decode(bytes.NewReader(signature))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrSignatureInvalid, err.Error())
+	}
+
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrSignatureInvalid, err.Error())
+	}
+
+	sig, ok := p.(*packet.Signature)
+	if !ok {
+		return 0, fmt.Errorf("%w: not a signature packet", ErrSignatureInvalid)
+	}
+
+	switch {
+	case sig.IssuerKeyId != nil:
+		return *sig.IssuerKeyId, nil
+	case len(sig.IssuerFingerprint) >= 8:
+		// the key id of a v4 key consists of the last 8 bytes of its fingerprint.
+		return binary.BigEndian.Uint64(sig.IssuerFingerprint[len(sig.IssuerFingerprint)-8:]), nil
+	default:
+		return 0, fmt.Errorf("%w: signature doesn't contain an issuer", ErrSignatureInvalid)
+	}
+}
+
+func verifySSH(signature []byte, payload []byte, keys []*types.SigningKey) result {
+	res := result{
+		Status:  enum.SignatureVerificationStatusBadSignature,
+		KeyType: enum.SigningKeyTypeSSH,
+	}
+
+	sig, err := parseSSHSignature(signature)
+	if err != nil {
+		return res
+	}
+
+	res.Fingerprint = ssh.FingerprintSHA256(sig.PublicKey)
+
+	for _, key := range keys {
+		if key.Type == enum.SigningKeyTypeSSH && key.Fingerprint == res.Fingerprint {
+			res.Key = key
+			break
+		}
+	}
+
+	if res.Key == nil {
+		res.Status = enum.SignatureVerificationStatusUnknownKey
+		return res
+	}
+
+	if err = sig.Verify(payload); err != nil {
+		return res
+	}
+
+	res.Status = enum.SignatureVerificationStatusVerified
+
+	return res
+}
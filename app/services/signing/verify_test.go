@@ -0,0 +1,107 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const testPayload = "tree abc\nauthor a <a@gitness.io> 1 +0000\n\nmessage\n"
+
+const testSSHKey = `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIP2aaSTSWoV9918YSk+tY7My/eXy3LKdgmOCGVebg+1p`
+
+const testSSHSignature = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAg/ZppJNJahX33XxhKT61jszL95f
+Lcsp2CY4IZV5uD7WkAAAADZ2l0AAAAAAAAAAZzaGE1MTIAAABTAAAAC3NzaC1lZDI1NTE5
+AAAAQNAvFLHQmz7v+TwKCoTCMJBkh26geh4WfCbLcilqkHlFGkP0/IP9jJ+JlIUNEgNuCr
+u7vp+juRVQLpm3D8ZBJwc=
+-----END SSH SIGNATURE-----
+`
+
+const testGPGKey = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatS+phYJKwYBBAHaRw8BAQdAmvPAuPcixfSD4+Camkx12Qgpy54m2kBZ8Adc
+Xu/FzAW0GEFkbWluIDxhZG1pbkBnaXRuZXNzLmlvPoiQBBMWCAA4FiEEENzrspWK
+26aOUXi0IaFNowbHBkkFAmrUvqYCGwMFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AA
+CgkQIaFNowbHBkluTgD/ZvFu0bVYyvgNfDHcA0d8wG81nYXcQ9w4L0IaYr4uK4MB
+AJrUfIBIkjst2Q5BYPyUjd7mRjIz72m4tfMtIOeRz2IM
+=WP95
+-----END PGP PUBLIC KEY BLOCK-----
+`
+
+const testGPGSignature = `-----BEGIN PGP SIGNATURE-----
+
+iHUEABYIAB0WIQQQ3OuylYrbpo5ReLQhoU2jBscGSQUCatS+pgAKCRAhoU2jBscG
+SZJcAP0R0Sv35YLoSU10uWlhprSygU12OrRHaN7bVN+E+e55LgD/a/IuithFSbfR
+KAUGjZ+zs6IN7ltiRbXG2vnBhQmE2AM=
+=JjPO
+-----END PGP SIGNATURE-----
+`
+
+func TestVerify(t *testing.T) {
+	sshKey := mustParseTestKey(t, testSSHKey)
+	gpgKey := mustParseTestKey(t, testGPGKey)
+
+	tests := []struct {
+		name      string
+		signature string
+		payload   string
+		keys      []*types.SigningKey
+		want      enum.SignatureVerificationStatus
+	}{
+		{"ssh verified", testSSHSignature, testPayload, []*types.SigningKey{gpgKey, sshKey},
+			enum.SignatureVerificationStatusVerified},
+		{"ssh unknown key", testSSHSignature, testPayload, []*types.SigningKey{gpgKey},
+			enum.SignatureVerificationStatusUnknownKey},
+		{"ssh bad signature", testSSHSignature, testPayload + "x", []*types.SigningKey{sshKey},
+			enum.SignatureVerificationStatusBadSignature},
+		{"gpg verified", testGPGSignature, testPayload, []*types.SigningKey{sshKey, gpgKey},
+			enum.SignatureVerificationStatusVerified},
+		{"gpg unknown key", testGPGSignature, testPayload, nil,
+			enum.SignatureVerificationStatusUnknownKey},
+		{"gpg bad signature", testGPGSignature, testPayload + "x", []*types.SigningKey{gpgKey},
+			enum.SignatureVerificationStatusBadSignature},
+		{"unsupported format", "-----BEGIN SIGNED MESSAGE-----", testPayload, []*types.SigningKey{sshKey},
+			enum.SignatureVerificationStatusUnverified},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := verify([]byte(test.signature), []byte(test.payload), test.keys)
+			if res.Status != test.want {
+				t.Errorf("want status %q, got %q", test.want, res.Status)
+			}
+			if res.Status == enum.SignatureVerificationStatusVerified && res.Key == nil {
+				t.Errorf("want signing key of verified signature, got nil")
+			}
+		})
+	}
+}
+
+func mustParseTestKey(t *testing.T, content string) *types.SigningKey {
+	key, err := ParseKey(content)
+	if err != nil {
+		t.Fatalf("failed to parse test key: %s", err)
+	}
+
+	return &types.SigningKey{
+		Type:        key.Type,
+		Fingerprint: key.Fingerprint,
+		Content:     content,
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/app/bootstrap"
+	"github.com/harness/gitness/app/pipeline/commit"
+	"github.com/harness/gitness/app/pipeline/triggerer"
+	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	cronJobType        = "gitness:trigger:cron"
+	cronJobUIDPrefix   = "gitness:trigger:cron:"
+	cronJobMaxDuration = time.Minute
+)
+
+// Cron executes pipelines periodically for triggers that have a cron expression.
+// Every such trigger is backed by a recurring job of the background job scheduler.
+type Cron struct {
+	scheduler     *job.Scheduler
+	triggerStore  store.TriggerStore
+	pipelineStore store.PipelineStore
+	repoStore     store.RepoStore
+	triggerSvc    triggerer.Triggerer
+	commitSvc     commit.Service
+}
+
+func NewCron(
+	scheduler *job.Scheduler,
+	triggerStore store.TriggerStore,
+	pipelineStore store.PipelineStore,
+	repoStore store.RepoStore,
+	triggerSvc triggerer.Triggerer,
+	commitSvc commit.Service,
+) *Cron {
+	return &Cron{
+		scheduler:     scheduler,
+		triggerStore:  triggerStore,
+		pipelineStore: pipelineStore,
+		repoStore:     repoStore,
+		triggerSvc:    triggerSvc,
+		commitSvc:     commitSvc,
+	}
+}
+
+func cronJobUID(triggerID int64) string {
+	return cronJobUIDPrefix + strconv.FormatInt(triggerID, 10)
+}
+
+// Schedule creates or updates the recurring job of the trigger.
+// If the trigger is disabled or doesn't have a cron expression, its job is removed.
+func (c *Cron) Schedule(ctx context.Context, trigger *types.Trigger) error {
+	if trigger.Cron == "" || trigger.Disabled {
+		return c.Unschedule(ctx, trigger.ID)
+	}
+
+	err := c.scheduler.AddRecurringWithData(ctx,
+		cronJobUID(trigger.ID),
+		cronJobType,
+		job.CronDefinition(trigger.Cron, trigger.Timezone),
+		strconv.FormatInt(trigger.ID, 10),
+		cronJobMaxDuration)
+	if err != nil {
+		return fmt.Errorf("failed to schedule cron job for trigger %d: %w", trigger.ID, err)
+	}
+
+	return nil
+}
+
+// Unschedule removes the recurring job of the trigger.
+func (c *Cron) Unschedule(ctx context.Context, triggerID int64) error {
+	err := c.scheduler.RemoveRecurring(ctx, cronJobUID(triggerID))
+	if err != nil {
+		return fmt.Errorf("failed to remove cron job for trigger %d: %w", triggerID, err)
+	}
+
+	return nil
+}
+
+// UnschedulePipeline removes the recurring jobs of all triggers of the pipeline.
+func (c *Cron) UnschedulePipeline(ctx context.Context, pipelineID int64) error {
+	const pageSize = 100
+	for page := 1; ; page++ {
+		triggers, err := c.triggerStore.List(ctx, pipelineID, types.ListQueryFilter{
+			Pagination: types.Pagination{Page: page, Size: pageSize},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list triggers of pipeline %d: %w", pipelineID, err)
+		}
+
+		for _, trigger := range triggers {
+			if trigger.Cron == "" {
+				continue
+			}
+
+			if err = c.Unschedule(ctx, trigger.ID); err != nil {
+				return err
+			}
+		}
+
+		if len(triggers) < pageSize {
+			return nil
+		}
+	}
+}
+
+// UnscheduleRepo removes the recurring jobs of all triggers of all pipelines of the repository.
+func (c *Cron) UnscheduleRepo(ctx context.Context, repoID int64) error {
+	const pageSize = 100
+	for page := 1; ; page++ {
+		pipelines, err := c.pipelineStore.List(ctx, repoID, types.ListQueryFilter{
+			Pagination: types.Pagination{Page: page, Size: pageSize},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list pipelines of repo %d: %w", repoID, err)
+		}
+
+		for _, pipeline := range pipelines {
+			if err = c.UnschedulePipeline(ctx, pipeline.ID); err != nil {
+				return err
+			}
+		}
+
+		if len(pipelines) < pageSize {
+			return nil
+		}
+	}
+}
+
+// Handle is the cron job handler. It executes the pipeline of the trigger
+// for the latest commit of the trigger's branch.
+func (c *Cron) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	triggerID, err := strconv.ParseInt(data, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid trigger id %q: %w", data, err)
+	}
+
+	trigger, err := c.triggerStore.Find(ctx, triggerID)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		// the trigger, its pipeline or its repository have been deleted in the meantime.
+		return c.unscheduleDeleted(ctx, triggerID, "trigger doesn't exist")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find trigger: %w", err)
+	}
+
+	if trigger.Disabled || trigger.Cron == "" {
+		return "trigger is disabled", nil
+	}
+
+	pipeline, err := c.pipelineStore.Find(ctx, trigger.PipelineID)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return c.unscheduleDeleted(ctx, triggerID, "pipeline doesn't exist")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find pipeline: %w", err)
+	}
+
+	// Don't fire triggers for disabled pipelines
+	if pipeline.Disabled {
+		return "pipeline is disabled", nil
+	}
+
+	repo, err := c.repoStore.Find(ctx, pipeline.RepoID)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return c.unscheduleDeleted(ctx, triggerID, "repo doesn't exist")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find repo: %w", err)
+	}
+
+	branch := trigger.Branch
+	if branch == "" {
+		branch = pipeline.DefaultBranch
+	}
+
+	commit, err := c.commitSvc.FindRef(ctx, repo, branch)
+	if err != nil {
+		return "", fmt.Errorf("failed to find latest commit of branch %s: %w", branch, err)
+	}
+
+	hook := &triggerer.Hook{
+		Trigger:     enum.TriggerCron,
+		TriggeredBy: bootstrap.NewSystemServiceSession().Principal.ID,
+		Ref:         "refs/heads/" + branch,
+		Before:      commit.SHA,
+		After:       commit.SHA,
+		Source:      branch,
+		Target:      branch,
+		Cron:        trigger.UID,
+	}
+	setCommitInfo(hook, commit)
+
+	execution, err := c.triggerSvc.Trigger(ctx, pipeline, hook)
+	if err != nil {
+		return "", fmt.Errorf("failed to trigger pipeline: %w", err)
+	}
+
+	if execution == nil {
+		return "execution skipped", nil
+	}
+
+	return fmt.Sprintf("execution %d created", execution.Number), nil
+}
+
+// unscheduleDeleted removes the recurring job of a trigger that doesn't exist anymore,
+// otherwise the job would keep on firing forever.
+func (c *Cron) unscheduleDeleted(ctx context.Context, triggerID int64, result string) (string, error) {
+	if err := c.Unschedule(ctx, triggerID); err != nil {
+		return "", err
+	}
+
+	return result + ", job removed", nil
+}
@@ -23,6 +23,7 @@ import (
 	gitevents "github.com/harness/gitness/app/events/git"
 	"github.com/harness/gitness/app/pipeline/triggerer"
 	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
 
@@ -84,11 +85,16 @@ func (s *Service) augmentCommitInfo(
 	if err != nil {
 		return fmt.Errorf("could not find commit info")
 	}
+	setCommitInfo(hook, commit)
+	return nil
+}
+
+// setCommitInfo copies information about the commit to the hook.
+func setCommitInfo(hook *triggerer.Hook, commit *types.Commit) {
 	hook.AuthorName = commit.Author.Identity.Name
 	hook.Title = commit.Title
 	hook.Timestamp = commit.Committer.When.UnixMilli()
 	hook.AuthorLogin = commit.Author.Identity.Name
 	hook.AuthorEmail = commit.Author.Identity.Email
 	hook.Message = commit.Message
-	return nil
 }
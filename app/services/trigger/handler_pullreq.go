@@ -72,6 +72,34 @@ func (s *Service) handleEventPullReqBranchUpdated(ctx context.Context,
 	return s.trigger(ctx, event.Payload.SourceRepoID, enum.TriggerActionPullReqBranchUpdated, hook)
 }
 
+func (s *Service) handleEventPullReqLabelAdded(ctx context.Context,
+	event *events.Event[*pullreqevents.LabelAddedPayload]) error {
+	hook := &triggerer.Hook{
+		Trigger:     enum.TriggerHook,
+		Action:      enum.TriggerActionPullReqLabelAdded,
+		TriggeredBy: bootstrap.NewSystemServiceSession().Principal.ID,
+	}
+	err := s.augmentPullReqInfo(ctx, hook, event.Payload.PullReqID)
+	if err != nil {
+		return fmt.Errorf("could not augment pull request info: %w", err)
+	}
+	return s.trigger(ctx, event.Payload.SourceRepoID, enum.TriggerActionPullReqLabelAdded, hook)
+}
+
+func (s *Service) handleEventPullReqLabelRemoved(ctx context.Context,
+	event *events.Event[*pullreqevents.LabelRemovedPayload]) error {
+	hook := &triggerer.Hook{
+		Trigger:     enum.TriggerHook,
+		Action:      enum.TriggerActionPullReqLabelRemoved,
+		TriggeredBy: bootstrap.NewSystemServiceSession().Principal.ID,
+	}
+	err := s.augmentPullReqInfo(ctx, hook, event.Payload.PullReqID)
+	if err != nil {
+		return fmt.Errorf("could not augment pull request info: %w", err)
+	}
+	return s.trigger(ctx, event.Payload.SourceRepoID, enum.TriggerActionPullReqLabelRemoved, hook)
+}
+
 // augmentPullReqInfo adds in information into the hook pertaining to the pull request
 // by querying the database.
 func (s *Service) augmentPullReqInfo(
@@ -90,6 +118,10 @@ func (s *Service) augmentPullReqInfo(
 	hook.AuthorEmail = pullreq.Author.Email
 	hook.Message = pullreq.Description
 	hook.Before = pullreq.MergeBaseSHA
+	if hook.After == "" {
+		// events that don't change the pull request branch run against its current head.
+		hook.After = pullreq.SourceSHA
+	}
 	hook.Target = pullreq.TargetBranch
 	hook.Source = pullreq.SourceBranch
 	// expand the branch to a git reference.
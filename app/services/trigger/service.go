@@ -130,6 +130,8 @@ func New(
 			_ = r.RegisterCreated(service.handleEventPullReqCreated)
 			_ = r.RegisterBranchUpdated(service.handleEventPullReqBranchUpdated)
 			_ = r.RegisterReopened(service.handleEventPullReqReopened)
+			_ = r.RegisterLabelAdded(service.handleEventPullReqLabelAdded)
+			_ = r.RegisterLabelRemoved(service.handleEventPullReqLabelRemoved)
 
 			return nil
 		})
@@ -21,6 +21,7 @@ import (
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/pipeline/commit"
 	"github.com/harness/gitness/app/pipeline/triggerer"
+	"github.com/harness/gitness/app/services/job"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/events"
 
@@ -29,6 +30,7 @@ import (
 
 var WireSet = wire.NewSet(
 	ProvideService,
+	ProvideCron,
 )
 
 func ProvideService(
@@ -46,3 +48,22 @@ func ProvideService(
 	return New(ctx, config, triggerStore, pullReqStore, repoStore, pipelineStore, triggerSvc,
 		commitSvc, gitReaderFactory, pullReqEvFactory)
 }
+
+func ProvideCron(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	triggerStore store.TriggerStore,
+	pipelineStore store.PipelineStore,
+	repoStore store.RepoStore,
+	triggerSvc triggerer.Triggerer,
+	commitSvc commit.Service,
+) (*Cron, error) {
+	cron := NewCron(scheduler, triggerStore, pipelineStore, repoStore, triggerSvc, commitSvc)
+
+	err := executor.Register(cronJobType, cron)
+	if err != nil {
+		return nil, err
+	}
+
+	return cron, nil
+}
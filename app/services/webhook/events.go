@@ -131,6 +131,54 @@ func (s *Service) findPullReqForEvent(ctx context.Context, prID int64) (*types.P
 	return pr, nil
 }
 
+// findActivityForEvent finds the pull request activity for the provided activityID.
+func (s *Service) findActivityForEvent(ctx context.Context, activityID int64) (*types.PullReqActivity, error) {
+	act, err := s.activityStore.Find(ctx, activityID)
+
+	if err != nil && errors.Is(err, store.ErrResourceNotFound) {
+		// not found error is unrecoverable - most likely a racing condition of the comment being deleted by now
+		return nil, events.NewDiscardEventErrorf("PR activity with id '%d' doesn't exist anymore", activityID)
+	}
+	if err != nil {
+		// all other errors we return and force the event to be reprocessed
+		return nil, fmt.Errorf("failed to get PR activity for id '%d': %w", activityID, err)
+	}
+
+	return act, nil
+}
+
+// findExecutionForEvent finds the pipeline execution for the provided executionID.
+func (s *Service) findExecutionForEvent(ctx context.Context, executionID int64) (*types.Execution, error) {
+	execution, err := s.executionStore.Find(ctx, executionID)
+
+	if err != nil && errors.Is(err, store.ErrResourceNotFound) {
+		// not found error is unrecoverable - most likely a racing condition of the pipeline being deleted by now
+		return nil, events.NewDiscardEventErrorf("execution with id '%d' doesn't exist anymore", executionID)
+	}
+	if err != nil {
+		// all other errors we return and force the event to be reprocessed
+		return nil, fmt.Errorf("failed to get execution for id '%d': %w", executionID, err)
+	}
+
+	return execution, nil
+}
+
+// findPipelineForEvent finds the pipeline for the provided pipelineID.
+func (s *Service) findPipelineForEvent(ctx context.Context, pipelineID int64) (*types.Pipeline, error) {
+	pipeline, err := s.pipelineStore.Find(ctx, pipelineID)
+
+	if err != nil && errors.Is(err, store.ErrResourceNotFound) {
+		// not found error is unrecoverable - most likely a racing condition of the pipeline being deleted by now
+		return nil, events.NewDiscardEventErrorf("pipeline with id '%d' doesn't exist anymore", pipelineID)
+	}
+	if err != nil {
+		// all other errors we return and force the event to be reprocessed
+		return nil, fmt.Errorf("failed to get pipeline for id '%d': %w", pipelineID, err)
+	}
+
+	return pipeline, nil
+}
+
 // findPrincipalForEvent finds the principal for the provided principalID.
 func (s *Service) findPrincipalForEvent(ctx context.Context, principalID int64) (*types.Principal, error) {
 	principal, err := s.principalStore.Find(ctx, principalID)
@@ -147,6 +195,22 @@ func (s *Service) findPrincipalForEvent(ctx context.Context, principalID int64)
 	return principal, nil
 }
 
+// findLabelForEvent finds the label with the provided id.
+func (s *Service) findLabelForEvent(ctx context.Context, labelID int64) (*types.Label, error) {
+	label, err := s.labelStore.Find(ctx, labelID)
+
+	if err != nil && errors.Is(err, store.ErrResourceNotFound) {
+		// the label got deleted in the meantime - discard event
+		return nil, events.NewDiscardEventErrorf("label with id '%d' doesn't exist anymore", labelID)
+	}
+	if err != nil {
+		// all other errors we return and force the event to be reprocessed
+		return nil, fmt.Errorf("failed to get label for id '%d': %w", labelID, err)
+	}
+
+	return label, nil
+}
+
 // triggerForEvent triggers all webhooks for the given parentType/ID and triggerType
 // using the eventID to generate a deterministic triggerID and sending the provided body as payload.
 func (s *Service) triggerForEvent(ctx context.Context, eventID string,
@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ExecutionPayload describes the body of the execution started and finished triggers.
+type ExecutionPayload struct {
+	BaseSegment
+	ReferenceSegment
+	ReferenceDetailsSegment
+	ExecutionSegment
+}
+
+// handleEventExecutionStarted handles started events for pipeline executions
+// and triggers execution started webhooks for the repo of the pipeline.
+func (s *Service) handleEventExecutionStarted(ctx context.Context,
+	event *events.Event[*pipelineevents.ExecutionStartedPayload]) error {
+	return s.triggerForEventWithExecution(ctx, enum.WebhookTriggerExecutionStarted,
+		event.ID, event.Payload.ExecutionID)
+}
+
+// handleEventExecutionFinished handles finished events for pipeline executions
+// and triggers execution finished webhooks for the repo of the pipeline.
+func (s *Service) handleEventExecutionFinished(ctx context.Context,
+	event *events.Event[*pipelineevents.ExecutionFinishedPayload]) error {
+	return s.triggerForEventWithExecution(ctx, enum.WebhookTriggerExecutionFinished,
+		event.ID, event.Payload.ExecutionID)
+}
+
+// triggerForEventWithExecution triggers all webhooks for the repo of the execution using the ExecutionPayload.
+// NOTE: the principal of the payload is the principal that triggered the execution.
+func (s *Service) triggerForEventWithExecution(ctx context.Context,
+	triggerType enum.WebhookTrigger, eventID string, executionID int64) error {
+	execution, err := s.findExecutionForEvent(ctx, executionID)
+	if err != nil {
+		return err
+	}
+
+	pipeline, err := s.findPipelineForEvent(ctx, execution.PipelineID)
+	if err != nil {
+		return err
+	}
+
+	return s.triggerForEventWithRepo(ctx, triggerType, eventID, execution.CreatedBy, execution.RepoID,
+		func(principal *types.Principal, repo *types.Repository) (any, error) {
+			repoInfo := repositoryInfoFrom(repo, s.urlProvider)
+
+			return &ExecutionPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   triggerType,
+					Repo:      repoInfo,
+					Principal: principalInfoFrom(principal),
+				},
+				ReferenceSegment: ReferenceSegment{
+					Ref: ReferenceInfo{
+						Name: execution.Ref,
+						Repo: repoInfo,
+					},
+				},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{
+					SHA: execution.After,
+				},
+				ExecutionSegment: ExecutionSegment{
+					Execution: executionInfoFrom(execution, pipeline),
+				},
+			}, nil
+		})
+}
@@ -185,3 +185,339 @@ func (s *Service) handleEventPullReqBranchUpdated(ctx context.Context,
 			}, nil
 		})
 }
+
+// PullReqMergedPayload describes the body of the pullreq merged trigger.
+type PullReqMergedPayload struct {
+	BaseSegment
+	PullReqSegment
+	PullReqTargetReferenceSegment
+	ReferenceSegment
+	ReferenceDetailsSegment
+	PullReqMergeSegment
+}
+
+// handleEventPullReqMerged handles merged events for pull requests
+// and triggers pullreq merged webhooks for the target repo.
+func (s *Service) handleEventPullReqMerged(ctx context.Context,
+	event *events.Event[*pullreqevents.MergedPayload]) error {
+	return s.triggerForEventWithPullReq(ctx, enum.WebhookTriggerPullReqMerged,
+		event.ID, event.Payload.PrincipalID, event.Payload.PullReqID,
+		func(principal *types.Principal, pr *types.PullReq, targetRepo, sourceRepo *types.Repository) (any, error) {
+			commitInfo, err := s.fetchCommitInfoForEvent(ctx, sourceRepo.GitUID, event.Payload.SourceSHA)
+			if err != nil {
+				return nil, err
+			}
+			targetRepoInfo := repositoryInfoFrom(targetRepo, s.urlProvider)
+			sourceRepoInfo := repositoryInfoFrom(sourceRepo, s.urlProvider)
+
+			return &PullReqMergedPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   enum.WebhookTriggerPullReqMerged,
+					Repo:      targetRepoInfo,
+					Principal: principalInfoFrom(principal),
+				},
+				PullReqSegment: PullReqSegment{
+					PullReq: pullReqInfoFrom(pr),
+				},
+				PullReqTargetReferenceSegment: PullReqTargetReferenceSegment{
+					TargetRef: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.TargetBranch,
+						Repo: targetRepoInfo,
+					},
+				},
+				ReferenceSegment: ReferenceSegment{
+					Ref: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.SourceBranch,
+						Repo: sourceRepoInfo,
+					},
+				},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{
+					SHA:    event.Payload.SourceSHA,
+					Commit: &commitInfo,
+				},
+				PullReqMergeSegment: PullReqMergeSegment{
+					MergeSHA: event.Payload.MergeSHA,
+				},
+			}, nil
+		})
+}
+
+// PullReqClosedPayload describes the body of the pullreq closed trigger.
+type PullReqClosedPayload struct {
+	BaseSegment
+	PullReqSegment
+	PullReqTargetReferenceSegment
+	ReferenceSegment
+	ReferenceDetailsSegment
+}
+
+// handleEventPullReqClosed handles closed events for pull requests
+// and triggers pullreq closed webhooks for the target repo.
+func (s *Service) handleEventPullReqClosed(ctx context.Context,
+	event *events.Event[*pullreqevents.ClosedPayload]) error {
+	return s.triggerForEventWithPullReq(ctx, enum.WebhookTriggerPullReqClosed,
+		event.ID, event.Payload.PrincipalID, event.Payload.PullReqID,
+		func(principal *types.Principal, pr *types.PullReq, targetRepo, sourceRepo *types.Repository) (any, error) {
+			targetRepoInfo := repositoryInfoFrom(targetRepo, s.urlProvider)
+			sourceRepoInfo := repositoryInfoFrom(sourceRepo, s.urlProvider)
+
+			return &PullReqClosedPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   enum.WebhookTriggerPullReqClosed,
+					Repo:      targetRepoInfo,
+					Principal: principalInfoFrom(principal),
+				},
+				PullReqSegment: PullReqSegment{
+					PullReq: pullReqInfoFrom(pr),
+				},
+				PullReqTargetReferenceSegment: PullReqTargetReferenceSegment{
+					TargetRef: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.TargetBranch,
+						Repo: targetRepoInfo,
+					},
+				},
+				ReferenceSegment: ReferenceSegment{
+					Ref: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.SourceBranch,
+						Repo: sourceRepoInfo,
+					},
+				},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{
+					SHA: pr.SourceSHA,
+				},
+			}, nil
+		})
+}
+
+// PullReqCommentCreatedPayload describes the body of the pullreq comment created trigger.
+type PullReqCommentCreatedPayload struct {
+	BaseSegment
+	PullReqSegment
+	PullReqTargetReferenceSegment
+	ReferenceSegment
+	ReferenceDetailsSegment
+	PullReqCommentSegment
+}
+
+// handleEventPullReqCommentCreated handles comment created events for pull requests
+// and triggers pullreq comment created webhooks for the target repo.
+func (s *Service) handleEventPullReqCommentCreated(ctx context.Context,
+	event *events.Event[*pullreqevents.CommentCreatedPayload]) error {
+	return s.triggerForEventWithPullReq(ctx, enum.WebhookTriggerPullReqCommentCreated,
+		event.ID, event.Payload.PrincipalID, event.Payload.PullReqID,
+		func(principal *types.Principal, pr *types.PullReq, targetRepo, sourceRepo *types.Repository) (any, error) {
+			act, err := s.findActivityForEvent(ctx, event.Payload.ActivityID)
+			if err != nil {
+				return nil, err
+			}
+			targetRepoInfo := repositoryInfoFrom(targetRepo, s.urlProvider)
+			sourceRepoInfo := repositoryInfoFrom(sourceRepo, s.urlProvider)
+
+			return &PullReqCommentCreatedPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   enum.WebhookTriggerPullReqCommentCreated,
+					Repo:      targetRepoInfo,
+					Principal: principalInfoFrom(principal),
+				},
+				PullReqSegment: PullReqSegment{
+					PullReq: pullReqInfoFrom(pr),
+				},
+				PullReqTargetReferenceSegment: PullReqTargetReferenceSegment{
+					TargetRef: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.TargetBranch,
+						Repo: targetRepoInfo,
+					},
+				},
+				ReferenceSegment: ReferenceSegment{
+					Ref: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.SourceBranch,
+						Repo: sourceRepoInfo,
+					},
+				},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{
+					SHA: pr.SourceSHA,
+				},
+				PullReqCommentSegment: PullReqCommentSegment{
+					Comment: commentInfoFrom(act),
+				},
+			}, nil
+		})
+}
+
+// PullReqReviewSubmittedPayload describes the body of the pullreq review submitted trigger.
+type PullReqReviewSubmittedPayload struct {
+	BaseSegment
+	PullReqSegment
+	PullReqTargetReferenceSegment
+	ReferenceSegment
+	ReferenceDetailsSegment
+	PullReqReviewSegment
+}
+
+// handleEventPullReqReviewSubmitted handles review submitted events for pull requests
+// and triggers pullreq review submitted webhooks for the target repo.
+func (s *Service) handleEventPullReqReviewSubmitted(ctx context.Context,
+	event *events.Event[*pullreqevents.ReviewSubmittedPayload]) error {
+	return s.triggerForEventWithPullReq(ctx, enum.WebhookTriggerPullReqReviewSubmitted,
+		event.ID, event.Payload.PrincipalID, event.Payload.PullReqID,
+		func(principal *types.Principal, pr *types.PullReq, targetRepo, sourceRepo *types.Repository) (any, error) {
+			targetRepoInfo := repositoryInfoFrom(targetRepo, s.urlProvider)
+			sourceRepoInfo := repositoryInfoFrom(sourceRepo, s.urlProvider)
+
+			return &PullReqReviewSubmittedPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   enum.WebhookTriggerPullReqReviewSubmitted,
+					Repo:      targetRepoInfo,
+					Principal: principalInfoFrom(principal),
+				},
+				PullReqSegment: PullReqSegment{
+					PullReq: pullReqInfoFrom(pr),
+				},
+				PullReqTargetReferenceSegment: PullReqTargetReferenceSegment{
+					TargetRef: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.TargetBranch,
+						Repo: targetRepoInfo,
+					},
+				},
+				ReferenceSegment: ReferenceSegment{
+					Ref: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.SourceBranch,
+						Repo: sourceRepoInfo,
+					},
+				},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{
+					SHA: event.Payload.CommitSHA,
+				},
+				PullReqReviewSegment: PullReqReviewSegment{
+					Review: ReviewInfo{
+						Decision:  event.Payload.Decision,
+						CommitSHA: event.Payload.CommitSHA,
+					},
+				},
+			}, nil
+		})
+}
+
+// PullReqReviewerAddedPayload describes the body of the pullreq reviewer added trigger.
+type PullReqReviewerAddedPayload struct {
+	BaseSegment
+	PullReqSegment
+	PullReqTargetReferenceSegment
+	ReferenceSegment
+	ReferenceDetailsSegment
+	PullReqReviewerSegment
+}
+
+// handleEventPullReqReviewerAdded handles reviewer added events for pull requests
+// and triggers pullreq reviewer added webhooks for the target repo.
+func (s *Service) handleEventPullReqReviewerAdded(ctx context.Context,
+	event *events.Event[*pullreqevents.ReviewerAddedPayload]) error {
+	return s.triggerForEventWithPullReq(ctx, enum.WebhookTriggerPullReqReviewerAdded,
+		event.ID, event.Payload.PrincipalID, event.Payload.PullReqID,
+		func(principal *types.Principal, pr *types.PullReq, targetRepo, sourceRepo *types.Repository) (any, error) {
+			reviewer, err := s.findPrincipalForEvent(ctx, event.Payload.ReviewerID)
+			if err != nil {
+				return nil, err
+			}
+			targetRepoInfo := repositoryInfoFrom(targetRepo, s.urlProvider)
+			sourceRepoInfo := repositoryInfoFrom(sourceRepo, s.urlProvider)
+
+			return &PullReqReviewerAddedPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   enum.WebhookTriggerPullReqReviewerAdded,
+					Repo:      targetRepoInfo,
+					Principal: principalInfoFrom(principal),
+				},
+				PullReqSegment: PullReqSegment{
+					PullReq: pullReqInfoFrom(pr),
+				},
+				PullReqTargetReferenceSegment: PullReqTargetReferenceSegment{
+					TargetRef: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.TargetBranch,
+						Repo: targetRepoInfo,
+					},
+				},
+				ReferenceSegment: ReferenceSegment{
+					Ref: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.SourceBranch,
+						Repo: sourceRepoInfo,
+					},
+				},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{
+					SHA: pr.SourceSHA,
+				},
+				PullReqReviewerSegment: PullReqReviewerSegment{
+					Reviewer: principalInfoFrom(reviewer),
+				},
+			}, nil
+		})
+}
+
+// PullReqLabelPayload describes the body of the pullreq label added and removed triggers.
+type PullReqLabelPayload struct {
+	BaseSegment
+	PullReqSegment
+	PullReqTargetReferenceSegment
+	ReferenceSegment
+	ReferenceDetailsSegment
+	PullReqLabelSegment
+}
+
+// handleEventPullReqLabelAdded handles label added events for pull requests
+// and triggers pullreq label added webhooks for the target repo.
+func (s *Service) handleEventPullReqLabelAdded(ctx context.Context,
+	event *events.Event[*pullreqevents.LabelAddedPayload]) error {
+	return s.triggerForPullReqLabelEvent(ctx, enum.WebhookTriggerPullReqLabelAdded,
+		event.ID, &event.Payload.Base, event.Payload.LabelID)
+}
+
+// handleEventPullReqLabelRemoved handles label removed events for pull requests
+// and triggers pullreq label removed webhooks for the target repo.
+func (s *Service) handleEventPullReqLabelRemoved(ctx context.Context,
+	event *events.Event[*pullreqevents.LabelRemovedPayload]) error {
+	return s.triggerForPullReqLabelEvent(ctx, enum.WebhookTriggerPullReqLabelRemoved,
+		event.ID, &event.Payload.Base, event.Payload.LabelID)
+}
+
+func (s *Service) triggerForPullReqLabelEvent(ctx context.Context, triggerType enum.WebhookTrigger,
+	eventID string, base *pullreqevents.Base, labelID int64) error {
+	return s.triggerForEventWithPullReq(ctx, triggerType,
+		eventID, base.PrincipalID, base.PullReqID,
+		func(principal *types.Principal, pr *types.PullReq, targetRepo, sourceRepo *types.Repository) (any, error) {
+			label, err := s.findLabelForEvent(ctx, labelID)
+			if err != nil {
+				return nil, err
+			}
+			targetRepoInfo := repositoryInfoFrom(targetRepo, s.urlProvider)
+			sourceRepoInfo := repositoryInfoFrom(sourceRepo, s.urlProvider)
+
+			return &PullReqLabelPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   triggerType,
+					Repo:      targetRepoInfo,
+					Principal: principalInfoFrom(principal),
+				},
+				PullReqSegment: PullReqSegment{
+					PullReq: pullReqInfoFrom(pr),
+				},
+				PullReqTargetReferenceSegment: PullReqTargetReferenceSegment{
+					TargetRef: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.TargetBranch,
+						Repo: targetRepoInfo,
+					},
+				},
+				ReferenceSegment: ReferenceSegment{
+					Ref: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.SourceBranch,
+						Repo: sourceRepoInfo,
+					},
+				},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{
+					SHA: pr.SourceSHA,
+				},
+				PullReqLabelSegment: PullReqLabelSegment{
+					Label: labelInfoFrom(label),
+				},
+			}, nil
+		})
+}
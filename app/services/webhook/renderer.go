@@ -0,0 +1,300 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	gitReferenceNamePrefixTag = "refs/tags/"
+
+	messageColorCreated = 0x2EB67D
+	messageColorDeleted = 0xE01E5A
+	messageColorDefault = 0x0092E4
+
+	shortSHALength = 7
+)
+
+// Renderer renders the summary of a webhook payload into the body of a specific webhook format.
+// The returned value is JSON serialized and used as request body as is.
+type Renderer interface {
+	Render(msg *Message) (any, error)
+}
+
+// Message is the format independent summary of a webhook payload that's passed to the renderers.
+type Message struct {
+	Trigger enum.WebhookTrigger
+	Title   string
+	Text    string
+	URL     string
+	Author  string
+	Color   int
+	Fields  []MessageField
+}
+
+// MessageField is a single name value pair shown as part of a Message.
+type MessageField struct {
+	Name  string
+	Value string
+}
+
+// defaultRenderers returns the renderers for all non-native webhook formats.
+func defaultRenderers() map[enum.WebhookFormat]Renderer {
+	return map[enum.WebhookFormat]Renderer{
+		enum.WebhookFormatSlack:   slackRenderer{},
+		enum.WebhookFormatMSTeams: msTeamsRenderer{},
+		enum.WebhookFormatDiscord: discordRenderer{},
+	}
+}
+
+// renderPayload renders the payload in the format configured for the webhook.
+// NOTE: for the native format the payload is returned as is.
+func (s *Service) renderPayload(webhook *types.Webhook, triggerType enum.WebhookTrigger, body any) (any, error) {
+	if webhook.Format == "" || webhook.Format == enum.WebhookFormatNative {
+		return body, nil
+	}
+
+	renderer, ok := s.renderers[webhook.Format]
+	if !ok {
+		return nil, fmt.Errorf("no renderer registered for webhook format '%s'", webhook.Format)
+	}
+
+	msg, err := messageFrom(s.urlProvider, triggerType, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize payload: %w", err)
+	}
+
+	return renderer.Render(msg)
+}
+
+// summaryPayload contains the union of all payload segments that are used to summarize a payload.
+type summaryPayload struct {
+	BaseSegment
+	PullReq   *PullReqInfo   `json:"pull_req"`
+	TargetRef *ReferenceInfo `json:"target_ref"`
+	Ref       *ReferenceInfo `json:"ref"`
+	SHA       string         `json:"sha"`
+	Commit    *CommitInfo    `json:"commit"`
+	OldSHA    string         `json:"old_sha"`
+	Forced    bool           `json:"forced"`
+	Comment   *CommentInfo   `json:"comment"`
+	Review    *ReviewInfo    `json:"review"`
+	Reviewer  *PrincipalInfo `json:"reviewer"`
+	Label     *LabelInfo     `json:"label"`
+	Execution *ExecutionInfo `json:"execution"`
+}
+
+// messageFrom summarizes any payload built from the webhook payload segments as a Message.
+// NOTE: The payload is mapped via its JSON representation, which allows to summarize all payloads
+// without knowing their concrete type (as long as they are built from the known segments).
+func messageFrom(urlProvider url.Provider, triggerType enum.WebhookTrigger, body any) (*Message, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize payload: %w", err)
+	}
+
+	payload := summaryPayload{}
+	if err = json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("failed to deserialize payload: %w", err)
+	}
+
+	msg := &Message{
+		Trigger: triggerType,
+		Author:  principalName(&payload.Principal),
+		Color:   messageColorFor(triggerType),
+		Fields: []MessageField{
+			{Name: "Repository", Value: payload.Repo.Path},
+		},
+	}
+
+	switch {
+	case payload.Execution != nil:
+		summarizeExecution(urlProvider, msg, &payload)
+	case payload.PullReq != nil:
+		summarizePullReq(urlProvider, msg, &payload)
+	case payload.Ref != nil:
+		summarizeReference(urlProvider, msg, &payload)
+	default:
+		msg.Title = fmt.Sprintf("[%s] %s", payload.Repo.Path, triggerAction(triggerType))
+		msg.Text = fmt.Sprintf("%s triggered %s", msg.Author, triggerType)
+	}
+
+	if payload.Commit != nil {
+		msg.Fields = append(msg.Fields, MessageField{
+			Name:  "Commit",
+			Value: fmt.Sprintf("%s %s", shortSHA(payload.Commit.SHA), commitTitle(payload.Commit.Message)),
+		})
+	}
+
+	return msg, nil
+}
+
+func summarizePullReq(urlProvider url.Provider, msg *Message, payload *summaryPayload) {
+	pr := payload.PullReq
+	msg.Title = fmt.Sprintf("[%s] Pull request #%d %s: %s",
+		payload.Repo.Path, pr.Number, triggerAction(msg.Trigger), pr.Title)
+	msg.URL = urlProvider.GenerateUIPRURL(payload.Repo.Path, pr.Number)
+	msg.Fields = append(msg.Fields,
+		MessageField{Name: "Source", Value: pr.SourceBranch},
+		MessageField{Name: "Target", Value: pr.TargetBranch},
+	)
+
+	switch {
+	case payload.Comment != nil:
+		msg.Text = payload.Comment.Text
+		if payload.Comment.CodeComment != nil {
+			msg.Fields = append(msg.Fields, MessageField{Name: "File", Value: payload.Comment.CodeComment.Path})
+		}
+	case payload.Review != nil:
+		msg.Text = fmt.Sprintf("%s submitted a review: %s", msg.Author, payload.Review.Decision)
+	case payload.Reviewer != nil:
+		msg.Text = fmt.Sprintf("%s added %s as reviewer", msg.Author, principalName(payload.Reviewer))
+	case payload.Label != nil && msg.Trigger == enum.WebhookTriggerPullReqLabelRemoved:
+		msg.Text = fmt.Sprintf("%s removed label %q", msg.Author, payload.Label.Name)
+	case payload.Label != nil:
+		msg.Text = fmt.Sprintf("%s added label %q", msg.Author, payload.Label.Name)
+	case msg.Trigger == enum.WebhookTriggerPullReqMerged:
+		msg.Text = fmt.Sprintf("%s merged %s into %s", msg.Author, pr.SourceBranch, pr.TargetBranch)
+	case msg.Trigger == enum.WebhookTriggerPullReqClosed:
+		msg.Text = fmt.Sprintf("%s closed the pull request", msg.Author)
+	default:
+		msg.Text = fmt.Sprintf("%s wants to merge %s into %s", msg.Author, pr.SourceBranch, pr.TargetBranch)
+	}
+}
+
+func summarizeReference(urlProvider url.Provider, msg *Message, payload *summaryPayload) {
+	kind, name := referenceKindAndName(payload.Ref.Name)
+	msg.Title = fmt.Sprintf("[%s] %s %s %s", payload.Repo.Path, kind, name, triggerAction(msg.Trigger))
+	msg.Text = fmt.Sprintf("%s %s %s %s", msg.Author, triggerAction(msg.Trigger), strings.ToLower(kind), name)
+	if payload.Forced {
+		msg.Text += " (forced)"
+	}
+	if isSHASet(payload.OldSHA) && isSHASet(payload.SHA) {
+		msg.URL = urlProvider.GenerateUICompareURL(payload.Repo.Path, payload.OldSHA, payload.SHA)
+	}
+}
+
+func summarizeExecution(urlProvider url.Provider, msg *Message, payload *summaryPayload) {
+	execution := payload.Execution
+	msg.Title = fmt.Sprintf("[%s] Pipeline %s execution #%d %s",
+		payload.Repo.Path, execution.PipelineUID, execution.Number, triggerAction(msg.Trigger))
+	msg.Text = execution.Title
+	if msg.Text == "" {
+		msg.Text = fmt.Sprintf("Triggered by %s", msg.Author)
+	}
+	msg.URL = urlProvider.GenerateUIExecutionURL(payload.Repo.Path, execution.PipelineUID, execution.Number)
+	msg.Fields = append(msg.Fields, MessageField{Name: "Status", Value: string(execution.Status)})
+	if payload.Ref != nil && payload.Ref.Name != "" {
+		_, name := referenceKindAndName(payload.Ref.Name)
+		msg.Fields = append(msg.Fields, MessageField{Name: "Ref", Value: name})
+	}
+	if payload.SHA != "" {
+		msg.Fields = append(msg.Fields, MessageField{Name: "Commit", Value: shortSHA(payload.SHA)})
+	}
+
+	if msg.Trigger == enum.WebhookTriggerExecutionFinished {
+		switch execution.Status {
+		case enum.CIStatusSuccess:
+			msg.Color = messageColorCreated
+		case enum.CIStatusFailure, enum.CIStatusError, enum.CIStatusKilled:
+			msg.Color = messageColorDeleted
+		default:
+		}
+	}
+}
+
+// principalName returns the display name of the principal (falls back to the uid).
+func principalName(principal *PrincipalInfo) string {
+	if principal.DisplayName != "" {
+		return principal.DisplayName
+	}
+	return principal.UID
+}
+
+// triggerAction returns a human readable action for the trigger (e.g. pullreq_created => opened).
+func triggerAction(triggerType enum.WebhookTrigger) string {
+	if triggerType == enum.WebhookTriggerPullReqCreated {
+		return "opened"
+	}
+
+	action := string(triggerType)
+	for _, prefix := range []string{"pullreq_", "branch_", "tag_", "execution_"} {
+		action = strings.TrimPrefix(action, prefix)
+	}
+
+	return strings.ReplaceAll(action, "_", " ")
+}
+
+// messageColorFor returns the color used to highlight the message of a trigger.
+func messageColorFor(triggerType enum.WebhookTrigger) int {
+	switch {
+	case strings.HasSuffix(string(triggerType), "_created"),
+		strings.HasSuffix(string(triggerType), "_reopened"):
+		return messageColorCreated
+	case strings.HasSuffix(string(triggerType), "_deleted"),
+		strings.HasSuffix(string(triggerType), "_closed"):
+		return messageColorDeleted
+	default:
+		return messageColorDefault
+	}
+}
+
+// referenceKindAndName returns the kind (branch or tag) and the short name of a git reference.
+func referenceKindAndName(ref string) (string, string) {
+	switch {
+	case strings.HasPrefix(ref, gitReferenceNamePrefixBranch):
+		return "Branch", strings.TrimPrefix(ref, gitReferenceNamePrefixBranch)
+	case strings.HasPrefix(ref, gitReferenceNamePrefixTag):
+		return "Tag", strings.TrimPrefix(ref, gitReferenceNamePrefixTag)
+	default:
+		return "Reference", ref
+	}
+}
+
+// isSHASet returns true in case the sha is neither empty nor the nil sha (e.g. old sha of created branches).
+func isSHASet(sha string) bool {
+	return sha != "" && sha != types.NilSHA
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > shortSHALength {
+		return sha[:shortSHALength]
+	}
+	return sha
+}
+
+// commitTitle returns the first line of a commit message.
+func commitTitle(message string) string {
+	title, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	return strings.TrimSpace(title)
+}
+
+// truncate shortens the string to at most maxRunes runes as some chat formats have strict limits.
+func truncate(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+
+	runes := []rune(s)
+	return string(runes[:maxRunes-1]) + "…"
+}
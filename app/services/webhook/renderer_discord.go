@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+const (
+	// discordMaxTitleLength is the max length of the title of a discord embed.
+	discordMaxTitleLength = 256
+	// discordMaxDescriptionLength is the max length of the description of a discord embed.
+	discordMaxDescriptionLength = 4096
+	// discordMaxFieldValueLength is the max length of the value of a discord embed field.
+	discordMaxFieldValueLength = 1024
+	// discordMaxFields is the max number of fields of a discord embed.
+	discordMaxFields = 25
+)
+
+// discordRenderer renders messages as Discord embed payloads.
+// See https://discord.com/developers/docs/resources/channel#embed-object for details.
+type discordRenderer struct{}
+
+type discordMessage struct {
+	Embeds []discordEmbed `json:"embeds"`
+}
+
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	URL         string         `json:"url,omitempty"`
+	Color       int            `json:"color"`
+	Author      *discordAuthor `json:"author,omitempty"`
+	Fields      []discordField `json:"fields,omitempty"`
+}
+
+type discordAuthor struct {
+	Name string `json:"name"`
+}
+
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+func (discordRenderer) Render(msg *Message) (any, error) {
+	embed := discordEmbed{
+		Title:       truncate(msg.Title, discordMaxTitleLength),
+		Description: truncate(msg.Text, discordMaxDescriptionLength),
+		URL:         msg.URL,
+		Color:       msg.Color,
+	}
+
+	if msg.Author != "" {
+		embed.Author = &discordAuthor{Name: msg.Author}
+	}
+
+	for i, field := range msg.Fields {
+		if i >= discordMaxFields {
+			break
+		}
+		embed.Fields = append(embed.Fields, discordField{
+			Name:   field.Name,
+			Value:  truncate(field.Value, discordMaxFieldValueLength),
+			Inline: true,
+		})
+	}
+
+	return &discordMessage{
+		Embeds: []discordEmbed{embed},
+	}, nil
+}
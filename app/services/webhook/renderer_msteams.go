@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+)
+
+// msTeamsRenderer renders messages as Microsoft Teams MessageCard payloads.
+// See https://learn.microsoft.com/outlook/actionable-messages/message-card-reference for details.
+type msTeamsRenderer struct{}
+
+type msTeamsMessageCard struct {
+	Type            string           `json:"@type"`
+	Context         string           `json:"@context"`
+	Summary         string           `json:"summary"`
+	ThemeColor      string           `json:"themeColor"`
+	Title           string           `json:"title"`
+	Text            string           `json:"text,omitempty"`
+	Sections        []msTeamsSection `json:"sections,omitempty"`
+	PotentialAction []msTeamsAction  `json:"potentialAction,omitempty"`
+}
+
+type msTeamsSection struct {
+	ActivityTitle string        `json:"activityTitle,omitempty"`
+	Facts         []msTeamsFact `json:"facts,omitempty"`
+}
+
+type msTeamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type msTeamsAction struct {
+	Type    string          `json:"@type"`
+	Name    string          `json:"name"`
+	Targets []msTeamsTarget `json:"targets"`
+}
+
+type msTeamsTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+func (msTeamsRenderer) Render(msg *Message) (any, error) {
+	facts := make([]msTeamsFact, len(msg.Fields))
+	for i, field := range msg.Fields {
+		facts[i] = msTeamsFact{
+			Name:  field.Name,
+			Value: field.Value,
+		}
+	}
+
+	card := &msTeamsMessageCard{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		Summary:    msg.Title,
+		ThemeColor: fmt.Sprintf("%06X", msg.Color),
+		Title:      msg.Title,
+		Text:       msg.Text,
+		Sections: []msTeamsSection{
+			{
+				ActivityTitle: msg.Author,
+				Facts:         facts,
+			},
+		},
+	}
+
+	if msg.URL != "" {
+		card.PotentialAction = []msTeamsAction{
+			{
+				Type: "OpenUri",
+				Name: "View",
+				Targets: []msTeamsTarget{
+					{OS: "default", URI: msg.URL},
+				},
+			},
+		}
+	}
+
+	return card, nil
+}
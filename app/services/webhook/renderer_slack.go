@@ -0,0 +1,100 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// slackMaxTextLength is the max length of a text object in a slack section block.
+	slackMaxTextLength = 3000
+	// slackMaxFields is the max number of fields in a slack section block.
+	slackMaxFields = 10
+)
+
+// slackEscaper escapes the control characters of slack mrkdwn.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// slackRenderer renders messages as Slack Block Kit payloads.
+// See https://api.slack.com/block-kit for details.
+type slackRenderer struct{}
+
+type slackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Fields   []slackText `json:"fields,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func (slackRenderer) Render(msg *Message) (any, error) {
+	title := "*" + slackEscaper.Replace(msg.Title) + "*"
+	if msg.URL != "" {
+		title = fmt.Sprintf("*<%s|%s>*", msg.URL, slackEscaper.Replace(msg.Title))
+	}
+
+	blocks := []slackBlock{
+		{
+			Type: "section",
+			Text: &slackText{
+				Type: "mrkdwn",
+				Text: truncate(title+"\n"+slackEscaper.Replace(msg.Text), slackMaxTextLength),
+			},
+		},
+	}
+
+	if len(msg.Fields) > 0 {
+		fields := make([]slackText, 0, len(msg.Fields))
+		for i, field := range msg.Fields {
+			if i >= slackMaxFields {
+				break
+			}
+			fields = append(fields, slackText{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("*%s*\n%s", slackEscaper.Replace(field.Name), slackEscaper.Replace(field.Value)),
+			})
+		}
+		blocks = append(blocks, slackBlock{
+			Type:   "section",
+			Fields: fields,
+		})
+	}
+
+	if msg.Author != "" {
+		blocks = append(blocks, slackBlock{
+			Type: "context",
+			Elements: []slackText{
+				{Type: "mrkdwn", Text: "by " + slackEscaper.Replace(msg.Author)},
+			},
+		})
+	}
+
+	return &slackMessage{
+		// text is used as fallback for notifications
+		Text:   msg.Title,
+		Blocks: blocks,
+	}, nil
+}
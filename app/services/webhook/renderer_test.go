@@ -0,0 +1,269 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestMessageFrom(t *testing.T) {
+	urlProvider, err := url.NewProvider("http://localhost", "http://localhost", "http://localhost",
+		"http://localhost", "http://gitness.local")
+	if err != nil {
+		t.Fatalf("failed to create url provider: %s", err)
+	}
+
+	repo := RepositoryInfo{ID: 1, Path: "space/repo"}
+	principal := PrincipalInfo{ID: 2, UID: "jdoe", DisplayName: "John Doe"}
+	commit := CommitInfo{SHA: "0123456789abcdef", Message: "add feature\n\nsome details"}
+
+	tests := []struct {
+		name    string
+		trigger enum.WebhookTrigger
+		body    any
+		exp     *Message
+	}{
+		{
+			name:    "pullreq",
+			trigger: enum.WebhookTriggerPullReqCreated,
+			body: &PullReqCreatedPayload{
+				BaseSegment: BaseSegment{Repo: repo, Principal: principal},
+				PullReqSegment: PullReqSegment{PullReq: PullReqInfo{
+					Number: 3, Title: "Feature", SourceBranch: "feature", TargetBranch: "main",
+				}},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{SHA: commit.SHA, Commit: &commit},
+			},
+			exp: &Message{
+				Trigger: enum.WebhookTriggerPullReqCreated,
+				Title:   "[space/repo] Pull request #3 opened: Feature",
+				Text:    "John Doe wants to merge feature into main",
+				URL:     "http://gitness.local/space/repo/pulls/3",
+				Author:  "John Doe",
+				Color:   messageColorCreated,
+				Fields: []MessageField{
+					{Name: "Repository", Value: "space/repo"},
+					{Name: "Source", Value: "feature"},
+					{Name: "Target", Value: "main"},
+					{Name: "Commit", Value: "0123456 add feature"},
+				},
+			},
+		},
+		{
+			name:    "branch",
+			trigger: enum.WebhookTriggerBranchUpdated,
+			body: &ReferencePayload{
+				BaseSegment:             BaseSegment{Repo: repo, Principal: principal},
+				ReferenceSegment:        ReferenceSegment{Ref: ReferenceInfo{Name: "refs/heads/main", Repo: repo}},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{SHA: "222"},
+				ReferenceUpdateSegment:  ReferenceUpdateSegment{OldSHA: "111", Forced: true},
+			},
+			exp: &Message{
+				Trigger: enum.WebhookTriggerBranchUpdated,
+				Title:   "[space/repo] Branch main updated",
+				Text:    "John Doe updated branch main (forced)",
+				URL:     "http://gitness.local/space/repo/pulls/compare/111...222",
+				Author:  "John Doe",
+				Color:   messageColorDefault,
+				Fields: []MessageField{
+					{Name: "Repository", Value: "space/repo"},
+				},
+			},
+		},
+		{
+			name:    "tag",
+			trigger: enum.WebhookTriggerTagDeleted,
+			body: &ReferencePayload{
+				BaseSegment:      BaseSegment{Repo: repo, Principal: PrincipalInfo{UID: "jdoe"}},
+				ReferenceSegment: ReferenceSegment{Ref: ReferenceInfo{Name: "refs/tags/v1.0", Repo: repo}},
+			},
+			exp: &Message{
+				Trigger: enum.WebhookTriggerTagDeleted,
+				Title:   "[space/repo] Tag v1.0 deleted",
+				Text:    "jdoe deleted tag v1.0",
+				Author:  "jdoe",
+				Color:   messageColorDeleted,
+				Fields: []MessageField{
+					{Name: "Repository", Value: "space/repo"},
+				},
+			},
+		},
+		{
+			name:    "comment",
+			trigger: enum.WebhookTriggerPullReqCommentCreated,
+			body: &PullReqCommentCreatedPayload{
+				BaseSegment: BaseSegment{Repo: repo, Principal: principal},
+				PullReqSegment: PullReqSegment{PullReq: PullReqInfo{
+					Number: 3, Title: "Feature", SourceBranch: "feature", TargetBranch: "main",
+				}},
+				PullReqCommentSegment: PullReqCommentSegment{Comment: CommentInfo{
+					ID: 7, Text: "looks good", CodeComment: &CodeCommentInfo{Path: "main.go"},
+				}},
+			},
+			exp: &Message{
+				Trigger: enum.WebhookTriggerPullReqCommentCreated,
+				Title:   "[space/repo] Pull request #3 comment created: Feature",
+				Text:    "looks good",
+				URL:     "http://gitness.local/space/repo/pulls/3",
+				Author:  "John Doe",
+				Color:   messageColorCreated,
+				Fields: []MessageField{
+					{Name: "Repository", Value: "space/repo"},
+					{Name: "Source", Value: "feature"},
+					{Name: "Target", Value: "main"},
+					{Name: "File", Value: "main.go"},
+				},
+			},
+		},
+		{
+			name:    "label",
+			trigger: enum.WebhookTriggerPullReqLabelRemoved,
+			body: &PullReqLabelPayload{
+				BaseSegment: BaseSegment{Repo: repo, Principal: principal},
+				PullReqSegment: PullReqSegment{PullReq: PullReqInfo{
+					Number: 3, Title: "Feature", SourceBranch: "feature", TargetBranch: "main",
+				}},
+				PullReqLabelSegment: PullReqLabelSegment{Label: LabelInfo{ID: 4, Name: "bug", Color: "#d73a4a"}},
+			},
+			exp: &Message{
+				Trigger: enum.WebhookTriggerPullReqLabelRemoved,
+				Title:   "[space/repo] Pull request #3 label removed: Feature",
+				Text:    `John Doe removed label "bug"`,
+				URL:     "http://gitness.local/space/repo/pulls/3",
+				Author:  "John Doe",
+				Color:   messageColorDefault,
+				Fields: []MessageField{
+					{Name: "Repository", Value: "space/repo"},
+					{Name: "Source", Value: "feature"},
+					{Name: "Target", Value: "main"},
+				},
+			},
+		},
+		{
+			name:    "execution",
+			trigger: enum.WebhookTriggerExecutionFinished,
+			body: &ExecutionPayload{
+				BaseSegment:             BaseSegment{Repo: repo, Principal: principal},
+				ReferenceSegment:        ReferenceSegment{Ref: ReferenceInfo{Name: "refs/heads/main", Repo: repo}},
+				ReferenceDetailsSegment: ReferenceDetailsSegment{SHA: commit.SHA},
+				ExecutionSegment: ExecutionSegment{Execution: ExecutionInfo{
+					Number: 5, PipelineUID: "build", Status: enum.CIStatusFailure,
+				}},
+			},
+			exp: &Message{
+				Trigger: enum.WebhookTriggerExecutionFinished,
+				Title:   "[space/repo] Pipeline build execution #5 finished",
+				Text:    "Triggered by John Doe",
+				URL:     "http://gitness.local/space/repo/pipelines/build/execution/5",
+				Author:  "John Doe",
+				Color:   messageColorDeleted,
+				Fields: []MessageField{
+					{Name: "Repository", Value: "space/repo"},
+					{Name: "Status", Value: "failure"},
+					{Name: "Ref", Value: "main"},
+					{Name: "Commit", Value: "0123456"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg, err := messageFrom(urlProvider, test.trigger, test.body)
+			if err != nil {
+				t.Fatalf("failed to summarize payload: %s", err)
+			}
+
+			if !reflect.DeepEqual(test.exp, msg) {
+				t.Errorf("message mismatch; want=%+v got=%+v", test.exp, msg)
+			}
+		})
+	}
+}
+
+func TestRenderers(t *testing.T) {
+	msg := &Message{
+		Title:  "[space/repo] Pull request #3 opened: <b>Feature</b>",
+		Text:   "John Doe wants to merge feature into main",
+		URL:    "http://gitness.local/space/repo/pulls/3",
+		Author: "John Doe",
+		Color:  messageColorCreated,
+		Fields: []MessageField{{Name: "Repository", Value: "space/repo"}},
+	}
+
+	tests := []struct {
+		format enum.WebhookFormat
+		exp    string
+	}{
+		{
+			format: enum.WebhookFormatSlack,
+			exp: `{"text":"[space/repo] Pull request #3 opened: <b>Feature</b>","blocks":[` +
+				`{"type":"section","text":{"type":"mrkdwn","text":"*<http://gitness.local/space/repo/pulls/3|` +
+				`[space/repo] Pull request #3 opened: &lt;b&gt;Feature&lt;/b&gt;>*\n` +
+				`John Doe wants to merge feature into main"}},` +
+				`{"type":"section","fields":[{"type":"mrkdwn","text":"*Repository*\nspace/repo"}]},` +
+				`{"type":"context","elements":[{"type":"mrkdwn","text":"by John Doe"}]}]}`,
+		},
+		{
+			format: enum.WebhookFormatMSTeams,
+			exp: `{"@type":"MessageCard","@context":"https://schema.org/extensions",` +
+				`"summary":"[space/repo] Pull request #3 opened: <b>Feature</b>",` +
+				`"themeColor":"2EB67D","title":"[space/repo] Pull request #3 opened: <b>Feature</b>",` +
+				`"text":"John Doe wants to merge feature into main",` +
+				`"sections":[{"activityTitle":"John Doe","facts":[{"name":"Repository","value":"space/repo"}]}],` +
+				`"potentialAction":[{"@type":"OpenUri","name":"View",` +
+				`"targets":[{"os":"default","uri":"http://gitness.local/space/repo/pulls/3"}]}]}`,
+		},
+		{
+			format: enum.WebhookFormatDiscord,
+			exp: `{"embeds":[{"title":"[space/repo] Pull request #3 opened: <b>Feature</b>",` +
+				`"description":"John Doe wants to merge feature into main",` +
+				`"url":"http://gitness.local/space/repo/pulls/3","color":3061373,"author":{"name":"John Doe"},` +
+				`"fields":[{"name":"Repository","value":"space/repo","inline":true}]}]}`,
+		},
+	}
+
+	renderers := defaultRenderers()
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			payload, err := renderers[test.format].Render(msg)
+			if err != nil {
+				t.Fatalf("failed to render message: %s", err)
+			}
+
+			raw, err := json.Marshal(payload)
+			if err != nil {
+				t.Fatalf("failed to serialize payload: %s", err)
+			}
+
+			// compare decoded values as the encoder escapes html characters
+			var want, got any
+			if err = json.Unmarshal([]byte(test.exp), &want); err != nil {
+				t.Fatalf("failed to deserialize expected payload: %s", err)
+			}
+			if err = json.Unmarshal(raw, &got); err != nil {
+				t.Fatalf("failed to deserialize payload: %s", err)
+			}
+
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("payload mismatch;\nwant=%s\ngot= %s", test.exp, raw)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	gitevents "github.com/harness/gitness/app/events/git"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
@@ -29,6 +30,7 @@ import (
 	"github.com/harness/gitness/events"
 	"github.com/harness/gitness/gitrpc"
 	"github.com/harness/gitness/stream"
+	"github.com/harness/gitness/types/enum"
 )
 
 const (
@@ -81,7 +83,11 @@ type Service struct {
 	urlProvider           url.Provider
 	repoStore             store.RepoStore
 	pullreqStore          store.PullReqStore
+	activityStore         store.PullReqActivityStore
+	executionStore        store.ExecutionStore
+	pipelineStore         store.PipelineStore
 	principalStore        store.PrincipalStore
+	labelStore            store.LabelStore
 	gitRPCClient          gitrpc.Interface
 	encrypter             encrypt.Encrypter
 
@@ -91,15 +97,21 @@ type Service struct {
 	secureHTTPClientInternal   *http.Client
 	insecureHTTPClientInternal *http.Client
 
+	// renderers contains the renderers of all non-native webhook formats.
+	renderers map[enum.WebhookFormat]Renderer
+
 	config Config
 }
 
 func NewService(ctx context.Context, config Config,
 	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
 	prReaderFactory *events.ReaderFactory[*pullreqevents.Reader],
+	pipelineReaderFactory *events.ReaderFactory[*pipelineevents.Reader],
 	webhookStore store.WebhookStore, webhookExecutionStore store.WebhookExecutionStore,
-	repoStore store.RepoStore, pullreqStore store.PullReqStore, urlProvider url.Provider,
-	principalStore store.PrincipalStore, gitRPCClient gitrpc.Interface, encrypter encrypt.Encrypter,
+	repoStore store.RepoStore, pullreqStore store.PullReqStore, activityStore store.PullReqActivityStore,
+	executionStore store.ExecutionStore, pipelineStore store.PipelineStore, urlProvider url.Provider,
+	principalStore store.PrincipalStore, labelStore store.LabelStore, gitRPCClient gitrpc.Interface,
+	encrypter encrypt.Encrypter,
 ) (*Service, error) {
 	if err := config.Prepare(); err != nil {
 		return nil, fmt.Errorf("provided webhook service config is invalid: %w", err)
@@ -109,8 +121,12 @@ func NewService(ctx context.Context, config Config,
 		webhookExecutionStore: webhookExecutionStore,
 		repoStore:             repoStore,
 		pullreqStore:          pullreqStore,
+		activityStore:         activityStore,
+		executionStore:        executionStore,
+		pipelineStore:         pipelineStore,
 		urlProvider:           urlProvider,
 		principalStore:        principalStore,
+		labelStore:            labelStore,
 		gitRPCClient:          gitRPCClient,
 		encrypter:             encrypter,
 
@@ -120,6 +136,8 @@ func NewService(ctx context.Context, config Config,
 		secureHTTPClientInternal:   newHTTPClient(config.AllowLoopback, true, false),
 		insecureHTTPClientInternal: newHTTPClient(config.AllowLoopback, true, true),
 
+		renderers: defaultRenderers(),
+
 		config: config,
 	}
 
@@ -162,6 +180,13 @@ func NewService(ctx context.Context, config Config,
 			_ = r.RegisterCreated(service.handleEventPullReqCreated)
 			_ = r.RegisterReopened(service.handleEventPullReqReopened)
 			_ = r.RegisterBranchUpdated(service.handleEventPullReqBranchUpdated)
+			_ = r.RegisterMerged(service.handleEventPullReqMerged)
+			_ = r.RegisterClosed(service.handleEventPullReqClosed)
+			_ = r.RegisterCommentCreated(service.handleEventPullReqCommentCreated)
+			_ = r.RegisterReviewSubmitted(service.handleEventPullReqReviewSubmitted)
+			_ = r.RegisterReviewerAdded(service.handleEventPullReqReviewerAdded)
+			_ = r.RegisterLabelAdded(service.handleEventPullReqLabelAdded)
+			_ = r.RegisterLabelRemoved(service.handleEventPullReqLabelRemoved)
 
 			return nil
 		})
@@ -169,5 +194,25 @@ func NewService(ctx context.Context, config Config,
 		return nil, fmt.Errorf("failed to launch pr event reader for webhooks: %w", err)
 	}
 
+	_, err = pipelineReaderFactory.Launch(ctx, eventsReaderGroupName, config.EventReaderName,
+		func(r *pipelineevents.Reader) error {
+			const idleTimeout = 1 * time.Minute
+			r.Configure(
+				stream.WithConcurrency(config.Concurrency),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(config.MaxRetries),
+				))
+
+			// register events
+			_ = r.RegisterExecutionStarted(service.handleEventExecutionStarted)
+			_ = r.RegisterExecutionFinished(service.handleEventExecutionFinished)
+
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch pipeline event reader for webhooks: %w", err)
+	}
+
 	return service, nil
 }
@@ -282,7 +282,8 @@ func (s *Service) executeWebhook(ctx context.Context, webhook *types.Webhook, tr
 
 // prepareHTTPRequest prepares a new http.Request object for the webhook using the provided body as request body.
 // All execution.Request.XXX values are set accordingly.
-// NOTE: if the body is an io.Reader, the value is used as response body as is, otherwise it'll be JSON serialized.
+// NOTE: if the body is an io.Reader, the value is used as response body as is, otherwise it'll be rendered in the
+// format of the webhook and JSON serialized.
 func (s *Service) prepareHTTPRequest(ctx context.Context, execution *types.WebhookExecution,
 	triggerType enum.WebhookTrigger, webhook *types.Webhook, body any) (*http.Request, error) {
 	// set URL as is (already has been validated, any other error will be caught in request creation)
@@ -309,8 +310,17 @@ func (s *Service) prepareHTTPRequest(ctx context.Context, execution *types.Webho
 		bBuff.Write(bBytes)
 
 	default:
+		// render the payload in the format of the webhook (native payloads are used as is)
+		payload, err := s.renderPayload(webhook, triggerType, body)
+		if err != nil {
+			// this is an internal issue, nothing the user can do - don't expose error details
+			execution.Error = "an error occurred rendering the request body"
+			execution.Result = enum.WebhookExecutionResultFatalError
+			return nil, fmt.Errorf("failed to render body in format '%s': %w", webhook.Format, err)
+		}
+
 		// all other types we json serialize
-		err := json.NewEncoder(bBuff).Encode(body)
+		err = json.NewEncoder(bBuff).Encode(payload)
 		if err != nil {
 			// this is an internal issue, nothing the user can do - don't expose error details
 			execution.Error = "an error occurred preparing the request body"
@@ -63,6 +63,36 @@ type PullReqSegment struct {
 	PullReq PullReqInfo `json:"pull_req"`
 }
 
+// PullReqMergeSegment contains details for the pull req merged payloads for webhooks.
+type PullReqMergeSegment struct {
+	MergeSHA string `json:"merge_sha"`
+}
+
+// PullReqCommentSegment contains details for all pull req comment related payloads for webhooks.
+type PullReqCommentSegment struct {
+	Comment CommentInfo `json:"comment"`
+}
+
+// PullReqReviewSegment contains details for all pull req review related payloads for webhooks.
+type PullReqReviewSegment struct {
+	Review ReviewInfo `json:"review"`
+}
+
+// PullReqReviewerSegment contains details for all pull req reviewer related payloads for webhooks.
+type PullReqReviewerSegment struct {
+	Reviewer PrincipalInfo `json:"reviewer"`
+}
+
+// PullReqLabelSegment contains details for all pull req label related payloads for webhooks.
+type PullReqLabelSegment struct {
+	Label LabelInfo `json:"label"`
+}
+
+// ExecutionSegment contains details for all pipeline execution related payloads for webhooks.
+type ExecutionSegment struct {
+	Execution ExecutionInfo `json:"execution"`
+}
+
 // RepositoryInfo describes the repo related info for a webhook payload.
 // NOTE: don't use types package as we want webhook payload to be independent from API calls.
 type RepositoryInfo struct {
@@ -138,6 +168,25 @@ func principalInfoFrom(principal *types.Principal) PrincipalInfo {
 	}
 }
 
+// LabelInfo describes the label related info for a webhook payload.
+// NOTE: don't use types package as we want webhook payload to be independent from API calls.
+type LabelInfo struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+}
+
+// labelInfoFrom gets the LabelInfo from a types.Label.
+func labelInfoFrom(label *types.Label) LabelInfo {
+	return LabelInfo{
+		ID:          label.ID,
+		Name:        label.Name,
+		Description: label.Description,
+		Color:       label.Color,
+	}
+}
+
 // CommitInfo describes the commit related info for a webhook payload.
 // NOTE: don't use types package as we want webhook payload to be independent from API calls.
 type CommitInfo struct {
@@ -193,3 +242,91 @@ type ReferenceInfo struct {
 	Name string         `json:"name"`
 	Repo RepositoryInfo `json:"repo"`
 }
+
+// CommentInfo describes the pull request comment related info for a webhook payload.
+// NOTE: don't use types package as we want webhook payload to be independent from API calls.
+type CommentInfo struct {
+	ID          int64            `json:"id"`
+	ParentID    *int64           `json:"parent_id,omitempty"`
+	Text        string           `json:"text"`
+	Created     int64            `json:"created"`
+	CodeComment *CodeCommentInfo `json:"code_comment,omitempty"`
+}
+
+// commentInfoFrom gets the CommentInfo from a types.PullReqActivity.
+func commentInfoFrom(act *types.PullReqActivity) CommentInfo {
+	info := CommentInfo{
+		ID:       act.ID,
+		ParentID: act.ParentID,
+		Text:     act.Text,
+		Created:  act.Created,
+	}
+
+	if act.IsValidCodeComment() {
+		info.CodeComment = &CodeCommentInfo{
+			Path:    act.CodeComment.Path,
+			LineNew: act.CodeComment.LineNew,
+			SpanNew: act.CodeComment.SpanNew,
+			LineOld: act.CodeComment.LineOld,
+			SpanOld: act.CodeComment.SpanOld,
+		}
+	}
+
+	return info
+}
+
+// CodeCommentInfo describes the code comment related info for a webhook payload.
+// NOTE: don't use types package as we want webhook payload to be independent from API calls.
+type CodeCommentInfo struct {
+	Path    string `json:"path"`
+	LineNew int    `json:"line_new"`
+	SpanNew int    `json:"span_new"`
+	LineOld int    `json:"line_old"`
+	SpanOld int    `json:"span_old"`
+}
+
+// ReviewInfo describes the pull request review related info for a webhook payload.
+// NOTE: don't use types package as we want webhook payload to be independent from API calls.
+type ReviewInfo struct {
+	Decision  enum.PullReqReviewDecision `json:"decision"`
+	CommitSHA string                     `json:"commit_sha"`
+}
+
+// ExecutionInfo describes the pipeline execution related info for a webhook payload.
+// NOTE: don't use types package as we want webhook payload to be independent from API calls.
+type ExecutionInfo struct {
+	ID          int64         `json:"id"`
+	Number      int64         `json:"number"`
+	PipelineID  int64         `json:"pipeline_id"`
+	PipelineUID string        `json:"pipeline_uid"`
+	Status      enum.CIStatus `json:"status"`
+	Error       string        `json:"error,omitempty"`
+	Trigger     string        `json:"trigger"`
+	Event       string        `json:"event"`
+	Ref         string        `json:"ref"`
+	SHA         string        `json:"sha"`
+	Title       string        `json:"title"`
+	Created     int64         `json:"created"`
+	Started     int64         `json:"started"`
+	Finished    int64         `json:"finished"`
+}
+
+// executionInfoFrom gets the ExecutionInfo from a types.Execution and its types.Pipeline.
+func executionInfoFrom(execution *types.Execution, pipeline *types.Pipeline) ExecutionInfo {
+	return ExecutionInfo{
+		ID:          execution.ID,
+		Number:      execution.Number,
+		PipelineID:  pipeline.ID,
+		PipelineUID: pipeline.UID,
+		Status:      execution.Status,
+		Error:       execution.Error,
+		Trigger:     execution.Trigger,
+		Event:       execution.Event,
+		Ref:         execution.Ref,
+		SHA:         execution.After,
+		Title:       execution.Title,
+		Created:     execution.Created,
+		Started:     execution.Started,
+		Finished:    execution.Finished,
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	gitevents "github.com/harness/gitness/app/events/git"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
@@ -36,10 +37,13 @@ var WireSet = wire.NewSet(
 func ProvideService(ctx context.Context, config Config,
 	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
 	prReaderFactory *events.ReaderFactory[*pullreqevents.Reader],
+	pipelineReaderFactory *events.ReaderFactory[*pipelineevents.Reader],
 	webhookStore store.WebhookStore, webhookExecutionStore store.WebhookExecutionStore,
-	repoStore store.RepoStore, pullreqStore store.PullReqStore, urlProvider url.Provider,
-	principalStore store.PrincipalStore, gitRPCClient gitrpc.Interface, encrypter encrypt.Encrypter) (*Service, error) {
-	return NewService(ctx, config, gitReaderFactory, prReaderFactory,
-		webhookStore, webhookExecutionStore, repoStore, pullreqStore,
-		urlProvider, principalStore, gitRPCClient, encrypter)
+	repoStore store.RepoStore, pullreqStore store.PullReqStore, activityStore store.PullReqActivityStore,
+	executionStore store.ExecutionStore, pipelineStore store.PipelineStore, urlProvider url.Provider,
+	principalStore store.PrincipalStore, labelStore store.LabelStore, gitRPCClient gitrpc.Interface,
+	encrypter encrypt.Encrypter) (*Service, error) {
+	return NewService(ctx, config, gitReaderFactory, prReaderFactory, pipelineReaderFactory,
+		webhookStore, webhookExecutionStore, repoStore, pullreqStore, activityStore, executionStore, pipelineStore,
+		urlProvider, principalStore, labelStore, gitRPCClient, encrypter)
 }
@@ -15,8 +15,11 @@
 package services
 
 import (
+	"github.com/harness/gitness/app/services/issue"
 	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/app/services/ldapsync"
 	"github.com/harness/gitness/app/services/metric"
+	"github.com/harness/gitness/app/services/mirror"
 	"github.com/harness/gitness/app/services/pullreq"
 	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/services/webhook"
@@ -34,6 +37,10 @@ type Services struct {
 	Trigger         *trigger.Service
 	JobScheduler    *job.Scheduler
 	MetricCollector *metric.Collector
+	Mirror          *mirror.Service
+	PushMirror      *mirror.PushService
+	Issue           *issue.Service
+	LDAPSync        *ldapsync.Service
 }
 
 func ProvideServices(
@@ -42,6 +49,10 @@ func ProvideServices(
 	triggerSvc *trigger.Service,
 	jobScheduler *job.Scheduler,
 	metricCollector *metric.Collector,
+	mirrorSvc *mirror.Service,
+	pushMirrorSvc *mirror.PushService,
+	issueSvc *issue.Service,
+	ldapSyncSvc *ldapsync.Service,
 ) Services {
 	return Services{
 		Webhook:         webhooksSvc,
@@ -49,5 +60,9 @@ func ProvideServices(
 		Trigger:         triggerSvc,
 		JobScheduler:    jobScheduler,
 		MetricCollector: metricCollector,
+		Mirror:          mirrorSvc,
+		PushMirror:      pushMirrorSvc,
+		Issue:           issueSvc,
+		LDAPSync:        ldapSyncSvc,
 	}
 }
@@ -0,0 +1,211 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	repoctrl "github.com/harness/gitness/app/api/controller/repo"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/gitrpc/rpc"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	gliderssh "github.com/gliderlabs/ssh"
+	"github.com/rs/zerolog/log"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+var (
+	errUnknownCommand    = errors.New("unknown command, only git operations are supported")
+	errRepoNotAccessible = errors.New("repository not found or access denied")
+	errUnknownPublicKey  = errors.New("public key is unknown or its owner is blocked")
+)
+
+// handlePublicKey authenticates the client using the public keys registered by the users.
+// The callback might be invoked for several keys the client only probes without proving possession,
+// hence no state is kept here - the session is resolved in handleSession from the key that was used.
+func (s *Server) handlePublicKey(ctx gliderssh.Context, key gliderssh.PublicKey) bool {
+	_, err := s.findSession(ctx, key)
+	return err == nil
+}
+
+// findSession returns the session of the principal that registered the provided public key.
+func (s *Server) findSession(ctx context.Context, key gliderssh.PublicKey) (*auth.Session, error) {
+	fingerprint := gossh.FingerprintSHA256(key)
+
+	publicKey, err := s.publicKeyStore.FindByFingerprint(ctx, fingerprint)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return nil, errUnknownPublicKey
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to find public key with fingerprint %s", fingerprint)
+		return nil, errUnknownPublicKey
+	}
+
+	principal, err := s.principalStore.Find(ctx, publicKey.PrincipalID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to find principal %d of public key", publicKey.PrincipalID)
+		return nil, errUnknownPublicKey
+	}
+
+	if principal.Blocked {
+		return nil, errUnknownPublicKey
+	}
+
+	return &auth.Session{
+		Principal: *principal,
+		Metadata:  &auth.PublicKeyMetadata{PublicKeyID: publicKey.ID},
+	}, nil
+}
+
+// handleSession serves a single ssh session - only git upload-pack and receive-pack are supported.
+func (s *Server) handleSession(sshSession gliderssh.Session) {
+	// the key the client authenticated with - only set once the client proved possession of its private key.
+	key := sshSession.PublicKey()
+	if key == nil {
+		_ = sshSession.Exit(1)
+		return
+	}
+
+	session, err := s.findSession(sshSession.Context(), key)
+	if err != nil {
+		_ = sshSession.Exit(1)
+		return
+	}
+
+	// no command means the user requested an interactive shell, which isn't supported.
+	if len(sshSession.Command()) == 0 {
+		_, _ = fmt.Fprintf(sshSession.Stderr(),
+			"Hi %s! You've successfully authenticated, but shell access is not provided.\n",
+			session.Principal.UID)
+		_ = sshSession.Exit(1)
+		return
+	}
+
+	if err := s.serveGit(sshSession, session); err != nil {
+		_, _ = fmt.Fprintf(sshSession.Stderr(), "fatal: %s\n", err)
+		_ = sshSession.Exit(1)
+		return
+	}
+
+	_ = sshSession.Exit(0)
+}
+
+func (s *Server) serveGit(sshSession gliderssh.Session, session *auth.Session) error {
+	ctx := log.Logger.With().
+		Str("principal_uid", session.Principal.UID).
+		Str("ssh.command", sshSession.RawCommand()).
+		Logger().
+		WithContext(sshSession.Context())
+
+	service, repoRef, err := parseGitCommand(sshSession.Command())
+	if err != nil {
+		return err
+	}
+
+	repo, err := s.repoStore.FindByRef(ctx, repoRef)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return errRepoNotAccessible
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to find repository")
+		return errors.New("failed to find repository")
+	}
+
+	permission, orPublic := enum.PermissionRepoView, true
+	if service == rpc.ServiceReceivePack {
+		permission, orPublic = enum.PermissionRepoPush, false
+	}
+
+	if err = apiauth.CheckRepo(ctx, s.authorizer, session, repo, permission, orPublic); err != nil {
+		return errRepoNotAccessible
+	}
+
+	params := &gitrpc.ServicePackParams{
+		Service:     service,
+		Data:        io.NopCloser(sshSession),
+		GitProtocol: getGitProtocol(sshSession.Environ()),
+		Stateful:    true,
+	}
+
+	// setup read/writeparams depending on whether it's a write operation
+	if service == rpc.ServiceReceivePack {
+		var writeParams gitrpc.WriteParams
+		writeParams, err = repoctrl.CreateRPCWriteParams(ctx, s.urlProvider, session, repo)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("failed to create RPC write params")
+			return errors.New("failed to prepare git operation")
+		}
+		params.WriteParams = &writeParams
+	} else {
+		readParams := repoctrl.CreateRPCReadParams(repo)
+		params.ReadParams = &readParams
+	}
+
+	if err = s.gitRPCClient.ServicePack(ctx, sshSession, params); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("git operation failed")
+		return errors.New("git operation failed")
+	}
+
+	return nil
+}
+
+// parseGitCommand parses the git command requested by the client (e.g. `git-upload-pack '/space/repo.git'`)
+// and returns the gitrpc service and the repo reference.
+func parseGitCommand(command []string) (string, string, error) {
+	// older clients might send the command with a space instead of a dash.
+	if len(command) == 3 && command[0] == "git" {
+		command = []string{"git-" + command[1], command[2]}
+	}
+	if len(command) != 2 {
+		return "", "", errUnknownCommand
+	}
+
+	var service string
+	switch command[0] {
+	case "git-" + rpc.ServiceUploadPack:
+		service = rpc.ServiceUploadPack
+	case "git-" + rpc.ServiceReceivePack:
+		service = rpc.ServiceReceivePack
+	default:
+		return "", "", errUnknownCommand
+	}
+
+	repoRef := strings.Trim(command[1], "/")
+	repoRef = strings.TrimSuffix(repoRef, url.GITSuffix)
+	if repoRef == "" {
+		return "", "", errRepoNotAccessible
+	}
+
+	return service, repoRef, nil
+}
+
+func getGitProtocol(environ []string) string {
+	for _, env := range environ {
+		if strings.HasPrefix(env, "GIT_PROTOCOL=") {
+			return strings.TrimPrefix(env, "GIT_PROTOCOL=")
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,227 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/gitrpc/rpc"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+
+	gliderssh "github.com/gliderlabs/ssh"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func TestParseGitCommand(t *testing.T) {
+	tests := []struct {
+		name       string
+		command    []string
+		expService string
+		expRepoRef string
+		expErr     bool
+	}{
+		{
+			name:       "upload-pack",
+			command:    []string{"git-upload-pack", "/space/repo.git"},
+			expService: rpc.ServiceUploadPack,
+			expRepoRef: "space/repo",
+		},
+		{
+			name:       "receive-pack-without-suffix",
+			command:    []string{"git-receive-pack", "space/sub/repo"},
+			expService: rpc.ServiceReceivePack,
+			expRepoRef: "space/sub/repo",
+		},
+		{
+			name:       "command-with-space",
+			command:    []string{"git", "upload-pack", "space/repo.git"},
+			expService: rpc.ServiceUploadPack,
+			expRepoRef: "space/repo",
+		},
+		{
+			name:    "unsupported-command",
+			command: []string{"git-upload-archive", "space/repo.git"},
+			expErr:  true,
+		},
+		{
+			name:    "missing-repo",
+			command: []string{"git-upload-pack", "/.git"},
+			expErr:  true,
+		},
+		{
+			name:    "too-many-arguments",
+			command: []string{"git-upload-pack", "space/repo.git", "other"},
+			expErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, repoRef, err := parseGitCommand(test.command)
+			if test.expErr {
+				if err == nil {
+					t.Errorf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if service != test.expService || repoRef != test.expRepoRef {
+				t.Errorf("expected service=%s repoRef=%s, got service=%s repoRef=%s",
+					test.expService, test.expRepoRef, service, repoRef)
+			}
+		})
+	}
+}
+
+// TestHandleSessionUsesAuthenticatedKey verifies that the session is resolved from the key the client
+// authenticated with, and not from keys the client only probed for (CVE-2024-45337).
+func TestHandleSessionUsesAuthenticatedKey(t *testing.T) {
+	victimKey := generatePublicKey(t)
+	attackerKey := generatePublicKey(t)
+	blockedKey := generatePublicKey(t)
+	unknownKey := generatePublicKey(t)
+
+	s := &Server{
+		publicKeyStore: &fakePublicKeyStore{keys: map[string]*types.PublicKey{
+			gossh.FingerprintSHA256(victimKey):   {ID: 1, PrincipalID: 1},
+			gossh.FingerprintSHA256(attackerKey): {ID: 2, PrincipalID: 2},
+			gossh.FingerprintSHA256(blockedKey):  {ID: 3, PrincipalID: 3},
+		}},
+		principalStore: &fakePrincipalStore{principals: map[int64]*types.Principal{
+			1: {ID: 1, UID: "victim"},
+			2: {ID: 2, UID: "attacker"},
+			3: {ID: 3, UID: "blocked", Blocked: true},
+		}},
+	}
+
+	ctx := &fakeContext{values: map[interface{}]interface{}{}}
+
+	// the client authenticates with its own key, but probes the victim's key afterwards.
+	if !s.handlePublicKey(ctx, attackerKey) {
+		t.Fatalf("expected attacker key to be accepted")
+	}
+	if !s.handlePublicKey(ctx, victimKey) {
+		t.Fatalf("expected victim key to be accepted")
+	}
+	if s.handlePublicKey(ctx, blockedKey) {
+		t.Errorf("expected key of blocked principal to be rejected")
+	}
+	if s.handlePublicKey(ctx, unknownKey) {
+		t.Errorf("expected unknown key to be rejected")
+	}
+
+	sshSession := &fakeSession{ctx: ctx, key: attackerKey}
+	s.handleSession(sshSession)
+
+	if !strings.Contains(sshSession.stderr.String(), "Hi attacker!") {
+		t.Errorf("expected session of attacker, got output %q", sshSession.stderr.String())
+	}
+
+	// a session without an authenticated public key is rejected.
+	sshSession = &fakeSession{ctx: ctx}
+	s.handleSession(sshSession)
+
+	if sshSession.exitCode != 1 || sshSession.stderr.Len() != 0 {
+		t.Errorf("expected session without public key to be rejected, got exit code %d and output %q",
+			sshSession.exitCode, sshSession.stderr.String())
+	}
+}
+
+func generatePublicKey(t *testing.T) gossh.PublicKey {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	key, err := gossh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatalf("failed to create public key: %v", err)
+	}
+
+	return key
+}
+
+type fakePublicKeyStore struct {
+	store.PublicKeyStore
+	keys map[string]*types.PublicKey
+}
+
+func (f *fakePublicKeyStore) FindByFingerprint(_ context.Context, fingerprint string) (*types.PublicKey, error) {
+	key, ok := f.keys[fingerprint]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return key, nil
+}
+
+type fakePrincipalStore struct {
+	store.PrincipalStore
+	principals map[int64]*types.Principal
+}
+
+func (f *fakePrincipalStore) Find(_ context.Context, id int64) (*types.Principal, error) {
+	principal, ok := f.principals[id]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return principal, nil
+}
+
+// fakeContext keeps the values set on the ssh context, everything else is unused by the handlers.
+type fakeContext struct {
+	gliderssh.Context
+	values map[interface{}]interface{}
+}
+
+func (f *fakeContext) Value(key interface{}) interface{} {
+	return f.values[key]
+}
+
+func (f *fakeContext) SetValue(key, value interface{}) {
+	f.values[key] = value
+}
+
+// fakeSession is an ssh session without a command, which results in the greeting of the principal.
+type fakeSession struct {
+	gliderssh.Session
+	ctx      gliderssh.Context
+	key      gliderssh.PublicKey
+	stderr   bytes.Buffer
+	exitCode int
+}
+
+func (f *fakeSession) Context() gliderssh.Context { return f.ctx }
+
+func (f *fakeSession) PublicKey() gliderssh.PublicKey { return f.key }
+
+func (f *fakeSession) Command() []string { return nil }
+
+func (f *fakeSession) Stderr() io.ReadWriter { return &f.stderr }
+
+func (f *fakeSession) Exit(code int) error {
+	f.exitCode = code
+	return nil
+}
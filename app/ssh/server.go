@@ -0,0 +1,159 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/gitrpc"
+
+	gliderssh "github.com/gliderlabs/ssh"
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultIdleTimeout defines the default time after which idle connections are closed.
+	DefaultIdleTimeout = 5 * time.Minute
+)
+
+// Config defines the config of the ssh server.
+type Config struct {
+	Port        int
+	HostKeyPath string
+	IdleTimeout time.Duration
+}
+
+// ShutdownFunction defines a function that is called to shutdown the server.
+type ShutdownFunction func(context.Context) error
+
+// Server is the ssh server that serves git operations over ssh.
+// Clients are authenticated using the public keys registered by the users.
+type Server struct {
+	config         Config
+	publicKeyStore store.PublicKeyStore
+	principalStore store.PrincipalStore
+	repoStore      store.RepoStore
+	authorizer     authz.Authorizer
+	gitRPCClient   gitrpc.Interface
+	urlProvider    url.Provider
+}
+
+func NewServer(
+	config Config,
+	publicKeyStore store.PublicKeyStore,
+	principalStore store.PrincipalStore,
+	repoStore store.RepoStore,
+	authorizer authz.Authorizer,
+	gitRPCClient gitrpc.Interface,
+	urlProvider url.Provider,
+) *Server {
+	if config.IdleTimeout == 0 {
+		config.IdleTimeout = DefaultIdleTimeout
+	}
+
+	return &Server{
+		config:         config,
+		publicKeyStore: publicKeyStore,
+		principalStore: principalStore,
+		repoStore:      repoStore,
+		authorizer:     authorizer,
+		gitRPCClient:   gitRPCClient,
+		urlProvider:    urlProvider,
+	}
+}
+
+// ListenAndServe initializes the server to respond to ssh network requests.
+func (s *Server) ListenAndServe() (*errgroup.Group, ShutdownFunction, error) {
+	hostKey, err := loadOrGenerateHostKey(s.config.HostKeyPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load ssh host key: %w", err)
+	}
+
+	srv := &gliderssh.Server{
+		Addr:             fmt.Sprintf(":%d", s.config.Port),
+		Handler:          s.handleSession,
+		PublicKeyHandler: s.handlePublicKey,
+		IdleTimeout:      s.config.IdleTimeout,
+		HostSigners:      []gliderssh.Signer{hostKey},
+	}
+
+	var g errgroup.Group
+	g.Go(func() error {
+		err := srv.ListenAndServe()
+		if errors.Is(err, gliderssh.ErrServerClosed) {
+			return nil
+		}
+		return err
+	})
+
+	return &g, srv.Shutdown, nil
+}
+
+// loadOrGenerateHostKey loads the private host key from the provided path.
+// In case the file doesn't exist yet, a new ed25519 key is generated and stored at the path.
+func loadOrGenerateHostKey(path string) (gossh.Signer, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		data, err = generateHostKey(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := gossh.ParsePrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse host key '%s': %w", path, err)
+	}
+
+	return signer, nil
+}
+
+func generateHostKey(path string) ([]byte, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate host key: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal host key: %w", err)
+	}
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create directory for host key '%s': %w", path, err)
+	}
+
+	if err = os.WriteFile(path, data, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write host key '%s': %w", path, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/gitrpc"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(ProvideServer)
+
+// ProvideServer provides an ssh server instance.
+func ProvideServer(
+	config *types.Config,
+	publicKeyStore store.PublicKeyStore,
+	principalStore store.PrincipalStore,
+	repoStore store.RepoStore,
+	authorizer authz.Authorizer,
+	gitRPCClient gitrpc.Interface,
+	urlProvider url.Provider,
+) *Server {
+	return NewServer(
+		Config{
+			Port:        config.Server.SSH.Port,
+			HostKeyPath: config.Server.SSH.HostKeyPath,
+		},
+		publicKeyStore,
+		principalStore,
+		repoStore,
+		authorizer,
+		gitRPCClient,
+		urlProvider,
+	)
+}
@@ -58,6 +58,9 @@ type (
 		// FindUserByEmail finds the user by email.
 		FindUserByEmail(ctx context.Context, email string) (*types.User, error)
 
+		// FindUserByExternalID finds the user that was provisioned from the external identity.
+		FindUserByExternalID(ctx context.Context, source enum.UserSource, externalID string) (*types.User, error)
+
 		// CreateUser saves the user details.
 		CreateUser(ctx context.Context, user *types.User) error
 
@@ -181,6 +184,10 @@ type (
 		// Find the repo by id.
 		Find(ctx context.Context, id int64) (*types.Repository, error)
 
+		// FindWithLock finds the repo by id and acquires an exclusive lock
+		// of the repo database row for the duration of the transaction.
+		FindWithLock(ctx context.Context, id int64) (*types.Repository, error)
+
 		// FindByRef finds the repo using the repoRef as either the id or the repo path.
 		FindByRef(ctx context.Context, repoRef string) (*types.Repository, error)
 
@@ -220,6 +227,9 @@ type (
 		ListUsers(ctx context.Context, spaceID int64, filter types.MembershipUserFilter) ([]types.MembershipUser, error)
 		CountSpaces(ctx context.Context, userID int64, filter types.MembershipSpaceFilter) (int64, error)
 		ListSpaces(ctx context.Context, userID int64, filter types.MembershipSpaceFilter) ([]types.MembershipSpace, error)
+
+		// ListSpaceIDsBySource returns the IDs of all spaces with memberships of the source.
+		ListSpaceIDsBySource(ctx context.Context, source enum.MembershipSource) ([]int64, error)
 	}
 
 	// TokenStore defines the token data storage.
@@ -246,6 +256,130 @@ type (
 		Count(ctx context.Context, principalID int64, tokenType enum.TokenType) (int64, error)
 	}
 
+	// PublicKeyStore defines the ssh public key data storage.
+	PublicKeyStore interface {
+		// FindByFingerprint finds the public key by its fingerprint.
+		FindByFingerprint(ctx context.Context, fingerprint string) (*types.PublicKey, error)
+
+		// FindByUID finds the public key by principal ID and public key UID.
+		FindByUID(ctx context.Context, principalID int64, uid string) (*types.PublicKey, error)
+
+		// Create saves the public key details.
+		Create(ctx context.Context, key *types.PublicKey) error
+
+		// Delete deletes the public key with the given id.
+		Delete(ctx context.Context, id int64) error
+
+		// List returns all public keys of a specific principal.
+		List(ctx context.Context, principalID int64) ([]*types.PublicKey, error)
+	}
+
+	// SigningKeyStore defines the commit and tag signing key data storage.
+	SigningKeyStore interface {
+		// FindByUID finds the signing key by principal ID and signing key UID.
+		FindByUID(ctx context.Context, principalID int64, uid string) (*types.SigningKey, error)
+
+		// Create saves the signing key details.
+		Create(ctx context.Context, key *types.SigningKey) error
+
+		// Delete deletes the signing key with the given id.
+		Delete(ctx context.Context, id int64) error
+
+		// List returns all signing keys of a specific principal.
+		List(ctx context.Context, principalID int64) ([]*types.SigningKey, error)
+	}
+
+	// TOTPStore defines the two-factor authentication data storage.
+	TOTPStore interface {
+		// Find finds the two-factor authentication configuration of a principal.
+		Find(ctx context.Context, principalID int64) (*types.TOTP, error)
+
+		// Create saves the two-factor authentication configuration of a principal.
+		Create(ctx context.Context, totp *types.TOTP) error
+
+		// Update updates the two-factor authentication configuration of a principal.
+		Update(ctx context.Context, totp *types.TOTP) error
+
+		// UpdateOptLock updates the two-factor authentication configuration using the optimistic locking mechanism.
+		UpdateOptLock(ctx context.Context, totp *types.TOTP,
+			mutateFn func(totp *types.TOTP) error) (*types.TOTP, error)
+
+		// Delete deletes the two-factor authentication configuration of a principal.
+		Delete(ctx context.Context, principalID int64) error
+	}
+
+	// AuditStore defines the audit event data storage.
+	AuditStore interface {
+		// Create saves the audit event.
+		Create(ctx context.Context, event *types.AuditEvent) error
+
+		// Count returns the number of audit events matching the filter.
+		Count(ctx context.Context, opts *types.AuditFilter) (int64, error)
+
+		// List returns a list of audit events matching the filter.
+		List(ctx context.Context, opts *types.AuditFilter) ([]*types.AuditEvent, error)
+	}
+
+	// RepoMirrorStore defines the pull mirror data storage.
+	RepoMirrorStore interface {
+		// Find finds the mirror details of a repository.
+		Find(ctx context.Context, repoID int64) (*types.RepoMirror, error)
+
+		// Create saves the mirror details of a repository.
+		Create(ctx context.Context, mirror *types.RepoMirror) error
+
+		// Update updates the mirror details of a repository.
+		Update(ctx context.Context, mirror *types.RepoMirror) error
+
+		// UpdateSyncStatus updates only the sync schedule and the sync status of a mirror.
+		UpdateSyncStatus(ctx context.Context, mirror *types.RepoMirror) error
+
+		// ListDue returns the mirrors that are due to be synced at the provided time (unix millis).
+		ListDue(ctx context.Context, now int64, limit int) ([]*types.RepoMirror, error)
+	}
+
+	// PushMirrorStore defines the push mirror data storage.
+	PushMirrorStore interface {
+		// Find finds the push mirror by id.
+		Find(ctx context.Context, id int64) (*types.PushMirror, error)
+
+		// FindByUID finds the push mirror by repo ID and push mirror UID.
+		FindByUID(ctx context.Context, repoID int64, uid string) (*types.PushMirror, error)
+
+		// Create saves the push mirror details.
+		Create(ctx context.Context, mirror *types.PushMirror) error
+
+		// Update updates the push mirror details.
+		Update(ctx context.Context, mirror *types.PushMirror) error
+
+		// UpdatePushStatus updates only the status of the last push of the push mirror.
+		UpdatePushStatus(ctx context.Context, mirror *types.PushMirror) error
+
+		// Delete deletes the push mirror with the given id.
+		Delete(ctx context.Context, id int64) error
+
+		// List returns all push mirrors of a repository.
+		List(ctx context.Context, repoID int64) ([]*types.PushMirror, error)
+	}
+
+	// LFSObjectStore defines the git LFS object data storage.
+	LFSObjectStore interface {
+		// Find finds the LFS object of a repository by its oid.
+		Find(ctx context.Context, repoID int64, oid string) (*types.LFSObject, error)
+
+		// FindMany finds all LFS objects of a repository with the provided oids.
+		FindMany(ctx context.Context, repoID int64, oids []string) ([]*types.LFSObject, error)
+
+		// Create saves the LFS object details.
+		Create(ctx context.Context, obj *types.LFSObject) error
+
+		// List returns all LFS objects of a repository.
+		List(ctx context.Context, repoID int64) ([]*types.LFSObject, error)
+
+		// Usage returns the number and total size of all LFS objects of a repository.
+		Usage(ctx context.Context, repoID int64) (*types.LFSUsage, error)
+	}
+
 	// PullReqStore defines the pull request data storage.
 	PullReqStore interface {
 		// Find the pull request by id.
@@ -285,6 +419,8 @@ type (
 		List(ctx context.Context, opts *types.PullReqFilter) ([]*types.PullReq, error)
 	}
 
+	// PullReqActivityStore defines the pull request activity storage.
+	// It also stores the activities of issues, which share the activity types and payloads.
 	PullReqActivityStore interface {
 		// Find the pull request activity by id.
 		Find(ctx context.Context, id int64) (*types.PullReqActivity, error)
@@ -297,6 +433,10 @@ type (
 		CreateWithPayload(ctx context.Context,
 			pr *types.PullReq, principalID int64, payload types.PullReqActivityPayload) (*types.PullReqActivity, error)
 
+		// CreateWithPayloadForIssue create a new system activity of an issue from the provided payload.
+		CreateWithPayloadForIssue(ctx context.Context,
+			issue *types.Issue, principalID int64, payload types.PullReqActivityPayload) (*types.PullReqActivity, error)
+
 		// Update the pull request activity. It will set new values to the Version and Updated fields.
 		Update(ctx context.Context, act *types.PullReqActivity) error
 
@@ -314,6 +454,13 @@ type (
 
 		// List returns a list of pull request activities in a pull request (a timeline).
 		List(ctx context.Context, prID int64, opts *types.PullReqActivityFilter) ([]*types.PullReqActivity, error)
+
+		// CountForIssue returns number of activities in an issue.
+		CountForIssue(ctx context.Context, issueID int64, opts *types.PullReqActivityFilter) (int64, error)
+
+		// ListForIssue returns a list of activities in an issue (a timeline).
+		ListForIssue(ctx context.Context,
+			issueID int64, opts *types.PullReqActivityFilter) ([]*types.PullReqActivity, error)
 	}
 
 	// CodeCommentView is to manipulate only code-comment subset of PullReqActivity.
@@ -401,6 +548,134 @@ type (
 			opts *types.WebhookFilter) ([]*types.Webhook, error)
 	}
 
+	// RuleStore defines the branch protection rule data storage.
+	RuleStore interface {
+		// Find finds the protection rule by id.
+		Find(ctx context.Context, id int64) (*types.Rule, error)
+
+		// FindByUID finds the protection rule by its uid for a given parent type and id.
+		FindByUID(ctx context.Context, parentType enum.RuleParent, parentID int64, uid string) (*types.Rule, error)
+
+		// Create creates a new protection rule.
+		Create(ctx context.Context, rule *types.Rule) error
+
+		// Update updates an existing protection rule.
+		Update(ctx context.Context, rule *types.Rule) error
+
+		// UpdateOptLock updates the protection rule using the optimistic locking mechanism.
+		UpdateOptLock(ctx context.Context, rule *types.Rule,
+			mutateFn func(rule *types.Rule) error) (*types.Rule, error)
+
+		// Delete deletes the protection rule for the given id.
+		Delete(ctx context.Context, id int64) error
+
+		// Count counts the protection rules for a given parent type and id.
+		Count(ctx context.Context, parentType enum.RuleParent, parentID int64,
+			opts *types.RuleFilter) (int64, error)
+
+		// List lists the protection rules for a given parent type and id.
+		List(ctx context.Context, parentType enum.RuleParent, parentID int64,
+			opts *types.RuleFilter) ([]*types.Rule, error)
+
+		// ListAllForRepo lists all protection rules of a repository and the provided spaces.
+		ListAllForRepo(ctx context.Context, repoID int64, spaceIDs []int64) ([]*types.Rule, error)
+	}
+
+	// LabelStore defines the label data storage.
+	LabelStore interface {
+		// Find finds the label by id.
+		Find(ctx context.Context, id int64) (*types.Label, error)
+
+		// Create creates a new label.
+		Create(ctx context.Context, label *types.Label) error
+
+		// Update updates an existing label.
+		Update(ctx context.Context, label *types.Label) error
+
+		// Delete deletes the label with the given id.
+		Delete(ctx context.Context, id int64) error
+
+		// Count counts the labels of the repository and the provided spaces.
+		Count(ctx context.Context, repoID int64, spaceIDs []int64, opts *types.LabelFilter) (int64, error)
+
+		// List lists the labels of the repository and the provided spaces.
+		List(ctx context.Context, repoID int64, spaceIDs []int64, opts *types.LabelFilter) ([]*types.Label, error)
+	}
+
+	// PullReqLabelStore defines the pull request label assignment data storage.
+	PullReqLabelStore interface {
+		// Assign assigns a label to a pull request.
+		Assign(ctx context.Context, prLabel *types.PullReqLabel) error
+
+		// Unassign removes a label from a pull request.
+		Unassign(ctx context.Context, pullReqID int64, labelID int64) error
+
+		// ListLabels returns the labels assigned to the pull request.
+		ListLabels(ctx context.Context, pullReqID int64) ([]*types.Label, error)
+
+		// ListLabelsForPullReqs returns the labels assigned to the provided pull requests mapped by pull request id.
+		ListLabelsForPullReqs(ctx context.Context, pullReqIDs []int64) (map[int64][]*types.Label, error)
+	}
+
+	// IssueStore defines the issue data storage.
+	IssueStore interface {
+		// Find the issue by id.
+		Find(ctx context.Context, id int64) (*types.Issue, error)
+
+		// FindByNumber finds the issue by repo ID and issue number.
+		FindByNumber(ctx context.Context, repoID, number int64) (*types.Issue, error)
+
+		// Create a new issue.
+		Create(ctx context.Context, issue *types.Issue) error
+
+		// Update the issue. It will set new values to the Version and Updated fields.
+		Update(ctx context.Context, issue *types.Issue) error
+
+		// UpdateOptLock the issue details using the optimistic locking mechanism.
+		UpdateOptLock(ctx context.Context, issue *types.Issue,
+			mutateFn func(issue *types.Issue) error) (*types.Issue, error)
+
+		// UpdateActivitySeq the issue's activity sequence number.
+		// It will set new values to the ActivitySeq, Version and Updated fields.
+		UpdateActivitySeq(ctx context.Context, issue *types.Issue) (*types.Issue, error)
+
+		// Count of issues in a repository.
+		Count(ctx context.Context, opts *types.IssueFilter) (int64, error)
+
+		// List returns a list of issues in a repository.
+		List(ctx context.Context, opts *types.IssueFilter) ([]*types.Issue, error)
+	}
+
+	// IssueAssigneeStore defines the issue assignee data storage.
+	IssueAssigneeStore interface {
+		// Assign assigns a principal to an issue.
+		Assign(ctx context.Context, assignee *types.IssueAssignee) error
+
+		// Unassign removes a principal from the assignees of an issue.
+		Unassign(ctx context.Context, issueID int64, principalID int64) error
+
+		// List returns the principals assigned to the issue.
+		List(ctx context.Context, issueID int64) ([]*types.PrincipalInfo, error)
+
+		// ListForIssues returns the principals assigned to the provided issues mapped by issue id.
+		ListForIssues(ctx context.Context, issueIDs []int64) (map[int64][]*types.PrincipalInfo, error)
+	}
+
+	// IssueLabelStore defines the issue label assignment data storage.
+	IssueLabelStore interface {
+		// Assign assigns a label to an issue.
+		Assign(ctx context.Context, issueLabel *types.IssueLabel) error
+
+		// Unassign removes a label from an issue.
+		Unassign(ctx context.Context, issueID int64, labelID int64) error
+
+		// ListLabels returns the labels assigned to the issue.
+		ListLabels(ctx context.Context, issueID int64) ([]*types.Label, error)
+
+		// ListLabelsForIssues returns the labels assigned to the provided issues mapped by issue id.
+		ListLabelsForIssues(ctx context.Context, issueIDs []int64) (map[int64][]*types.Label, error)
+	}
+
 	// WebhookExecutionStore defines the webhook execution data storage.
 	WebhookExecutionStore interface {
 		// Find finds the webhook execution by id.
@@ -427,6 +702,9 @@ type (
 		// List returns a list of status check results for a specific commit in a repo.
 		List(ctx context.Context, repoID int64, commitSHA string, opts types.CheckListOptions) ([]types.Check, error)
 
+		// ListByUIDs returns the status check results with the provided UIDs for a specific commit in a repo.
+		ListByUIDs(ctx context.Context, repoID int64, commitSHA string, uids []string) ([]types.Check, error)
+
 		// ListRecent returns a list of recently executed status checks in a repository.
 		ListRecent(ctx context.Context, repoID int64, since time.Time) ([]string, error)
 	}
@@ -452,6 +730,9 @@ type (
 		// DeleteByGroupID deletes all jobs for a group id
 		DeleteByGroupID(ctx context.Context, groupID string) (int64, error)
 
+		// Delete deletes a job by its unique identifier.
+		Delete(ctx context.Context, uid string) error
+
 		// Create is used to create a new job.
 		Create(ctx context.Context, job *types.Job) error
 
@@ -676,6 +957,9 @@ type (
 	}
 
 	TriggerStore interface {
+		// Find returns a trigger given a trigger ID.
+		Find(ctx context.Context, id int64) (*types.Trigger, error)
+
 		// FindByUID returns a trigger given a pipeline and a trigger UID.
 		FindByUID(ctx context.Context, pipelineID int64, uid string) (*types.Trigger, error)
 
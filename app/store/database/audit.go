@@ -0,0 +1,269 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+var _ store.AuditStore = (*AuditStore)(nil)
+
+// NewAuditStore returns a new AuditStore.
+func NewAuditStore(db *sqlx.DB) *AuditStore {
+	return &AuditStore{db}
+}
+
+// AuditStore implements a AuditStore backed by a relational database.
+type AuditStore struct {
+	db *sqlx.DB
+}
+
+type auditEvent struct {
+	ID           int64                  `db:"audit_event_id"`
+	Created      int64                  `db:"audit_event_created"`
+	ActorID      int64                  `db:"audit_event_actor_id"`
+	ActorUID     string                 `db:"audit_event_actor_uid"`
+	Action       enum.AuditAction       `db:"audit_event_action"`
+	ResourceType enum.AuditResourceType `db:"audit_event_resource_type"`
+	ResourceName string                 `db:"audit_event_resource_name"`
+	SpaceID      null.Int               `db:"audit_event_space_id"`
+	Data         string                 `db:"audit_event_data"`
+}
+
+const (
+	auditEventColumns = `
+		 audit_event_id
+		,audit_event_created
+		,audit_event_actor_id
+		,audit_event_actor_uid
+		,audit_event_action
+		,audit_event_resource_type
+		,audit_event_resource_name
+		,audit_event_space_id
+		,audit_event_data`
+)
+
+// Create saves the audit event.
+func (s *AuditStore) Create(ctx context.Context, event *types.AuditEvent) error {
+	const sqlQuery = `
+	INSERT INTO audit_events (
+		 audit_event_created
+		,audit_event_actor_id
+		,audit_event_actor_uid
+		,audit_event_action
+		,audit_event_resource_type
+		,audit_event_resource_name
+		,audit_event_space_id
+		,audit_event_data
+	) values (
+		 :audit_event_created
+		,:audit_event_actor_id
+		,:audit_event_actor_uid
+		,:audit_event_action
+		,:audit_event_resource_type
+		,:audit_event_resource_name
+		,:audit_event_space_id
+		,:audit_event_data
+	) RETURNING audit_event_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbEvent, err := mapToInternalAuditEvent(event)
+	if err != nil {
+		return err
+	}
+
+	query, arg, err := db.BindNamed(sqlQuery, dbEvent)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind audit event object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&event.ID); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Count returns the number of audit events matching the filter.
+func (s *AuditStore) Count(ctx context.Context, opts *types.AuditFilter) (int64, error) {
+	stmt := database.Builder.
+		Select("count(*)").
+		From("audit_events")
+
+	stmt = applyAuditFilter(stmt, opts)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var count int64
+	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(err, "Failed executing count query")
+	}
+
+	return count, nil
+}
+
+// List returns a list of audit events matching the filter.
+func (s *AuditStore) List(ctx context.Context, opts *types.AuditFilter) ([]*types.AuditEvent, error) {
+	stmt := database.Builder.
+		Select(auditEventColumns).
+		From("audit_events")
+
+	stmt = applyAuditFilter(stmt, opts)
+
+	stmt = stmt.Limit(database.Limit(opts.Size))
+
+	if opts.Cursor != nil {
+		op := ">"
+		if opts.Order == enum.OrderDesc {
+			op = "<"
+		}
+
+		stmt = stmt.Where(squirrel.Or{
+			squirrel.Expr("audit_event_created "+op+" ?", opts.Cursor.Created),
+			squirrel.And{
+				squirrel.Eq{"audit_event_created": opts.Cursor.Created},
+				squirrel.Expr("audit_event_id "+op+" ?", opts.Cursor.ID),
+			},
+		})
+	} else {
+		stmt = stmt.Offset(database.Offset(opts.Page, opts.Size))
+	}
+
+	// NOTE: string concatenation is safe because the
+	// order attribute is an enum and is not user-defined,
+	// and is therefore not subject to injection attacks.
+	stmt = stmt.OrderBy("audit_event_created " + opts.Order.String() + ", audit_event_id " + opts.Order.String())
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	dst := make([]*auditEvent, 0)
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed executing custom list query")
+	}
+
+	res := make([]*types.AuditEvent, len(dst))
+	for i := range dst {
+		if res[i], err = mapToAuditEvent(dst[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func applyAuditFilter(stmt squirrel.SelectBuilder, opts *types.AuditFilter) squirrel.SelectBuilder {
+	if opts.SpaceID != 0 {
+		stmt = stmt.Where(`audit_event_space_id IN (
+			WITH RECURSIVE audit_spaces AS (
+				SELECT space_id FROM spaces WHERE space_id = ?
+				UNION ALL
+				SELECT spaces.space_id FROM spaces
+				JOIN audit_spaces ON spaces.space_parent_id = audit_spaces.space_id
+			)
+			SELECT space_id FROM audit_spaces
+		)`, opts.SpaceID)
+	}
+
+	if opts.ActorUID != "" {
+		stmt = stmt.Where("LOWER(audit_event_actor_uid) = LOWER(?)", opts.ActorUID)
+	}
+
+	if len(opts.ResourceTypes) > 0 {
+		stmt = stmt.Where(squirrel.Eq{"audit_event_resource_type": opts.ResourceTypes})
+	}
+
+	if len(opts.Actions) > 0 {
+		stmt = stmt.Where(squirrel.Eq{"audit_event_action": opts.Actions})
+	}
+
+	if opts.After != 0 {
+		stmt = stmt.Where("audit_event_created > ?", opts.After)
+	}
+
+	if opts.Before != 0 {
+		stmt = stmt.Where("audit_event_created < ?", opts.Before)
+	}
+
+	return stmt
+}
+
+func mapToAuditEvent(e *auditEvent) (*types.AuditEvent, error) {
+	res := &types.AuditEvent{
+		ID:           e.ID,
+		Created:      e.Created,
+		ActorID:      e.ActorID,
+		ActorUID:     e.ActorUID,
+		Action:       e.Action,
+		ResourceType: e.ResourceType,
+		ResourceName: e.ResourceName,
+		SpaceID:      e.SpaceID.Int64,
+	}
+
+	if err := json.Unmarshal([]byte(e.Data), &res.Data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data of audit event %d: %w", e.ID, err)
+	}
+
+	return res, nil
+}
+
+func mapToInternalAuditEvent(e *types.AuditEvent) (*auditEvent, error) {
+	data := e.Data
+	if data == nil {
+		data = map[string]string{}
+	}
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit event data: %w", err)
+	}
+
+	return &auditEvent{
+		ID:           e.ID,
+		Created:      e.Created,
+		ActorID:      e.ActorID,
+		ActorUID:     e.ActorUID,
+		Action:       e.Action,
+		ResourceType: e.ResourceType,
+		ResourceName: e.ResourceName,
+		SpaceID:      null.NewInt(e.SpaceID, e.SpaceID != 0),
+		Data:         string(dataJSON),
+	}, nil
+}
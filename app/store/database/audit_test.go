@@ -0,0 +1,175 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func setupAuditStore(t *testing.T) *AuditStore {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupTestDB(t)
+
+	// space 1 (from setupTestDB) contains space 2, which contains space 3. Space 4 is unrelated.
+	fixtures := []string{
+		`INSERT INTO spaces (space_id, space_parent_id, space_uid, space_is_public, space_created_by,
+			space_created, space_updated)
+		VALUES (2, 1, 'sub', false, 1, 0, 0)`,
+		`INSERT INTO spaces (space_id, space_parent_id, space_uid, space_is_public, space_created_by,
+			space_created, space_updated)
+		VALUES (3, 2, 'subsub', false, 1, 0, 0)`,
+		`INSERT INTO spaces (space_id, space_uid, space_is_public, space_created_by, space_created, space_updated)
+		VALUES (4, 'other', false, 1, 0, 0)`,
+	}
+	for _, fixture := range fixtures {
+		if _, err := db.ExecContext(ctx, fixture); err != nil {
+			t.Fatalf("failed to insert fixture: %v", err)
+		}
+	}
+
+	s := NewAuditStore(db)
+
+	// events of the same millisecond are ordered by their IDs.
+	events := []struct {
+		created int64
+		spaceID int64
+	}{
+		{created: 100, spaceID: 1},
+		{created: 200, spaceID: 2},
+		{created: 200, spaceID: 4},
+		{created: 200, spaceID: 3},
+		{created: 300, spaceID: 0},
+		{created: 400, spaceID: 2},
+	}
+	for _, e := range events {
+		err := s.Create(ctx, &types.AuditEvent{
+			Created:      e.created,
+			ActorID:      1,
+			ActorUID:     "user",
+			Action:       enum.AuditActionUpdate,
+			ResourceType: enum.AuditResourceTypeSpace,
+			ResourceName: "space",
+			SpaceID:      e.spaceID,
+		})
+		if err != nil {
+			t.Fatalf("failed to create audit event: %v", err)
+		}
+	}
+
+	return s
+}
+
+func TestAuditStoreSubSpaces(t *testing.T) {
+	ctx := context.Background()
+	s := setupAuditStore(t)
+
+	tests := []struct {
+		name    string
+		spaceID int64
+		expIDs  []int64
+	}{
+		{name: "all", spaceID: 0, expIDs: []int64{1, 2, 3, 4, 5, 6}},
+		{name: "top-level-space", spaceID: 1, expIDs: []int64{1, 2, 4, 6}},
+		{name: "sub-space", spaceID: 2, expIDs: []int64{2, 4, 6}},
+		{name: "leaf-space", spaceID: 3, expIDs: []int64{4}},
+		{name: "unrelated-space", spaceID: 4, expIDs: []int64{3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter := &types.AuditFilter{SpaceID: test.spaceID, Order: enum.OrderAsc}
+
+			count, err := s.Count(ctx, filter)
+			if err != nil {
+				t.Fatalf("failed to count audit events: %v", err)
+			}
+			if count != int64(len(test.expIDs)) {
+				t.Errorf("expected %d audit events, got %d", len(test.expIDs), count)
+			}
+
+			list, err := s.List(ctx, filter)
+			if err != nil {
+				t.Fatalf("failed to list audit events: %v", err)
+			}
+			if ids := auditEventIDs(list); !reflect.DeepEqual(ids, test.expIDs) {
+				t.Errorf("expected audit events %v, got %v", test.expIDs, ids)
+			}
+		})
+	}
+}
+
+func TestAuditStoreCursor(t *testing.T) {
+	ctx := context.Background()
+	s := setupAuditStore(t)
+
+	tests := []struct {
+		name   string
+		order  enum.Order
+		expIDs []int64
+	}{
+		{name: "ascending", order: enum.OrderAsc, expIDs: []int64{1, 2, 3, 4, 5, 6}},
+		{name: "descending", order: enum.OrderDesc, expIDs: []int64{6, 5, 4, 3, 2, 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// pages of two split the events of the same millisecond.
+			filter := &types.AuditFilter{Size: 2, Order: test.order}
+
+			var ids []int64
+			for i := 0; i < 4; i++ {
+				list, err := s.List(ctx, filter)
+				if err != nil {
+					t.Fatalf("failed to list audit events: %v", err)
+				}
+				if len(list) == 0 {
+					break
+				}
+
+				ids = append(ids, auditEventIDs(list)...)
+
+				last := list[len(list)-1]
+				filter.Cursor = &types.AuditCursor{Created: last.Created, ID: last.ID}
+
+				// an event recorded in the meantime doesn't shift the following pages.
+				if i == 0 && test.order == enum.OrderDesc {
+					if err = s.Create(ctx, &types.AuditEvent{Created: 500, Action: enum.AuditActionCreate,
+						ResourceType: enum.AuditResourceTypeSpace, ResourceName: "space"}); err != nil {
+						t.Fatalf("failed to create audit event: %v", err)
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(ids, test.expIDs) {
+				t.Errorf("expected audit events %v, got %v", test.expIDs, ids)
+			}
+		})
+	}
+}
+
+func auditEventIDs(events []*types.AuditEvent) []int64 {
+	ids := make([]int64, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+	return ids
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
@@ -206,6 +207,41 @@ func (s *CheckStore) List(ctx context.Context,
 	return result, nil
 }
 
+// ListByUIDs returns the status check results with the provided UIDs for a specific commit in a repo.
+func (s *CheckStore) ListByUIDs(ctx context.Context,
+	repoID int64,
+	commitSHA string,
+	uids []string,
+) ([]types.Check, error) {
+	stmt := database.Builder.
+		Select(checkColumns).
+		From("checks").
+		Where("check_repo_id = ?", repoID).
+		Where("check_commit_sha = ?", commitSHA).
+		Where(squirrel.Eq{"check_uid": uids}).
+		OrderBy("check_uid")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	dst := make([]*check, 0)
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to execute list status checks by uids query")
+	}
+
+	result, err := s.mapSliceCheck(ctx, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // ListRecent returns a list of recently executed status checks in a repository.
 func (s *CheckStore) ListRecent(ctx context.Context, repoID int64, since time.Time) ([]string, error) {
 	stmt := database.Builder.
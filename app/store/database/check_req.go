@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
 	"github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types"
@@ -142,11 +143,20 @@ func (s *ReqCheckStore) Delete(ctx context.Context, repoID, reqCheckID int64) er
 
 	db := dbtx.GetAccessor(ctx, s.db)
 
-	_, err = db.ExecContext(ctx, sql, args...)
+	result, err := db.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return database.ProcessSQLErrorf(err, "Failed to execute delete required status check query")
 	}
 
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to get number of deleted required status checks")
+	}
+
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+
 	return nil
 }
 
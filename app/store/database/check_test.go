@@ -0,0 +1,130 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestCheckStoreListByUIDs(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	s := NewCheckStore(db, fakePrincipalInfoCache{})
+
+	checks := []struct {
+		sha    string
+		uid    string
+		status enum.CheckStatus
+	}{
+		{sha: "sha1", uid: "test", status: enum.CheckStatusSuccess},
+		{sha: "sha1", uid: "build", status: enum.CheckStatusFailure},
+		{sha: "sha1", uid: "lint", status: enum.CheckStatusSuccess},
+		{sha: "sha2", uid: "build", status: enum.CheckStatusSuccess},
+	}
+	for _, c := range checks {
+		err := s.Upsert(ctx, &types.Check{
+			CreatedBy: 1,
+			RepoID:    1,
+			CommitSHA: c.sha,
+			UID:       c.uid,
+			Status:    c.status,
+			Metadata:  json.RawMessage("{}"),
+			Payload:   types.CheckPayload{Kind: enum.CheckPayloadKindEmpty, Data: json.RawMessage("{}")},
+		})
+		if err != nil {
+			t.Fatalf("failed to create check: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		repoID    int64
+		sha       string
+		uids      []string
+		expUIDs   []string
+		expStatus []enum.CheckStatus
+	}{
+		{
+			name:      "sorted-by-uid",
+			repoID:    1,
+			sha:       "sha1",
+			uids:      []string{"test", "build"},
+			expUIDs:   []string{"build", "test"},
+			expStatus: []enum.CheckStatus{enum.CheckStatusFailure, enum.CheckStatusSuccess},
+		},
+		{
+			name:      "other-commit",
+			repoID:    1,
+			sha:       "sha2",
+			uids:      []string{"build", "test"},
+			expUIDs:   []string{"build"},
+			expStatus: []enum.CheckStatus{enum.CheckStatusSuccess},
+		},
+		{
+			name:    "unknown-uid",
+			repoID:  1,
+			sha:     "sha1",
+			uids:    []string{"deploy"},
+			expUIDs: []string{},
+		},
+		{
+			name:    "no-uids",
+			repoID:  1,
+			sha:     "sha1",
+			uids:    []string{},
+			expUIDs: []string{},
+		},
+		{
+			name:    "other-repo",
+			repoID:  2,
+			sha:     "sha1",
+			uids:    []string{"build"},
+			expUIDs: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list, err := s.ListByUIDs(ctx, test.repoID, test.sha, test.uids)
+			if err != nil {
+				t.Fatalf("failed to list checks: %v", err)
+			}
+
+			if len(list) != len(test.expUIDs) {
+				t.Fatalf("expected %d checks, got %d", len(test.expUIDs), len(list))
+			}
+
+			for i := range list {
+				if list[i].UID != test.expUIDs[i] {
+					t.Errorf("check %d: expected uid %q, got %q", i, test.expUIDs[i], list[i].UID)
+				}
+				if list[i].Status != test.expStatus[i] {
+					t.Errorf("check %d: expected status %q, got %q", i, test.expStatus[i], list[i].Status)
+				}
+				if list[i].CommitSHA != test.sha {
+					t.Errorf("check %d: expected commit %q, got %q", i, test.sha, list[i].CommitSHA)
+				}
+				if list[i].ReportedBy.ID != 1 {
+					t.Errorf("check %d: expected reporter 1, got %d", i, list[i].ReportedBy.ID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.IssueAssigneeStore = (*IssueAssigneeStore)(nil)
+
+// NewIssueAssigneeStore returns a new IssueAssigneeStore.
+func NewIssueAssigneeStore(db *sqlx.DB,
+	pCache store.PrincipalInfoCache) *IssueAssigneeStore {
+	return &IssueAssigneeStore{
+		db:     db,
+		pCache: pCache,
+	}
+}
+
+// IssueAssigneeStore implements store.IssueAssigneeStore backed by a relational database.
+type IssueAssigneeStore struct {
+	db     *sqlx.DB
+	pCache store.PrincipalInfoCache
+}
+
+// issueAssignee is used to fetch the assignees of issues from the database.
+type issueAssignee struct {
+	IssueID     int64 `db:"issue_assignee_issue_id"`
+	PrincipalID int64 `db:"issue_assignee_principal_id"`
+}
+
+// Assign assigns a principal to an issue.
+func (s *IssueAssigneeStore) Assign(ctx context.Context, assignee *types.IssueAssignee) error {
+	const sqlQuery = `
+		INSERT INTO issue_assignees (
+			issue_assignee_issue_id
+			,issue_assignee_principal_id
+			,issue_assignee_created_by
+			,issue_assignee_created
+		) values ($1, $2, $3, $4)`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery,
+		assignee.IssueID, assignee.PrincipalID, assignee.CreatedBy, assignee.Created); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Unassign removes a principal from the assignees of an issue.
+// It returns store.ErrResourceNotFound if the principal isn't assigned to the issue.
+func (s *IssueAssigneeStore) Unassign(ctx context.Context, issueID int64, principalID int64) error {
+	const sqlQuery = `
+		DELETE FROM issue_assignees
+		WHERE issue_assignee_issue_id = $1 AND issue_assignee_principal_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	result, err := db.ExecContext(ctx, sqlQuery, issueID, principalID)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to get number of deleted rows")
+	}
+
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+// List returns the principals assigned to the issue.
+func (s *IssueAssigneeStore) List(ctx context.Context, issueID int64) ([]*types.PrincipalInfo, error) {
+	assignees, err := s.ListForIssues(ctx, []int64{issueID})
+	if err != nil {
+		return nil, err
+	}
+
+	return assignees[issueID], nil
+}
+
+// ListForIssues returns the principals assigned to the provided issues mapped by issue id.
+func (s *IssueAssigneeStore) ListForIssues(ctx context.Context,
+	issueIDs []int64) (map[int64][]*types.PrincipalInfo, error) {
+	stmt := database.Builder.
+		Select("issue_assignee_issue_id, issue_assignee_principal_id").
+		From("issue_assignees").
+		Where(squirrel.Eq{"issue_assignee_issue_id": issueIDs}).
+		OrderBy("issue_assignee_created", "issue_assignee_principal_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*issueAssignee{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	ids := make([]int64, len(dst))
+	for i, assignee := range dst {
+		ids[i] = assignee.PrincipalID
+	}
+
+	infoMap, err := s.pCache.Map(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load assignee principal infos: %w", err)
+	}
+
+	res := make(map[int64][]*types.PrincipalInfo, len(issueIDs))
+	for _, assignee := range dst {
+		info, ok := infoMap[assignee.PrincipalID]
+		if !ok {
+			continue
+		}
+
+		res[assignee.IssueID] = append(res[assignee.IssueID], info)
+	}
+
+	return res, nil
+}
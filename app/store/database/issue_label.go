@@ -0,0 +1,140 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.IssueLabelStore = (*IssueLabelStore)(nil)
+
+// NewIssueLabelStore returns a new IssueLabelStore.
+func NewIssueLabelStore(db *sqlx.DB) *IssueLabelStore {
+	return &IssueLabelStore{
+		db: db,
+	}
+}
+
+// IssueLabelStore implements store.IssueLabelStore backed by a relational database.
+type IssueLabelStore struct {
+	db *sqlx.DB
+}
+
+// issueLabel is used to fetch the labels of issues from the database.
+type issueLabel struct {
+	IssueID int64 `db:"issue_label_issue_id"`
+	label
+}
+
+// Assign assigns a label to an issue.
+func (s *IssueLabelStore) Assign(ctx context.Context, issueLabel *types.IssueLabel) error {
+	const sqlQuery = `
+		INSERT INTO issue_labels (
+			issue_label_issue_id
+			,issue_label_label_id
+			,issue_label_created_by
+			,issue_label_created
+		) values ($1, $2, $3, $4)`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery,
+		issueLabel.IssueID, issueLabel.LabelID, issueLabel.CreatedBy, issueLabel.Created); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Unassign removes a label from an issue.
+// It returns store.ErrResourceNotFound if the label isn't assigned to the issue.
+func (s *IssueLabelStore) Unassign(ctx context.Context, issueID int64, labelID int64) error {
+	const sqlQuery = `
+		DELETE FROM issue_labels
+		WHERE issue_label_issue_id = $1 AND issue_label_label_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	result, err := db.ExecContext(ctx, sqlQuery, issueID, labelID)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to get number of deleted rows")
+	}
+
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+// ListLabels returns the labels assigned to the issue.
+func (s *IssueLabelStore) ListLabels(ctx context.Context, issueID int64) ([]*types.Label, error) {
+	labels, err := s.ListLabelsForIssues(ctx, []int64{issueID})
+	if err != nil {
+		return nil, err
+	}
+
+	return labels[issueID], nil
+}
+
+// ListLabelsForIssues returns the labels assigned to the provided issues mapped by issue id.
+func (s *IssueLabelStore) ListLabelsForIssues(ctx context.Context,
+	issueIDs []int64) (map[int64][]*types.Label, error) {
+	stmt := database.Builder.
+		Select("issue_label_issue_id,"+labelColumns).
+		From("issue_labels").
+		InnerJoin("labels ON label_id = issue_label_label_id").
+		Where(squirrel.Eq{"issue_label_issue_id": issueIDs}).
+		OrderBy("LOWER(label_name)", "label_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*issueLabel{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	res := make(map[int64][]*types.Label, len(issueIDs))
+	for _, issueLabel := range dst {
+		l, err := mapToLabel(&issueLabel.label)
+		if err != nil {
+			return nil, err
+		}
+
+		res[issueLabel.IssueID] = append(res[issueLabel.IssueID], l)
+	}
+
+	return res, nil
+}
@@ -87,6 +87,26 @@ func (s *JobStore) Find(ctx context.Context, uid string) (*types.Job, error) {
 	return result, nil
 }
 
+// Delete deletes a job by its unique identifier.
+func (s *JobStore) Delete(ctx context.Context, uid string) error {
+	stmt := database.Builder.
+		Delete("jobs").
+		Where("job_uid = ?", uid)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert delete job query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(err, "failed to execute delete job query")
+	}
+
+	return nil
+}
+
 // DeleteByGroupID deletes all jobs for a group id.
 func (s *JobStore) DeleteByGroupID(ctx context.Context, groupID string) (int64, error) {
 	stmt := database.Builder.
@@ -0,0 +1,312 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.LabelStore = (*LabelStore)(nil)
+
+// NewLabelStore returns a new LabelStore.
+func NewLabelStore(db *sqlx.DB) *LabelStore {
+	return &LabelStore{
+		db: db,
+	}
+}
+
+// LabelStore implements store.LabelStore backed by a relational database.
+type LabelStore struct {
+	db *sqlx.DB
+}
+
+// label is an internal representation used to store label data in the database.
+type label struct {
+	ID        int64    `db:"label_id"`
+	RepoID    null.Int `db:"label_repo_id"`
+	SpaceID   null.Int `db:"label_space_id"`
+	CreatedBy int64    `db:"label_created_by"`
+	Created   int64    `db:"label_created"`
+	Updated   int64    `db:"label_updated"`
+
+	Name        string `db:"label_name"`
+	Description string `db:"label_description"`
+	Color       string `db:"label_color"`
+}
+
+const (
+	labelColumns = `
+		 label_id
+		,label_repo_id
+		,label_space_id
+		,label_created_by
+		,label_created
+		,label_updated
+		,label_name
+		,label_description
+		,label_color`
+
+	labelSelectBase = `
+	SELECT` + labelColumns + `
+	FROM labels`
+)
+
+// Find finds the label by id.
+func (s *LabelStore) Find(ctx context.Context, id int64) (*types.Label, error) {
+	const sqlQuery = labelSelectBase + `
+		WHERE label_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := &label{}
+	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	return mapToLabel(dst)
+}
+
+// Create creates a new label.
+func (s *LabelStore) Create(ctx context.Context, l *types.Label) error {
+	const sqlQuery = `
+		INSERT INTO labels (
+			label_repo_id
+			,label_space_id
+			,label_created_by
+			,label_created
+			,label_updated
+			,label_name
+			,label_description
+			,label_color
+		) values (
+			:label_repo_id
+			,:label_space_id
+			,:label_created_by
+			,:label_created
+			,:label_updated
+			,:label_name
+			,:label_description
+			,:label_color
+		) RETURNING label_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbLabel, err := mapToInternalLabel(l)
+	if err != nil {
+		return err
+	}
+
+	query, arg, err := db.BindNamed(sqlQuery, dbLabel)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind label object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&l.ID); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Update updates an existing label.
+func (s *LabelStore) Update(ctx context.Context, l *types.Label) error {
+	const sqlQuery = `
+		UPDATE labels
+		SET
+			 label_updated = :label_updated
+			,label_name = :label_name
+			,label_description = :label_description
+			,label_color = :label_color
+		WHERE label_id = :label_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbLabel, err := mapToInternalLabel(l)
+	if err != nil {
+		return err
+	}
+
+	query, arg, err := db.BindNamed(sqlQuery, dbLabel)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind label object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(err, "Update query failed")
+	}
+
+	return nil
+}
+
+// Delete deletes the label with the given id.
+func (s *LabelStore) Delete(ctx context.Context, id int64) error {
+	const sqlQuery = `
+		DELETE FROM labels
+		WHERE label_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, id); err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	return nil
+}
+
+// Count counts the labels of the repository and the provided spaces.
+func (s *LabelStore) Count(ctx context.Context, repoID int64, spaceIDs []int64,
+	opts *types.LabelFilter) (int64, error) {
+	stmt := database.Builder.
+		Select("count(*)").
+		From("labels")
+
+	stmt = applyLabelFilter(stmt, repoID, spaceIDs, opts)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var count int64
+	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(err, "Failed executing count query")
+	}
+
+	return count, nil
+}
+
+// List lists the labels of the repository and the provided spaces.
+func (s *LabelStore) List(ctx context.Context, repoID int64, spaceIDs []int64,
+	opts *types.LabelFilter) ([]*types.Label, error) {
+	stmt := database.Builder.
+		Select(labelColumns).
+		From("labels")
+
+	stmt = applyLabelFilter(stmt, repoID, spaceIDs, opts)
+
+	stmt = stmt.Limit(database.Limit(opts.Size))
+	stmt = stmt.Offset(database.Offset(opts.Page, opts.Size))
+	stmt = stmt.OrderBy("LOWER(label_name)", "label_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*label{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	return mapToLabels(dst)
+}
+
+func applyLabelFilter(
+	stmt squirrel.SelectBuilder,
+	repoID int64,
+	spaceIDs []int64,
+	opts *types.LabelFilter,
+) squirrel.SelectBuilder {
+	stmt = stmt.Where(squirrel.Or{
+		squirrel.Eq{"label_repo_id": repoID},
+		squirrel.Eq{"label_space_id": spaceIDs},
+	})
+
+	if opts.Query != "" {
+		stmt = stmt.Where("LOWER(label_name) LIKE ?", fmt.Sprintf("%%%s%%", strings.ToLower(opts.Query)))
+	}
+
+	return stmt
+}
+
+func mapToLabel(l *label) (*types.Label, error) {
+	res := &types.Label{
+		ID:          l.ID,
+		CreatedBy:   l.CreatedBy,
+		Created:     l.Created,
+		Updated:     l.Updated,
+		Name:        l.Name,
+		Description: l.Description,
+		Color:       l.Color,
+	}
+
+	switch {
+	case l.RepoID.Valid && l.SpaceID.Valid:
+		return nil, fmt.Errorf("both repoID and spaceID are set for label %d", l.ID)
+	case l.RepoID.Valid:
+		res.ParentType = enum.LabelParentRepo
+		res.ParentID = l.RepoID.Int64
+	case l.SpaceID.Valid:
+		res.ParentType = enum.LabelParentSpace
+		res.ParentID = l.SpaceID.Int64
+	default:
+		return nil, fmt.Errorf("neither repoID nor spaceID are set for label %d", l.ID)
+	}
+
+	return res, nil
+}
+
+func mapToInternalLabel(l *types.Label) (*label, error) {
+	res := &label{
+		ID:          l.ID,
+		CreatedBy:   l.CreatedBy,
+		Created:     l.Created,
+		Updated:     l.Updated,
+		Name:        l.Name,
+		Description: l.Description,
+		Color:       l.Color,
+	}
+
+	switch l.ParentType {
+	case enum.LabelParentRepo:
+		res.RepoID = null.IntFrom(l.ParentID)
+	case enum.LabelParentSpace:
+		res.SpaceID = null.IntFrom(l.ParentID)
+	default:
+		return nil, fmt.Errorf("label parent type %q is not supported", l.ParentType)
+	}
+
+	return res, nil
+}
+
+func mapToLabels(labels []*label) ([]*types.Label, error) {
+	var err error
+	m := make([]*types.Label, len(labels))
+	for i, l := range labels {
+		m[i], err = mapToLabel(l)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
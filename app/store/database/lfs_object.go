@@ -0,0 +1,170 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.LFSObjectStore = (*LFSObjectStore)(nil)
+
+// NewLFSObjectStore returns a new LFSObjectStore.
+func NewLFSObjectStore(db *sqlx.DB) *LFSObjectStore {
+	return &LFSObjectStore{db}
+}
+
+// LFSObjectStore implements a LFSObjectStore backed by a relational database.
+type LFSObjectStore struct {
+	db *sqlx.DB
+}
+
+const lfsObjectColumns = `
+	 lfs_object_id
+	,lfs_object_repo_id
+	,lfs_object_oid
+	,lfs_object_size
+	,lfs_object_created
+	,lfs_object_created_by`
+
+// Find finds the LFS object of a repository by its oid.
+func (s *LFSObjectStore) Find(ctx context.Context, repoID int64, oid string) (*types.LFSObject, error) {
+	stmt := database.Builder.
+		Select(lfsObjectColumns).
+		From("lfs_objects").
+		Where("lfs_object_repo_id = ?", repoID).
+		Where("lfs_object_oid = ?", oid)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.LFSObject)
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find LFS object")
+	}
+
+	return dst, nil
+}
+
+// FindMany finds all LFS objects of a repository with the provided oids.
+func (s *LFSObjectStore) FindMany(ctx context.Context, repoID int64, oids []string) ([]*types.LFSObject, error) {
+	stmt := database.Builder.
+		Select(lfsObjectColumns).
+		From("lfs_objects").
+		Where("lfs_object_repo_id = ?", repoID).
+		Where(squirrel.Eq{"lfs_object_oid": oids})
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.LFSObject{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find LFS objects")
+	}
+
+	return dst, nil
+}
+
+// Create saves the LFS object details.
+func (s *LFSObjectStore) Create(ctx context.Context, obj *types.LFSObject) error {
+	const sqlQuery = `
+	INSERT INTO lfs_objects (
+		 lfs_object_repo_id
+		,lfs_object_oid
+		,lfs_object_size
+		,lfs_object_created
+		,lfs_object_created_by
+	) values (
+		 :lfs_object_repo_id
+		,:lfs_object_oid
+		,:lfs_object_size
+		,:lfs_object_created
+		,:lfs_object_created_by
+	) RETURNING lfs_object_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(sqlQuery, obj)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind LFS object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&obj.ID); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert LFS object query failed")
+	}
+
+	return nil
+}
+
+// List returns all LFS objects of a repository.
+func (s *LFSObjectStore) List(ctx context.Context, repoID int64) ([]*types.LFSObject, error) {
+	stmt := database.Builder.
+		Select(lfsObjectColumns).
+		From("lfs_objects").
+		Where("lfs_object_repo_id = ?", repoID).
+		OrderBy("lfs_object_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.LFSObject{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to list LFS objects")
+	}
+
+	return dst, nil
+}
+
+// Usage returns the number and total size of all LFS objects of a repository.
+func (s *LFSObjectStore) Usage(ctx context.Context, repoID int64) (*types.LFSUsage, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)", "COALESCE(SUM(lfs_object_size), 0)").
+		From("lfs_objects").
+		Where("lfs_object_repo_id = ?", repoID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	usage := &types.LFSUsage{}
+	if err = db.QueryRowContext(ctx, sql, args...).Scan(&usage.Count, &usage.Size); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to get LFS usage")
+	}
+
+	return usage, nil
+}
@@ -60,7 +60,8 @@ type membership struct {
 	Created   int64 `db:"membership_created"`
 	Updated   int64 `db:"membership_updated"`
 
-	Role enum.MembershipRole `db:"membership_role"`
+	Role   enum.MembershipRole   `db:"membership_role"`
+	Source enum.MembershipSource `db:"membership_source"`
 }
 
 type membershipPrincipal struct {
@@ -80,7 +81,8 @@ const (
 		,membership_created_by
 		,membership_created
 		,membership_updated
-		,membership_role`
+		,membership_role
+		,membership_source`
 
 	membershipSelectBase = `
 	SELECT` + membershipColumns + `
@@ -128,6 +130,7 @@ func (s *MembershipStore) Create(ctx context.Context, membership *types.Membersh
 		,membership_created
 		,membership_updated
 		,membership_role
+		,membership_source
 	) values (
 		 :membership_space_id
 		,:membership_principal_id
@@ -135,6 +138,7 @@ func (s *MembershipStore) Create(ctx context.Context, membership *types.Membersh
 		,:membership_created
 		,:membership_updated
 		,:membership_role
+		,:membership_source
 	)`
 
 	db := dbtx.GetAccessor(ctx, s.db)
@@ -151,13 +155,14 @@ func (s *MembershipStore) Create(ctx context.Context, membership *types.Membersh
 	return nil
 }
 
-// Update updates the role of a member of a space.
+// Update updates the role and the source of a member of a space.
 func (s *MembershipStore) Update(ctx context.Context, membership *types.Membership) error {
 	const sqlQuery = `
 	UPDATE memberships
 	SET
 		 membership_updated = :membership_updated
 		,membership_role = :membership_role
+		,membership_source = :membership_source
 	WHERE membership_space_id = :membership_space_id AND
 	      membership_principal_id = :membership_principal_id`
 
@@ -196,6 +201,23 @@ func (s *MembershipStore) Delete(ctx context.Context, key types.MembershipKey) e
 	return nil
 }
 
+// ListSpaceIDsBySource returns the IDs of all spaces with memberships of the source.
+func (s *MembershipStore) ListSpaceIDsBySource(ctx context.Context, source enum.MembershipSource) ([]int64, error) {
+	const sqlQuery = `
+	SELECT DISTINCT membership_space_id
+	FROM memberships
+	WHERE membership_source = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var spaceIDs []int64
+	if err := db.SelectContext(ctx, &spaceIDs, sqlQuery, source); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to list spaces with memberships of source")
+	}
+
+	return spaceIDs, nil
+}
+
 // CountUsers returns a number of users memberships that matches the provided filter.
 func (s *MembershipStore) CountUsers(ctx context.Context,
 	spaceID int64,
@@ -384,6 +406,7 @@ func mapToMembership(m *membership) types.Membership {
 		Created:   m.Created,
 		Updated:   m.Updated,
 		Role:      m.Role,
+		Source:    m.Source,
 	}
 }
 
@@ -395,6 +418,7 @@ func mapToInternalMembership(m *types.Membership) membership {
 		Created:     m.Created,
 		Updated:     m.Updated,
 		Role:        m.Role,
+		Source:      m.Source,
 	}
 }
 
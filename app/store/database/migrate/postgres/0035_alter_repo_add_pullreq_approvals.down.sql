@@ -0,0 +1,4 @@
+ALTER TABLE repositories
+    DROP COLUMN repo_pullreq_min_approvals,
+    DROP COLUMN repo_pullreq_dismiss_stale_approvals,
+    DROP COLUMN repo_pullreq_require_peer_approval;
@@ -0,0 +1,4 @@
+ALTER TABLE repositories
+    ADD COLUMN repo_pullreq_min_approvals INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN repo_pullreq_dismiss_stale_approvals BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN repo_pullreq_require_peer_approval BOOLEAN NOT NULL DEFAULT false;
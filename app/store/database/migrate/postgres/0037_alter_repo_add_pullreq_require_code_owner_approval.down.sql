@@ -0,0 +1 @@
+ALTER TABLE repositories DROP COLUMN repo_pullreq_require_code_owner_approval;
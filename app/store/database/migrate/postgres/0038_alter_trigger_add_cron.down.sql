@@ -0,0 +1,4 @@
+ALTER TABLE triggers
+    DROP COLUMN trigger_cron,
+    DROP COLUMN trigger_branch,
+    DROP COLUMN trigger_timezone;
@@ -0,0 +1,4 @@
+ALTER TABLE triggers
+    ADD COLUMN trigger_cron TEXT NOT NULL DEFAULT '',
+    ADD COLUMN trigger_branch TEXT NOT NULL DEFAULT '',
+    ADD COLUMN trigger_timezone TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE repositories DROP COLUMN repo_pullreq_allowed_merge_methods;
@@ -0,0 +1,5 @@
+ALTER TABLE repositories
+    ADD COLUMN repo_pullreq_merge_title_template TEXT NOT NULL DEFAULT '',
+    ADD COLUMN repo_pullreq_merge_message_template TEXT NOT NULL DEFAULT '',
+    ADD COLUMN repo_pullreq_squash_title_template TEXT NOT NULL DEFAULT '',
+    ADD COLUMN repo_pullreq_squash_message_template TEXT NOT NULL DEFAULT '';
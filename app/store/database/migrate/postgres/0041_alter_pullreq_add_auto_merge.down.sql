@@ -0,0 +1,3 @@
+ALTER TABLE pullreqs
+    DROP COLUMN pullreq_auto_merge_by,
+    DROP COLUMN pullreq_auto_merge_method;
@@ -0,0 +1,26 @@
+ALTER TABLE repositories ADD COLUMN repo_is_mirror BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE repo_mirrors (
+ mirror_repo_id INTEGER PRIMARY KEY
+,mirror_created_by INTEGER NOT NULL
+,mirror_created BIGINT NOT NULL
+,mirror_updated BIGINT NOT NULL
+,mirror_remote_url TEXT NOT NULL
+,mirror_credentials BYTEA
+,mirror_interval BIGINT NOT NULL
+,mirror_next_sync BIGINT NOT NULL
+,mirror_last_sync BIGINT NOT NULL DEFAULT 0
+,mirror_last_sync_status TEXT NOT NULL DEFAULT ''
+,mirror_last_sync_error TEXT NOT NULL DEFAULT ''
+,CONSTRAINT fk_mirror_repo_id FOREIGN KEY (mirror_repo_id)
+    REFERENCES repositories (repo_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_mirror_created_by FOREIGN KEY (mirror_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+);
+
+CREATE INDEX repo_mirrors_next_sync
+    ON repo_mirrors(mirror_next_sync);
@@ -0,0 +1,18 @@
+DELETE FROM pullreq_activities WHERE pullreq_activity_issue_id IS NOT NULL;
+
+DROP INDEX pullreq_activities_issue_id_order_sub_order;
+
+ALTER TABLE pullreq_activities
+    DROP CONSTRAINT chk_pullreq_activities_owner;
+
+ALTER TABLE pullreq_activities
+    DROP COLUMN pullreq_activity_issue_id;
+
+ALTER TABLE pullreq_activities
+    ALTER COLUMN pullreq_activity_pullreq_id SET NOT NULL;
+
+DROP TABLE issue_labels;
+DROP TABLE issue_assignees;
+DROP TABLE issues;
+
+ALTER TABLE repositories DROP COLUMN repo_issue_seq;
@@ -0,0 +1 @@
+ALTER TABLE memberships ADD COLUMN membership_source TEXT NOT NULL DEFAULT 'manual';
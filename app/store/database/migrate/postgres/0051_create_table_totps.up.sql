@@ -0,0 +1,17 @@
+CREATE TABLE totps (
+ totp_principal_id INTEGER PRIMARY KEY
+,totp_created BIGINT NOT NULL
+,totp_updated BIGINT NOT NULL
+,totp_version INTEGER NOT NULL DEFAULT 0
+,totp_secret BYTEA NOT NULL
+,totp_enabled BOOLEAN NOT NULL
+,totp_recovery_codes TEXT NOT NULL
+,totp_last_used_step BIGINT NOT NULL DEFAULT 0
+,totp_challenge TEXT NOT NULL DEFAULT ''
+,totp_failed_attempts INTEGER NOT NULL DEFAULT 0
+,totp_locked_until BIGINT NOT NULL DEFAULT 0
+,CONSTRAINT fk_totp_principal_id FOREIGN KEY (totp_principal_id)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
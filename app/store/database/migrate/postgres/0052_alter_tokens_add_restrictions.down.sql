@@ -0,0 +1,3 @@
+ALTER TABLE tokens DROP COLUMN token_scopes;
+ALTER TABLE tokens DROP COLUMN token_space_ids;
+ALTER TABLE tokens DROP COLUMN token_repo_ids;
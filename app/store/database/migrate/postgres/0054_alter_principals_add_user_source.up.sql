@@ -0,0 +1,6 @@
+ALTER TABLE principals ADD COLUMN principal_user_source TEXT NOT NULL DEFAULT '';
+ALTER TABLE principals ADD COLUMN principal_user_external_id TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX principals_user_source_external_id
+    ON principals(principal_user_source, principal_user_external_id)
+    WHERE principal_user_source <> '';
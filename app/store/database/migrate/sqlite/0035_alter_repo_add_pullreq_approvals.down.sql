@@ -0,0 +1,3 @@
+ALTER TABLE repositories DROP COLUMN repo_pullreq_min_approvals;
+ALTER TABLE repositories DROP COLUMN repo_pullreq_dismiss_stale_approvals;
+ALTER TABLE repositories DROP COLUMN repo_pullreq_require_peer_approval;
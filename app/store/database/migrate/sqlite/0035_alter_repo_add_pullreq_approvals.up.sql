@@ -0,0 +1,3 @@
+ALTER TABLE repositories ADD COLUMN repo_pullreq_min_approvals INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE repositories ADD COLUMN repo_pullreq_dismiss_stale_approvals BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE repositories ADD COLUMN repo_pullreq_require_peer_approval BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,3 @@
+ALTER TABLE pullreqs ADD COLUMN pullreq_source_pushed_by INTEGER NOT NULL DEFAULT 0;
+
+UPDATE pullreqs SET pullreq_source_pushed_by = pullreq_created_by;
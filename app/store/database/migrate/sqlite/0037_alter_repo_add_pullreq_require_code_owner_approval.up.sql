@@ -0,0 +1 @@
+ALTER TABLE repositories ADD COLUMN repo_pullreq_require_code_owner_approval BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1 @@
+ALTER TABLE repositories ADD COLUMN repo_pullreq_allowed_merge_methods TEXT NOT NULL DEFAULT '[]';
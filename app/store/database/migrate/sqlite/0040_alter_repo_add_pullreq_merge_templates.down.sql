@@ -0,0 +1,4 @@
+ALTER TABLE repositories DROP COLUMN repo_pullreq_merge_title_template;
+ALTER TABLE repositories DROP COLUMN repo_pullreq_merge_message_template;
+ALTER TABLE repositories DROP COLUMN repo_pullreq_squash_title_template;
+ALTER TABLE repositories DROP COLUMN repo_pullreq_squash_message_template;
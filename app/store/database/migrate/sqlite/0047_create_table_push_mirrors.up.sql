@@ -0,0 +1,25 @@
+CREATE TABLE push_mirrors (
+ push_mirror_id INTEGER PRIMARY KEY AUTOINCREMENT
+,push_mirror_repo_id INTEGER NOT NULL
+,push_mirror_uid TEXT NOT NULL
+,push_mirror_created_by INTEGER NOT NULL
+,push_mirror_created BIGINT NOT NULL
+,push_mirror_updated BIGINT NOT NULL
+,push_mirror_remote_url TEXT NOT NULL
+,push_mirror_credentials BLOB
+,push_mirror_protected_branches_only BOOLEAN NOT NULL
+,push_mirror_last_push BIGINT NOT NULL DEFAULT 0
+,push_mirror_last_push_status TEXT NOT NULL DEFAULT ''
+,push_mirror_last_push_error TEXT NOT NULL DEFAULT ''
+,CONSTRAINT fk_push_mirror_repo_id FOREIGN KEY (push_mirror_repo_id)
+    REFERENCES repositories (repo_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_push_mirror_created_by FOREIGN KEY (push_mirror_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+);
+
+CREATE UNIQUE INDEX push_mirrors_repo_id_uid
+    ON push_mirrors(push_mirror_repo_id, LOWER(push_mirror_uid));
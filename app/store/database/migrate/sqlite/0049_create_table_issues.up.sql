@@ -0,0 +1,210 @@
+ALTER TABLE repositories ADD COLUMN repo_issue_seq INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE issues (
+ issue_id INTEGER PRIMARY KEY AUTOINCREMENT
+,issue_version INTEGER NOT NULL
+,issue_number INTEGER NOT NULL
+,issue_created_by INTEGER NOT NULL
+,issue_created BIGINT NOT NULL
+,issue_updated BIGINT NOT NULL
+,issue_edited BIGINT NOT NULL
+,issue_state TEXT NOT NULL
+,issue_title TEXT NOT NULL
+,issue_description TEXT NOT NULL
+,issue_repo_id INTEGER NOT NULL
+,issue_activity_seq INTEGER NOT NULL DEFAULT 0
+,issue_comment_count INTEGER NOT NULL DEFAULT 0
+,issue_closed_by INTEGER
+,issue_closed BIGINT
+,CONSTRAINT fk_issue_created_by FOREIGN KEY (issue_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+,CONSTRAINT fk_issue_closed_by FOREIGN KEY (issue_closed_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+,CONSTRAINT fk_issue_repo_id FOREIGN KEY (issue_repo_id)
+    REFERENCES repositories (repo_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX issues_repo_id_number
+    ON issues(issue_repo_id, issue_number);
+
+CREATE TABLE pullreq_activities_new (
+ pullreq_activity_id INTEGER PRIMARY KEY AUTOINCREMENT
+,pullreq_activity_version BIGINT NOT NULL
+,pullreq_activity_created_by INTEGER
+,pullreq_activity_created BIGINT NOT NULL
+,pullreq_activity_updated BIGINT NOT NULL
+,pullreq_activity_edited BIGINT NOT NULL
+,pullreq_activity_deleted BIGINT
+,pullreq_activity_parent_id INTEGER
+,pullreq_activity_repo_id INTEGER NOT NULL
+,pullreq_activity_pullreq_id INTEGER
+,pullreq_activity_issue_id INTEGER
+,pullreq_activity_order INTEGER NOT NULL
+,pullreq_activity_sub_order INTEGER NOT NULL
+,pullreq_activity_reply_seq INTEGER NOT NULL
+,pullreq_activity_type TEXT NOT NULL
+,pullreq_activity_kind TEXT NOT NULL
+,pullreq_activity_text TEXT NOT NULL
+,pullreq_activity_payload TEXT NOT NULL DEFAULT '{}'
+,pullreq_activity_metadata TEXT NOT NULL DEFAULT '{}'
+,pullreq_activity_resolved_by INTEGER DEFAULT 0
+,pullreq_activity_resolved BIGINT NULL
+,pullreq_activity_outdated BOOLEAN
+,pullreq_activity_code_comment_merge_base_sha TEXT
+,pullreq_activity_code_comment_source_sha TEXT
+,pullreq_activity_code_comment_path TEXT
+,pullreq_activity_code_comment_line_new INTEGER
+,pullreq_activity_code_comment_span_new INTEGER
+,pullreq_activity_code_comment_line_old INTEGER
+,pullreq_activity_code_comment_span_old INTEGER
+,CONSTRAINT fk_pullreq_activities_created_by FOREIGN KEY (pullreq_activity_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+,CONSTRAINT fk_pullreq_activities_parent_id FOREIGN KEY (pullreq_activity_parent_id)
+    REFERENCES pullreq_activities_new (pullreq_activity_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_activities_repo_id FOREIGN KEY (pullreq_activity_repo_id)
+    REFERENCES repositories (repo_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_activities_pullreq_id FOREIGN KEY (pullreq_activity_pullreq_id)
+    REFERENCES pullreqs (pullreq_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_activities_issue_id FOREIGN KEY (pullreq_activity_issue_id)
+    REFERENCES issues (issue_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_activities_resolved_by FOREIGN KEY (pullreq_activity_resolved_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+,CONSTRAINT chk_pullreq_activities_owner
+    CHECK ((pullreq_activity_pullreq_id IS NULL) <> (pullreq_activity_issue_id IS NULL))
+);
+
+INSERT INTO pullreq_activities_new (
+ pullreq_activity_id
+,pullreq_activity_version
+,pullreq_activity_created_by
+,pullreq_activity_created
+,pullreq_activity_updated
+,pullreq_activity_edited
+,pullreq_activity_deleted
+,pullreq_activity_parent_id
+,pullreq_activity_repo_id
+,pullreq_activity_pullreq_id
+,pullreq_activity_order
+,pullreq_activity_sub_order
+,pullreq_activity_reply_seq
+,pullreq_activity_type
+,pullreq_activity_kind
+,pullreq_activity_text
+,pullreq_activity_payload
+,pullreq_activity_metadata
+,pullreq_activity_resolved_by
+,pullreq_activity_resolved
+,pullreq_activity_outdated
+,pullreq_activity_code_comment_merge_base_sha
+,pullreq_activity_code_comment_source_sha
+,pullreq_activity_code_comment_path
+,pullreq_activity_code_comment_line_new
+,pullreq_activity_code_comment_span_new
+,pullreq_activity_code_comment_line_old
+,pullreq_activity_code_comment_span_old
+)
+SELECT
+ pullreq_activity_id
+,pullreq_activity_version
+,pullreq_activity_created_by
+,pullreq_activity_created
+,pullreq_activity_updated
+,pullreq_activity_edited
+,pullreq_activity_deleted
+,pullreq_activity_parent_id
+,pullreq_activity_repo_id
+,pullreq_activity_pullreq_id
+,pullreq_activity_order
+,pullreq_activity_sub_order
+,pullreq_activity_reply_seq
+,pullreq_activity_type
+,pullreq_activity_kind
+,pullreq_activity_text
+,pullreq_activity_payload
+,pullreq_activity_metadata
+,pullreq_activity_resolved_by
+,pullreq_activity_resolved
+,pullreq_activity_outdated
+,pullreq_activity_code_comment_merge_base_sha
+,pullreq_activity_code_comment_source_sha
+,pullreq_activity_code_comment_path
+,pullreq_activity_code_comment_line_new
+,pullreq_activity_code_comment_span_new
+,pullreq_activity_code_comment_line_old
+,pullreq_activity_code_comment_span_old
+FROM pullreq_activities;
+
+DROP TABLE pullreq_activities;
+
+ALTER TABLE pullreq_activities_new
+    RENAME TO pullreq_activities;
+
+CREATE UNIQUE INDEX pullreq_activities_pullreq_id_order_sub_order
+    ON pullreq_activities(pullreq_activity_pullreq_id, pullreq_activity_order, pullreq_activity_sub_order);
+
+CREATE UNIQUE INDEX pullreq_activities_issue_id_order_sub_order
+    ON pullreq_activities(pullreq_activity_issue_id, pullreq_activity_order, pullreq_activity_sub_order);
+
+CREATE TABLE issue_assignees (
+ issue_assignee_issue_id INTEGER NOT NULL
+,issue_assignee_principal_id INTEGER NOT NULL
+,issue_assignee_created_by INTEGER NOT NULL
+,issue_assignee_created BIGINT NOT NULL
+,CONSTRAINT pk_issue_assignees PRIMARY KEY (issue_assignee_issue_id, issue_assignee_principal_id)
+,CONSTRAINT fk_issue_assignee_issue_id FOREIGN KEY (issue_assignee_issue_id)
+    REFERENCES issues (issue_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_issue_assignee_principal_id FOREIGN KEY (issue_assignee_principal_id)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_issue_assignee_created_by FOREIGN KEY (issue_assignee_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+);
+
+CREATE INDEX issue_assignees_principal_id
+    ON issue_assignees(issue_assignee_principal_id);
+
+CREATE TABLE issue_labels (
+ issue_label_issue_id INTEGER NOT NULL
+,issue_label_label_id INTEGER NOT NULL
+,issue_label_created_by INTEGER NOT NULL
+,issue_label_created BIGINT NOT NULL
+,CONSTRAINT pk_issue_labels PRIMARY KEY (issue_label_issue_id, issue_label_label_id)
+,CONSTRAINT fk_issue_label_issue_id FOREIGN KEY (issue_label_issue_id)
+    REFERENCES issues (issue_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_issue_label_label_id FOREIGN KEY (issue_label_label_id)
+    REFERENCES labels (label_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_issue_label_created_by FOREIGN KEY (issue_label_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+);
+
+CREATE INDEX issue_labels_label_id
+    ON issue_labels(issue_label_label_id);
@@ -0,0 +1,3 @@
+ALTER TABLE tokens ADD COLUMN token_scopes TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE tokens ADD COLUMN token_space_ids TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE tokens ADD COLUMN token_repo_ids TEXT NOT NULL DEFAULT '[]';
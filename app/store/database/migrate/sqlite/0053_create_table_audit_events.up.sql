@@ -0,0 +1,17 @@
+CREATE TABLE audit_events (
+ audit_event_id INTEGER PRIMARY KEY AUTOINCREMENT
+,audit_event_created BIGINT NOT NULL
+,audit_event_actor_id INTEGER NOT NULL
+,audit_event_actor_uid TEXT NOT NULL
+,audit_event_action TEXT NOT NULL
+,audit_event_resource_type TEXT NOT NULL
+,audit_event_resource_name TEXT NOT NULL
+,audit_event_space_id INTEGER
+,audit_event_data TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX audit_events_created
+    ON audit_events(audit_event_created);
+
+CREATE INDEX audit_events_space_id_created
+    ON audit_events(audit_event_space_id, audit_event_created);
@@ -0,0 +1,4 @@
+DROP INDEX principals_user_source_external_id;
+
+ALTER TABLE principals DROP COLUMN principal_user_source;
+ALTER TABLE principals DROP COLUMN principal_user_external_id;
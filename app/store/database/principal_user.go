@@ -37,7 +37,9 @@ type user struct {
 }
 
 const userColumns = principalCommonColumns + `
-	,principal_user_password`
+	,principal_user_password
+	,principal_user_source
+	,principal_user_external_id`
 
 const userSelectBase = `
 	SELECT` + userColumns + `
@@ -96,6 +98,30 @@ func (s *PrincipalStore) FindUserByEmail(ctx context.Context, email string) (*ty
 	return s.mapDBUser(dst), nil
 }
 
+// FindUserByExternalID finds the user that was provisioned from the external identity.
+func (s *PrincipalStore) FindUserByExternalID(
+	ctx context.Context,
+	source enum.UserSource,
+	externalID string,
+) (*types.User, error) {
+	const sqlQuery = userSelectBase + `
+		WHERE principal_type = 'user' AND principal_user_source = $1 AND principal_user_external_id = $2`
+
+	// local users don't have an external identity.
+	if source == enum.UserSourceLocal {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(user)
+	if err := db.GetContext(ctx, dst, sqlQuery, source, externalID); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select by external id query failed")
+	}
+
+	return s.mapDBUser(dst), nil
+}
+
 // CreateUser saves the user details.
 func (s *PrincipalStore) CreateUser(ctx context.Context, user *types.User) error {
 	const sqlQuery = `
@@ -111,6 +137,8 @@ func (s *PrincipalStore) CreateUser(ctx context.Context, user *types.User) error
 			,principal_created
 			,principal_updated
 			,principal_user_password
+			,principal_user_source
+			,principal_user_external_id
 		) values (
 			'user'
 			,:principal_uid
@@ -123,6 +151,8 @@ func (s *PrincipalStore) CreateUser(ctx context.Context, user *types.User) error
 			,:principal_created
 			,:principal_updated
 			,:principal_user_password
+			,:principal_user_source
+			,:principal_user_external_id
 		) RETURNING principal_id`
 
 	dbUser, err := s.mapToDBUser(user)
@@ -0,0 +1,154 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.PublicKeyStore = (*PublicKeyStore)(nil)
+
+// NewPublicKeyStore returns a new PublicKeyStore.
+func NewPublicKeyStore(db *sqlx.DB) *PublicKeyStore {
+	return &PublicKeyStore{db}
+}
+
+// PublicKeyStore implements a PublicKeyStore backed by a relational database.
+type PublicKeyStore struct {
+	db *sqlx.DB
+}
+
+// FindByFingerprint finds the public key by its fingerprint.
+func (s *PublicKeyStore) FindByFingerprint(ctx context.Context, fingerprint string) (*types.PublicKey, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.PublicKey)
+	if err := db.GetContext(ctx, dst, publicKeySelectByFingerprint, fingerprint); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find public key by fingerprint")
+	}
+
+	return dst, nil
+}
+
+// FindByUID finds the public key by principal ID and public key UID.
+func (s *PublicKeyStore) FindByUID(ctx context.Context, principalID int64, uid string) (*types.PublicKey, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.PublicKey)
+	if err := db.GetContext(ctx, dst, publicKeySelectByPrincipalIDAndUID, principalID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find public key by UID")
+	}
+
+	return dst, nil
+}
+
+// Create saves the public key details.
+func (s *PublicKeyStore) Create(ctx context.Context, key *types.PublicKey) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(publicKeyInsert, key)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind public key object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&key.ID); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Delete deletes the public key with the given id.
+func (s *PublicKeyStore) Delete(ctx context.Context, id int64) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, publicKeyDelete, id); err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	return nil
+}
+
+// List returns all public keys of a specific principal.
+func (s *PublicKeyStore) List(ctx context.Context, principalID int64) ([]*types.PublicKey, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.PublicKey{}
+
+	err := db.SelectContext(ctx, &dst, publicKeySelectForPrincipalID, principalID)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed executing public key list query")
+	}
+
+	return dst, nil
+}
+
+const publicKeySelectBase = `
+SELECT
+public_key_id
+,public_key_principal_id
+,public_key_created
+,public_key_uid
+,public_key_fingerprint
+,public_key_content
+,public_key_comment
+,public_key_type
+FROM public_keys
+`
+
+const publicKeySelectByFingerprint = publicKeySelectBase + `
+WHERE public_key_fingerprint = $1
+`
+
+const publicKeySelectByPrincipalIDAndUID = publicKeySelectBase + `
+WHERE public_key_principal_id = $1 AND LOWER(public_key_uid) = LOWER($2)
+`
+
+const publicKeySelectForPrincipalID = publicKeySelectBase + `
+WHERE public_key_principal_id = $1
+ORDER BY public_key_created DESC
+`
+
+const publicKeyDelete = `
+DELETE FROM public_keys
+WHERE public_key_id = $1
+`
+
+const publicKeyInsert = `
+INSERT INTO public_keys (
+	public_key_principal_id
+	,public_key_created
+	,public_key_uid
+	,public_key_fingerprint
+	,public_key_content
+	,public_key_comment
+	,public_key_type
+) values (
+	:public_key_principal_id
+	,:public_key_created
+	,:public_key_uid
+	,:public_key_fingerprint
+	,:public_key_content
+	,:public_key_comment
+	,:public_key_type
+) RETURNING public_key_id
+`
@@ -75,6 +75,7 @@ type pullReq struct {
 	SourceRepoID int64  `db:"pullreq_source_repo_id"`
 	SourceBranch string `db:"pullreq_source_branch"`
 	SourceSHA    string `db:"pullreq_source_sha"`
+	SourcePusher int64  `db:"pullreq_source_pushed_by"`
 	TargetRepoID int64  `db:"pullreq_target_repo_id"`
 	TargetBranch string `db:"pullreq_target_branch"`
 
@@ -89,6 +90,9 @@ type pullReq struct {
 	MergeBaseSHA     string                `db:"pullreq_merge_base_sha"`
 	MergeSHA         null.String           `db:"pullreq_merge_sha"`
 	MergeConflicts   null.String           `db:"pullreq_merge_conflicts"`
+
+	AutoMergeBy     null.Int    `db:"pullreq_auto_merge_by"`
+	AutoMergeMethod null.String `db:"pullreq_auto_merge_method"`
 }
 
 const (
@@ -109,6 +113,7 @@ const (
 		,pullreq_source_repo_id
 		,pullreq_source_branch
 		,pullreq_source_sha
+		,pullreq_source_pushed_by
 		,pullreq_target_repo_id
 		,pullreq_target_branch
 		,pullreq_activity_seq
@@ -119,7 +124,9 @@ const (
 		,pullreq_merge_target_sha
 		,pullreq_merge_base_sha
 		,pullreq_merge_sha
-		,pullreq_merge_conflicts`
+		,pullreq_merge_conflicts
+		,pullreq_auto_merge_by
+		,pullreq_auto_merge_method`
 
 	pullReqSelectBase = `
 	SELECT` + pullReqColumns + `
@@ -198,6 +205,7 @@ func (s *PullReqStore) Create(ctx context.Context, pr *types.PullReq) error {
 		,pullreq_source_repo_id
 		,pullreq_source_branch
 		,pullreq_source_sha
+		,pullreq_source_pushed_by
 		,pullreq_target_repo_id
 		,pullreq_target_branch
 		,pullreq_activity_seq
@@ -209,6 +217,8 @@ func (s *PullReqStore) Create(ctx context.Context, pr *types.PullReq) error {
 		,pullreq_merge_base_sha
 		,pullreq_merge_sha
 		,pullreq_merge_conflicts
+		,pullreq_auto_merge_by
+		,pullreq_auto_merge_method
 	) values (
 		 :pullreq_version
 		,:pullreq_number
@@ -225,6 +235,7 @@ func (s *PullReqStore) Create(ctx context.Context, pr *types.PullReq) error {
 		,:pullreq_source_repo_id
 		,:pullreq_source_branch
 		,:pullreq_source_sha
+		,:pullreq_source_pushed_by
 		,:pullreq_target_repo_id
 		,:pullreq_target_branch
 		,:pullreq_activity_seq
@@ -236,6 +247,8 @@ func (s *PullReqStore) Create(ctx context.Context, pr *types.PullReq) error {
 		,:pullreq_merge_base_sha
 		,:pullreq_merge_sha
 		,:pullreq_merge_conflicts
+		,:pullreq_auto_merge_by
+		,:pullreq_auto_merge_method
 	) RETURNING pullreq_id`
 
 	db := dbtx.GetAccessor(ctx, s.db)
@@ -268,6 +281,7 @@ func (s *PullReqStore) Update(ctx context.Context, pr *types.PullReq) error {
 		,pullreq_description = :pullreq_description
 		,pullreq_activity_seq = :pullreq_activity_seq
 		,pullreq_source_sha = :pullreq_source_sha
+		,pullreq_source_pushed_by = :pullreq_source_pushed_by
 		,pullreq_merged_by = :pullreq_merged_by
 		,pullreq_merged = :pullreq_merged
 		,pullreq_merge_method = :pullreq_merge_method
@@ -276,6 +290,8 @@ func (s *PullReqStore) Update(ctx context.Context, pr *types.PullReq) error {
 		,pullreq_merge_base_sha = :pullreq_merge_base_sha
 		,pullreq_merge_sha = :pullreq_merge_sha
 		,pullreq_merge_conflicts = :pullreq_merge_conflicts
+		,pullreq_auto_merge_by = :pullreq_auto_merge_by
+		,pullreq_auto_merge_method = :pullreq_auto_merge_method
 	WHERE pullreq_id = :pullreq_id AND pullreq_version = :pullreq_version - 1`
 
 	db := dbtx.GetAccessor(ctx, s.db)
@@ -417,6 +433,14 @@ func (s *PullReqStore) Count(ctx context.Context, opts *types.PullReqFilter) (in
 		stmt = stmt.Where("pullreq_target_branch = ?", opts.TargetBranch)
 	}
 
+	if opts.SourceSHA != "" {
+		stmt = stmt.Where("pullreq_source_sha = ?", opts.SourceSHA)
+	}
+
+	if opts.AutoMerge {
+		stmt = stmt.Where("pullreq_auto_merge_method IS NOT NULL")
+	}
+
 	if opts.Query != "" {
 		stmt = stmt.Where("LOWER(pullreq_title) LIKE ?", fmt.Sprintf("%%%s%%", strings.ToLower(opts.Query)))
 	}
@@ -425,6 +449,10 @@ func (s *PullReqStore) Count(ctx context.Context, opts *types.PullReqFilter) (in
 		stmt = stmt.Where("pullreq_created_by = ?", opts.CreatedBy)
 	}
 
+	if len(opts.LabelIDs) > 0 {
+		stmt = applyPullReqLabelFilter(stmt, opts.LabelIDs)
+	}
+
 	sql, args, err := stmt.ToSql()
 	if err != nil {
 		return 0, errors.Wrap(err, "Failed to convert query to sql")
@@ -469,6 +497,14 @@ func (s *PullReqStore) List(ctx context.Context, opts *types.PullReqFilter) ([]*
 		stmt = stmt.Where("pullreq_target_branch = ?", opts.TargetBranch)
 	}
 
+	if opts.SourceSHA != "" {
+		stmt = stmt.Where("pullreq_source_sha = ?", opts.SourceSHA)
+	}
+
+	if opts.AutoMerge {
+		stmt = stmt.Where("pullreq_auto_merge_method IS NOT NULL")
+	}
+
 	if opts.Query != "" {
 		stmt = stmt.Where("LOWER(pullreq_title) LIKE ?", fmt.Sprintf("%%%s%%", strings.ToLower(opts.Query)))
 	}
@@ -477,6 +513,10 @@ func (s *PullReqStore) List(ctx context.Context, opts *types.PullReqFilter) ([]*
 		stmt = stmt.Where("pullreq_created_by = ?", opts.CreatedBy)
 	}
 
+	if len(opts.LabelIDs) > 0 {
+		stmt = applyPullReqLabelFilter(stmt, opts.LabelIDs)
+	}
+
 	stmt = stmt.Limit(database.Limit(opts.Size))
 	stmt = stmt.Offset(database.Offset(opts.Page, opts.Size))
 
@@ -507,6 +547,23 @@ func (s *PullReqStore) List(ctx context.Context, opts *types.PullReqFilter) ([]*
 	return result, nil
 }
 
+// applyPullReqLabelFilter limits the pull requests to the ones that have all the provided labels assigned.
+// NOTE: The label IDs are expected to be unique.
+func applyPullReqLabelFilter(stmt squirrel.SelectBuilder, labelIDs []int64) squirrel.SelectBuilder {
+	args := make([]any, 0, len(labelIDs)+1)
+	for _, id := range labelIDs {
+		args = append(args, id)
+	}
+	args = append(args, len(labelIDs))
+
+	return stmt.Where(`pullreq_id IN (
+		SELECT pullreq_label_pullreq_id
+		FROM pullreq_labels
+		WHERE pullreq_label_label_id IN (`+squirrel.Placeholders(len(labelIDs))+`)
+		GROUP BY pullreq_label_pullreq_id
+		HAVING COUNT(*) = ?)`, args...)
+}
+
 func mapPullReq(pr *pullReq) *types.PullReq {
 	return &types.PullReq{
 		ID:               pr.ID,
@@ -525,6 +582,7 @@ func mapPullReq(pr *pullReq) *types.PullReq {
 		SourceRepoID:     pr.SourceRepoID,
 		SourceBranch:     pr.SourceBranch,
 		SourceSHA:        pr.SourceSHA,
+		SourcePusher:     pr.SourcePusher,
 		TargetRepoID:     pr.TargetRepoID,
 		TargetBranch:     pr.TargetBranch,
 		ActivitySeq:      pr.ActivitySeq,
@@ -536,8 +594,11 @@ func mapPullReq(pr *pullReq) *types.PullReq {
 		MergeBaseSHA:     pr.MergeBaseSHA,
 		MergeSHA:         pr.MergeSHA.Ptr(),
 		MergeConflicts:   pr.MergeConflicts.Ptr(),
+		AutoMergeBy:      pr.AutoMergeBy.Ptr(),
+		AutoMergeMethod:  (*enum.MergeMethod)(pr.AutoMergeMethod.Ptr()),
 		Author:           types.PrincipalInfo{},
 		Merger:           nil,
+		AutoMerger:       nil,
 		Stats: types.PullReqStats{
 			Conversations:   pr.CommentCount,
 			UnresolvedCount: pr.UnresolvedCount,
@@ -567,6 +628,7 @@ func mapInternalPullReq(pr *types.PullReq) *pullReq {
 		SourceRepoID:     pr.SourceRepoID,
 		SourceBranch:     pr.SourceBranch,
 		SourceSHA:        pr.SourceSHA,
+		SourcePusher:     pr.SourcePusher,
 		TargetRepoID:     pr.TargetRepoID,
 		TargetBranch:     pr.TargetBranch,
 		ActivitySeq:      pr.ActivitySeq,
@@ -578,6 +640,8 @@ func mapInternalPullReq(pr *types.PullReq) *pullReq {
 		MergeBaseSHA:     pr.MergeBaseSHA,
 		MergeSHA:         null.StringFromPtr(pr.MergeSHA),
 		MergeConflicts:   null.StringFromPtr(pr.MergeConflicts),
+		AutoMergeBy:      null.IntFromPtr(pr.AutoMergeBy),
+		AutoMergeMethod:  null.StringFromPtr((*string)(pr.AutoMergeMethod)),
 	}
 
 	return m
@@ -586,7 +650,7 @@ func mapInternalPullReq(pr *types.PullReq) *pullReq {
 func (s *PullReqStore) mapPullReq(ctx context.Context, pr *pullReq) *types.PullReq {
 	m := mapPullReq(pr)
 
-	var author, merger *types.PrincipalInfo
+	var author, merger, autoMerger *types.PrincipalInfo
 	var err error
 
 	author, err = s.pCache.Get(ctx, pr.CreatedBy)
@@ -605,6 +669,14 @@ func (s *PullReqStore) mapPullReq(ctx context.Context, pr *pullReq) *types.PullR
 		m.Merger = merger
 	}
 
+	if pr.AutoMergeBy.Valid {
+		autoMerger, err = s.pCache.Get(ctx, pr.AutoMergeBy.Int64)
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed to load PR auto merger")
+		}
+		m.AutoMerger = autoMerger
+	}
+
 	return m
 }
 
@@ -616,6 +688,9 @@ func (s *PullReqStore) mapSlicePullReq(ctx context.Context, prs []*pullReq) ([]*
 		if pr.MergedBy.Valid {
 			ids = append(ids, pr.MergedBy.Int64)
 		}
+		if pr.AutoMergeBy.Valid {
+			ids = append(ids, pr.AutoMergeBy.Int64)
+		}
 	}
 
 	// pull principal infos from cache
@@ -636,6 +711,11 @@ func (s *PullReqStore) mapSlicePullReq(ctx context.Context, prs []*pullReq) ([]*
 				m[i].Merger = merger
 			}
 		}
+		if pr.AutoMergeBy.Valid {
+			if autoMerger, ok := infoMap[pr.AutoMergeBy.Int64]; ok {
+				m[i].AutoMerger = autoMerger
+			}
+		}
 	}
 
 	return m, nil
@@ -67,7 +67,8 @@ type pullReqActivity struct {
 
 	ParentID  null.Int `db:"pullreq_activity_parent_id"`
 	RepoID    int64    `db:"pullreq_activity_repo_id"`
-	PullReqID int64    `db:"pullreq_activity_pullreq_id"`
+	PullReqID null.Int `db:"pullreq_activity_pullreq_id"`
+	IssueID   null.Int `db:"pullreq_activity_issue_id"`
 
 	Order    int64 `db:"pullreq_activity_order"`
 	SubOrder int64 `db:"pullreq_activity_sub_order"`
@@ -105,6 +106,7 @@ const (
 		,pullreq_activity_parent_id
 		,pullreq_activity_repo_id
 		,pullreq_activity_pullreq_id
+		,pullreq_activity_issue_id
 		,pullreq_activity_order
 		,pullreq_activity_sub_order
 		,pullreq_activity_reply_seq
@@ -157,6 +159,7 @@ func (s *PullReqActivityStore) Create(ctx context.Context, act *types.PullReqAct
 		,pullreq_activity_parent_id
 		,pullreq_activity_repo_id
 		,pullreq_activity_pullreq_id
+		,pullreq_activity_issue_id
 		,pullreq_activity_order
 		,pullreq_activity_sub_order
 		,pullreq_activity_reply_seq
@@ -185,6 +188,7 @@ func (s *PullReqActivityStore) Create(ctx context.Context, act *types.PullReqAct
 		,:pullreq_activity_parent_id
 		,:pullreq_activity_repo_id
 		,:pullreq_activity_pullreq_id
+		,:pullreq_activity_issue_id
 		,:pullreq_activity_order
 		,:pullreq_activity_sub_order
 		,:pullreq_activity_reply_seq
@@ -222,15 +226,45 @@ func (s *PullReqActivityStore) Create(ctx context.Context, act *types.PullReqAct
 func (s *PullReqActivityStore) CreateWithPayload(ctx context.Context,
 	pr *types.PullReq, principalID int64, payload types.PullReqActivityPayload,
 ) (*types.PullReqActivity, error) {
+	act := newSystemActivity(principalID, pr.TargetRepoID, pr.ActivitySeq, payload)
+	act.PullReqID = pr.ID
+
+	err := s.Create(ctx, act)
+	if err != nil {
+		err = fmt.Errorf("failed to write pull request system '%s' activity: %w", payload.ActivityType(), err)
+		return nil, err
+	}
+
+	return act, nil
+}
+
+// CreateWithPayloadForIssue creates a new system activity of the issue with the provided payload.
+func (s *PullReqActivityStore) CreateWithPayloadForIssue(ctx context.Context,
+	issue *types.Issue, principalID int64, payload types.PullReqActivityPayload,
+) (*types.PullReqActivity, error) {
+	act := newSystemActivity(principalID, issue.RepoID, issue.ActivitySeq, payload)
+	act.IssueID = issue.ID
+
+	err := s.Create(ctx, act)
+	if err != nil {
+		err = fmt.Errorf("failed to write issue system '%s' activity: %w", payload.ActivityType(), err)
+		return nil, err
+	}
+
+	return act, nil
+}
+
+func newSystemActivity(
+	principalID int64, repoID int64, order int64, payload types.PullReqActivityPayload,
+) *types.PullReqActivity {
 	now := time.Now().UnixMilli()
 	act := &types.PullReqActivity{
 		CreatedBy: principalID,
 		Created:   now,
 		Updated:   now,
 		Edited:    now,
-		RepoID:    pr.TargetRepoID,
-		PullReqID: pr.ID,
-		Order:     pr.ActivitySeq,
+		RepoID:    repoID,
+		Order:     order,
 		SubOrder:  0,
 		ReplySeq:  0,
 		Type:      payload.ActivityType(),
@@ -240,13 +274,7 @@ func (s *PullReqActivityStore) CreateWithPayload(ctx context.Context,
 
 	_ = act.SetPayload(payload)
 
-	err := s.Create(ctx, act)
-	if err != nil {
-		err = fmt.Errorf("failed to write pull request system '%s' activity: %w", payload.ActivityType(), err)
-		return nil, err
-	}
-
-	return act, nil
+	return act
 }
 
 // Update updates the pull request.
@@ -334,35 +362,33 @@ func (s *PullReqActivityStore) UpdateOptLock(ctx context.Context,
 	}
 }
 
-// Count of pull requests for a repo.
+// Count of pull request activities in a pull request.
 func (s *PullReqActivityStore) Count(ctx context.Context,
 	prID int64,
 	opts *types.PullReqActivityFilter,
+) (int64, error) {
+	return s.count(ctx, "pullreq_activity_pullreq_id", prID, opts)
+}
+
+// CountForIssue returns number of activities in an issue.
+func (s *PullReqActivityStore) CountForIssue(ctx context.Context,
+	issueID int64,
+	opts *types.PullReqActivityFilter,
+) (int64, error) {
+	return s.count(ctx, "pullreq_activity_issue_id", issueID, opts)
+}
+
+func (s *PullReqActivityStore) count(ctx context.Context,
+	ownerColumn string,
+	ownerID int64,
+	opts *types.PullReqActivityFilter,
 ) (int64, error) {
 	stmt := database.Builder.
 		Select("count(*)").
 		From("pullreq_activities").
-		Where("pullreq_activity_pullreq_id = ?", prID)
-
-	if len(opts.Types) == 1 {
-		stmt = stmt.Where("pullreq_activity_type = ?", opts.Types[0])
-	} else if len(opts.Types) > 1 {
-		stmt = stmt.Where(squirrel.Eq{"pullreq_activity_type": opts.Types})
-	}
+		Where(ownerColumn+" = ?", ownerID)
 
-	if len(opts.Kinds) == 1 {
-		stmt = stmt.Where("pullreq_activity_kind = ?", opts.Kinds[0])
-	} else if len(opts.Kinds) > 1 {
-		stmt = stmt.Where(squirrel.Eq{"pullreq_activity_kind": opts.Kinds})
-	}
-
-	if opts.After != 0 {
-		stmt = stmt.Where("pullreq_activity_created > ?", opts.After)
-	}
-
-	if opts.Before != 0 {
-		stmt = stmt.Where("pullreq_activity_created < ?", opts.Before)
-	}
+	stmt = applyPullReqActivityFilter(stmt, opts)
 
 	sql, args, err := stmt.ToSql()
 	if err != nil {
@@ -380,35 +406,33 @@ func (s *PullReqActivityStore) Count(ctx context.Context,
 	return count, nil
 }
 
-// List returns a list of pull requests for a repo.
+// List returns a list of pull request activities in a pull request (a timeline).
 func (s *PullReqActivityStore) List(ctx context.Context,
 	prID int64,
 	opts *types.PullReqActivityFilter,
+) ([]*types.PullReqActivity, error) {
+	return s.list(ctx, "pullreq_activity_pullreq_id", prID, opts)
+}
+
+// ListForIssue returns a list of activities in an issue (a timeline).
+func (s *PullReqActivityStore) ListForIssue(ctx context.Context,
+	issueID int64,
+	opts *types.PullReqActivityFilter,
+) ([]*types.PullReqActivity, error) {
+	return s.list(ctx, "pullreq_activity_issue_id", issueID, opts)
+}
+
+func (s *PullReqActivityStore) list(ctx context.Context,
+	ownerColumn string,
+	ownerID int64,
+	opts *types.PullReqActivityFilter,
 ) ([]*types.PullReqActivity, error) {
 	stmt := database.Builder.
 		Select(pullreqActivityColumns).
 		From("pullreq_activities").
-		Where("pullreq_activity_pullreq_id = ?", prID)
-
-	if len(opts.Types) == 1 {
-		stmt = stmt.Where("pullreq_activity_type = ?", opts.Types[0])
-	} else if len(opts.Types) > 1 {
-		stmt = stmt.Where(squirrel.Eq{"pullreq_activity_type": opts.Types})
-	}
-
-	if len(opts.Kinds) == 1 {
-		stmt = stmt.Where("pullreq_activity_kind = ?", opts.Kinds[0])
-	} else if len(opts.Kinds) > 1 {
-		stmt = stmt.Where(squirrel.Eq{"pullreq_activity_kind": opts.Kinds})
-	}
-
-	if opts.After != 0 {
-		stmt = stmt.Where("pullreq_activity_created > ?", opts.After)
-	}
+		Where(ownerColumn+" = ?", ownerID)
 
-	if opts.Before != 0 {
-		stmt = stmt.Where("pullreq_activity_created < ?", opts.Before)
-	}
+	stmt = applyPullReqActivityFilter(stmt, opts)
 
 	if opts.Limit > 0 {
 		stmt = stmt.Limit(database.Limit(opts.Limit))
@@ -437,6 +461,33 @@ func (s *PullReqActivityStore) List(ctx context.Context,
 	return result, nil
 }
 
+func applyPullReqActivityFilter(
+	stmt squirrel.SelectBuilder,
+	opts *types.PullReqActivityFilter,
+) squirrel.SelectBuilder {
+	if len(opts.Types) == 1 {
+		stmt = stmt.Where("pullreq_activity_type = ?", opts.Types[0])
+	} else if len(opts.Types) > 1 {
+		stmt = stmt.Where(squirrel.Eq{"pullreq_activity_type": opts.Types})
+	}
+
+	if len(opts.Kinds) == 1 {
+		stmt = stmt.Where("pullreq_activity_kind = ?", opts.Kinds[0])
+	} else if len(opts.Kinds) > 1 {
+		stmt = stmt.Where(squirrel.Eq{"pullreq_activity_kind": opts.Kinds})
+	}
+
+	if opts.After != 0 {
+		stmt = stmt.Where("pullreq_activity_created > ?", opts.After)
+	}
+
+	if opts.Before != 0 {
+		stmt = stmt.Where("pullreq_activity_created < ?", opts.Before)
+	}
+
+	return stmt
+}
+
 func (s *PullReqActivityStore) CountUnresolved(ctx context.Context, prID int64) (int, error) {
 	stmt := database.Builder.
 		Select("count(*)").
@@ -474,7 +525,8 @@ func mapPullReqActivity(act *pullReqActivity) *types.PullReqActivity {
 		Deleted:    act.Deleted.Ptr(),
 		ParentID:   act.ParentID.Ptr(),
 		RepoID:     act.RepoID,
-		PullReqID:  act.PullReqID,
+		PullReqID:  act.PullReqID.Int64,
+		IssueID:    act.IssueID.Int64,
 		Order:      act.Order,
 		SubOrder:   act.SubOrder,
 		ReplySeq:   act.ReplySeq,
@@ -517,7 +569,8 @@ func mapInternalPullReqActivity(act *types.PullReqActivity) *pullReqActivity {
 		Deleted:    null.IntFromPtr(act.Deleted),
 		ParentID:   null.IntFromPtr(act.ParentID),
 		RepoID:     act.RepoID,
-		PullReqID:  act.PullReqID,
+		PullReqID:  null.NewInt(act.PullReqID, act.PullReqID != 0),
+		IssueID:    null.NewInt(act.IssueID, act.IssueID != 0),
 		Order:      act.Order,
 		SubOrder:   act.SubOrder,
 		ReplySeq:   act.ReplySeq,
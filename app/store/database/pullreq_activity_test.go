@@ -0,0 +1,215 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/harness/gitness/app/store/database/migrate"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type fakePrincipalInfoCache struct{}
+
+func (fakePrincipalInfoCache) Stats() (int64, int64) { return 0, 0 }
+
+func (fakePrincipalInfoCache) Get(_ context.Context, id int64) (*types.PrincipalInfo, error) {
+	return &types.PrincipalInfo{ID: id}, nil
+}
+
+func (fakePrincipalInfoCache) Map(_ context.Context, ids []int64) (map[int64]*types.PrincipalInfo, error) {
+	m := make(map[int64]*types.PrincipalInfo, len(ids))
+	for _, id := range ids {
+		m[id] = &types.PrincipalInfo{ID: id}
+	}
+	return m, nil
+}
+
+// setupTestDB creates a migrated sqlite database with a user and a repository
+// that has a pull request and an issue, both with the ID 1.
+func setupTestDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	ctx := context.Background()
+	db, err := database.ConnectAndMigrate(ctx, "sqlite3",
+		filepath.Join(t.TempDir(), "database.sqlite3"), migrate.Migrate)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	fixtures := []string{
+		`INSERT INTO principals (principal_id, principal_uid, principal_uid_unique, principal_email,
+			principal_type, principal_display_name, principal_admin, principal_blocked, principal_salt,
+			principal_created, principal_updated)
+		VALUES (1, 'user', 'user', 'user@example.com', 'user', 'User', false, false, 'salt', 0, 0)`,
+		`INSERT INTO spaces (space_id, space_uid, space_is_public, space_created_by, space_created, space_updated)
+		VALUES (1, 'space', false, 1, 0, 0)`,
+		`INSERT INTO repositories (repo_id, repo_parent_id, repo_uid, repo_is_public, repo_created_by,
+			repo_created, repo_updated, repo_git_uid, repo_default_branch, repo_pullreq_seq, repo_num_forks,
+			repo_num_pulls, repo_num_closed_pulls, repo_num_open_pulls, repo_num_merged_pulls)
+		VALUES (1, 1, 'repo', false, 1, 0, 0, 'git-uid', 'main', 1, 0, 1, 0, 1, 0)`,
+		`INSERT INTO pullreqs (pullreq_id, pullreq_created_by, pullreq_created, pullreq_updated, pullreq_edited,
+			pullreq_number, pullreq_state, pullreq_title, pullreq_description, pullreq_source_repo_id,
+			pullreq_source_branch, pullreq_source_sha, pullreq_target_repo_id, pullreq_target_branch,
+			pullreq_merge_check_status)
+		VALUES (1, 1, 0, 0, 0, 1, 'open', 'pr', '', 1, 'feature', 'sha', 1, 'main', 'unchecked')`,
+		`INSERT INTO issues (issue_id, issue_version, issue_number, issue_created_by, issue_created,
+			issue_updated, issue_edited, issue_state, issue_title, issue_description, issue_repo_id)
+		VALUES (1, 0, 1, 1, 0, 0, 0, 'open', 'issue', '', 1)`,
+	}
+	for _, fixture := range fixtures {
+		if _, err = db.ExecContext(ctx, fixture); err != nil {
+			t.Fatalf("failed to insert fixture: %v", err)
+		}
+	}
+
+	return db
+}
+
+func TestPullReqActivityStoreIssueActivities(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	s := NewPullReqActivityStore(db, fakePrincipalInfoCache{})
+
+	pr := &types.PullReq{ID: 1, TargetRepoID: 1, ActivitySeq: 1}
+	issue := &types.Issue{ID: 1, RepoID: 1, ActivitySeq: 1}
+
+	prAct, err := s.CreateWithPayload(ctx, pr, 1, &types.PullRequestActivityPayloadTitleChange{Old: "a", New: "b"})
+	if err != nil {
+		t.Fatalf("failed to create pull request activity: %v", err)
+	}
+
+	issueAct, err := s.CreateWithPayloadForIssue(ctx, issue, 1,
+		&types.IssueActivityPayloadStateChange{Old: enum.IssueStateOpen, New: enum.IssueStateClosed})
+	if err != nil {
+		t.Fatalf("failed to create issue activity: %v", err)
+	}
+
+	issue.ActivitySeq = 2
+	comment := &types.PullReqActivity{
+		CreatedBy: 1,
+		RepoID:    1,
+		IssueID:   issue.ID,
+		Order:     issue.ActivitySeq,
+		Type:      enum.PullReqActivityTypeComment,
+		Kind:      enum.PullReqActivityKindComment,
+		Text:      "comment",
+	}
+	_ = comment.SetPayload(&types.PullRequestActivityPayloadComment{})
+	if err = s.Create(ctx, comment); err != nil {
+		t.Fatalf("failed to create issue comment: %v", err)
+	}
+
+	found, err := s.Find(ctx, issueAct.ID)
+	if err != nil {
+		t.Fatalf("failed to find issue activity: %v", err)
+	}
+	if found.IssueID != issue.ID || found.PullReqID != 0 {
+		t.Errorf("issue activity has issue ID %d and pull request ID %d", found.IssueID, found.PullReqID)
+	}
+
+	payload, err := found.GetPayload()
+	if err != nil {
+		t.Fatalf("failed to get issue activity payload: %v", err)
+	}
+	if p, ok := payload.(*types.IssueActivityPayloadStateChange); !ok || p.New != enum.IssueStateClosed {
+		t.Errorf("unexpected issue activity payload: %#v", payload)
+	}
+
+	prActs, err := s.List(ctx, pr.ID, &types.PullReqActivityFilter{})
+	if err != nil {
+		t.Fatalf("failed to list pull request activities: %v", err)
+	}
+	if len(prActs) != 1 || prActs[0].ID != prAct.ID {
+		t.Errorf("expected only the pull request activity, got %d activities", len(prActs))
+	}
+
+	issueActs, err := s.ListForIssue(ctx, issue.ID, &types.PullReqActivityFilter{})
+	if err != nil {
+		t.Fatalf("failed to list issue activities: %v", err)
+	}
+	if len(issueActs) != 2 || issueActs[0].ID != issueAct.ID || issueActs[1].ID != comment.ID {
+		t.Errorf("expected the two issue activities in order, got %d activities", len(issueActs))
+	}
+
+	tests := []struct {
+		name   string
+		filter *types.PullReqActivityFilter
+		exp    int64
+	}{
+		{name: "all", filter: &types.PullReqActivityFilter{}, exp: 2},
+		{
+			name:   "comments",
+			filter: &types.PullReqActivityFilter{Kinds: []enum.PullReqActivityKind{enum.PullReqActivityKindComment}},
+			exp:    1,
+		},
+		{
+			name:   "title-change",
+			filter: &types.PullReqActivityFilter{Types: []enum.PullReqActivityType{enum.PullReqActivityTypeTitleChange}},
+			exp:    0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			count, err := s.CountForIssue(ctx, issue.ID, test.filter)
+			if err != nil {
+				t.Fatalf("failed to count issue activities: %v", err)
+			}
+			if count != test.exp {
+				t.Errorf("expected %d activities, got %d", test.exp, count)
+			}
+		})
+	}
+}
+
+func TestPullReqActivityStoreRequiresSingleOwner(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	s := NewPullReqActivityStore(db, fakePrincipalInfoCache{})
+
+	tests := []struct {
+		name      string
+		pullReqID int64
+		issueID   int64
+	}{
+		{name: "none"},
+		{name: "both", pullReqID: 1, issueID: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			act := &types.PullReqActivity{
+				CreatedBy: 1,
+				RepoID:    1,
+				PullReqID: test.pullReqID,
+				IssueID:   test.issueID,
+				Order:     10,
+				Type:      enum.PullReqActivityTypeComment,
+				Kind:      enum.PullReqActivityKindComment,
+			}
+			_ = act.SetPayload(&types.PullRequestActivityPayloadComment{})
+			if err := s.Create(ctx, act); err == nil {
+				t.Error("expected the activity to be rejected")
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.PullReqLabelStore = (*PullReqLabelStore)(nil)
+
+// NewPullReqLabelStore returns a new PullReqLabelStore.
+func NewPullReqLabelStore(db *sqlx.DB) *PullReqLabelStore {
+	return &PullReqLabelStore{
+		db: db,
+	}
+}
+
+// PullReqLabelStore implements store.PullReqLabelStore backed by a relational database.
+type PullReqLabelStore struct {
+	db *sqlx.DB
+}
+
+// pullReqLabel is used to fetch the labels of pull requests from the database.
+type pullReqLabel struct {
+	PullReqID int64 `db:"pullreq_label_pullreq_id"`
+	label
+}
+
+// Assign assigns a label to a pull request.
+func (s *PullReqLabelStore) Assign(ctx context.Context, prLabel *types.PullReqLabel) error {
+	const sqlQuery = `
+		INSERT INTO pullreq_labels (
+			pullreq_label_pullreq_id
+			,pullreq_label_label_id
+			,pullreq_label_created_by
+			,pullreq_label_created
+		) values ($1, $2, $3, $4)`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery,
+		prLabel.PullReqID, prLabel.LabelID, prLabel.CreatedBy, prLabel.Created); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Unassign removes a label from a pull request.
+// It returns store.ErrResourceNotFound if the label isn't assigned to the pull request.
+func (s *PullReqLabelStore) Unassign(ctx context.Context, pullReqID int64, labelID int64) error {
+	const sqlQuery = `
+		DELETE FROM pullreq_labels
+		WHERE pullreq_label_pullreq_id = $1 AND pullreq_label_label_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	result, err := db.ExecContext(ctx, sqlQuery, pullReqID, labelID)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to get number of deleted rows")
+	}
+
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+// ListLabels returns the labels assigned to the pull request.
+func (s *PullReqLabelStore) ListLabels(ctx context.Context, pullReqID int64) ([]*types.Label, error) {
+	labels, err := s.ListLabelsForPullReqs(ctx, []int64{pullReqID})
+	if err != nil {
+		return nil, err
+	}
+
+	return labels[pullReqID], nil
+}
+
+// ListLabelsForPullReqs returns the labels assigned to the provided pull requests mapped by pull request id.
+func (s *PullReqLabelStore) ListLabelsForPullReqs(ctx context.Context,
+	pullReqIDs []int64) (map[int64][]*types.Label, error) {
+	stmt := database.Builder.
+		Select("pullreq_label_pullreq_id,"+labelColumns).
+		From("pullreq_labels").
+		InnerJoin("labels ON label_id = pullreq_label_label_id").
+		Where(squirrel.Eq{"pullreq_label_pullreq_id": pullReqIDs}).
+		OrderBy("LOWER(label_name)", "label_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*pullReqLabel{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	res := make(map[int64][]*types.Label, len(pullReqIDs))
+	for _, prLabel := range dst {
+		l, err := mapToLabel(&prLabel.label)
+		if err != nil {
+			return nil, err
+		}
+
+		res[prLabel.PullReqID] = append(res[prLabel.PullReqID], l)
+	}
+
+	return res, nil
+}
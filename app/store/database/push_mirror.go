@@ -0,0 +1,218 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.PushMirrorStore = (*PushMirrorStore)(nil)
+
+// NewPushMirrorStore returns a new PushMirrorStore.
+func NewPushMirrorStore(db *sqlx.DB) *PushMirrorStore {
+	return &PushMirrorStore{db}
+}
+
+// PushMirrorStore implements a PushMirrorStore backed by a relational database.
+type PushMirrorStore struct {
+	db *sqlx.DB
+}
+
+// Find finds the push mirror by id.
+func (s *PushMirrorStore) Find(ctx context.Context, id int64) (*types.PushMirror, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.PushMirror)
+	if err := db.GetContext(ctx, dst, pushMirrorSelectByID, id); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find push mirror")
+	}
+
+	return dst, nil
+}
+
+// FindByUID finds the push mirror by repo ID and push mirror UID.
+func (s *PushMirrorStore) FindByUID(ctx context.Context, repoID int64, uid string) (*types.PushMirror, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.PushMirror)
+	if err := db.GetContext(ctx, dst, pushMirrorSelectByRepoIDAndUID, repoID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find push mirror by UID")
+	}
+
+	return dst, nil
+}
+
+// Create saves the push mirror details.
+func (s *PushMirrorStore) Create(ctx context.Context, mirror *types.PushMirror) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(pushMirrorInsert, mirror)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind push mirror object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&mirror.ID); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Update updates the push mirror details. It will set a new value to the Updated field.
+func (s *PushMirrorStore) Update(ctx context.Context, mirror *types.PushMirror) error {
+	return s.update(ctx, pushMirrorUpdate, mirror)
+}
+
+// UpdatePushStatus updates only the status of the last push of the push mirror.
+// It will set a new value to the Updated field.
+func (s *PushMirrorStore) UpdatePushStatus(ctx context.Context, mirror *types.PushMirror) error {
+	return s.update(ctx, pushMirrorUpdatePushStatus, mirror)
+}
+
+func (s *PushMirrorStore) update(ctx context.Context, sqlQuery string, mirror *types.PushMirror) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	updated := *mirror
+	updated.Updated = time.Now().UnixMilli()
+
+	query, arg, err := db.BindNamed(sqlQuery, &updated)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind push mirror object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(err, "Update query failed")
+	}
+
+	*mirror = updated
+
+	return nil
+}
+
+// Delete deletes the push mirror with the given id.
+func (s *PushMirrorStore) Delete(ctx context.Context, id int64) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, pushMirrorDelete, id); err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	return nil
+}
+
+// List returns all push mirrors of a repository.
+func (s *PushMirrorStore) List(ctx context.Context, repoID int64) ([]*types.PushMirror, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.PushMirror{}
+
+	if err := db.SelectContext(ctx, &dst, pushMirrorSelectForRepoID, repoID); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed executing push mirror list query")
+	}
+
+	return dst, nil
+}
+
+const pushMirrorSelectBase = `
+SELECT
+push_mirror_id
+,push_mirror_repo_id
+,push_mirror_uid
+,push_mirror_created_by
+,push_mirror_created
+,push_mirror_updated
+,push_mirror_remote_url
+,push_mirror_credentials
+,push_mirror_protected_branches_only
+,push_mirror_last_push
+,push_mirror_last_push_status
+,push_mirror_last_push_error
+FROM push_mirrors
+`
+
+const pushMirrorSelectByID = pushMirrorSelectBase + `
+WHERE push_mirror_id = $1
+`
+
+const pushMirrorSelectByRepoIDAndUID = pushMirrorSelectBase + `
+WHERE push_mirror_repo_id = $1 AND LOWER(push_mirror_uid) = LOWER($2)
+`
+
+const pushMirrorSelectForRepoID = pushMirrorSelectBase + `
+WHERE push_mirror_repo_id = $1
+ORDER BY push_mirror_uid ASC
+`
+
+const pushMirrorDelete = `
+DELETE FROM push_mirrors
+WHERE push_mirror_id = $1
+`
+
+const pushMirrorInsert = `
+INSERT INTO push_mirrors (
+	push_mirror_repo_id
+	,push_mirror_uid
+	,push_mirror_created_by
+	,push_mirror_created
+	,push_mirror_updated
+	,push_mirror_remote_url
+	,push_mirror_credentials
+	,push_mirror_protected_branches_only
+	,push_mirror_last_push
+	,push_mirror_last_push_status
+	,push_mirror_last_push_error
+) values (
+	:push_mirror_repo_id
+	,:push_mirror_uid
+	,:push_mirror_created_by
+	,:push_mirror_created
+	,:push_mirror_updated
+	,:push_mirror_remote_url
+	,:push_mirror_credentials
+	,:push_mirror_protected_branches_only
+	,:push_mirror_last_push
+	,:push_mirror_last_push_status
+	,:push_mirror_last_push_error
+) RETURNING push_mirror_id
+`
+
+const pushMirrorUpdate = `
+UPDATE push_mirrors
+SET
+	push_mirror_updated = :push_mirror_updated
+	,push_mirror_uid = :push_mirror_uid
+	,push_mirror_remote_url = :push_mirror_remote_url
+	,push_mirror_credentials = :push_mirror_credentials
+	,push_mirror_protected_branches_only = :push_mirror_protected_branches_only
+WHERE push_mirror_id = :push_mirror_id
+`
+
+const pushMirrorUpdatePushStatus = `
+UPDATE push_mirrors
+SET
+	push_mirror_updated = :push_mirror_updated
+	,push_mirror_last_push = :push_mirror_last_push
+	,push_mirror_last_push_status = :push_mirror_last_push_status
+	,push_mirror_last_push_error = :push_mirror_last_push_error
+WHERE push_mirror_id = :push_mirror_id
+`
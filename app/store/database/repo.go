@@ -16,6 +16,7 @@ package database
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/jmoiron/sqlx"
+	sqlxtypes "github.com/jmoiron/sqlx/types"
 	"github.com/pkg/errors"
 )
 
@@ -71,6 +73,7 @@ type repository struct {
 	DefaultBranch string `db:"repo_default_branch"`
 	ForkID        int64  `db:"repo_fork_id"`
 	PullReqSeq    int64  `db:"repo_pullreq_seq"`
+	IssueSeq      int64  `db:"repo_issue_seq"`
 
 	NumForks       int `db:"repo_num_forks"`
 	NumPulls       int `db:"repo_num_pulls"`
@@ -79,6 +82,20 @@ type repository struct {
 	NumMergedPulls int `db:"repo_num_merged_pulls"`
 
 	Importing bool `db:"repo_importing"`
+	IsMirror  bool `db:"repo_is_mirror"`
+
+	PullReqMinApprovals          int  `db:"repo_pullreq_min_approvals"`
+	PullReqDismissStaleApprovals bool `db:"repo_pullreq_dismiss_stale_approvals"`
+	PullReqRequirePeerApproval   bool `db:"repo_pullreq_require_peer_approval"`
+
+	PullReqRequireCodeOwnerApproval bool `db:"repo_pullreq_require_code_owner_approval"`
+
+	PullReqAllowedMergeMethods sqlxtypes.JSONText `db:"repo_pullreq_allowed_merge_methods"`
+
+	PullReqMergeTitleTemplate    string `db:"repo_pullreq_merge_title_template"`
+	PullReqMergeMessageTemplate  string `db:"repo_pullreq_merge_message_template"`
+	PullReqSquashTitleTemplate   string `db:"repo_pullreq_squash_title_template"`
+	PullReqSquashMessageTemplate string `db:"repo_pullreq_squash_message_template"`
 }
 
 const (
@@ -95,13 +112,24 @@ const (
 		,repo_git_uid
 		,repo_default_branch
 		,repo_pullreq_seq
+		,repo_issue_seq
 		,repo_fork_id
 		,repo_num_forks
 		,repo_num_pulls
 		,repo_num_closed_pulls
 		,repo_num_open_pulls
 		,repo_num_merged_pulls
-		,repo_importing`
+		,repo_importing
+		,repo_is_mirror
+		,repo_pullreq_min_approvals
+		,repo_pullreq_dismiss_stale_approvals
+		,repo_pullreq_require_peer_approval
+		,repo_pullreq_require_code_owner_approval
+		,repo_pullreq_allowed_merge_methods
+		,repo_pullreq_merge_title_template
+		,repo_pullreq_merge_message_template
+		,repo_pullreq_squash_title_template
+		,repo_pullreq_squash_message_template`
 
 	repoSelectBase = `
 		SELECT` + repoColumnsForJoin + `
@@ -110,9 +138,22 @@ const (
 
 // Find finds the repo by id.
 func (s *RepoStore) Find(ctx context.Context, id int64) (*types.Repository, error) {
-	const sqlQuery = repoSelectBase + `
+	return s.findInternal(ctx, id, false)
+}
+
+// FindWithLock finds the repo by id and locks the repo for the duration of the transaction.
+func (s *RepoStore) FindWithLock(ctx context.Context, id int64) (*types.Repository, error) {
+	return s.findInternal(ctx, id, true)
+}
+
+func (s *RepoStore) findInternal(ctx context.Context, id int64, lock bool) (*types.Repository, error) {
+	sqlQuery := repoSelectBase + `
 		WHERE repo_id = $1`
 
+	if lock && !strings.HasPrefix(s.db.DriverName(), "sqlite") {
+		sqlQuery += "\n" + database.SQLForUpdate
+	}
+
 	db := dbtx.GetAccessor(ctx, s.db)
 
 	dst := new(repository)
@@ -174,12 +215,23 @@ func (s *RepoStore) Create(ctx context.Context, repo *types.Repository) error {
 			,repo_default_branch
 			,repo_fork_id
 			,repo_pullreq_seq
+			,repo_issue_seq
 			,repo_num_forks
 			,repo_num_pulls
 			,repo_num_closed_pulls
 			,repo_num_open_pulls
 			,repo_num_merged_pulls
 			,repo_importing
+			,repo_is_mirror
+			,repo_pullreq_min_approvals
+			,repo_pullreq_dismiss_stale_approvals
+			,repo_pullreq_require_peer_approval
+			,repo_pullreq_require_code_owner_approval
+			,repo_pullreq_allowed_merge_methods
+			,repo_pullreq_merge_title_template
+			,repo_pullreq_merge_message_template
+			,repo_pullreq_squash_title_template
+			,repo_pullreq_squash_message_template
 		) values (
 			:repo_version
 			,:repo_parent_id
@@ -193,12 +245,23 @@ func (s *RepoStore) Create(ctx context.Context, repo *types.Repository) error {
 			,:repo_default_branch
 			,:repo_fork_id
 			,:repo_pullreq_seq
+			,:repo_issue_seq
 			,:repo_num_forks
 			,:repo_num_pulls
 			,:repo_num_closed_pulls
 			,:repo_num_open_pulls
 			,:repo_num_merged_pulls
 			,:repo_importing
+			,:repo_is_mirror
+			,:repo_pullreq_min_approvals
+			,:repo_pullreq_dismiss_stale_approvals
+			,:repo_pullreq_require_peer_approval
+			,:repo_pullreq_require_code_owner_approval
+			,:repo_pullreq_allowed_merge_methods
+			,:repo_pullreq_merge_title_template
+			,:repo_pullreq_merge_message_template
+			,:repo_pullreq_squash_title_template
+			,:repo_pullreq_squash_message_template
 		) RETURNING repo_id`
 
 	db := dbtx.GetAccessor(ctx, s.db)
@@ -235,12 +298,23 @@ func (s *RepoStore) Update(ctx context.Context, repo *types.Repository) error {
 			,repo_is_public = :repo_is_public
 			,repo_default_branch = :repo_default_branch
 			,repo_pullreq_seq = :repo_pullreq_seq
+			,repo_issue_seq = :repo_issue_seq
 			,repo_num_forks = :repo_num_forks
 			,repo_num_pulls = :repo_num_pulls
 			,repo_num_closed_pulls = :repo_num_closed_pulls
 			,repo_num_open_pulls = :repo_num_open_pulls
 			,repo_num_merged_pulls = :repo_num_merged_pulls
 			,repo_importing = :repo_importing
+			,repo_is_mirror = :repo_is_mirror
+			,repo_pullreq_min_approvals = :repo_pullreq_min_approvals
+			,repo_pullreq_dismiss_stale_approvals = :repo_pullreq_dismiss_stale_approvals
+			,repo_pullreq_require_peer_approval = :repo_pullreq_require_peer_approval
+			,repo_pullreq_require_code_owner_approval = :repo_pullreq_require_code_owner_approval
+			,repo_pullreq_allowed_merge_methods = :repo_pullreq_allowed_merge_methods
+			,repo_pullreq_merge_title_template = :repo_pullreq_merge_title_template
+			,repo_pullreq_merge_message_template = :repo_pullreq_merge_message_template
+			,repo_pullreq_squash_title_template = :repo_pullreq_squash_title_template
+			,repo_pullreq_squash_message_template = :repo_pullreq_squash_message_template
 		WHERE repo_id = :repo_id AND repo_version = :repo_version - 1`
 
 	dbRepo := mapToInternalRepo(repo)
@@ -414,15 +488,32 @@ func (s *RepoStore) mapToRepo(
 		DefaultBranch:  in.DefaultBranch,
 		ForkID:         in.ForkID,
 		PullReqSeq:     in.PullReqSeq,
+		IssueSeq:       in.IssueSeq,
 		NumForks:       in.NumForks,
 		NumPulls:       in.NumPulls,
 		NumClosedPulls: in.NumClosedPulls,
 		NumOpenPulls:   in.NumOpenPulls,
 		NumMergedPulls: in.NumMergedPulls,
 		Importing:      in.Importing,
+		IsMirror:       in.IsMirror,
+
+		PullReqMinApprovals:          in.PullReqMinApprovals,
+		PullReqDismissStaleApprovals: in.PullReqDismissStaleApprovals,
+		PullReqRequirePeerApproval:   in.PullReqRequirePeerApproval,
+
+		PullReqRequireCodeOwnerApproval: in.PullReqRequireCodeOwnerApproval,
+
+		PullReqMergeTitleTemplate:    in.PullReqMergeTitleTemplate,
+		PullReqMergeMessageTemplate:  in.PullReqMergeMessageTemplate,
+		PullReqSquashTitleTemplate:   in.PullReqSquashTitleTemplate,
+		PullReqSquashMessageTemplate: in.PullReqSquashMessageTemplate,
 		// Path: is set below
 	}
 
+	if err = json.Unmarshal(in.PullReqAllowedMergeMethods, &res.PullReqAllowedMergeMethods); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal allowed merge methods of repo %d: %w", in.ID, err)
+	}
+
 	res.Path, err = s.getRepoPath(ctx, in.ParentID, in.UID)
 	if err != nil {
 		return nil, err
@@ -469,11 +560,26 @@ func mapToInternalRepo(in *types.Repository) *repository {
 		DefaultBranch:  in.DefaultBranch,
 		ForkID:         in.ForkID,
 		PullReqSeq:     in.PullReqSeq,
+		IssueSeq:       in.IssueSeq,
 		NumForks:       in.NumForks,
 		NumPulls:       in.NumPulls,
 		NumClosedPulls: in.NumClosedPulls,
 		NumOpenPulls:   in.NumOpenPulls,
 		NumMergedPulls: in.NumMergedPulls,
 		Importing:      in.Importing,
+		IsMirror:       in.IsMirror,
+
+		PullReqMinApprovals:          in.PullReqMinApprovals,
+		PullReqDismissStaleApprovals: in.PullReqDismissStaleApprovals,
+		PullReqRequirePeerApproval:   in.PullReqRequirePeerApproval,
+
+		PullReqRequireCodeOwnerApproval: in.PullReqRequireCodeOwnerApproval,
+
+		PullReqAllowedMergeMethods: EncodeToSQLXJSON(in.PullReqAllowedMergeMethods),
+
+		PullReqMergeTitleTemplate:    in.PullReqMergeTitleTemplate,
+		PullReqMergeMessageTemplate:  in.PullReqMergeMessageTemplate,
+		PullReqSquashTitleTemplate:   in.PullReqSquashTitleTemplate,
+		PullReqSquashMessageTemplate: in.PullReqSquashMessageTemplate,
 	}
 }
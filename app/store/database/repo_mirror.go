@@ -0,0 +1,202 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.RepoMirrorStore = (*RepoMirrorStore)(nil)
+
+// NewRepoMirrorStore returns a new RepoMirrorStore.
+func NewRepoMirrorStore(db *sqlx.DB) *RepoMirrorStore {
+	return &RepoMirrorStore{db}
+}
+
+// RepoMirrorStore implements a RepoMirrorStore backed by a relational database.
+type RepoMirrorStore struct {
+	db *sqlx.DB
+}
+
+// Find finds the mirror details of a repository.
+func (s *RepoMirrorStore) Find(ctx context.Context, repoID int64) (*types.RepoMirror, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.RepoMirror)
+	if err := db.GetContext(ctx, dst, repoMirrorSelectByRepoID, repoID); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find repo mirror")
+	}
+
+	return dst, nil
+}
+
+// Create saves the mirror details of a repository.
+func (s *RepoMirrorStore) Create(ctx context.Context, mirror *types.RepoMirror) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(repoMirrorInsert, mirror)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind repo mirror object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Update updates the mirror details of a repository. It will set a new value to the Updated field.
+func (s *RepoMirrorStore) Update(ctx context.Context, mirror *types.RepoMirror) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	updated := *mirror
+	updated.Updated = time.Now().UnixMilli()
+
+	query, arg, err := db.BindNamed(repoMirrorUpdate, &updated)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind repo mirror object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(err, "Update query failed")
+	}
+
+	*mirror = updated
+
+	return nil
+}
+
+// UpdateSyncStatus updates only the sync schedule and the sync status of a mirror.
+// It will set a new value to the Updated field.
+func (s *RepoMirrorStore) UpdateSyncStatus(ctx context.Context, mirror *types.RepoMirror) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	updated := *mirror
+	updated.Updated = time.Now().UnixMilli()
+
+	query, arg, err := db.BindNamed(repoMirrorUpdateSyncStatus, &updated)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind repo mirror object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(err, "Update query failed")
+	}
+
+	*mirror = updated
+
+	return nil
+}
+
+// ListDue returns the mirrors that are due to be synced at the provided time (unix millis).
+func (s *RepoMirrorStore) ListDue(ctx context.Context, now int64, limit int) ([]*types.RepoMirror, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.RepoMirror{}
+
+	if err := db.SelectContext(ctx, &dst, repoMirrorSelectDue, now, limit); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed executing repo mirror list query")
+	}
+
+	return dst, nil
+}
+
+const repoMirrorSelectBase = `
+SELECT
+mirror_repo_id
+,mirror_created_by
+,mirror_created
+,mirror_updated
+,mirror_remote_url
+,mirror_credentials
+,mirror_interval
+,mirror_next_sync
+,mirror_last_sync
+,mirror_last_sync_status
+,mirror_last_sync_error
+FROM repo_mirrors
+`
+
+const repoMirrorSelectByRepoID = repoMirrorSelectBase + `
+WHERE mirror_repo_id = $1
+`
+
+const repoMirrorSelectDue = repoMirrorSelectBase + `
+WHERE mirror_next_sync <= $1
+ORDER BY mirror_next_sync ASC
+LIMIT $2
+`
+
+const repoMirrorInsert = `
+INSERT INTO repo_mirrors (
+	mirror_repo_id
+	,mirror_created_by
+	,mirror_created
+	,mirror_updated
+	,mirror_remote_url
+	,mirror_credentials
+	,mirror_interval
+	,mirror_next_sync
+	,mirror_last_sync
+	,mirror_last_sync_status
+	,mirror_last_sync_error
+) values (
+	:mirror_repo_id
+	,:mirror_created_by
+	,:mirror_created
+	,:mirror_updated
+	,:mirror_remote_url
+	,:mirror_credentials
+	,:mirror_interval
+	,:mirror_next_sync
+	,:mirror_last_sync
+	,:mirror_last_sync_status
+	,:mirror_last_sync_error
+)
+`
+
+const repoMirrorUpdate = `
+UPDATE repo_mirrors
+SET
+	mirror_updated = :mirror_updated
+	,mirror_remote_url = :mirror_remote_url
+	,mirror_credentials = :mirror_credentials
+	,mirror_interval = :mirror_interval
+	,mirror_next_sync = :mirror_next_sync
+	,mirror_last_sync = :mirror_last_sync
+	,mirror_last_sync_status = :mirror_last_sync_status
+	,mirror_last_sync_error = :mirror_last_sync_error
+WHERE mirror_repo_id = :mirror_repo_id
+`
+
+const repoMirrorUpdateSyncStatus = `
+UPDATE repo_mirrors
+SET
+	mirror_updated = :mirror_updated
+	,mirror_next_sync = :mirror_next_sync
+	,mirror_last_sync = :mirror_last_sync
+	,mirror_last_sync_status = :mirror_last_sync_status
+	,mirror_last_sync_error = :mirror_last_sync_error
+WHERE mirror_repo_id = :mirror_repo_id
+`
@@ -0,0 +1,477 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+var _ store.RuleStore = (*RuleStore)(nil)
+
+// NewRuleStore returns a new RuleStore.
+func NewRuleStore(db *sqlx.DB) *RuleStore {
+	return &RuleStore{
+		db: db,
+	}
+}
+
+// RuleStore implements store.RuleStore backed by a relational database.
+type RuleStore struct {
+	db *sqlx.DB
+}
+
+// rule is an internal representation used to store protection rule data in the database.
+type rule struct {
+	ID        int64    `db:"rule_id"`
+	Version   int64    `db:"rule_version"`
+	RepoID    null.Int `db:"rule_repo_id"`
+	SpaceID   null.Int `db:"rule_space_id"`
+	CreatedBy int64    `db:"rule_created_by"`
+	Created   int64    `db:"rule_created"`
+	Updated   int64    `db:"rule_updated"`
+
+	UID         string `db:"rule_uid"`
+	Description string `db:"rule_description"`
+	Pattern     string `db:"rule_pattern"`
+	Definition  string `db:"rule_definition"`
+}
+
+const (
+	ruleColumns = `
+		 rule_id
+		,rule_version
+		,rule_repo_id
+		,rule_space_id
+		,rule_created_by
+		,rule_created
+		,rule_updated
+		,rule_uid
+		,rule_description
+		,rule_pattern
+		,rule_definition`
+
+	ruleSelectBase = `
+	SELECT` + ruleColumns + `
+	FROM rules`
+)
+
+// Find finds the protection rule by id.
+func (s *RuleStore) Find(ctx context.Context, id int64) (*types.Rule, error) {
+	const sqlQuery = ruleSelectBase + `
+		WHERE rule_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := &rule{}
+	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	res, err := mapToRule(dst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map rule to external type: %w", err)
+	}
+
+	return res, nil
+}
+
+// FindByUID finds the protection rule by its uid for a given parent type and id.
+func (s *RuleStore) FindByUID(ctx context.Context, parentType enum.RuleParent, parentID int64,
+	uid string) (*types.Rule, error) {
+	stmt := database.Builder.
+		Select(ruleColumns).
+		From("rules").
+		Where("LOWER(rule_uid) = ?", strings.ToLower(uid))
+
+	stmt, err := applyRuleParent(stmt, parentType, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := &rule{}
+	if err = db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	res, err := mapToRule(dst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map rule to external type: %w", err)
+	}
+
+	return res, nil
+}
+
+// Create creates a new protection rule.
+func (s *RuleStore) Create(ctx context.Context, r *types.Rule) error {
+	const sqlQuery = `
+		INSERT INTO rules (
+			rule_repo_id
+			,rule_space_id
+			,rule_created_by
+			,rule_created
+			,rule_updated
+			,rule_uid
+			,rule_description
+			,rule_pattern
+			,rule_definition
+		) values (
+			:rule_repo_id
+			,:rule_space_id
+			,:rule_created_by
+			,:rule_created
+			,:rule_updated
+			,:rule_uid
+			,:rule_description
+			,:rule_pattern
+			,:rule_definition
+		) RETURNING rule_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbRule, err := mapToInternalRule(r)
+	if err != nil {
+		return fmt.Errorf("failed to map rule to internal db type: %w", err)
+	}
+
+	query, arg, err := db.BindNamed(sqlQuery, dbRule)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind rule object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&r.ID); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Update updates an existing protection rule.
+func (s *RuleStore) Update(ctx context.Context, r *types.Rule) error {
+	const sqlQuery = `
+		UPDATE rules
+		SET
+			 rule_version = :rule_version
+			,rule_updated = :rule_updated
+			,rule_uid = :rule_uid
+			,rule_description = :rule_description
+			,rule_pattern = :rule_pattern
+			,rule_definition = :rule_definition
+		WHERE rule_id = :rule_id and rule_version = :rule_version - 1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbRule, err := mapToInternalRule(r)
+	if err != nil {
+		return fmt.Errorf("failed to map rule to internal db type: %w", err)
+	}
+
+	// update Version (used for optimistic locking) and Updated time
+	dbRule.Version++
+	dbRule.Updated = time.Now().UnixMilli()
+
+	query, arg, err := db.BindNamed(sqlQuery, dbRule)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind rule object")
+	}
+
+	result, err := db.ExecContext(ctx, query, arg...)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "failed to update rule")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to get number of updated rows")
+	}
+
+	if count == 0 {
+		return gitness_store.ErrVersionConflict
+	}
+
+	r.Version = dbRule.Version
+	r.Updated = dbRule.Updated
+
+	return nil
+}
+
+// UpdateOptLock updates the protection rule using the optimistic locking mechanism.
+func (s *RuleStore) UpdateOptLock(ctx context.Context, r *types.Rule,
+	mutateFn func(r *types.Rule) error) (*types.Rule, error) {
+	for {
+		dup := *r
+
+		err := mutateFn(&dup)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mutate the rule: %w", err)
+		}
+
+		err = s.Update(ctx, &dup)
+		if err == nil {
+			return &dup, nil
+		}
+		if !errors.Is(err, gitness_store.ErrVersionConflict) {
+			return nil, fmt.Errorf("failed to update the rule: %w", err)
+		}
+
+		r, err = s.Find(ctx, r.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the latst version of the rule: %w", err)
+		}
+	}
+}
+
+// Delete deletes the protection rule for the given id.
+func (s *RuleStore) Delete(ctx context.Context, id int64) error {
+	const sqlQuery = `
+		DELETE FROM rules
+		WHERE rule_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, id); err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	return nil
+}
+
+// Count counts the protection rules for a given parent type and id.
+func (s *RuleStore) Count(ctx context.Context, parentType enum.RuleParent, parentID int64,
+	opts *types.RuleFilter) (int64, error) {
+	stmt := database.Builder.
+		Select("count(*)").
+		From("rules")
+
+	stmt, err := applyRuleParent(stmt, parentType, parentID)
+	if err != nil {
+		return 0, err
+	}
+
+	if opts.Query != "" {
+		stmt = stmt.Where("LOWER(rule_uid) LIKE ?", fmt.Sprintf("%%%s%%", strings.ToLower(opts.Query)))
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var count int64
+	err = db.QueryRowContext(ctx, sql, args...).Scan(&count)
+	if err != nil {
+		return 0, database.ProcessSQLErrorf(err, "Failed executing count query")
+	}
+
+	return count, nil
+}
+
+// List lists the protection rules for a given parent type and id.
+func (s *RuleStore) List(ctx context.Context, parentType enum.RuleParent, parentID int64,
+	opts *types.RuleFilter) ([]*types.Rule, error) {
+	stmt := database.Builder.
+		Select(ruleColumns).
+		From("rules")
+
+	stmt, err := applyRuleParent(stmt, parentType, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Query != "" {
+		stmt = stmt.Where("LOWER(rule_uid) LIKE ?", fmt.Sprintf("%%%s%%", strings.ToLower(opts.Query)))
+	}
+
+	stmt = stmt.Limit(database.Limit(opts.Size))
+	stmt = stmt.Offset(database.Offset(opts.Page, opts.Size))
+
+	switch opts.Sort {
+	case enum.RuleAttrNone:
+		// NOTE: string concatenation is safe because the
+		// order attribute is an enum and is not user-defined,
+		// and is therefore not subject to injection attacks.
+		stmt = stmt.OrderBy("rule_id " + opts.Order.String())
+	case enum.RuleAttrUID:
+		stmt = stmt.OrderBy("LOWER(rule_uid) " + opts.Order.String())
+	case enum.RuleAttrCreated:
+		stmt = stmt.OrderBy("rule_created " + opts.Order.String())
+	case enum.RuleAttrUpdated:
+		stmt = stmt.OrderBy("rule_updated " + opts.Order.String())
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*rule{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	res, err := mapToRules(dst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map rules to external type: %w", err)
+	}
+
+	return res, nil
+}
+
+// ListAllForRepo lists all protection rules of a repository and the provided spaces.
+func (s *RuleStore) ListAllForRepo(ctx context.Context, repoID int64, spaceIDs []int64) ([]*types.Rule, error) {
+	stmt := database.Builder.
+		Select(ruleColumns).
+		From("rules").
+		Where(squirrel.Or{
+			squirrel.Eq{"rule_repo_id": repoID},
+			squirrel.Eq{"rule_space_id": spaceIDs},
+		}).
+		OrderBy("rule_id")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*rule{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Select query failed")
+	}
+
+	res, err := mapToRules(dst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map rules to external type: %w", err)
+	}
+
+	return res, nil
+}
+
+func applyRuleParent(
+	stmt squirrel.SelectBuilder,
+	parentType enum.RuleParent,
+	parentID int64,
+) (squirrel.SelectBuilder, error) {
+	switch parentType {
+	case enum.RuleParentRepo:
+		return stmt.Where("rule_repo_id = ?", parentID), nil
+	case enum.RuleParentSpace:
+		return stmt.Where("rule_space_id = ?", parentID), nil
+	default:
+		return stmt, fmt.Errorf("rule parent type '%s' is not supported", parentType)
+	}
+}
+
+func mapToRule(r *rule) (*types.Rule, error) {
+	res := &types.Rule{
+		ID:          r.ID,
+		Version:     r.Version,
+		CreatedBy:   r.CreatedBy,
+		Created:     r.Created,
+		Updated:     r.Updated,
+		UID:         r.UID,
+		Description: r.Description,
+		Pattern:     r.Pattern,
+	}
+
+	if err := json.Unmarshal([]byte(r.Definition), &res.Definition); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal definition of rule %d: %w", r.ID, err)
+	}
+
+	switch {
+	case r.RepoID.Valid && r.SpaceID.Valid:
+		return nil, fmt.Errorf("both repoID and spaceID are set for rule %d", r.ID)
+	case r.RepoID.Valid:
+		res.ParentType = enum.RuleParentRepo
+		res.ParentID = r.RepoID.Int64
+	case r.SpaceID.Valid:
+		res.ParentType = enum.RuleParentSpace
+		res.ParentID = r.SpaceID.Int64
+	default:
+		return nil, fmt.Errorf("neither repoID nor spaceID are set for rule %d", r.ID)
+	}
+
+	return res, nil
+}
+
+func mapToInternalRule(r *types.Rule) (*rule, error) {
+	definition, err := json.Marshal(r.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rule definition: %w", err)
+	}
+
+	res := &rule{
+		ID:          r.ID,
+		Version:     r.Version,
+		CreatedBy:   r.CreatedBy,
+		Created:     r.Created,
+		Updated:     r.Updated,
+		UID:         r.UID,
+		Description: r.Description,
+		Pattern:     r.Pattern,
+		Definition:  string(definition),
+	}
+
+	switch r.ParentType {
+	case enum.RuleParentRepo:
+		res.RepoID = null.IntFrom(r.ParentID)
+	case enum.RuleParentSpace:
+		res.SpaceID = null.IntFrom(r.ParentID)
+	default:
+		return nil, fmt.Errorf("rule parent type %q is not supported", r.ParentType)
+	}
+
+	return res, nil
+}
+
+func mapToRules(rules []*rule) ([]*types.Rule, error) {
+	var err error
+	m := make([]*types.Rule, len(rules))
+	for i, r := range rules {
+		m[i], err = mapToRule(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.SigningKeyStore = (*SigningKeyStore)(nil)
+
+// NewSigningKeyStore returns a new SigningKeyStore.
+func NewSigningKeyStore(db *sqlx.DB) *SigningKeyStore {
+	return &SigningKeyStore{db}
+}
+
+// SigningKeyStore implements a SigningKeyStore backed by a relational database.
+type SigningKeyStore struct {
+	db *sqlx.DB
+}
+
+// FindByUID finds the signing key by principal ID and signing key UID.
+func (s *SigningKeyStore) FindByUID(ctx context.Context, principalID int64, uid string) (*types.SigningKey, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.SigningKey)
+	if err := db.GetContext(ctx, dst, signingKeySelectByPrincipalIDAndUID, principalID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find signing key by UID")
+	}
+
+	return dst, nil
+}
+
+// Create saves the signing key details.
+func (s *SigningKeyStore) Create(ctx context.Context, key *types.SigningKey) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(signingKeyInsert, key)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind signing key object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&key.ID); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Delete deletes the signing key with the given id.
+func (s *SigningKeyStore) Delete(ctx context.Context, id int64) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, signingKeyDelete, id); err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	return nil
+}
+
+// List returns all signing keys of a specific principal.
+func (s *SigningKeyStore) List(ctx context.Context, principalID int64) ([]*types.SigningKey, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.SigningKey{}
+
+	err := db.SelectContext(ctx, &dst, signingKeySelectForPrincipalID, principalID)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed executing signing key list query")
+	}
+
+	return dst, nil
+}
+
+const signingKeySelectBase = `
+SELECT
+signing_key_id
+,signing_key_principal_id
+,signing_key_created
+,signing_key_uid
+,signing_key_type
+,signing_key_fingerprint
+,signing_key_content
+FROM signing_keys
+`
+
+const signingKeySelectByPrincipalIDAndUID = signingKeySelectBase + `
+WHERE signing_key_principal_id = $1 AND LOWER(signing_key_uid) = LOWER($2)
+`
+
+const signingKeySelectForPrincipalID = signingKeySelectBase + `
+WHERE signing_key_principal_id = $1
+ORDER BY signing_key_created DESC
+`
+
+const signingKeyDelete = `
+DELETE FROM signing_keys
+WHERE signing_key_id = $1
+`
+
+const signingKeyInsert = `
+INSERT INTO signing_keys (
+	signing_key_principal_id
+	,signing_key_created
+	,signing_key_uid
+	,signing_key_type
+	,signing_key_fingerprint
+	,signing_key_content
+) values (
+	:signing_key_principal_id
+	,:signing_key_created
+	,:signing_key_uid
+	,:signing_key_type
+	,:signing_key_fingerprint
+	,:signing_key_content
+) RETURNING signing_key_id
+`
@@ -16,6 +16,8 @@ package database
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/store/database"
@@ -38,35 +40,53 @@ type TokenStore struct {
 	db *sqlx.DB
 }
 
+type token struct {
+	ID          int64          `db:"token_id"`
+	PrincipalID int64          `db:"token_principal_id"`
+	Type        enum.TokenType `db:"token_type"`
+	UID         string         `db:"token_uid"`
+	ExpiresAt   *int64         `db:"token_expires_at"`
+	IssuedAt    int64          `db:"token_issued_at"`
+	CreatedBy   int64          `db:"token_created_by"`
+	Scopes      string         `db:"token_scopes"`
+	SpaceIDs    string         `db:"token_space_ids"`
+	RepoIDs     string         `db:"token_repo_ids"`
+}
+
 // Find finds the token by id.
 func (s *TokenStore) Find(ctx context.Context, id int64) (*types.Token, error) {
 	db := dbtx.GetAccessor(ctx, s.db)
 
-	dst := new(types.Token)
+	dst := new(token)
 	if err := db.GetContext(ctx, dst, TokenSelectByID, id); err != nil {
 		return nil, database.ProcessSQLErrorf(err, "Failed to find token")
 	}
 
-	return dst, nil
+	return mapToToken(dst)
 }
 
 // FindByUID finds the token by principalId and tokenUID.
 func (s *TokenStore) FindByUID(ctx context.Context, principalID int64, tokenUID string) (*types.Token, error) {
 	db := dbtx.GetAccessor(ctx, s.db)
 
-	dst := new(types.Token)
+	dst := new(token)
 	if err := db.GetContext(ctx, dst, TokenSelectByPrincipalIDAndUID, principalID, tokenUID); err != nil {
 		return nil, database.ProcessSQLErrorf(err, "Failed to find token by UID")
 	}
 
-	return dst, nil
+	return mapToToken(dst)
 }
 
 // Create saves the token details.
 func (s *TokenStore) Create(ctx context.Context, token *types.Token) error {
 	db := dbtx.GetAccessor(ctx, s.db)
 
-	query, arg, err := db.BindNamed(tokenInsert, token)
+	dbToken, err := mapToInternalToken(token)
+	if err != nil {
+		return err
+	}
+
+	query, arg, err := db.BindNamed(tokenInsert, dbToken)
 	if err != nil {
 		return database.ProcessSQLErrorf(err, "Failed to bind token object")
 	}
@@ -119,7 +139,7 @@ func (s *TokenStore) List(ctx context.Context,
 	principalID int64, tokenType enum.TokenType) ([]*types.Token, error) {
 	db := dbtx.GetAccessor(ctx, s.db)
 
-	dst := []*types.Token{}
+	dst := []*token{}
 
 	// TODO: custom filters / sorting for tokens.
 
@@ -127,7 +147,78 @@ func (s *TokenStore) List(ctx context.Context,
 	if err != nil {
 		return nil, database.ProcessSQLErrorf(err, "Failed executing token list query")
 	}
-	return dst, nil
+
+	res := make([]*types.Token, len(dst))
+	for i := range dst {
+		if res[i], err = mapToToken(dst[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func mapToToken(t *token) (*types.Token, error) {
+	res := &types.Token{
+		ID:          t.ID,
+		PrincipalID: t.PrincipalID,
+		Type:        t.Type,
+		UID:         t.UID,
+		ExpiresAt:   t.ExpiresAt,
+		IssuedAt:    t.IssuedAt,
+		CreatedBy:   t.CreatedBy,
+	}
+
+	if err := json.Unmarshal([]byte(t.Scopes), &res.Scopes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scopes of token %d: %w", t.ID, err)
+	}
+	if err := json.Unmarshal([]byte(t.SpaceIDs), &res.SpaceIDs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal space ids of token %d: %w", t.ID, err)
+	}
+	if err := json.Unmarshal([]byte(t.RepoIDs), &res.RepoIDs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal repo ids of token %d: %w", t.ID, err)
+	}
+
+	return res, nil
+}
+
+func mapToInternalToken(t *types.Token) (*token, error) {
+	res := &token{
+		ID:          t.ID,
+		PrincipalID: t.PrincipalID,
+		Type:        t.Type,
+		UID:         t.UID,
+		ExpiresAt:   t.ExpiresAt,
+		IssuedAt:    t.IssuedAt,
+		CreatedBy:   t.CreatedBy,
+	}
+
+	var err error
+	if res.Scopes, err = marshalTokenRestriction(t.Scopes); err != nil {
+		return nil, fmt.Errorf("failed to marshal token scopes: %w", err)
+	}
+	if res.SpaceIDs, err = marshalTokenRestriction(t.SpaceIDs); err != nil {
+		return nil, fmt.Errorf("failed to marshal token space ids: %w", err)
+	}
+	if res.RepoIDs, err = marshalTokenRestriction(t.RepoIDs); err != nil {
+		return nil, fmt.Errorf("failed to marshal token repo ids: %w", err)
+	}
+
+	return res, nil
+}
+
+// marshalTokenRestriction stores missing restrictions as empty list.
+func marshalTokenRestriction[T any](values []T) (string, error) {
+	if values == nil {
+		values = []T{}
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
 }
 
 const tokenSelectBase = `
@@ -139,6 +230,9 @@ token_id
 ,token_expires_at
 ,token_issued_at
 ,token_created_by
+,token_scopes
+,token_space_ids
+,token_repo_ids
 FROM tokens
 ` //#nosec G101
 
@@ -179,6 +273,9 @@ INSERT INTO tokens (
 	,token_expires_at
 	,token_issued_at
 	,token_created_by
+	,token_scopes
+	,token_space_ids
+	,token_repo_ids
 ) values (
 	:token_type
 	,:token_uid
@@ -186,5 +283,8 @@ INSERT INTO tokens (
 	,:token_expires_at
 	,:token_issued_at
 	,:token_created_by
+	,:token_scopes
+	,:token_space_ids
+	,:token_repo_ids
 ) RETURNING token_id
 `
@@ -0,0 +1,279 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.TOTPStore = (*TOTPStore)(nil)
+
+// NewTOTPStore returns a new TOTPStore.
+func NewTOTPStore(db *sqlx.DB) *TOTPStore {
+	return &TOTPStore{db}
+}
+
+// TOTPStore implements a TOTPStore backed by a relational database.
+type TOTPStore struct {
+	db *sqlx.DB
+}
+
+type totp struct {
+	PrincipalID    int64  `db:"totp_principal_id"`
+	Created        int64  `db:"totp_created"`
+	Updated        int64  `db:"totp_updated"`
+	Version        int64  `db:"totp_version"`
+	Secret         []byte `db:"totp_secret"`
+	Enabled        bool   `db:"totp_enabled"`
+	RecoveryCodes  string `db:"totp_recovery_codes"`
+	LastUsedStep   int64  `db:"totp_last_used_step"`
+	Challenge      string `db:"totp_challenge"`
+	FailedAttempts int    `db:"totp_failed_attempts"`
+	LockedUntil    int64  `db:"totp_locked_until"`
+}
+
+// Find finds the two-factor authentication configuration of a principal.
+func (s *TOTPStore) Find(ctx context.Context, principalID int64) (*types.TOTP, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(totp)
+	if err := db.GetContext(ctx, dst, totpSelectByPrincipalID, principalID); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find totp")
+	}
+
+	return mapToTOTP(dst)
+}
+
+// Create saves the two-factor authentication configuration of a principal.
+func (s *TOTPStore) Create(ctx context.Context, totp *types.TOTP) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbTOTP, err := mapToInternalTOTP(totp)
+	if err != nil {
+		return err
+	}
+
+	query, arg, err := db.BindNamed(totpInsert, dbTOTP)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind totp object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Update updates the two-factor authentication configuration of a principal.
+// It will set a new value to the Updated field and increment the Version,
+// ErrVersionConflict is returned if the configuration has been updated in the meantime.
+func (s *TOTPStore) Update(ctx context.Context, totp *types.TOTP) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	updated := *totp
+	updated.Updated = time.Now().UnixMilli()
+	updated.Version++
+
+	dbTOTP, err := mapToInternalTOTP(&updated)
+	if err != nil {
+		return err
+	}
+
+	query, arg, err := db.BindNamed(totpUpdate, dbTOTP)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to bind totp object")
+	}
+
+	result, err := db.ExecContext(ctx, query, arg...)
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Update query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(err, "Failed to get number of updated rows")
+	}
+
+	if count == 0 {
+		return gitness_store.ErrVersionConflict
+	}
+
+	*totp = updated
+
+	return nil
+}
+
+// UpdateOptLock updates the two-factor authentication configuration using the optimistic locking mechanism.
+func (s *TOTPStore) UpdateOptLock(ctx context.Context, totp *types.TOTP,
+	mutateFn func(totp *types.TOTP) error,
+) (*types.TOTP, error) {
+	for {
+		dup := *totp
+
+		err := mutateFn(&dup)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.Update(ctx, &dup)
+		if err == nil {
+			return &dup, nil
+		}
+		if !errors.Is(err, gitness_store.ErrVersionConflict) {
+			return nil, err
+		}
+
+		totp, err = s.Find(ctx, totp.PrincipalID)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Delete deletes the two-factor authentication configuration of a principal.
+func (s *TOTPStore) Delete(ctx context.Context, principalID int64) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, totpDelete, principalID); err != nil {
+		return database.ProcessSQLErrorf(err, "The delete query failed")
+	}
+
+	return nil
+}
+
+func mapToTOTP(t *totp) (*types.TOTP, error) {
+	res := &types.TOTP{
+		PrincipalID:    t.PrincipalID,
+		Created:        t.Created,
+		Updated:        t.Updated,
+		Version:        t.Version,
+		Secret:         t.Secret,
+		Enabled:        t.Enabled,
+		LastUsedStep:   t.LastUsedStep,
+		Challenge:      t.Challenge,
+		FailedAttempts: t.FailedAttempts,
+		LockedUntil:    t.LockedUntil,
+	}
+
+	if err := json.Unmarshal([]byte(t.RecoveryCodes), &res.RecoveryCodes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal recovery codes of principal %d: %w", t.PrincipalID, err)
+	}
+
+	return res, nil
+}
+
+func mapToInternalTOTP(t *types.TOTP) (*totp, error) {
+	recoveryCodes := t.RecoveryCodes
+	if recoveryCodes == nil {
+		recoveryCodes = []string{}
+	}
+
+	recoveryCodesJSON, err := json.Marshal(recoveryCodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal recovery codes: %w", err)
+	}
+
+	return &totp{
+		PrincipalID:    t.PrincipalID,
+		Created:        t.Created,
+		Updated:        t.Updated,
+		Version:        t.Version,
+		Secret:         t.Secret,
+		Enabled:        t.Enabled,
+		RecoveryCodes:  string(recoveryCodesJSON),
+		LastUsedStep:   t.LastUsedStep,
+		Challenge:      t.Challenge,
+		FailedAttempts: t.FailedAttempts,
+		LockedUntil:    t.LockedUntil,
+	}, nil
+}
+
+const totpSelectByPrincipalID = `
+SELECT
+totp_principal_id
+,totp_created
+,totp_updated
+,totp_version
+,totp_secret
+,totp_enabled
+,totp_recovery_codes
+,totp_last_used_step
+,totp_challenge
+,totp_failed_attempts
+,totp_locked_until
+FROM totps
+WHERE totp_principal_id = $1
+`
+
+const totpDelete = `
+DELETE FROM totps
+WHERE totp_principal_id = $1
+`
+
+const totpInsert = `
+INSERT INTO totps (
+	totp_principal_id
+	,totp_created
+	,totp_updated
+	,totp_version
+	,totp_secret
+	,totp_enabled
+	,totp_recovery_codes
+	,totp_last_used_step
+	,totp_challenge
+	,totp_failed_attempts
+	,totp_locked_until
+) values (
+	:totp_principal_id
+	,:totp_created
+	,:totp_updated
+	,:totp_version
+	,:totp_secret
+	,:totp_enabled
+	,:totp_recovery_codes
+	,:totp_last_used_step
+	,:totp_challenge
+	,:totp_failed_attempts
+	,:totp_locked_until
+)
+`
+
+const totpUpdate = `
+UPDATE totps
+SET
+	totp_updated = :totp_updated
+	,totp_version = :totp_version
+	,totp_secret = :totp_secret
+	,totp_enabled = :totp_enabled
+	,totp_recovery_codes = :totp_recovery_codes
+	,totp_last_used_step = :totp_last_used_step
+	,totp_challenge = :totp_challenge
+	,totp_failed_attempts = :totp_failed_attempts
+	,totp_locked_until = :totp_locked_until
+WHERE totp_principal_id = :totp_principal_id AND totp_version = :totp_version - 1
+`
@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+)
+
+func TestTOTPStoreUpdateVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	s := NewTOTPStore(setupTestDB(t))
+
+	err := s.Create(ctx, &types.TOTP{PrincipalID: 1, Secret: []byte("secret"), Challenge: "challenge"})
+	if err != nil {
+		t.Fatalf("failed to create totp: %v", err)
+	}
+
+	first, err := s.Find(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to find totp: %v", err)
+	}
+	second := *first
+
+	// both requests accept the same challenge, only the first one may use it.
+	first.Challenge = ""
+	first.LastUsedStep = 42
+	if err = s.Update(ctx, first); err != nil {
+		t.Fatalf("failed to update totp: %v", err)
+	}
+
+	second.Challenge = ""
+	second.LastUsedStep = 42
+	if err = s.Update(ctx, &second); !errors.Is(err, gitness_store.ErrVersionConflict) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	updated, err := s.UpdateOptLock(ctx, &second, func(totp *types.TOTP) error {
+		totp.FailedAttempts++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to update totp with optimistic lock: %v", err)
+	}
+
+	found, err := s.Find(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to find totp: %v", err)
+	}
+	if found.Version != 2 || updated.Version != 2 {
+		t.Errorf("expected version 2, got %d (returned %d)", found.Version, updated.Version)
+	}
+	if found.FailedAttempts != 1 || found.LastUsedStep != 42 {
+		t.Errorf("expected the failed attempt on top of the first update, got %+v", found)
+	}
+}
@@ -46,6 +46,9 @@ type trigger struct {
 	CreatedBy   int64              `db:"trigger_created_by"`
 	Disabled    bool               `db:"trigger_disabled"`
 	Actions     sqlxtypes.JSONText `db:"trigger_actions"`
+	Cron        string             `db:"trigger_cron"`
+	Branch      string             `db:"trigger_branch"`
+	Timezone    string             `db:"trigger_timezone"`
 	Created     int64              `db:"trigger_created"`
 	Updated     int64              `db:"trigger_updated"`
 	Version     int64              `db:"trigger_version"`
@@ -68,6 +71,9 @@ func mapInternalToTrigger(trigger *trigger) (*types.Trigger, error) {
 		CreatedBy:   trigger.CreatedBy,
 		Disabled:    trigger.Disabled,
 		Actions:     actions,
+		Cron:        trigger.Cron,
+		Branch:      trigger.Branch,
+		Timezone:    trigger.Timezone,
 		UID:         trigger.UID,
 		Created:     trigger.Created,
 		Updated:     trigger.Updated,
@@ -99,6 +105,9 @@ func mapTriggerToInternal(t *types.Trigger) *trigger {
 		CreatedBy:   t.CreatedBy,
 		Disabled:    t.Disabled,
 		Actions:     EncodeToSQLXJSON(t.Actions),
+		Cron:        t.Cron,
+		Branch:      t.Branch,
+		Timezone:    t.Timezone,
 		Created:     t.Created,
 		Updated:     t.Updated,
 		Version:     t.Version,
@@ -122,6 +131,9 @@ const (
 		,trigger_uid
 		,trigger_disabled
 		,trigger_actions
+		,trigger_cron
+		,trigger_branch
+		,trigger_timezone
 		,trigger_description
 		,trigger_pipeline_id
 		,trigger_created
@@ -130,7 +142,22 @@ const (
 	`
 )
 
-// Find returns an trigger given a pipeline ID and a trigger UID.
+// Find returns a trigger given a trigger ID.
+func (s *triggerStore) Find(ctx context.Context, id int64) (*types.Trigger, error) {
+	const findQueryStmt = `
+	SELECT` + triggerColumns + `
+	FROM triggers
+	WHERE trigger_id = $1`
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(trigger)
+	if err := db.GetContext(ctx, dst, findQueryStmt, id); err != nil {
+		return nil, database.ProcessSQLErrorf(err, "Failed to find trigger")
+	}
+	return mapInternalToTrigger(dst)
+}
+
+// FindByUID returns an trigger given a pipeline ID and a trigger UID.
 func (s *triggerStore) FindByUID(ctx context.Context, pipelineID int64, uid string) (*types.Trigger, error) {
 	const findQueryStmt = `
 	SELECT` + triggerColumns + `
@@ -152,6 +179,9 @@ func (s *triggerStore) Create(ctx context.Context, t *types.Trigger) error {
 		trigger_uid
 		,trigger_description
 		,trigger_actions
+		,trigger_cron
+		,trigger_branch
+		,trigger_timezone
 		,trigger_disabled
 		,trigger_type
 		,trigger_secret
@@ -165,6 +195,9 @@ func (s *triggerStore) Create(ctx context.Context, t *types.Trigger) error {
 		:trigger_uid
 		,:trigger_description
 		,:trigger_actions
+		,:trigger_cron
+		,:trigger_branch
+		,:trigger_timezone
 		,:trigger_disabled
 		,:trigger_type
 		,:trigger_secret
@@ -187,6 +220,8 @@ func (s *triggerStore) Create(ctx context.Context, t *types.Trigger) error {
 		return database.ProcessSQLErrorf(err, "Trigger query failed")
 	}
 
+	t.ID = trigger.ID
+
 	return nil
 }
 
@@ -200,6 +235,9 @@ func (s *triggerStore) Update(ctx context.Context, t *types.Trigger) error {
 		,trigger_disabled = :trigger_disabled
 		,trigger_updated = :trigger_updated
 		,trigger_actions = :trigger_actions
+		,trigger_cron = :trigger_cron
+		,trigger_branch = :trigger_branch
+		,trigger_timezone = :trigger_timezone
 		,trigger_version = :trigger_version
 	WHERE trigger_id = :trigger_id AND trigger_version = :trigger_version - 1`
 	updatedAt := time.Now()
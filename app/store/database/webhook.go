@@ -63,6 +63,7 @@ type webhook struct {
 	Secret                string      `db:"webhook_secret"`
 	Enabled               bool        `db:"webhook_enabled"`
 	Insecure              bool        `db:"webhook_insecure"`
+	Format                string      `db:"webhook_format"`
 	Triggers              string      `db:"webhook_triggers"`
 	LatestExecutionResult null.String `db:"webhook_latest_execution_result"`
 }
@@ -82,6 +83,7 @@ const (
 		,webhook_secret
 		,webhook_enabled
 		,webhook_insecure
+		,webhook_format
 		,webhook_triggers
 		,webhook_latest_execution_result
 		,webhook_internal`
@@ -126,6 +128,7 @@ func (s *WebhookStore) Create(ctx context.Context, hook *types.Webhook) error {
 			,webhook_secret
 			,webhook_enabled
 			,webhook_insecure
+			,webhook_format
 			,webhook_triggers
 			,webhook_latest_execution_result
 			,webhook_internal
@@ -141,6 +144,7 @@ func (s *WebhookStore) Create(ctx context.Context, hook *types.Webhook) error {
 			,:webhook_secret
 			,:webhook_enabled
 			,:webhook_insecure
+			,:webhook_format
 			,:webhook_triggers
 			,:webhook_latest_execution_result
 			,:webhook_internal
@@ -178,6 +182,7 @@ func (s *WebhookStore) Update(ctx context.Context, hook *types.Webhook) error {
 			,webhook_secret = :webhook_secret
 			,webhook_enabled = :webhook_enabled
 			,webhook_insecure = :webhook_insecure
+			,webhook_format = :webhook_format
 			,webhook_triggers = :webhook_triggers
 			,webhook_latest_execution_result = :webhook_latest_execution_result
 			,webhook_internal = :webhook_internal
@@ -365,6 +370,7 @@ func mapToWebhook(hook *webhook) (*types.Webhook, error) {
 		Secret:                hook.Secret,
 		Enabled:               hook.Enabled,
 		Insecure:              hook.Insecure,
+		Format:                enum.WebhookFormat(hook.Format),
 		Triggers:              triggersFromString(hook.Triggers),
 		LatestExecutionResult: (*enum.WebhookExecutionResult)(hook.LatestExecutionResult.Ptr()),
 		Internal:              hook.Internal,
@@ -399,6 +405,7 @@ func mapToInternalWebhook(hook *types.Webhook) (*webhook, error) {
 		Secret:                hook.Secret,
 		Enabled:               hook.Enabled,
 		Insecure:              hook.Insecure,
+		Format:                string(hook.Format),
 		Triggers:              triggersToString(hook.Triggers),
 		LatestExecutionResult: null.StringFromPtr((*string)(hook.LatestExecutionResult)),
 		Internal:              hook.Internal,
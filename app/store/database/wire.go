@@ -42,6 +42,13 @@ var WireSet = wire.NewSet(
 	ProvideRepoGitInfoView,
 	ProvideMembershipStore,
 	ProvideTokenStore,
+	ProvidePublicKeyStore,
+	ProvideSigningKeyStore,
+	ProvideTOTPStore,
+	ProvideAuditStore,
+	ProvideRepoMirrorStore,
+	ProvidePushMirrorStore,
+	ProvideLFSObjectStore,
 	ProvidePullReqStore,
 	ProvidePullReqActivityStore,
 	ProvideCodeCommentView,
@@ -50,6 +57,12 @@ var WireSet = wire.NewSet(
 	ProvidePullReqFileViewStore,
 	ProvideWebhookStore,
 	ProvideWebhookExecutionStore,
+	ProvideRuleStore,
+	ProvideLabelStore,
+	ProvidePullReqLabelStore,
+	ProvideIssueStore,
+	ProvideIssueAssigneeStore,
+	ProvideIssueLabelStore,
 	ProvideCheckStore,
 	ProvideReqCheckStore,
 	ProvideConnectorStore,
@@ -178,6 +191,41 @@ func ProvideTokenStore(db *sqlx.DB) store.TokenStore {
 	return NewTokenStore(db)
 }
 
+// ProvidePublicKeyStore provides a public key store.
+func ProvidePublicKeyStore(db *sqlx.DB) store.PublicKeyStore {
+	return NewPublicKeyStore(db)
+}
+
+// ProvideSigningKeyStore provides a signing key store.
+func ProvideSigningKeyStore(db *sqlx.DB) store.SigningKeyStore {
+	return NewSigningKeyStore(db)
+}
+
+// ProvideTOTPStore provides a two-factor authentication store.
+func ProvideTOTPStore(db *sqlx.DB) store.TOTPStore {
+	return NewTOTPStore(db)
+}
+
+// ProvideAuditStore provides an audit event store.
+func ProvideAuditStore(db *sqlx.DB) store.AuditStore {
+	return NewAuditStore(db)
+}
+
+// ProvideRepoMirrorStore provides a repo mirror store.
+func ProvideRepoMirrorStore(db *sqlx.DB) store.RepoMirrorStore {
+	return NewRepoMirrorStore(db)
+}
+
+// ProvidePushMirrorStore provides a push mirror store.
+func ProvidePushMirrorStore(db *sqlx.DB) store.PushMirrorStore {
+	return NewPushMirrorStore(db)
+}
+
+// ProvideLFSObjectStore provides a git LFS object store.
+func ProvideLFSObjectStore(db *sqlx.DB) store.LFSObjectStore {
+	return NewLFSObjectStore(db)
+}
+
 // ProvidePullReqStore provides a pull request store.
 func ProvidePullReqStore(db *sqlx.DB,
 	principalInfoCache store.PrincipalInfoCache,
@@ -224,6 +272,36 @@ func ProvideWebhookExecutionStore(db *sqlx.DB) store.WebhookExecutionStore {
 	return NewWebhookExecutionStore(db)
 }
 
+// ProvideRuleStore provides a protection rule store.
+func ProvideRuleStore(db *sqlx.DB) store.RuleStore {
+	return NewRuleStore(db)
+}
+
+// ProvideLabelStore provides a label store.
+func ProvideLabelStore(db *sqlx.DB) store.LabelStore {
+	return NewLabelStore(db)
+}
+
+// ProvidePullReqLabelStore provides a pull request label store.
+func ProvidePullReqLabelStore(db *sqlx.DB) store.PullReqLabelStore {
+	return NewPullReqLabelStore(db)
+}
+
+// ProvideIssueStore provides an issue store.
+func ProvideIssueStore(db *sqlx.DB, pCache store.PrincipalInfoCache) store.IssueStore {
+	return NewIssueStore(db, pCache)
+}
+
+// ProvideIssueAssigneeStore provides an issue assignee store.
+func ProvideIssueAssigneeStore(db *sqlx.DB, pCache store.PrincipalInfoCache) store.IssueAssigneeStore {
+	return NewIssueAssigneeStore(db, pCache)
+}
+
+// ProvideIssueLabelStore provides an issue label store.
+func ProvideIssueLabelStore(db *sqlx.DB) store.IssueLabelStore {
+	return NewIssueLabelStore(db)
+}
+
 // ProvideCheckStore provides a status check result store.
 func ProvideCheckStore(db *sqlx.DB,
 	principalInfoCache store.PrincipalInfoCache,
@@ -0,0 +1,39 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"io"
+)
+
+// LFSContentStore provides an interface for the persistent storage backend of git LFS object contents.
+type LFSContentStore interface {
+	// Find returns the content of the LFS object of a repository.
+	Find(ctx context.Context, repoID int64, oid string) (io.ReadCloser, error)
+
+	// CreateTemp copies content from Reader r to a new temporary object and returns its key.
+	// Temporary objects are never served, they have to be moved into place with Move or purged with DeleteTemp.
+	CreateTemp(ctx context.Context, r io.Reader) (string, error)
+
+	// Move moves the temporary object into place as the content of the LFS object of a repository.
+	Move(ctx context.Context, tmpKey string, repoID int64, oid string) error
+
+	// DeleteTemp purges the temporary object.
+	DeleteTemp(ctx context.Context, tmpKey string) error
+
+	// Delete purges the content of the LFS object of a repository.
+	Delete(ctx context.Context, repoID int64, oid string) error
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+)
+
+// NewLocalContentStore returns a new LFS content store that stores the objects on the local disk.
+func NewLocalContentStore(root string) store.LFSContentStore {
+	return &localStore{
+		root: root,
+	}
+}
+
+type localStore struct {
+	root string
+}
+
+func (s *localStore) Find(_ context.Context, repoID int64, oid string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(repoID, oid))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open LFS object: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *localStore) CreateTemp(_ context.Context, r io.Reader) (string, error) {
+	dir := filepath.Join(s.root, tmpDir)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create directory of temporary LFS objects: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file for LFS object: %w", err)
+	}
+
+	_, err = io.Copy(tmp, r)
+	if cErr := tmp.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", fmt.Errorf("failed to write LFS object: %w", err)
+	}
+
+	return filepath.Base(tmp.Name()), nil
+}
+
+func (s *localStore) Move(_ context.Context, tmpKey string, repoID int64, oid string) error {
+	tmpPath, err := s.tmpPath(tmpKey)
+	if err != nil {
+		return err
+	}
+
+	path := s.path(repoID, oid)
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory of LFS object: %w", err)
+	}
+
+	// the rename is atomic, a concurrent upload of the same object just replaces identical content.
+	if err = os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to move LFS object into place: %w", err)
+	}
+
+	return nil
+}
+
+func (s *localStore) DeleteTemp(_ context.Context, tmpKey string) error {
+	tmpPath, err := s.tmpPath(tmpKey)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(tmpPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete temporary LFS object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *localStore) Delete(_ context.Context, repoID int64, oid string) error {
+	err := os.Remove(s.path(repoID, oid))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete LFS object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *localStore) path(repoID int64, oid string) string {
+	return filepath.Join(s.root, objectKey(repoID, oid))
+}
+
+func (s *localStore) tmpPath(tmpKey string) (string, error) {
+	if tmpKey == "" || filepath.Base(tmpKey) != tmpKey {
+		return "", fmt.Errorf("invalid temporary LFS object key %q", tmpKey)
+	}
+
+	return filepath.Join(s.root, tmpDir, tmpKey), nil
+}
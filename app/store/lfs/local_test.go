@@ -0,0 +1,129 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+)
+
+const testOID = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+func TestLocalContentStore(t *testing.T) {
+	root := t.TempDir()
+	testContentStore(t, NewLocalContentStore(root))
+
+	// no temporary objects are left behind.
+	entries, err := os.ReadDir(filepath.Join(root, tmpDir))
+	if err != nil {
+		t.Fatalf("failed to read directory of temporary objects: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no temporary objects, got %d", len(entries))
+	}
+}
+
+func TestLocalContentStoreRejectsInvalidTempKey(t *testing.T) {
+	s := NewLocalContentStore(t.TempDir())
+	ctx := context.Background()
+
+	for _, tmpKey := range []string{"", "../1/2c/26/" + testOID, "a/b"} {
+		if err := s.Move(ctx, tmpKey, 1, testOID); err == nil {
+			t.Errorf("expected move of temporary key %q to fail", tmpKey)
+		}
+		if err := s.DeleteTemp(ctx, tmpKey); err == nil {
+			t.Errorf("expected delete of temporary key %q to fail", tmpKey)
+		}
+	}
+}
+
+// testContentStore verifies the behavior shared by all content store implementations.
+func testContentStore(t *testing.T, s store.LFSContentStore) {
+	ctx := context.Background()
+
+	_, err := s.Find(ctx, 1, testOID)
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		t.Fatalf("expected not found for missing object, got %v", err)
+	}
+
+	tmpKey, err := s.CreateTemp(ctx, strings.NewReader("foo"))
+	if err != nil {
+		t.Fatalf("failed to create temporary object: %v", err)
+	}
+
+	// temporary objects aren't served.
+	_, err = s.Find(ctx, 1, testOID)
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		t.Fatalf("expected not found before the object is moved into place, got %v", err)
+	}
+
+	if err = s.Move(ctx, tmpKey, 1, testOID); err != nil {
+		t.Fatalf("failed to move object into place: %v", err)
+	}
+
+	expectContent(t, s, 1, testOID, "foo")
+
+	// a discarded upload of the same object doesn't touch the content in place.
+	tmpKey, err = s.CreateTemp(ctx, strings.NewReader("bar"))
+	if err != nil {
+		t.Fatalf("failed to create temporary object: %v", err)
+	}
+	if err = s.DeleteTemp(ctx, tmpKey); err != nil {
+		t.Fatalf("failed to delete temporary object: %v", err)
+	}
+
+	expectContent(t, s, 1, testOID, "foo")
+
+	// objects are stored per repository.
+	_, err = s.Find(ctx, 2, testOID)
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		t.Errorf("expected not found for object of other repository, got %v", err)
+	}
+
+	if err = s.Delete(ctx, 1, testOID); err != nil {
+		t.Fatalf("failed to delete object: %v", err)
+	}
+
+	_, err = s.Find(ctx, 1, testOID)
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		t.Errorf("expected not found for deleted object, got %v", err)
+	}
+}
+
+func expectContent(t *testing.T, s store.LFSContentStore, repoID int64, oid string, exp string) {
+	t.Helper()
+
+	r, err := s.Find(context.Background(), repoID, oid)
+	if err != nil {
+		t.Fatalf("failed to find object: %v", err)
+	}
+	defer r.Close()
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read object: %v", err)
+	}
+	if string(content) != exp {
+		t.Errorf("expected content %q, got %q", exp, string(content))
+	}
+}
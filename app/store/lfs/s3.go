@@ -0,0 +1,138 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/dchest/uniuri"
+)
+
+// NewS3ContentStore returns a new LFS content store backed by an S3 compatible storage.
+func NewS3ContentStore(bucket, prefix, endpoint string, pathStyle bool) store.LFSContentStore {
+	disableSSL := false
+
+	if endpoint != "" {
+		disableSSL = !strings.HasPrefix(endpoint, "https://")
+	}
+
+	return &s3store{
+		bucket: bucket,
+		prefix: prefix,
+		session: session.Must(
+			session.NewSession(&aws.Config{
+				Endpoint:         aws.String(endpoint),
+				DisableSSL:       aws.Bool(disableSSL),
+				S3ForcePathStyle: aws.Bool(pathStyle),
+			}),
+		),
+	}
+}
+
+type s3store struct {
+	bucket  string
+	prefix  string
+	session *session.Session
+}
+
+func (s *s3store) Find(ctx context.Context, repoID int64, oid string) (io.ReadCloser, error) {
+	svc := s3.New(s.session)
+	out, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(repoID, oid)),
+	})
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (s *s3store) CreateTemp(ctx context.Context, r io.Reader) (string, error) {
+	tmpKey := uniuri.NewLen(32)
+
+	uploader := s3manager.NewUploader(s.session)
+	input := &s3manager.UploadInput{
+		ACL:    aws.String("private"),
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.tmpKey(tmpKey)),
+		Body:   r,
+	}
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return "", err
+	}
+
+	return tmpKey, nil
+}
+
+func (s *s3store) Move(ctx context.Context, tmpKey string, repoID int64, oid string) error {
+	svc := s3.New(s.session)
+
+	// S3 doesn't support renames, the object is copied and the temporary object deleted afterwards.
+	copySource := &url.URL{Path: s.bucket + "/" + strings.TrimPrefix(s.tmpKey(tmpKey), "/")}
+	_, err := svc.CopyObjectWithContext(ctx, &s3.CopyObjectInput{
+		ACL:        aws.String("private"),
+		Bucket:     aws.String(s.bucket),
+		Key:        aws.String(s.key(repoID, oid)),
+		CopySource: aws.String(copySource.EscapedPath()),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.DeleteTemp(ctx, tmpKey)
+}
+
+func (s *s3store) DeleteTemp(ctx context.Context, tmpKey string) error {
+	svc := s3.New(s.session)
+	_, err := svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.tmpKey(tmpKey)),
+	})
+	return err
+}
+
+func (s *s3store) Delete(ctx context.Context, repoID int64, oid string) error {
+	svc := s3.New(s.session)
+	_, err := svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(repoID, oid)),
+	})
+	return err
+}
+
+func (s *s3store) key(repoID int64, oid string) string {
+	return path.Join("/", s.prefix, objectKey(repoID, oid))
+}
+
+func (s *s3store) tmpKey(tmpKey string) string {
+	return path.Join("/", s.prefix, tmpDir, tmpKey)
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lfs
+
+import (
+	"path"
+	"strconv"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideContentStore,
+)
+
+func ProvideContentStore(config *types.Config) store.LFSContentStore {
+	if config.LFS.S3.Bucket != "" {
+		return NewS3ContentStore(
+			config.LFS.S3.Bucket,
+			config.LFS.S3.Prefix,
+			config.LFS.S3.Endpoint,
+			config.LFS.S3.PathStyle,
+		)
+	}
+	return NewLocalContentStore(config.LFS.LocalPath)
+}
+
+// tmpDir is the directory (or key prefix) of uploads that weren't verified yet.
+// It can't collide with the objects of repositories, as those are stored under the numeric repo id.
+const tmpDir = "tmp"
+
+// objectKey returns the storage key of an LFS object of a repository.
+// The objects are sharded by the first characters of their oid (e.g. 1/ab/cd/abcdef...).
+// NOTE: the oid is expected to be validated by the caller.
+func objectKey(repoID int64, oid string) string {
+	return path.Join(strconv.FormatInt(repoID, 10), oid[0:2], oid[2:4], oid)
+}
@@ -48,6 +48,7 @@ func CreateUserSession(
 		principal,
 		uid,
 		ptr.Duration(userSessionTokenLifeTime),
+		types.TokenRestrictions{},
 	)
 }
 
@@ -58,6 +59,7 @@ func CreatePAT(
 	createdFor *types.User,
 	uid string,
 	lifetime *time.Duration,
+	restrictions types.TokenRestrictions,
 ) (*types.Token, string, error) {
 	return create(
 		ctx,
@@ -67,6 +69,7 @@ func CreatePAT(
 		createdFor.ToPrincipal(),
 		uid,
 		lifetime,
+		restrictions,
 	)
 }
 
@@ -77,6 +80,7 @@ func CreateSAT(
 	createdFor *types.ServiceAccount,
 	uid string,
 	lifetime *time.Duration,
+	restrictions types.TokenRestrictions,
 ) (*types.Token, string, error) {
 	return create(
 		ctx,
@@ -86,6 +90,7 @@ func CreateSAT(
 		createdFor.ToPrincipal(),
 		uid,
 		lifetime,
+		restrictions,
 	)
 }
 
@@ -97,6 +102,7 @@ func create(
 	createdFor *types.Principal,
 	uid string,
 	lifetime *time.Duration,
+	restrictions types.TokenRestrictions,
 ) (*types.Token, string, error) {
 	issuedAt := time.Now()
 
@@ -113,6 +119,8 @@ func create(
 		IssuedAt:    issuedAt.UnixMilli(),
 		ExpiresAt:   expiresAt,
 		CreatedBy:   createdBy.ID,
+
+		TokenRestrictions: restrictions,
 	}
 
 	err := tokenStore.Create(ctx, &token)
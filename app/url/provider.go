@@ -53,6 +53,9 @@ type Provider interface {
 	// GenerateUICompareURL returns the url for the UI screen comparing two references.
 	GenerateUICompareURL(repoPath string, ref1 string, ref2 string) string
 
+	// GenerateUIExecutionURL returns the url for the UI screen of a pipeline execution.
+	GenerateUIExecutionURL(repoPath string, pipelineUID string, executionNum int64) string
+
 	// GetAPIHostname returns the host for the api endpoint.
 	GetAPIHostname() string
 
@@ -159,6 +162,10 @@ func (p *provider) GenerateUICompareURL(repoPath string, ref1 string, ref2 strin
 	return p.uiURL.JoinPath(repoPath, "pulls/compare", ref1+"..."+ref2).String()
 }
 
+func (p *provider) GenerateUIExecutionURL(repoPath string, pipelineUID string, executionNum int64) string {
+	return p.uiURL.JoinPath(repoPath, "pipelines", pipelineUID, "execution", fmt.Sprint(executionNum)).String()
+}
+
 func (p *provider) GetAPIHostname() string {
 	return p.apiURL.Hostname()
 }
@@ -42,7 +42,13 @@ func (c *loginCommand) run(*kingpin.ParseContext) error {
 		Password:        password,
 	}
 
-	ts, err := provide.OpenClient(c.server).Login(ctx, in)
+	client := provide.OpenClient(c.server)
+	resp, err := client.Login(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	ts, err := completeTwoFactorLogin(ctx, client, resp)
 	if err != nil {
 		return err
 	}
@@ -52,7 +52,13 @@ func (c *registerCommand) run(*kingpin.ParseContext) error {
 		Password:    password,
 	}
 
-	ts, err := provide.OpenClient(c.server).Register(ctx, input)
+	client := provide.OpenClient(c.server)
+	resp, err := client.Register(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	ts, err := completeTwoFactorLogin(ctx, client, resp)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,60 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/api/controller/user"
+	"github.com/harness/gitness/cli/textui"
+	"github.com/harness/gitness/client"
+	"github.com/harness/gitness/types"
+)
+
+// completeTwoFactorLogin asks for the one-time password in case the login requires two-factor authentication.
+func completeTwoFactorLogin(
+	ctx context.Context,
+	httpClient client.Client,
+	resp *types.LoginResponse,
+) (*types.TokenResponse, error) {
+	challenge := resp.TwoFactorChallenge
+	if challenge == nil {
+		return resp.TokenResponse, nil
+	}
+
+	if challenge.Enrollment != nil {
+		fmt.Println("Two-factor authentication is required. Add the account to your authenticator app:")
+		fmt.Printf("  %s\n", challenge.Enrollment.URI)
+		fmt.Printf("or enter the secret manually: %s\n", challenge.Enrollment.Secret)
+	}
+
+	resp, err := httpClient.LoginTOTP(ctx, &user.LoginTOTPInput{
+		Challenge: challenge.Token,
+		Code:      textui.OneTimePassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.RecoveryCodes) > 0 {
+		fmt.Println("Store these recovery codes in a safe place, each can be used once if you lose your device:")
+		for _, code := range resp.RecoveryCodes {
+			fmt.Printf("  %s\n", code)
+		}
+	}
+
+	return resp.TokenResponse, nil
+}
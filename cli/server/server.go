@@ -22,6 +22,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/harness/gitness/app/ssh"
 	"github.com/harness/gitness/profiler"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/version"
@@ -96,12 +97,43 @@ func (c *command) run(*kingpin.ParseContext) error {
 			}
 		}
 
+		// initialize periodic sync of mirror repositories
+		if system.services.Mirror != nil {
+			err := system.services.Mirror.Register(gCtx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to register mirror sync")
+				return err
+			}
+		}
+
+		// initialize periodic sync of LDAP groups into space memberships
+		if system.services.LDAPSync != nil {
+			err := system.services.LDAPSync.Register(gCtx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to register LDAP group sync")
+				return err
+			}
+		}
+
 		return system.services.JobScheduler.Run(gCtx)
 	})
 
 	// start server
 	gHTTP, shutdownHTTP := system.server.ListenAndServe()
 	g.Go(gHTTP.Wait)
+
+	// start ssh server for git operations if enabled
+	var shutdownSSH ssh.ShutdownFunction
+	if config.Server.SSH.Enabled {
+		var gSSH *errgroup.Group
+		gSSH, shutdownSSH, err = system.sshServer.ListenAndServe()
+		if err != nil {
+			return fmt.Errorf("failed to start ssh server: %w", err)
+		}
+		g.Go(gSSH.Wait)
+		log.Info().Int("port", config.Server.SSH.Port).Msg("ssh server started")
+	}
+
 	if c.enableCI {
 		// start populating plugins
 		g.Go(func() error {
@@ -156,6 +188,12 @@ func (c *command) run(*kingpin.ParseContext) error {
 		log.Err(sErr).Msg("failed to shutdown http server gracefully")
 	}
 
+	if shutdownSSH != nil {
+		if sErr := shutdownSSH(shutdownCtx); sErr != nil {
+			log.Err(sErr).Msg("failed to shutdown ssh server gracefully")
+		}
+	}
+
 	if c.enableGitRPC {
 		if rpcErr := system.gitRPCServer.Stop(); rpcErr != nil {
 			log.Err(rpcErr).Msg("failed to shutdown grpc server gracefully")
@@ -19,6 +19,7 @@ import (
 	"github.com/harness/gitness/app/pipeline/plugin"
 	"github.com/harness/gitness/app/server"
 	"github.com/harness/gitness/app/services"
+	"github.com/harness/gitness/app/ssh"
 	gitrpcserver "github.com/harness/gitness/gitrpc/server"
 	gitrpccron "github.com/harness/gitness/gitrpc/server/cron"
 
@@ -29,6 +30,7 @@ import (
 type System struct {
 	bootstrap      bootstrap.Bootstrap
 	server         *server.Server
+	sshServer      *ssh.Server
 	gitRPCServer   *gitrpcserver.GRPCServer
 	pluginManager  *plugin.Manager
 	poller         *poller.Poller
@@ -37,12 +39,13 @@ type System struct {
 }
 
 // NewSystem returns a new system structure.
-func NewSystem(bootstrap bootstrap.Bootstrap, server *server.Server, poller *poller.Poller,
+func NewSystem(bootstrap bootstrap.Bootstrap, server *server.Server, sshServer *ssh.Server, poller *poller.Poller,
 	gitRPCServer *gitrpcserver.GRPCServer, pluginManager *plugin.Manager,
 	gitrpccron *gitrpccron.Manager, services services.Services) *System {
 	return &System{
 		bootstrap:      bootstrap,
 		server:         server,
+		sshServer:      sshServer,
 		poller:         poller,
 		gitRPCServer:   gitRPCServer,
 		pluginManager:  pluginManager,
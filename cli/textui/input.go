@@ -74,6 +74,16 @@ func Email() string {
 	return strings.TrimSpace(email)
 }
 
+// OneTimePassword returns the one-time password or recovery code from stdin.
+func OneTimePassword() string {
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Print("Enter One-Time Password or Recovery Code: ")
+	code, _ := reader.ReadString('\n')
+
+	return strings.TrimSpace(code)
+}
+
 // Password returns the password from stdin.
 func Password() string {
 	fmt.Print("Enter Password: ")
@@ -24,10 +24,13 @@ import (
 // Client to access the remote APIs.
 type Client interface {
 	// Login authenticates the user and returns a JWT token.
-	Login(ctx context.Context, input *user.LoginInput) (*types.TokenResponse, error)
+	Login(ctx context.Context, input *user.LoginInput) (*types.LoginResponse, error)
+
+	// LoginTOTP completes a login with a one-time password and returns a JWT token.
+	LoginTOTP(ctx context.Context, input *user.LoginTOTPInput) (*types.LoginResponse, error)
 
 	// Register registers a new  user and returns a JWT token.
-	Register(ctx context.Context, input *user.RegisterInput) (*types.TokenResponse, error)
+	Register(ctx context.Context, input *user.RegisterInput) (*types.LoginResponse, error)
 
 	// Self returns the currently authenticated user.
 	Self(ctx context.Context) (*types.User, error)
@@ -10,16 +10,21 @@ package main
 import (
 	"context"
 
+	controlleraudit "github.com/harness/gitness/app/api/controller/audit"
 	checkcontroller "github.com/harness/gitness/app/api/controller/check"
 	"github.com/harness/gitness/app/api/controller/connector"
 	"github.com/harness/gitness/app/api/controller/execution"
 	"github.com/harness/gitness/app/api/controller/githook"
+	"github.com/harness/gitness/app/api/controller/issue"
+	"github.com/harness/gitness/app/api/controller/label"
+	controllerlfs "github.com/harness/gitness/app/api/controller/lfs"
 	controllerlogs "github.com/harness/gitness/app/api/controller/logs"
 	"github.com/harness/gitness/app/api/controller/pipeline"
 	"github.com/harness/gitness/app/api/controller/plugin"
 	"github.com/harness/gitness/app/api/controller/principal"
 	"github.com/harness/gitness/app/api/controller/pullreq"
 	"github.com/harness/gitness/app/api/controller/repo"
+	"github.com/harness/gitness/app/api/controller/rule"
 	"github.com/harness/gitness/app/api/controller/secret"
 	"github.com/harness/gitness/app/api/controller/service"
 	"github.com/harness/gitness/app/api/controller/serviceaccount"
@@ -29,10 +34,15 @@ import (
 	controllertrigger "github.com/harness/gitness/app/api/controller/trigger"
 	"github.com/harness/gitness/app/api/controller/user"
 	controllerwebhook "github.com/harness/gitness/app/api/controller/webhook"
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authn"
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/auth/ldap"
+	"github.com/harness/gitness/app/auth/oidc"
 	"github.com/harness/gitness/app/bootstrap"
+	checkevents "github.com/harness/gitness/app/events/check"
 	gitevents "github.com/harness/gitness/app/events/git"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/pipeline/canceler"
 	"github.com/harness/gitness/app/pipeline/commit"
@@ -46,17 +56,27 @@ import (
 	"github.com/harness/gitness/app/server"
 	"github.com/harness/gitness/app/services"
 	"github.com/harness/gitness/app/services/codecomments"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/services/codesearch"
 	"github.com/harness/gitness/app/services/exporter"
 	"github.com/harness/gitness/app/services/importer"
+	issueservice "github.com/harness/gitness/app/services/issue"
 	"github.com/harness/gitness/app/services/job"
+	labelservice "github.com/harness/gitness/app/services/label"
+	"github.com/harness/gitness/app/services/ldapsync"
 	"github.com/harness/gitness/app/services/metric"
+	"github.com/harness/gitness/app/services/mirror"
+	"github.com/harness/gitness/app/services/protection"
 	pullreqservice "github.com/harness/gitness/app/services/pullreq"
+	"github.com/harness/gitness/app/services/signing"
 	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/services/webhook"
 	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/ssh"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/store/cache"
 	"github.com/harness/gitness/app/store/database"
+	"github.com/harness/gitness/app/store/lfs"
 	"github.com/harness/gitness/app/store/logs"
 	"github.com/harness/gitness/app/url"
 	cliserver "github.com/harness/gitness/cli/server"
@@ -88,6 +108,7 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		pullreqservice.WireSet,
 		services.WireSet,
 		server.WireSet,
+		ssh.WireSet,
 		url.WireSet,
 		space.WireSet,
 		repo.WireSet,
@@ -100,7 +121,11 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		system.WireSet,
 		authn.WireSet,
 		authz.WireSet,
+		oidc.WireSet,
+		ldap.WireSet,
 		gitevents.WireSet,
+		checkevents.WireSet,
+		pipelineevents.WireSet,
 		pullreqevents.WireSet,
 		cliserver.ProvideGitRPCServerConfig,
 		gitrpcserver.WireSet,
@@ -125,9 +150,11 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		checkcontroller.WireSet,
 		execution.WireSet,
 		pipeline.WireSet,
+		lfs.WireSet,
 		logs.WireSet,
 		livelog.WireSet,
 		controllerlogs.WireSet,
+		controllerlfs.WireSet,
 		secret.WireSet,
 		connector.WireSet,
 		template.WireSet,
@@ -145,6 +172,19 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		canceler.WireSet,
 		exporter.WireSet,
 		metric.WireSet,
+		rule.WireSet,
+		protection.WireSet,
+		labelservice.WireSet,
+		label.WireSet,
+		issueservice.WireSet,
+		issue.WireSet,
+		signing.WireSet,
+		codesearch.WireSet,
+		mirror.WireSet,
+		ldapsync.WireSet,
+		codeowners.WireSet,
+		audit.WireSet,
+		controlleraudit.WireSet,
 	)
 	return &cliserver.System{}, nil
 }
@@ -8,30 +8,40 @@ package main
 
 import (
 	"context"
+	audit2 "github.com/harness/gitness/app/api/controller/audit"
 	check2 "github.com/harness/gitness/app/api/controller/check"
 	"github.com/harness/gitness/app/api/controller/connector"
 	"github.com/harness/gitness/app/api/controller/execution"
 	"github.com/harness/gitness/app/api/controller/githook"
+	"github.com/harness/gitness/app/api/controller/issue"
+	label2 "github.com/harness/gitness/app/api/controller/label"
+	lfs2 "github.com/harness/gitness/app/api/controller/lfs"
 	logs2 "github.com/harness/gitness/app/api/controller/logs"
 	"github.com/harness/gitness/app/api/controller/pipeline"
 	"github.com/harness/gitness/app/api/controller/plugin"
 	"github.com/harness/gitness/app/api/controller/principal"
 	pullreq2 "github.com/harness/gitness/app/api/controller/pullreq"
 	"github.com/harness/gitness/app/api/controller/repo"
+	"github.com/harness/gitness/app/api/controller/rule"
 	"github.com/harness/gitness/app/api/controller/secret"
 	"github.com/harness/gitness/app/api/controller/service"
 	"github.com/harness/gitness/app/api/controller/serviceaccount"
 	"github.com/harness/gitness/app/api/controller/space"
 	"github.com/harness/gitness/app/api/controller/system"
 	"github.com/harness/gitness/app/api/controller/template"
-	"github.com/harness/gitness/app/api/controller/trigger"
+	trigger2 "github.com/harness/gitness/app/api/controller/trigger"
 	"github.com/harness/gitness/app/api/controller/user"
 	webhook2 "github.com/harness/gitness/app/api/controller/webhook"
+	"github.com/harness/gitness/app/audit"
 	"github.com/harness/gitness/app/auth/authn"
 	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/auth/ldap"
+	"github.com/harness/gitness/app/auth/oidc"
 	"github.com/harness/gitness/app/bootstrap"
-	events3 "github.com/harness/gitness/app/events/git"
-	events2 "github.com/harness/gitness/app/events/pullreq"
+	events3 "github.com/harness/gitness/app/events/check"
+	events2 "github.com/harness/gitness/app/events/git"
+	events5 "github.com/harness/gitness/app/events/pipeline"
+	events4 "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/pipeline/canceler"
 	"github.com/harness/gitness/app/pipeline/commit"
 	"github.com/harness/gitness/app/pipeline/file"
@@ -44,17 +54,27 @@ import (
 	server2 "github.com/harness/gitness/app/server"
 	"github.com/harness/gitness/app/services"
 	"github.com/harness/gitness/app/services/codecomments"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/services/codesearch"
 	"github.com/harness/gitness/app/services/exporter"
 	"github.com/harness/gitness/app/services/importer"
+	issue2 "github.com/harness/gitness/app/services/issue"
 	"github.com/harness/gitness/app/services/job"
+	"github.com/harness/gitness/app/services/label"
+	"github.com/harness/gitness/app/services/ldapsync"
 	"github.com/harness/gitness/app/services/metric"
+	"github.com/harness/gitness/app/services/mirror"
+	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/services/pullreq"
-	trigger2 "github.com/harness/gitness/app/services/trigger"
+	"github.com/harness/gitness/app/services/signing"
+	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/services/webhook"
 	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/ssh"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/store/cache"
 	"github.com/harness/gitness/app/store/database"
+	"github.com/harness/gitness/app/store/lfs"
 	"github.com/harness/gitness/app/store/logs"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/cli/server"
@@ -90,20 +110,37 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	principalInfoCache := cache.ProvidePrincipalInfoCache(principalInfoView)
 	membershipStore := database.ProvideMembershipStore(db, principalInfoCache, spacePathStore)
 	permissionCache := authz.ProvidePermissionCache(spaceStore, membershipStore)
-	authorizer := authz.ProvideAuthorizer(permissionCache, spaceStore)
+	repoStore := database.ProvideRepoStore(db, spacePathCache, spacePathStore)
+	authorizer := authz.ProvideAuthorizer(permissionCache, spaceStore, repoStore)
 	principalUIDTransformation := store.ProvidePrincipalUIDTransformation()
 	principalStore := database.ProvidePrincipalStore(db, principalUIDTransformation)
 	tokenStore := database.ProvideTokenStore(db)
-	controller := user.ProvideController(transactor, principalUID, authorizer, principalStore, tokenStore, membershipStore)
+	publicKeyStore := database.ProvidePublicKeyStore(db)
+	signingKeyStore := database.ProvideSigningKeyStore(db)
+	provider, err := oidc.ProvideProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	authenticator, err := ldap.ProvideAuthenticator(config)
+	if err != nil {
+		return nil, err
+	}
+	totpStore := database.ProvideTOTPStore(db)
+	encrypter, err := encrypt.ProvideEncrypter(config)
+	if err != nil {
+		return nil, err
+	}
+	auditStore := database.ProvideAuditStore(db)
+	recorder := audit.ProvideRecorder(auditStore)
+	controller := user.ProvideController(config, transactor, principalUID, authorizer, principalStore, tokenStore, publicKeyStore, signingKeyStore, membershipStore, spaceStore, repoStore, provider, authenticator, totpStore, encrypter, recorder)
 	serviceController := service.NewController(principalUID, authorizer, principalStore)
 	bootstrapBootstrap := bootstrap.ProvideBootstrap(config, controller, serviceController)
-	authenticator := authn.ProvideAuthenticator(config, principalStore, tokenStore)
-	provider, err := url.ProvideURLProvider(config)
+	authnAuthenticator := authn.ProvideAuthenticator(config, principalStore, tokenStore)
+	urlProvider, err := url.ProvideURLProvider(config)
 	if err != nil {
 		return nil, err
 	}
 	pathUID := check.ProvidePathUIDCheck()
-	repoStore := database.ProvideRepoStore(db, spacePathCache, spacePathStore)
 	pipelineStore := database.ProvidePipelineStore(db)
 	gitrpcConfig, err := server.ProvideGitRPCClientConfig()
 	if err != nil {
@@ -114,10 +151,6 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 		return nil, err
 	}
 	triggerStore := database.ProvideTriggerStore(db)
-	encrypter, err := encrypt.ProvideEncrypter(config)
-	if err != nil {
-		return nil, err
-	}
 	jobStore := database.ProvideJobStore(db)
 	pubsubConfig := pubsub.ProvideConfig(config)
 	universalClient, err := server.ProvideRedis(config)
@@ -133,99 +166,152 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 		return nil, err
 	}
 	streamer := sse.ProvideEventsStreaming(pubSub)
-	repository, err := importer.ProvideRepoImporter(config, provider, gitrpcInterface, transactor, repoStore, pipelineStore, triggerStore, encrypter, jobScheduler, executor, streamer)
+	repository, err := importer.ProvideRepoImporter(config, urlProvider, gitrpcInterface, transactor, repoStore, pipelineStore, triggerStore, encrypter, jobScheduler, executor, streamer)
 	if err != nil {
 		return nil, err
 	}
-	repoController := repo.ProvideController(config, transactor, provider, pathUID, authorizer, repoStore, spaceStore, pipelineStore, principalStore, gitrpcInterface, repository)
-	executionStore := database.ProvideExecutionStore(db)
+	pullReqStore := database.ProvidePullReqStore(db, principalInfoCache)
+	pullReqReviewerStore := database.ProvidePullReqReviewerStore(db, principalInfoCache)
+	ruleStore := database.ProvideRuleStore(db)
 	checkStore := database.ProvideCheckStore(db, principalInfoCache)
+	reqCheckStore := database.ProvideReqCheckStore(db, principalInfoCache)
+	codeownersService := codeowners.ProvideService(gitrpcInterface, principalStore)
+	protectionManager := protection.ProvideManager(ruleStore, spaceStore, checkStore, reqCheckStore, codeownersService, gitrpcInterface)
+	lfsObjectStore := database.ProvideLFSObjectStore(db)
+	lfsContentStore := lfs.ProvideContentStore(config)
+	verifier := signing.ProvideVerifier(principalStore, signingKeyStore)
+	eventsConfig, err := server.ProvideEventsConfig()
+	if err != nil {
+		return nil, err
+	}
+	eventsSystem, err := events.ProvideSystem(eventsConfig, universalClient)
+	if err != nil {
+		return nil, err
+	}
+	readerFactory, err := events2.ProvideReaderFactory(eventsSystem)
+	if err != nil {
+		return nil, err
+	}
+	codesearchService, err := codesearch.ProvideService(ctx, config, readerFactory, gitrpcInterface, repoStore)
+	if err != nil {
+		return nil, err
+	}
+	repoMirrorStore := database.ProvideRepoMirrorStore(db)
+	reporter, err := events2.ProvideReporter(eventsSystem)
+	if err != nil {
+		return nil, err
+	}
+	mirrorService, err := mirror.ProvideService(config, gitrpcInterface, urlProvider, repoStore, repoMirrorStore, encrypter, reporter, jobScheduler, executor)
+	if err != nil {
+		return nil, err
+	}
+	pushMirrorStore := database.ProvidePushMirrorStore(db)
+	pushService, err := mirror.ProvidePushService(ctx, config, readerFactory, gitrpcInterface, repoStore, pushMirrorStore, protectionManager, encrypter, jobScheduler, executor, mutexManager)
+	if err != nil {
+		return nil, err
+	}
+	executionStore := database.ProvideExecutionStore(db)
+	eventsReporter, err := events3.ProvideReporter(eventsSystem)
+	if err != nil {
+		return nil, err
+	}
+	reporter3, err := events5.ProvideReporter(eventsSystem)
+	if err != nil {
+		return nil, err
+	}
 	stageStore := database.ProvideStageStore(db)
 	schedulerScheduler, err := scheduler.ProvideScheduler(stageStore, mutexManager)
 	if err != nil {
 		return nil, err
 	}
 	stepStore := database.ProvideStepStore(db)
-	cancelerCanceler := canceler.ProvideCanceler(executionStore, streamer, repoStore, schedulerScheduler, stageStore, stepStore)
+	cancelerCanceler := canceler.ProvideCanceler(executionStore, streamer, repoStore, schedulerScheduler, stageStore, stepStore, reporter3)
 	commitService := commit.ProvideService(gitrpcInterface)
 	fileService := file.ProvideService(gitrpcInterface)
-	triggererTriggerer := triggerer.ProvideTriggerer(executionStore, checkStore, stageStore, transactor, pipelineStore, fileService, schedulerScheduler, repoStore)
-	executionController := execution.ProvideController(transactor, authorizer, executionStore, checkStore, cancelerCanceler, commitService, triggererTriggerer, repoStore, stageStore, pipelineStore)
+	triggererTriggerer := triggerer.ProvideTriggerer(executionStore, checkStore, eventsReporter, stageStore, transactor, pipelineStore, fileService, schedulerScheduler, repoStore, reporter3)
+	triggerCron, err := trigger.ProvideCron(jobScheduler, executor, triggerStore, pipelineStore, repoStore, triggererTriggerer, commitService)
+	if err != nil {
+		return nil, err
+	}
+	repoController := repo.ProvideController(config, transactor, urlProvider, pathUID, authorizer, repoStore, spaceStore, pipelineStore, principalStore, gitrpcInterface, repository, pullReqStore, pullReqReviewerStore, protectionManager, codeownersService, lfsObjectStore, lfsContentStore, verifier, codesearchService, mirrorService, pushService, recorder, triggerCron)
+	executionController := execution.ProvideController(transactor, authorizer, executionStore, checkStore, eventsReporter, cancelerCanceler, commitService, triggererTriggerer, repoStore, stageStore, pipelineStore)
 	logStore := logs.ProvideLogStore(db, config)
 	logStream := livelog.ProvideLogStream()
 	logsController := logs2.ProvideController(authorizer, executionStore, repoStore, pipelineStore, stageStore, stepStore, logStore, logStream)
 	secretStore := database.ProvideSecretStore(db)
 	connectorStore := database.ProvideConnectorStore(db)
 	templateStore := database.ProvideTemplateStore(db)
-	exporterRepository, err := exporter.ProvideSpaceExporter(provider, gitrpcInterface, repoStore, jobScheduler, executor, encrypter, streamer)
+	exporterRepository, err := exporter.ProvideSpaceExporter(urlProvider, gitrpcInterface, repoStore, jobScheduler, executor, encrypter, streamer)
 	if err != nil {
 		return nil, err
 	}
-	spaceController := space.ProvideController(config, transactor, provider, streamer, pathUID, authorizer, spacePathStore, pipelineStore, secretStore, connectorStore, templateStore, spaceStore, repoStore, principalStore, repoController, membershipStore, repository, exporterRepository)
-	pipelineController := pipeline.ProvideController(pathUID, repoStore, triggerStore, authorizer, pipelineStore)
-	secretController := secret.ProvideController(pathUID, encrypter, secretStore, authorizer, spaceStore)
-	triggerController := trigger.ProvideController(authorizer, triggerStore, pathUID, pipelineStore, repoStore)
+	spaceController := space.ProvideController(config, transactor, urlProvider, streamer, pathUID, authorizer, spacePathStore, pipelineStore, secretStore, connectorStore, templateStore, spaceStore, repoStore, principalStore, repoController, membershipStore, repository, exporterRepository, codesearchService, recorder)
+	pipelineController := pipeline.ProvideController(pathUID, repoStore, triggerStore, authorizer, pipelineStore, triggerCron)
+	secretController := secret.ProvideController(pathUID, encrypter, secretStore, authorizer, spaceStore, recorder)
+	triggerController := trigger2.ProvideController(authorizer, triggerStore, pathUID, pipelineStore, repoStore, triggerCron)
 	connectorController := connector.ProvideController(pathUID, connectorStore, authorizer, spaceStore)
 	templateController := template.ProvideController(pathUID, templateStore, authorizer, spaceStore)
 	pluginStore := database.ProvidePluginStore(db)
 	pluginController := plugin.ProvideController(pluginStore)
-	pullReqStore := database.ProvidePullReqStore(db, principalInfoCache)
 	pullReqActivityStore := database.ProvidePullReqActivityStore(db, principalInfoCache)
 	codeCommentView := database.ProvideCodeCommentView(db)
 	pullReqReviewStore := database.ProvidePullReqReviewStore(db)
-	pullReqReviewerStore := database.ProvidePullReqReviewerStore(db, principalInfoCache)
 	pullReqFileViewStore := database.ProvidePullReqFileViewStore(db)
-	eventsConfig, err := server.ProvideEventsConfig()
-	if err != nil {
-		return nil, err
-	}
-	eventsSystem, err := events.ProvideSystem(eventsConfig, universalClient)
-	if err != nil {
-		return nil, err
-	}
-	reporter, err := events2.ProvideReporter(eventsSystem)
+	reporter2, err := events4.ProvideReporter(eventsSystem)
 	if err != nil {
 		return nil, err
 	}
 	migrator := codecomments.ProvideMigrator(gitrpcInterface)
-	readerFactory, err := events3.ProvideReaderFactory(eventsSystem)
+	eventsReaderFactory, err := events4.ProvideReaderFactory(eventsSystem)
 	if err != nil {
 		return nil, err
 	}
-	eventsReaderFactory, err := events2.ProvideReaderFactory(eventsSystem)
+	readerFactory2, err := events3.ProvideReaderFactory(eventsSystem)
 	if err != nil {
 		return nil, err
 	}
 	repoGitInfoView := database.ProvideRepoGitInfoView(db)
 	repoGitInfoCache := cache.ProvideRepoGitInfoCache(repoGitInfoView)
-	pullreqService, err := pullreq.ProvideService(ctx, config, readerFactory, eventsReaderFactory, reporter, gitrpcInterface, repoGitInfoCache, repoStore, pullReqStore, pullReqActivityStore, codeCommentView, migrator, pullReqFileViewStore, pubSub, provider, streamer)
+	pullreqService, err := pullreq.ProvideService(ctx, config, readerFactory, eventsReaderFactory, readerFactory2, reporter2, gitrpcInterface, repoGitInfoCache, repoStore, pullReqStore, pullReqActivityStore, codeCommentView, migrator, pullReqFileViewStore, pullReqReviewerStore, principalStore, codeownersService, protectionManager, authorizer, mutexManager, pubSub, urlProvider, streamer)
 	if err != nil {
 		return nil, err
 	}
-	pullreqController := pullreq2.ProvideController(transactor, provider, authorizer, pullReqStore, pullReqActivityStore, codeCommentView, pullReqReviewStore, pullReqReviewerStore, repoStore, principalStore, pullReqFileViewStore, gitrpcInterface, reporter, mutexManager, migrator, pullreqService, streamer)
+	labelStore := database.ProvideLabelStore(db)
+	labelService := label.ProvideService(labelStore, repoStore, spaceStore)
+	pullReqLabelStore := database.ProvidePullReqLabelStore(db)
+	pullreqController := pullreq2.ProvideController(transactor, urlProvider, authorizer, pullReqStore, pullReqActivityStore, codeCommentView, pullReqReviewStore, pullReqReviewerStore, repoStore, principalStore, pullReqFileViewStore, gitrpcInterface, reporter2, migrator, pullreqService, streamer, protectionManager, verifier, labelService, pullReqLabelStore)
 	webhookConfig := server.ProvideWebhookConfig(config)
 	webhookStore := database.ProvideWebhookStore(db)
 	webhookExecutionStore := database.ProvideWebhookExecutionStore(db)
-	webhookService, err := webhook.ProvideService(ctx, webhookConfig, readerFactory, eventsReaderFactory, webhookStore, webhookExecutionStore, repoStore, pullReqStore, provider, principalStore, gitrpcInterface, encrypter)
+	readerFactory3, err := events5.ProvideReaderFactory(eventsSystem)
 	if err != nil {
 		return nil, err
 	}
-	webhookController := webhook2.ProvideController(webhookConfig, authorizer, webhookStore, webhookExecutionStore, repoStore, webhookService, encrypter)
-	eventsReporter, err := events3.ProvideReporter(eventsSystem)
+	webhookService, err := webhook.ProvideService(ctx, webhookConfig, readerFactory, eventsReaderFactory, readerFactory3, webhookStore, webhookExecutionStore, repoStore, pullReqStore, pullReqActivityStore, executionStore, pipelineStore, urlProvider, principalStore, labelStore, gitrpcInterface, encrypter)
 	if err != nil {
 		return nil, err
 	}
-	githookController := githook.ProvideController(authorizer, principalStore, repoStore, eventsReporter, pullReqStore, provider)
-	serviceaccountController := serviceaccount.NewController(principalUID, authorizer, principalStore, spaceStore, repoStore, tokenStore)
+	webhookController := webhook2.ProvideController(webhookConfig, authorizer, webhookStore, webhookExecutionStore, repoStore, webhookService, encrypter, recorder)
+	githookController := githook.ProvideController(authorizer, principalStore, repoStore, reporter, pullReqStore, urlProvider, gitrpcInterface, protectionManager)
+	serviceaccountController := serviceaccount.NewController(principalUID, authorizer, principalStore, spaceStore, repoStore, tokenStore, recorder)
 	principalController := principal.ProvideController(principalStore)
-	checkController := check2.ProvideController(transactor, authorizer, repoStore, checkStore, gitrpcInterface)
+	checkController := check2.ProvideController(transactor, authorizer, repoStore, checkStore, reqCheckStore, gitrpcInterface, eventsReporter)
+	ruleController := rule.ProvideController(authorizer, ruleStore, repoStore, spaceStore, principalStore, recorder)
+	labelController := label2.ProvideController(authorizer, labelStore, repoStore, spaceStore, labelService)
+	issueStore := database.ProvideIssueStore(db, principalInfoCache)
+	issueAssigneeStore := database.ProvideIssueAssigneeStore(db, principalInfoCache)
+	issueLabelStore := database.ProvideIssueLabelStore(db)
+	issueController := issue.ProvideController(transactor, authorizer, issueStore, pullReqActivityStore, issueAssigneeStore, issueLabelStore, repoStore, principalStore, labelService, streamer)
+	auditController := audit2.ProvideController(authorizer, auditStore, spaceStore)
 	systemController := system.NewController(principalStore, config)
-	apiHandler := router.ProvideAPIHandler(config, authenticator, repoController, executionController, logsController, spaceController, pipelineController, secretController, triggerController, connectorController, templateController, pluginController, pullreqController, webhookController, githookController, serviceaccountController, controller, principalController, checkController, systemController)
-	gitHandler := router.ProvideGitHandler(config, provider, repoStore, authenticator, authorizer, gitrpcInterface)
+	apiHandler := router.ProvideAPIHandler(config, authnAuthenticator, repoController, executionController, logsController, spaceController, pipelineController, secretController, triggerController, connectorController, templateController, pluginController, pullreqController, webhookController, githookController, serviceaccountController, controller, principalController, checkController, ruleController, labelController, issueController, auditController, systemController)
+	lfsController := lfs2.ProvideController(config, authorizer, repoStore, lfsObjectStore, lfsContentStore, urlProvider)
+	gitHandler := router.ProvideGitHandler(config, urlProvider, repoStore, authnAuthenticator, authorizer, gitrpcInterface, lfsController)
 	webHandler := router.ProvideWebHandler(config)
-	routerRouter := router.ProvideRouter(config, apiHandler, gitHandler, webHandler, provider)
+	routerRouter := router.ProvideRouter(config, apiHandler, gitHandler, webHandler, urlProvider)
 	serverServer := server2.ProvideServer(config, routerRouter)
-	executionManager := manager.ProvideExecutionManager(config, executionStore, pipelineStore, provider, streamer, fileService, logStore, logStream, checkStore, repoStore, schedulerScheduler, secretStore, stageStore, stepStore, principalStore)
+	sshServer := ssh.ProvideServer(config, publicKeyStore, principalStore, repoStore, authorizer, gitrpcInterface, urlProvider)
+	executionManager := manager.ProvideExecutionManager(config, executionStore, pipelineStore, urlProvider, streamer, fileService, logStore, logStream, checkStore, eventsReporter, reporter3, repoStore, schedulerScheduler, secretStore, stageStore, stepStore, principalStore)
 	client := manager.ProvideExecutionClient(executionManager, config)
 	pluginManager := plugin2.ProvidePluginManager(config, pluginStore)
 	runtimeRunner, err := runner.ProvideExecutionRunner(config, client, pluginManager, executionManager)
@@ -249,7 +335,7 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	}
 	cronManager := cron.ProvideManager(serverConfig)
 	triggerConfig := server.ProvideTriggerConfig(config)
-	triggerService, err := trigger2.ProvideService(ctx, triggerConfig, triggerStore, commitService, pullReqStore, repoStore, pipelineStore, triggererTriggerer, readerFactory, eventsReaderFactory)
+	triggerService, err := trigger.ProvideService(ctx, triggerConfig, triggerStore, commitService, pullReqStore, repoStore, pipelineStore, triggererTriggerer, readerFactory, eventsReaderFactory)
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +343,15 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	if err != nil {
 		return nil, err
 	}
-	servicesServices := services.ProvideServices(webhookService, pullreqService, triggerService, jobScheduler, collector)
-	serverSystem := server.NewSystem(bootstrapBootstrap, serverServer, poller, grpcServer, pluginManager, cronManager, servicesServices)
+	issueService, err := issue2.ProvideService(ctx, config, readerFactory, eventsReaderFactory, transactor, gitrpcInterface, repoStore, pullReqStore, issueStore, pullReqActivityStore, streamer)
+	if err != nil {
+		return nil, err
+	}
+	ldapsyncService, err := ldapsync.ProvideService(config, authenticator, principalStore, spaceStore, membershipStore, jobScheduler, executor, recorder)
+	if err != nil {
+		return nil, err
+	}
+	servicesServices := services.ProvideServices(webhookService, pullreqService, triggerService, jobScheduler, collector, mirrorService, pushService, issueService, ldapsyncService)
+	serverSystem := server.NewSystem(bootstrapBootstrap, serverServer, sshServer, poller, grpcServer, pluginManager, cronManager, servicesServices)
 	return serverSystem, nil
 }
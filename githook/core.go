@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 )
@@ -48,7 +49,8 @@ func (c *CLICore) PreReceive(ctx context.Context) error {
 	}
 
 	in := &PreReceiveInput{
-		RefUpdates: refUpdates,
+		Environment: getEnvironment(),
+		RefUpdates:  refUpdates,
 	}
 
 	out, err := c.client.PreReceive(ctx, in)
@@ -114,6 +116,23 @@ func handleServerHookOutput(out *Output, err error) error {
 	return nil
 }
 
+// getEnvironment returns the git environment of the current hook execution.
+// During pre-receive the pushed objects are stored in a quarantine directory which is exposed via
+// GIT_OBJECT_DIRECTORY - for more details see https://git-scm.com/docs/git-receive-pack#_quarantine_environment
+func getEnvironment() Environment {
+	var dirs []string
+	if dir := os.Getenv("GIT_OBJECT_DIRECTORY"); dir != "" {
+		dirs = append(dirs, dir)
+	}
+	if alternates := os.Getenv("GIT_ALTERNATE_OBJECT_DIRECTORIES"); alternates != "" {
+		dirs = append(dirs, filepath.SplitList(alternates)...)
+	}
+
+	return Environment{
+		AlternateObjectDirs: dirs,
+	}
+}
+
 // getUpdatedReferencesFromStdIn reads the updated references provided by git from stdin.
 // The expected format is "<old-value> SP <new-value> SP <ref-name> LF"
 // For more details see https://git-scm.com/docs/githooks#pre-receive
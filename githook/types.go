@@ -39,8 +39,18 @@ type PostReceiveInput struct {
 	RefUpdates []ReferenceUpdate `json:"ref_updates"`
 }
 
+// Environment contains the git environment of the hook execution.
+type Environment struct {
+	// AlternateObjectDirs contains the object directories that have to be used in addition to the
+	// object directory of the repository (e.g. the quarantine directory containing the pushed objects).
+	AlternateObjectDirs []string `json:"alternate_object_dirs,omitempty"`
+}
+
 // PreReceiveInput represents the input of the pre-receive git hook.
 type PreReceiveInput struct {
+	// Environment contains the git environment of the pre-receive hook execution.
+	Environment Environment `json:"environment"`
+
 	// RefUpdates contains all references that are being updated as part of the git operation.
 	RefUpdates []ReferenceUpdate `json:"ref_updates"`
 }
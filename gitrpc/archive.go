@@ -0,0 +1,87 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/gitrpc/internal/streamio"
+	"github.com/harness/gitness/gitrpc/rpc"
+)
+
+type GetArchiveParams struct {
+	ReadParams
+	// GitRef is the branch, tag or commit to archive.
+	GitRef string
+	Format enum.ArchiveFormat
+	// Prefix is prepended to all paths inside the archive (optional).
+	Prefix string
+	// Paths limits the archive to the provided paths (optional).
+	Paths []string
+}
+
+func (p *GetArchiveParams) Validate() error {
+	if p == nil {
+		return ErrNoParamsProvided
+	}
+
+	if p.GitRef == "" {
+		return ErrInvalidArgumentf("git ref cannot be empty")
+	}
+
+	if _, ok := p.Format.Sanitize(); !ok {
+		return ErrInvalidArgumentf("unsupported archive format '%s'", p.Format)
+	}
+
+	return nil
+}
+
+// GetArchive returns a reader streaming the archive of the tree of the provided git ref.
+func (c *Client) GetArchive(ctx context.Context, params *GetArchiveParams) (io.Reader, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	stream, err := c.repoService.GetArchive(ctx, &rpc.GetArchiveRequest{
+		Base:   mapToRPCReadRequest(params.ReadParams),
+		GitRef: params.GitRef,
+		Format: params.Format.ToRPC(),
+		Prefix: params.Prefix,
+		Paths:  params.Paths,
+	})
+	if err != nil {
+		return nil, processRPCErrorf(err, "failed to start archive stream")
+	}
+
+	// receive the first message before returning to surface errors (e.g. unknown ref) to the caller.
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return bytes.NewReader(nil), nil
+	}
+	if err != nil {
+		return nil, processRPCErrorf(err, "failed to get archive for '%s'", params.GitRef)
+	}
+
+	reader := streamio.NewReader(func() ([]byte, error) {
+		resp, rErr := stream.Recv()
+		return resp.GetData(), rErr
+	})
+
+	return io.MultiReader(bytes.NewReader(first.GetData()), reader), nil
+}
@@ -43,6 +43,15 @@ type Commit struct {
 	Message   string    `json:"message,omitempty"`
 	Author    Signature `json:"author"`
 	Committer Signature `json:"committer"`
+	// Signature is the cryptographic signature of the commit (nil if the commit isn't signed).
+	Signature *ObjectSignature `json:"-"`
+}
+
+// ObjectSignature contains the cryptographic signature of a git object (commit or tag)
+// together with the payload that got signed.
+type ObjectSignature struct {
+	Signature []byte
+	Payload   []byte
 }
 
 type Signature struct {
@@ -276,3 +285,93 @@ func (c *Client) MergeBase(ctx context.Context,
 		MergeBaseSHA: result.MergeBaseSha,
 	}, nil
 }
+
+type IsAncestorParams struct {
+	ReadParams
+	AncestorCommitSHA   string
+	DescendantCommitSHA string
+}
+
+type IsAncestorOutput struct {
+	IsAncestor bool
+}
+
+// IsAncestor returns whether the ancestor commit is an ancestor of the descendant commit.
+func (c *Client) IsAncestor(ctx context.Context,
+	params IsAncestorParams,
+) (IsAncestorOutput, error) {
+	result, err := c.repoService.IsAncestor(ctx, &rpc.IsAncestorRequest{
+		Base:                mapToRPCReadRequest(params.ReadParams),
+		AncestorCommitSha:   params.AncestorCommitSHA,
+		DescendantCommitSha: params.DescendantCommitSHA,
+	})
+	if err != nil {
+		return IsAncestorOutput{}, processRPCErrorf(err, "failed to check ancestry of commits")
+	}
+
+	return IsAncestorOutput{
+		IsAncestor: result.IsAncestor,
+	}, nil
+}
+
+type FindMergeCommitParams struct {
+	ReadParams
+	// BaseSHA is the commit up to which the history is inspected (exclusive).
+	// If empty, all commits reachable from HeadSHA that aren't reachable from any reference are inspected.
+	BaseSHA string
+	HeadSHA string
+}
+
+type FindMergeCommitOutput struct {
+	// MergeCommitSHA is the sha of the first merge commit found (empty if there is none).
+	MergeCommitSHA string
+}
+
+// FindMergeCommit returns a merge commit in the history of HeadSHA (up to BaseSHA), if there is any.
+func (c *Client) FindMergeCommit(ctx context.Context,
+	params FindMergeCommitParams,
+) (FindMergeCommitOutput, error) {
+	result, err := c.repoService.FindMergeCommit(ctx, &rpc.FindMergeCommitRequest{
+		Base:    mapToRPCReadRequest(params.ReadParams),
+		BaseSha: params.BaseSHA,
+		HeadSha: params.HeadSHA,
+	})
+	if err != nil {
+		return FindMergeCommitOutput{}, processRPCErrorf(err, "failed to find merge commit")
+	}
+
+	return FindMergeCommitOutput{
+		MergeCommitSHA: result.MergeCommitSha,
+	}, nil
+}
+
+type FindUnsignedCommitParams struct {
+	ReadParams
+	// BaseSHA is the commit up to which the history is inspected (exclusive).
+	// If empty, all commits reachable from HeadSHA that aren't reachable from any reference are inspected.
+	BaseSHA string
+	HeadSHA string
+}
+
+type FindUnsignedCommitOutput struct {
+	// UnsignedCommitSHA is the sha of the first commit without signature found (empty if there is none).
+	UnsignedCommitSHA string
+}
+
+// FindUnsignedCommit returns a commit without signature in the history of HeadSHA (up to BaseSHA), if there is any.
+func (c *Client) FindUnsignedCommit(ctx context.Context,
+	params FindUnsignedCommitParams,
+) (FindUnsignedCommitOutput, error) {
+	result, err := c.repoService.FindUnsignedCommit(ctx, &rpc.FindUnsignedCommitRequest{
+		Base:    mapToRPCReadRequest(params.ReadParams),
+		BaseSha: params.BaseSHA,
+		HeadSha: params.HeadSHA,
+	})
+	if err != nil {
+		return FindUnsignedCommitOutput{}, processRPCErrorf(err, "failed to find unsigned commit")
+	}
+
+	return FindUnsignedCommitOutput{
+		UnsignedCommitSHA: result.UnsignedCommitSha,
+	}, nil
+}
@@ -21,6 +21,10 @@ import (
 // ReadParams contains the base parameters for read operations.
 type ReadParams struct {
 	RepoUID string
+
+	// AlternateObjectDirs contains a list of alternate object directories (e.g. the quarantine directory
+	// of a push that is in progress). The directories have to be located inside of the repository.
+	AlternateObjectDirs []string
 }
 
 func (p ReadParams) Validate() error {
@@ -39,7 +43,8 @@ type WriteParams struct {
 
 func mapToRPCReadRequest(p ReadParams) *rpc.ReadRequest {
 	return &rpc.ReadRequest{
-		RepoUid: p.RepoUID,
+		RepoUid:             p.RepoUID,
+		AlternateObjectDirs: p.AlternateObjectDirs,
 	}
 }
 
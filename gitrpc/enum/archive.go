@@ -0,0 +1,75 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+import "github.com/harness/gitness/gitrpc/rpc"
+
+// ArchiveFormat represents the format of a repository archive.
+type ArchiveFormat string
+
+const (
+	// ArchiveFormatTar uncompressed tar archive.
+	ArchiveFormatTar ArchiveFormat = "tar"
+	// ArchiveFormatTarGz gzip compressed tar archive.
+	ArchiveFormatTarGz ArchiveFormat = "tar.gz"
+	// ArchiveFormatZip zip archive.
+	ArchiveFormatZip ArchiveFormat = "zip"
+)
+
+var ArchiveFormats = []ArchiveFormat{
+	ArchiveFormatTar,
+	ArchiveFormatTarGz,
+	ArchiveFormatZip,
+}
+
+func ArchiveFormatFromRPC(t rpc.ArchiveFormat) ArchiveFormat {
+	switch t {
+	case rpc.ArchiveFormat_ArchiveFormatTar:
+		return ArchiveFormatTar
+	case rpc.ArchiveFormat_ArchiveFormatTarGz:
+		return ArchiveFormatTarGz
+	case rpc.ArchiveFormat_ArchiveFormatZip:
+		return ArchiveFormatZip
+	default:
+		return ArchiveFormatTar
+	}
+}
+
+func (f ArchiveFormat) ToRPC() rpc.ArchiveFormat {
+	switch f {
+	case ArchiveFormatTar:
+		return rpc.ArchiveFormat_ArchiveFormatTar
+	case ArchiveFormatTarGz:
+		return rpc.ArchiveFormat_ArchiveFormatTarGz
+	case ArchiveFormatZip:
+		return rpc.ArchiveFormat_ArchiveFormatZip
+	default:
+		return rpc.ArchiveFormat_ArchiveFormatTar
+	}
+}
+
+func (f ArchiveFormat) Sanitize() (ArchiveFormat, bool) {
+	switch f {
+	case ArchiveFormatTar, ArchiveFormatTarGz, ArchiveFormatZip:
+		return f, true
+	default:
+		return ArchiveFormatTar, false
+	}
+}
+
+// Extension returns the file extension (without leading dot) used for the archive format.
+func (f ArchiveFormat) Extension() string {
+	return string(f)
+}
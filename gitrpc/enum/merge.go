@@ -26,12 +26,18 @@ const (
 	MergeMethodSquash MergeMethod = "squash"
 	// MergeMethodRebase rebase before merging.
 	MergeMethodRebase MergeMethod = "rebase"
+	// MergeMethodFastForward fast-forward the base branch, fails if the base branch has diverged.
+	MergeMethodFastForward MergeMethod = "fast-forward"
+	// MergeMethodRebaseMerge rebase before merging and create merge commit (semi-linear history).
+	MergeMethodRebaseMerge MergeMethod = "rebase-merge"
 )
 
 var MergeMethods = []MergeMethod{
 	MergeMethodMerge,
 	MergeMethodSquash,
 	MergeMethodRebase,
+	MergeMethodFastForward,
+	MergeMethodRebaseMerge,
 }
 
 func MergeMethodFromRPC(t rpc.MergeRequest_MergeMethod) MergeMethod {
@@ -42,6 +48,10 @@ func MergeMethodFromRPC(t rpc.MergeRequest_MergeMethod) MergeMethod {
 		return MergeMethodSquash
 	case rpc.MergeRequest_rebase:
 		return MergeMethodRebase
+	case rpc.MergeRequest_fast_forward:
+		return MergeMethodFastForward
+	case rpc.MergeRequest_rebase_merge:
+		return MergeMethodRebaseMerge
 	default:
 		return MergeMethodMerge
 	}
@@ -55,6 +65,10 @@ func (m MergeMethod) ToRPC() rpc.MergeRequest_MergeMethod {
 		return rpc.MergeRequest_squash
 	case MergeMethodRebase:
 		return rpc.MergeRequest_rebase
+	case MergeMethodFastForward:
+		return rpc.MergeRequest_fast_forward
+	case MergeMethodRebaseMerge:
+		return rpc.MergeRequest_rebase_merge
 	default:
 		return rpc.MergeRequest_merge
 	}
@@ -62,7 +76,7 @@ func (m MergeMethod) ToRPC() rpc.MergeRequest_MergeMethod {
 
 func (m MergeMethod) Sanitize() (MergeMethod, bool) {
 	switch m {
-	case MergeMethodMerge, MergeMethodSquash, MergeMethodRebase:
+	case MergeMethodMerge, MergeMethodSquash, MergeMethodRebase, MergeMethodFastForward, MergeMethodRebaseMerge:
 		return m, true
 	default:
 		return MergeMethodMerge, false
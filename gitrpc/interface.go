@@ -41,9 +41,13 @@ type Interface interface {
 	UpdateRef(ctx context.Context, params UpdateRefParams) error
 
 	SyncRepository(ctx context.Context, params *SyncRepositoryParams) (*SyncRepositoryOutput, error)
+	ForkRepository(ctx context.Context, params *ForkRepositoryParams) (*ForkRepositoryOutput, error)
 
 	MatchFiles(ctx context.Context, params *MatchFilesParams) (*MatchFilesOutput, error)
 
+	// GetArchive returns a reader streaming the archive of the tree of the provided git ref.
+	GetArchive(ctx context.Context, params *GetArchiveParams) (io.Reader, error)
+
 	/*
 	 * Commits service
 	 */
@@ -53,6 +57,9 @@ type Interface interface {
 	GetCommitDivergences(ctx context.Context, params *GetCommitDivergencesParams) (*GetCommitDivergencesOutput, error)
 	CommitFiles(ctx context.Context, params *CommitFilesParams) (CommitFilesResponse, error)
 	MergeBase(ctx context.Context, params MergeBaseParams) (MergeBaseOutput, error)
+	IsAncestor(ctx context.Context, params IsAncestorParams) (IsAncestorOutput, error)
+	FindMergeCommit(ctx context.Context, params FindMergeCommitParams) (FindMergeCommitOutput, error)
+	FindUnsignedCommit(ctx context.Context, params FindUnsignedCommitParams) (FindUnsignedCommitOutput, error)
 
 	/*
 	 * Git Cli Service
@@ -0,0 +1,83 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/gitrpc/internal/types"
+
+	"code.gitea.io/gitea/modules/git"
+)
+
+// Archive streams an archive of the tree of the provided ref to the writer.
+// If prefix is set, all paths in the archive are placed under the prefix directory,
+// and if paths are provided only those paths are included in the archive.
+func (g Adapter) Archive(
+	ctx context.Context,
+	repoPath string,
+	ref string,
+	format enum.ArchiveFormat,
+	prefix string,
+	paths []string,
+	w io.Writer,
+) error {
+	// guard against arguments being interpreted as options by git.
+	if strings.HasPrefix(ref, "-") || strings.HasPrefix(prefix, "-") {
+		return fmt.Errorf("archive ref and prefix can't start with '-': %w", types.ErrInvalidArgument)
+	}
+
+	args := make([]string, 0, 6+len(paths))
+	args = append(args, "archive", "--format="+string(format))
+	if prefix != "" {
+		args = append(args, "--prefix="+strings.TrimSuffix(prefix, "/")+"/")
+	}
+	args = append(args, ref)
+	if len(paths) > 0 {
+		args = append(args, "--")
+		args = append(args, paths...)
+	}
+
+	cmd := git.NewCommand(ctx, args...)
+	cmd.SetDescription(fmt.Sprintf("Archive [repo_path: %s]", repoPath))
+	errbuf := bytes.Buffer{}
+	if err := cmd.Run(&git.RunOpts{
+		Dir:    repoPath,
+		Stderr: &errbuf,
+		Stdout: w,
+	}); err != nil {
+		stderr := errbuf.String()
+		switch {
+		// fatal: not a valid object name: <ref>
+		case strings.Contains(stderr, "not a valid object name"):
+			return fmt.Errorf("git ref '%s' not found: %w", ref, types.ErrNotFound)
+		// fatal: pathspec '<path>' did not match any files
+		case strings.Contains(stderr, "did not match any files"):
+			return fmt.Errorf("provided paths not found in '%s': %w", ref, types.ErrNotFound)
+		}
+
+		if stderr != "" {
+			err = &runStdError{err: err, stderr: stderr}
+		}
+		return processGiteaErrorf(err, "git archive failed for '%s' with err: %v", ref, err)
+	}
+
+	return nil
+}
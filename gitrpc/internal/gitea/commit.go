@@ -15,10 +15,12 @@
 package gitea
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 
@@ -443,3 +445,116 @@ func parseLinesToSlice(output []byte) []string {
 
 	return slice
 }
+
+// IsAncestor returns whether the commit with sha ancestorSHA is an ancestor of the commit with sha descendantSHA.
+// The env is passed to the git command (e.g. to provide access to quarantined objects).
+func (g Adapter) IsAncestor(ctx context.Context, repoPath string, env []string,
+	ancestorSHA string, descendantSHA string) (bool, error) {
+	_, _, err := gitea.NewCommand(ctx, "merge-base", "--is-ancestor", ancestorSHA, descendantSHA).
+		RunStdString(&gitea.RunOpts{Dir: repoPath, Env: env})
+	if err == nil {
+		return true, nil
+	}
+
+	// exit code 1 means the first commit isn't an ancestor of the second
+	var runErr gitea.RunStdError
+	if errors.As(err, &runErr) && runErr.IsExitCode(1) && runErr.Stderr() == "" {
+		return false, nil
+	}
+
+	return false, processGiteaErrorf(err, "failed to check ancestry of commits")
+}
+
+// FindMergeCommit returns the sha of a merge commit in the range baseSHA..headSHA (empty if there is none).
+// In case baseSHA is empty, all commits reachable from headSHA that aren't reachable from any reference are inspected.
+// The env is passed to the git command (e.g. to provide access to quarantined objects).
+func (g Adapter) FindMergeCommit(ctx context.Context, repoPath string, env []string,
+	baseSHA string, headSHA string) (string, error) {
+	args := []string{"rev-list", "--min-parents=2", "--max-count=1"}
+	if baseSHA == "" {
+		args = append(args, headSHA, "--not", "--all")
+	} else {
+		args = append(args, baseSHA+".."+headSHA)
+	}
+
+	stdout, _, err := gitea.NewCommand(ctx, args...).RunStdString(&gitea.RunOpts{Dir: repoPath, Env: env})
+	if err != nil {
+		return "", processGiteaErrorf(err, "failed to find merge commit")
+	}
+
+	return strings.TrimSpace(stdout), nil
+}
+
+// FindUnsignedCommit returns the sha of a commit without signature in the range baseSHA..headSHA
+// (empty if all commits are signed).
+// In case baseSHA is empty, all commits reachable from headSHA that aren't reachable from any reference are inspected.
+// The env is passed to the git command (e.g. to provide access to quarantined objects).
+func (g Adapter) FindUnsignedCommit(ctx context.Context, repoPath string, env []string,
+	baseSHA string, headSHA string) (string, error) {
+	args := []string{"rev-list", "--header"}
+	if baseSHA == "" {
+		args = append(args, headSHA, "--not", "--all")
+	} else {
+		args = append(args, baseSHA+".."+headSHA)
+	}
+
+	// the command is canceled as soon as an unsigned commit is found.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pipeReader, pipeWriter := io.Pipe()
+	defer func() { _ = pipeReader.Close() }()
+
+	go func() {
+		stderr := &bytes.Buffer{}
+		err := gitea.NewCommand(ctx, args...).Run(&gitea.RunOpts{
+			Dir:    repoPath,
+			Env:    env,
+			Stdout: pipeWriter,
+			Stderr: stderr,
+		})
+		if err != nil && stderr.Len() > 0 {
+			err = &runStdError{err: err, stderr: stderr.String()}
+		}
+		_ = pipeWriter.CloseWithError(err)
+	}()
+
+	reader := bufio.NewReader(pipeReader)
+	for {
+		// rev-list --header terminates each raw commit with a NUL byte.
+		record, err := reader.ReadBytes(0)
+		if sha, signed := parseRevListHeaderRecord(record); sha != "" && !signed {
+			return sha, nil
+		}
+		if errors.Is(err, io.EOF) {
+			return "", nil
+		}
+		if err != nil {
+			return "", processGiteaErrorf(err, "failed to find unsigned commit")
+		}
+	}
+}
+
+// parseRevListHeaderRecord returns the sha of the raw commit printed by rev-list --header
+// and whether the commit contains a signature header.
+func parseRevListHeaderRecord(record []byte) (string, bool) {
+	record = bytes.TrimRight(record, "\x00")
+	if len(record) == 0 {
+		return "", false
+	}
+
+	lines := strings.Split(string(record), "\n")
+	sha := strings.TrimSpace(lines[0])
+
+	// commit headers end with the first empty line.
+	for _, line := range lines[1:] {
+		if line == "" {
+			break
+		}
+		if strings.HasPrefix(line, "gpgsig ") || strings.HasPrefix(line, "gpgsig-sha256 ") {
+			return sha, true
+		}
+	}
+
+	return sha, false
+}
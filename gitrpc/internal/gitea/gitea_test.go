@@ -99,3 +99,38 @@ func testParseTagDataFromCatFileFor(t *testing.T, object string, typ types.GitOb
 	require.Equal(t, tagger.Identity.Email, res.Tagger.Identity.Email, data)
 	require.Equal(t, tagger.When, res.Tagger.When, data)
 }
+
+func TestParseTagDataFromCatFileWithSignature(t *testing.T) {
+	header := "object sha012\ntype commit\ntag v1.0\ntagger max <max@mail.com> 1666401234 -0700\n\n"
+	signature := "-----BEGIN SSH SIGNATURE-----\nU1NIU0lH\n-----END SSH SIGNATURE-----\n"
+	data := header + "title\n\nbody\n" + signature
+
+	res, err := parseTagDataFromCatFile([]byte(data))
+	require.NoError(t, err)
+
+	require.Equal(t, "title\n\nbody", res.Message)
+	require.Equal(t, "title", res.Title)
+	require.NotNil(t, res.Signature)
+	require.Equal(t, signature, string(res.Signature.Signature))
+	require.Equal(t, header+"title\n\nbody\n", string(res.Signature.Payload))
+
+	res, err = parseTagDataFromCatFile([]byte(header + "unsigned"))
+	require.NoError(t, err)
+	require.Nil(t, res.Signature)
+}
+
+func TestParseRevListHeaderRecord(t *testing.T) {
+	signed := "sha1\ntree t\nauthor a <a@b.c> 1 +0000\ngpgsig -----BEGIN SSH SIGNATURE-----\n U1NI\n" +
+		" -----END SSH SIGNATURE-----\n\n    message\x00"
+	sha, ok := parseRevListHeaderRecord([]byte(signed))
+	require.Equal(t, "sha1", sha)
+	require.True(t, ok)
+
+	unsigned := "sha2\ntree t\nauthor a <a@b.c> 1 +0000\n\n    gpgsig in message\x00"
+	sha, ok = parseRevListHeaderRecord([]byte(unsigned))
+	require.Equal(t, "sha2", sha)
+	require.False(t, ok)
+
+	sha, _ = parseRevListHeaderRecord([]byte{})
+	require.Equal(t, "", sha)
+}
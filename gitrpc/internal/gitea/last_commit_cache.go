@@ -20,6 +20,7 @@ import (
 	"encoding/gob"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 
 	gitea "code.gitea.io/gitea/modules/git"
 	gogitplumbing "github.com/go-git/go-git/v5/plumbing"
+	gogitobject "github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-redis/redis/v8"
 )
 
@@ -139,6 +141,14 @@ func (c commitEntryGetter) Find(ctx context.Context, key CommitEntryKey) (*types
 		return nil, fmt.Errorf("failed to load commit data: %w", err)
 	}
 
+	var signature *types.ObjectSignature
+	if commit.PGPSignature != "" {
+		signature, err = mapGogitCommitSignature(commit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var title string
 	var message string
 
@@ -166,5 +176,29 @@ func (c commitEntryGetter) Find(ctx context.Context, key CommitEntryKey) (*types
 			},
 			When: commit.Committer.When,
 		},
+		Signature: signature,
+	}, nil
+}
+
+// mapGogitCommitSignature returns the signature of the commit together with the signed payload.
+func mapGogitCommitSignature(commit *gogitobject.Commit) (*types.ObjectSignature, error) {
+	encoded := &gogitplumbing.MemoryObject{}
+	if err := commit.EncodeWithoutSignature(encoded); err != nil {
+		return nil, fmt.Errorf("failed to encode commit payload: %w", err)
+	}
+
+	reader, err := encoded.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit payload: %w", err)
+	}
+
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit payload: %w", err)
+	}
+
+	return &types.ObjectSignature{
+		Signature: []byte(commit.PGPSignature),
+		Payload:   payload,
 	}, nil
 }
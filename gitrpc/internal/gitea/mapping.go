@@ -49,6 +49,9 @@ func processGiteaErrorf(err error, format string, args ...interface{}) error {
 		return types.ErrNotFound
 	case gitea.IsErrBranchNotExist(err):
 		return types.ErrNotFound
+	case errors.Is(err, types.ErrFailedToConnect),
+		errors.Is(err, types.ErrAuthenticationFailed):
+		return err
 	default:
 		return fallbackErr
 	}
@@ -93,6 +96,10 @@ func mapGiteaRunStdError(err gitea.RunStdError, fallback error) error {
 	case err.IsExitCode(128) && strings.Contains(err.Stderr(), "Failed to connect"):
 		return types.ErrFailedToConnect
 
+	// exit status 128 - fatal: Authentication failed for 'http://127.0.0.1:4101/space/repo.git/'
+	case err.IsExitCode(128) && strings.Contains(err.Stderr(), "Authentication failed"):
+		return types.ErrAuthenticationFailed
+
 	default:
 		return fallback
 	}
@@ -149,9 +156,21 @@ func mapGiteaCommit(giteaCommit *gitea.Commit) (*types.Commit, error) {
 		Message:   strings.TrimRight(giteaCommit.Message(), "\n"),
 		Author:    author,
 		Committer: committer,
+		Signature: mapGiteaCommitSignature(giteaCommit.Signature),
 	}, nil
 }
 
+func mapGiteaCommitSignature(giteaSignature *gitea.CommitGPGSignature) *types.ObjectSignature {
+	if giteaSignature == nil || giteaSignature.Signature == "" {
+		return nil
+	}
+
+	return &types.ObjectSignature{
+		Signature: []byte(giteaSignature.Signature),
+		Payload:   []byte(giteaSignature.Payload),
+	}
+}
+
 func mapGogitNodeToTreeNodeModeAndType(
 	gogitMode gogitfilemode.FileMode,
 ) (types.TreeNodeType, types.TreeNodeMode, error) {
@@ -213,7 +213,19 @@ func runMergeCommand(
 		Env:    env,
 	}); err != nil {
 		// Merge will leave a MERGE_HEAD file in the .git folder if there is a conflict
-		if _, statErr := os.Stat(filepath.Join(tmpBasePath, ".git", "MERGE_HEAD")); statErr == nil {
+		_, statErr := os.Stat(filepath.Join(tmpBasePath, ".git", "MERGE_HEAD"))
+		isConflict := statErr == nil
+
+		// Squash merge doesn't write MERGE_HEAD, but the conflicting files remain unmerged in the index
+		if !isConflict && mergeMethod == enum.MergeMethodSquash {
+			var conflicts strings.Builder
+			if cfErr := conflictFiles(ctx, pr, env, tmpBasePath, &conflicts); cfErr != nil {
+				return cfErr
+			}
+			isConflict = conflicts.Len() > 0
+		}
+
+		if isConflict {
 			// We have a merge conflict error
 			if err = conflictFiles(ctx, pr, env, tmpBasePath, &outbuf); err != nil {
 				return err
@@ -342,90 +354,10 @@ func (g Adapter) Merge(
 			}
 		}
 	case enum.MergeMethodRebase:
-		// Checkout head branch
-		if err := git.NewCommand(ctx, "checkout", "-b", stagingBranch, trackingBranch).
-			Run(&git.RunOpts{
-				Dir:    tmpBasePath,
-				Stdout: &outbuf,
-				Stderr: &errbuf,
-			}); err != nil {
-			return fmt.Errorf(
-				"git checkout base prior to merge post staging rebase  [%s -> %s]: %w\n%s\n%s",
-				pr.HeadBranch, pr.BaseBranch, err, outbuf.String(), errbuf.String(),
-			)
-		}
-		outbuf.Reset()
-		errbuf.Reset()
-
-		// Rebase before merging
-		if err := git.NewCommand(ctx, "rebase", baseBranch).
-			Run(&git.RunOpts{
-				Dir:    tmpBasePath,
-				Stdout: &outbuf,
-				Stderr: &errbuf,
-			}); err != nil {
-			// Rebase will leave a REBASE_HEAD file in .git if there is a conflict
-			if _, statErr := os.Stat(filepath.Join(tmpBasePath, ".git", "REBASE_HEAD")); statErr == nil {
-				var commitSha string
-
-				// TBD git version we will support
-				// failingCommitPath := filepath.Join(tmpBasePath, ".git", "rebase-apply", "original-commit") // Git < 2.26
-				// if _, cpErr := os.Stat(failingCommitPath); statErr != nil {
-				// 	return fmt.Errorf("git rebase staging on to base [%s -> %s]: %v\n%s\n%s",
-				// 	pr.HeadBranch, pr.BaseBranch, cpErr, outbuf.String(), errbuf.String())
-				// }
-
-				failingCommitPath := filepath.Join(tmpBasePath, ".git", "rebase-merge", "stopped-sha") // Git >= 2.26
-				if _, cpErr := os.Stat(failingCommitPath); cpErr != nil {
-					return fmt.Errorf(
-						"git rebase staging on to base [%s -> %s]: %w\n%s\n%s",
-						pr.HeadBranch, pr.BaseBranch, cpErr, outbuf.String(), errbuf.String(),
-					)
-				}
-
-				commitShaBytes, readErr := os.ReadFile(failingCommitPath)
-				if readErr != nil {
-					// Abandon this attempt to handle the error
-					return fmt.Errorf(
-						"git rebase staging on to base [%s -> %s]: %w\n%s\n%s",
-						pr.HeadBranch, pr.BaseBranch, readErr, outbuf.String(), errbuf.String(),
-					)
-				}
-				commitSha = strings.TrimSpace(string(commitShaBytes))
-
-				log.Debug().Msgf("RebaseConflict at %s [%s -> %s]: %v\n%s\n%s",
-					commitSha, pr.HeadBranch, pr.BaseBranch, err, outbuf.String(), errbuf.String(),
-				)
-				return &types.MergeConflictsError{
-					Method:    mergeMethod,
-					CommitSHA: commitSha,
-					StdOut:    outbuf.String(),
-					StdErr:    errbuf.String(),
-					Err:       err,
-				}
-			}
-			return fmt.Errorf(
-				"git rebase staging on to base [%s -> %s]: %w\n%s\n%s",
-				pr.HeadBranch, pr.BaseBranch, err, outbuf.String(), errbuf.String(),
-			)
-		}
-		outbuf.Reset()
-		errbuf.Reset()
-
-		// Checkout base branch again
-		if err := git.NewCommand(ctx, "checkout", baseBranch).
-			Run(&git.RunOpts{
-				Dir:    tmpBasePath,
-				Stdout: &outbuf,
-				Stderr: &errbuf,
-			}); err != nil {
-			return fmt.Errorf(
-				"git checkout base prior to merge post staging rebase  [%s -> %s]: %w\n%s\n%s",
-				pr.HeadBranch, pr.BaseBranch, err, outbuf.String(), errbuf.String(),
-			)
+		err := rebaseOnBase(ctx, pr, mergeMethod, baseBranch, trackingBranch, stagingBranch, tmpBasePath, env)
+		if err != nil {
+			return err
 		}
-		outbuf.Reset()
-		errbuf.Reset()
 
 		cmd := git.NewCommand(ctx, "merge", "--ff-only", stagingBranch)
 
@@ -433,6 +365,25 @@ func (g Adapter) Merge(
 		if err := runMergeCommand(ctx, pr, mergeMethod, cmd, tmpBasePath, env); err != nil {
 			return err
 		}
+	case enum.MergeMethodRebaseMerge:
+		err := rebaseOnBase(ctx, pr, mergeMethod, baseBranch, trackingBranch, stagingBranch, tmpBasePath, env)
+		if err != nil {
+			return err
+		}
+
+		cmd := git.NewCommand(ctx, "merge", "--no-ff", "--no-commit", stagingBranch)
+		if err := runMergeCommand(ctx, pr, mergeMethod, cmd, tmpBasePath, env); err != nil {
+			return fmt.Errorf("unable to merge staging into base: %w", err)
+		}
+
+		if err := commitAndSignNoAuthor(ctx, pr, mergeMsg, signArg, tmpBasePath, env); err != nil {
+			return fmt.Errorf("unable to make final commit: %w", err)
+		}
+	case enum.MergeMethodFastForward:
+		cmd := git.NewCommand(ctx, "merge", "--ff-only", trackingBranch)
+		if err := runMergeCommand(ctx, pr, mergeMethod, cmd, tmpBasePath, env); err != nil {
+			return fmt.Errorf("unable to fast-forward base to tracking: %w", err)
+		}
 	default:
 		return fmt.Errorf("wrong merge method provided: %s", mergeMethod)
 	}
@@ -440,6 +391,109 @@ func (g Adapter) Merge(
 	return nil
 }
 
+// rebaseOnBase checks out the tracking branch as the staging branch and rebases it on top of the base branch.
+// When finished, the base branch is checked out again.
+//
+//nolint:gocognit,nestif
+func rebaseOnBase(
+	ctx context.Context,
+	pr *types.PullRequest,
+	mergeMethod enum.MergeMethod,
+	baseBranch string,
+	trackingBranch string,
+	stagingBranch string,
+	tmpBasePath string,
+	env []string,
+) error {
+	var outbuf, errbuf strings.Builder
+
+	// Checkout head branch
+	if err := git.NewCommand(ctx, "checkout", "-b", stagingBranch, trackingBranch).
+		Run(&git.RunOpts{
+			Dir:    tmpBasePath,
+			Stdout: &outbuf,
+			Stderr: &errbuf,
+		}); err != nil {
+		return fmt.Errorf(
+			"git checkout base prior to merge post staging rebase  [%s -> %s]: %w\n%s\n%s",
+			pr.HeadBranch, pr.BaseBranch, err, outbuf.String(), errbuf.String(),
+		)
+	}
+	outbuf.Reset()
+	errbuf.Reset()
+
+	// Rebase before merging
+	if err := git.NewCommand(ctx, "rebase", baseBranch).
+		Run(&git.RunOpts{
+			Env:    env,
+			Dir:    tmpBasePath,
+			Stdout: &outbuf,
+			Stderr: &errbuf,
+		}); err != nil {
+		// Rebase will leave a REBASE_HEAD file in .git if there is a conflict
+		if _, statErr := os.Stat(filepath.Join(tmpBasePath, ".git", "REBASE_HEAD")); statErr == nil {
+			var commitSha string
+
+			// TBD git version we will support
+			// failingCommitPath := filepath.Join(tmpBasePath, ".git", "rebase-apply", "original-commit") // Git < 2.26
+			// if _, cpErr := os.Stat(failingCommitPath); statErr != nil {
+			// 	return fmt.Errorf("git rebase staging on to base [%s -> %s]: %v\n%s\n%s",
+			// 	pr.HeadBranch, pr.BaseBranch, cpErr, outbuf.String(), errbuf.String())
+			// }
+
+			failingCommitPath := filepath.Join(tmpBasePath, ".git", "rebase-merge", "stopped-sha") // Git >= 2.26
+			if _, cpErr := os.Stat(failingCommitPath); cpErr != nil {
+				return fmt.Errorf(
+					"git rebase staging on to base [%s -> %s]: %w\n%s\n%s",
+					pr.HeadBranch, pr.BaseBranch, cpErr, outbuf.String(), errbuf.String(),
+				)
+			}
+
+			commitShaBytes, readErr := os.ReadFile(failingCommitPath)
+			if readErr != nil {
+				// Abandon this attempt to handle the error
+				return fmt.Errorf(
+					"git rebase staging on to base [%s -> %s]: %w\n%s\n%s",
+					pr.HeadBranch, pr.BaseBranch, readErr, outbuf.String(), errbuf.String(),
+				)
+			}
+			commitSha = strings.TrimSpace(string(commitShaBytes))
+
+			log.Debug().Msgf("RebaseConflict at %s [%s -> %s]: %v\n%s\n%s",
+				commitSha, pr.HeadBranch, pr.BaseBranch, err, outbuf.String(), errbuf.String(),
+			)
+			return &types.MergeConflictsError{
+				Method:    mergeMethod,
+				CommitSHA: commitSha,
+				StdOut:    outbuf.String(),
+				StdErr:    errbuf.String(),
+				Err:       err,
+			}
+		}
+		return fmt.Errorf(
+			"git rebase staging on to base [%s -> %s]: %w\n%s\n%s",
+			pr.HeadBranch, pr.BaseBranch, err, outbuf.String(), errbuf.String(),
+		)
+	}
+	outbuf.Reset()
+	errbuf.Reset()
+
+	// Checkout base branch again
+	if err := git.NewCommand(ctx, "checkout", baseBranch).
+		Run(&git.RunOpts{
+			Dir:    tmpBasePath,
+			Stdout: &outbuf,
+			Stderr: &errbuf,
+		}); err != nil {
+		return fmt.Errorf(
+			"git checkout base prior to merge post staging rebase  [%s -> %s]: %w\n%s\n%s",
+			pr.HeadBranch, pr.BaseBranch, err, outbuf.String(), errbuf.String(),
+		)
+	}
+
+	return nil
+}
+
 func conflictFiles(ctx context.Context,
 	pr *types.PullRequest,
 	env []string,
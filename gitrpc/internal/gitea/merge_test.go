@@ -0,0 +1,278 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/gitrpc/internal/types"
+
+	gitea "code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/setting"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "gitea-home")
+	if err != nil {
+		panic(err)
+	}
+
+	setting.Git.HomePath = home
+	if err = gitea.InitSimple(context.Background()); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+
+	_ = os.RemoveAll(home)
+	os.Exit(code)
+}
+
+// mergeEnv is the environment of the merge, it provides the identity of the merge commits.
+var mergeEnv = []string{
+	"GIT_AUTHOR_NAME=Merger",
+	"GIT_AUTHOR_EMAIL=merger@example.com",
+	"GIT_COMMITTER_NAME=Merger",
+	"GIT_COMMITTER_EMAIL=merger@example.com",
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   enum.MergeMethod
+		diverged bool
+		// expected subjects and committers of the first-parent commits of the base branch, newest first
+		expLog []string
+		// expected subject and committer of the merged commit, if the head of the base branch is a merge commit
+		expMerged string
+	}{
+		{
+			name:      "merge",
+			method:    enum.MergeMethodMerge,
+			diverged:  true,
+			expLog:    []string{"merge message Merger", "main Dev", "initial Dev"},
+			expMerged: "feature Dev",
+		},
+		{
+			name:     "squash",
+			method:   enum.MergeMethodSquash,
+			diverged: true,
+			expLog:   []string{"merge message Merger", "main Dev", "initial Dev"},
+		},
+		{
+			name:     "rebase",
+			method:   enum.MergeMethodRebase,
+			diverged: true,
+			// the rebased commit is committed with the identity from the merge environment.
+			expLog: []string{"feature Merger", "main Dev", "initial Dev"},
+		},
+		{
+			name:      "rebase-merge",
+			method:    enum.MergeMethodRebaseMerge,
+			diverged:  true,
+			expLog:    []string{"merge message Merger", "main Dev", "initial Dev"},
+			expMerged: "feature Merger",
+		},
+		{
+			name:   "fast-forward",
+			method: enum.MergeMethodFastForward,
+			expLog: []string{"feature Dev", "initial Dev"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repoPath := createMergeRepo(t, "feature.txt", "main.txt", test.diverged)
+			tmpPath := mergePR(t, repoPath, test.method)
+
+			require.Equal(t, test.expLog, gitLines(t, tmpPath, "log", "--first-parent", "--format=%s %cn", "base"))
+
+			parents := strings.Fields(gitLines(t, tmpPath, "log", "-1", "--format=%P", "base")[0])
+			if test.expMerged == "" {
+				require.Len(t, parents, 1)
+			} else {
+				require.Len(t, parents, 2)
+				require.Equal(t, []string{test.expMerged}, gitLines(t, tmpPath, "log", "-1", "--format=%s %cn", "base^2"))
+			}
+
+			// all merge methods must include the changes of both branches.
+			files := gitLines(t, tmpPath, "ls-tree", "--name-only", "base")
+			require.Contains(t, files, "feature.txt")
+			if test.diverged {
+				require.Contains(t, files, "main.txt")
+			}
+		})
+	}
+}
+
+func TestMergeSquashAuthor(t *testing.T) {
+	repoPath := createMergeRepo(t, "feature.txt", "main.txt", true)
+	tmpPath := mergePR(t, repoPath, enum.MergeMethodSquash)
+
+	require.Equal(t, []string{"Author <author@example.com>"},
+		gitLines(t, tmpPath, "log", "-1", "--format=%an <%ae>", "base"))
+}
+
+func TestMergeConflicts(t *testing.T) {
+	methods := []enum.MergeMethod{
+		enum.MergeMethodMerge,
+		enum.MergeMethodSquash,
+		enum.MergeMethodRebase,
+		enum.MergeMethodRebaseMerge,
+	}
+
+	for _, method := range methods {
+		t.Run(string(method), func(t *testing.T) {
+			// both branches add the same file with different content.
+			repoPath := createMergeRepo(t, "conflict.txt", "conflict.txt", true)
+
+			err := tryMergePR(t, repoPath, method)
+
+			conflictErr := &types.MergeConflictsError{}
+			require.True(t, errors.As(err, &conflictErr), "expected merge conflicts error, got %v", err)
+			require.Equal(t, method, conflictErr.Method)
+
+			if method == enum.MergeMethodRebase || method == enum.MergeMethodRebaseMerge {
+				require.NotEmpty(t, conflictErr.CommitSHA, "expected the commit that failed to rebase")
+			} else {
+				require.Contains(t, conflictErr.StdOut, "conflict.txt")
+			}
+		})
+	}
+}
+
+func TestMergeFastForwardDiverged(t *testing.T) {
+	repoPath := createMergeRepo(t, "feature.txt", "main.txt", true)
+
+	err := tryMergePR(t, repoPath, enum.MergeMethodFastForward)
+	require.Error(t, err)
+	require.False(t, types.IsMergeConflictsError(err), "diverged branches aren't a merge conflict")
+}
+
+// createMergeRepo creates a repository with the branches main and feature.
+// The feature branch adds the feature file, the main branch adds the main file if the branches diverge.
+func createMergeRepo(t *testing.T, featureFile, mainFile string, diverged bool) string {
+	t.Helper()
+
+	workPath := t.TempDir()
+
+	runGit(t, workPath, "init", "--initial-branch=main")
+	commitFile(t, workPath, "initial.txt", "initial", "initial")
+
+	runGit(t, workPath, "checkout", "-b", "feature")
+	commitFile(t, workPath, featureFile, "feature content", "feature")
+
+	runGit(t, workPath, "checkout", "main")
+	if diverged {
+		commitFile(t, workPath, mainFile, "main content", "main")
+	}
+
+	// the repositories are bare, as the repositories of the server.
+	repoPath := filepath.Join(t.TempDir(), "repo.git")
+	runGit(t, workPath, "clone", "--bare", workPath, repoPath)
+
+	return repoPath
+}
+
+// mergePR merges the feature branch into the main branch in a temporary repository
+// and returns the path of the temporary repository.
+func mergePR(t *testing.T, repoPath string, method enum.MergeMethod) string {
+	t.Helper()
+
+	tmpPath, err := prepareMerge(t, repoPath, method)
+	require.NoError(t, err)
+
+	return tmpPath
+}
+
+func tryMergePR(t *testing.T, repoPath string, method enum.MergeMethod) error {
+	t.Helper()
+
+	_, err := prepareMerge(t, repoPath, method)
+	return err
+}
+
+// prepareMerge prepares the temporary repository the same way the merge service does and merges in it.
+func prepareMerge(t *testing.T, repoPath string, method enum.MergeMethod) (string, error) {
+	t.Helper()
+
+	ctx := context.Background()
+	g := Adapter{}
+
+	pr := &types.PullRequest{
+		BaseRepoPath: repoPath,
+		BaseBranch:   "main",
+		HeadBranch:   "feature",
+	}
+
+	tmpRepo, err := g.CreateTemporaryRepoForPR(ctx, t.TempDir(), pr, "base", "tracking")
+	require.NoError(t, err)
+
+	sparseCheckoutList, err := g.GetDiffTree(ctx, tmpRepo.Path, "base", "tracking")
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(tmpRepo.Path, ".git", "info", "sparse-checkout"),
+		[]byte(sparseCheckoutList), 0o600)
+	require.NoError(t, err)
+
+	require.NoError(t, g.Config(ctx, tmpRepo.Path, "core.sparseCheckout", "true"))
+	require.NoError(t, g.ReadTree(ctx, tmpRepo.Path, "HEAD", io.Discard))
+
+	err = g.Merge(ctx, pr, method, "base", "tracking", tmpRepo.Path, "merge message", mergeEnv,
+		&types.Identity{Name: "Author", Email: "author@example.com"})
+
+	return tmpRepo.Path, err
+}
+
+func commitFile(t *testing.T, repoPath, file, content, message string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, file), []byte(content), 0o600))
+	runGit(t, repoPath, "add", file)
+	runGit(t, repoPath, "commit", "-m", message)
+}
+
+func runGit(t *testing.T, repoPath string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoPath
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Dev",
+		"GIT_AUTHOR_EMAIL=dev@example.com",
+		"GIT_COMMITTER_NAME=Dev",
+		"GIT_COMMITTER_EMAIL=dev@example.com",
+	)
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+
+	return string(out)
+}
+
+func gitLines(t *testing.T, repoPath string, args ...string) []string {
+	t.Helper()
+
+	return strings.Split(strings.TrimSpace(runGit(t, repoPath, args...)), "\n")
+}
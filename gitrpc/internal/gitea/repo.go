@@ -125,8 +125,12 @@ func (g Adapter) Clone(ctx context.Context, from, to string, opts types.CloneRep
 }
 
 // Sync synchronizes the repository to match the provided source.
+// If no refSpecs are provided all references of the source are synchronized.
 // NOTE: This is a read operation and doesn't trigger any server side hooks.
-func (g Adapter) Sync(ctx context.Context, repoPath string, remoteURL string) error {
+func (g Adapter) Sync(ctx context.Context, repoPath string, remoteURL string, refSpecs []string) error {
+	if len(refSpecs) == 0 {
+		refSpecs = []string{"+refs/*:refs/*"}
+	}
 	args := []string{
 		"-c", "advice.fetchShowForcedUpdates=false",
 		"-c", "credential.helper=",
@@ -138,8 +142,8 @@ func (g Adapter) Sync(ctx context.Context, repoPath string, remoteURL string) er
 		"--no-write-fetch-head",
 		"--no-show-forced-updates",
 		remoteURL,
-		"+refs/*:refs/*",
 	}
+	args = append(args, refSpecs...)
 
 	cmd := gitea.NewCommand(ctx, args...)
 	_, _, err := cmd.RunStdString(&gitea.RunOpts{
@@ -216,12 +220,17 @@ func Push(ctx context.Context, repoPath string, opts types.PushOptions) error {
 	if opts.Mirror {
 		cmd.AddArguments("--mirror")
 	}
+	if opts.Prune {
+		cmd.AddArguments("--prune")
+	}
 	cmd.AddArguments("--", opts.Remote)
 
 	if len(opts.Branch) > 0 {
 		cmd.AddArguments(opts.Branch)
 	}
 
+	cmd.AddArguments(opts.RefSpecs...)
+
 	// remove credentials if there are any
 	logRemote := opts.Remote
 	if strings.Contains(logRemote, "://") && strings.Contains(logRemote, "@") {
@@ -272,6 +281,10 @@ func Push(ctx context.Context, repoPath string, opts types.PushOptions) error {
 				Err:    err,
 			}
 			return err
+		case strings.Contains(errbuf.String(), "Failed to connect"):
+			return types.ErrFailedToConnect
+		case strings.Contains(errbuf.String(), "Authentication failed"):
+			return types.ErrAuthenticationFailed
 		default:
 			// fall through to normal error handling
 		}
@@ -34,6 +34,12 @@ const (
 	pgpSignatureEndToken   = "\n-----END PGP SIGNATURE-----"     //#nosec G101
 )
 
+// tagSignatureBeginTokens contains the tokens marking the beginning of a signature appended to a tag message.
+var tagSignatureBeginTokens = []string{
+	"-----BEGIN PGP SIGNATURE-----",
+	"-----BEGIN SSH SIGNATURE-----",
+}
+
 // GetAnnotatedTag returns the tag for a specific tag sha.
 func (g Adapter) GetAnnotatedTag(ctx context.Context, repoPath string, sha string) (*types.Tag, error) {
 	tags, err := giteaGetAnnotatedTags(ctx, repoPath, []string{sha})
@@ -176,8 +182,20 @@ func parseTagDataFromCatFile(data []byte) (tag types.Tag, err error) {
 		return
 	}
 
-	// remainder is message and gpg (remove leading and tailing new lines)
-	message := string(bytes.Trim(data[p:], "\n"))
+	// remainder is message and signature
+	remainder := data[p:]
+
+	// handle signature appended to the message (the payload is everything before the signature)
+	if sigStart := findTagSignatureStart(remainder); sigStart > -1 {
+		tag.Signature = &types.ObjectSignature{
+			Signature: append([]byte(nil), remainder[sigStart:]...),
+			Payload:   append([]byte(nil), data[:p+sigStart]...),
+		}
+		remainder = remainder[:sigStart]
+	}
+
+	// remove leading and tailing new lines from message
+	message := string(bytes.Trim(remainder, "\n"))
 
 	// handle gpg signature
 	pgpEnd := strings.Index(message, pgpSignatureEndToken)
@@ -200,6 +218,18 @@ func parseTagDataFromCatFile(data []byte) (tag types.Tag, err error) {
 	return tag, nil
 }
 
+// findTagSignatureStart returns the index of the signature appended to the tag message (-1 if there is none).
+func findTagSignatureStart(remainder []byte) int {
+	for _, token := range tagSignatureBeginTokens {
+		idx := bytes.LastIndex(remainder, []byte(token))
+		if idx == 0 || idx > 0 && remainder[idx-1] == '\n' {
+			return idx
+		}
+	}
+
+	return -1
+}
+
 func giteaParseCatFileLine(data []byte, start int, header string) (string, int, error) {
 	// for simplicity only look at data from start onwards
 	data = data[start:]
@@ -0,0 +1,57 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/gitrpc/internal/streamio"
+	"github.com/harness/gitness/gitrpc/internal/types"
+	"github.com/harness/gitness/gitrpc/rpc"
+)
+
+func (s RepositoryService) GetArchive(
+	request *rpc.GetArchiveRequest,
+	stream rpc.RepositoryService_GetArchiveServer,
+) error {
+	if err := validateGetArchiveRequest(request); err != nil {
+		return err
+	}
+
+	base := request.GetBase()
+	repoPath := getFullPathForRepo(s.reposRoot, base.GetRepoUid())
+
+	sw := streamio.NewWriter(func(p []byte) error {
+		return stream.Send(&rpc.GetArchiveResponse{Data: p})
+	})
+
+	err := s.adapter.Archive(stream.Context(), repoPath, request.GetGitRef(),
+		enum.ArchiveFormatFromRPC(request.GetFormat()), request.GetPrefix(), request.GetPaths(), sw)
+	if err != nil {
+		return processGitErrorf(err, "failed to create archive for '%s'", request.GetGitRef())
+	}
+
+	return nil
+}
+
+func validateGetArchiveRequest(request *rpc.GetArchiveRequest) error {
+	if request.GetBase() == nil {
+		return types.ErrBaseCannotBeEmpty
+	}
+	if request.GetGitRef() == "" {
+		return ErrInvalidArgumentf("git ref cannot be empty")
+	}
+
+	return nil
+}
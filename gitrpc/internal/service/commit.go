@@ -177,3 +177,66 @@ func (s RepositoryService) MergeBase(ctx context.Context,
 		MergeBaseSha: mergeBase,
 	}, nil
 }
+
+func (s RepositoryService) IsAncestor(ctx context.Context,
+	r *rpc.IsAncestorRequest,
+) (*rpc.IsAncestorResponse, error) {
+	base := r.GetBase()
+	repoPath := getFullPathForRepo(s.reposRoot, base.GetRepoUid())
+
+	env, err := getAlternateObjectDirsEnv(repoPath, base.GetAlternateObjectDirs())
+	if err != nil {
+		return nil, err
+	}
+
+	isAncestor, err := s.adapter.IsAncestor(ctx, repoPath, env, r.GetAncestorCommitSha(), r.GetDescendantCommitSha())
+	if err != nil {
+		return nil, processGitErrorf(err, "failed to check ancestry")
+	}
+
+	return &rpc.IsAncestorResponse{
+		IsAncestor: isAncestor,
+	}, nil
+}
+
+func (s RepositoryService) FindMergeCommit(ctx context.Context,
+	r *rpc.FindMergeCommitRequest,
+) (*rpc.FindMergeCommitResponse, error) {
+	base := r.GetBase()
+	repoPath := getFullPathForRepo(s.reposRoot, base.GetRepoUid())
+
+	env, err := getAlternateObjectDirsEnv(repoPath, base.GetAlternateObjectDirs())
+	if err != nil {
+		return nil, err
+	}
+
+	mergeCommitSHA, err := s.adapter.FindMergeCommit(ctx, repoPath, env, r.GetBaseSha(), r.GetHeadSha())
+	if err != nil {
+		return nil, processGitErrorf(err, "failed to find merge commit")
+	}
+
+	return &rpc.FindMergeCommitResponse{
+		MergeCommitSha: mergeCommitSHA,
+	}, nil
+}
+
+func (s RepositoryService) FindUnsignedCommit(ctx context.Context,
+	r *rpc.FindUnsignedCommitRequest,
+) (*rpc.FindUnsignedCommitResponse, error) {
+	base := r.GetBase()
+	repoPath := getFullPathForRepo(s.reposRoot, base.GetRepoUid())
+
+	env, err := getAlternateObjectDirsEnv(repoPath, base.GetAlternateObjectDirs())
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedCommitSHA, err := s.adapter.FindUnsignedCommit(ctx, repoPath, env, r.GetBaseSha(), r.GetHeadSha())
+	if err != nil {
+		return nil, processGitErrorf(err, "failed to find unsigned commit")
+	}
+
+	return &rpc.FindUnsignedCommitResponse{
+		UnsignedCommitSha: unsignedCommitSHA,
+	}, nil
+}
@@ -230,7 +230,8 @@ func processGitErrorf(err error, format string, args ...interface{}) error {
 		return ErrFailedPreconditionf("merging failed due to conflicting changes with the target branch", files, err)
 	case types.IsMergeUnrelatedHistoriesError(err):
 		return ErrFailedPrecondition(err)
-	case errors.Is(err, types.ErrFailedToConnect):
+	case errors.Is(err, types.ErrFailedToConnect),
+		errors.Is(err, types.ErrAuthenticationFailed):
 		return ErrInvalidArgument(err)
 	default:
 		return ErrInternalf(format, args...)
@@ -157,8 +157,15 @@ func serviceRPC(ctx context.Context, stdin io.Reader, stdout io.Writer,
 	var (
 		stderr bytes.Buffer
 	)
-	cmd := git.NewCommand(ctx, service, "--stateless-rpc", dir)
-	cmd.SetDescription(fmt.Sprintf("%s %s %s [repo_path: %s]", git.GitExecutable, service, "--stateless-rpc", dir))
+	args := []string{service}
+	// stateful connections (e.g. ssh) let git handle ref advertisement and negotiation within one session.
+	if !request.GetStateful() {
+		args = append(args, "--stateless-rpc")
+	}
+	args = append(args, dir)
+
+	cmd := git.NewCommand(ctx, args...)
+	cmd.SetDescription(fmt.Sprintf("%s %s [repo_path: %s]", git.GitExecutable, strings.Join(args, " "), dir))
 	err := cmd.Run(&git.RunOpts{
 		Dir:               dir,
 		Env:               environ,
@@ -62,8 +62,11 @@ type GitAdapter interface {
 	Merge(ctx context.Context, pr *types.PullRequest, mergeMethod enum.MergeMethod, baseBranch, trackingBranch string,
 		tmpBasePath string, mergeMsg string, env []string, identity *types.Identity) error
 	GetMergeBase(ctx context.Context, repoPath, remote, base, head string) (string, string, error)
+	IsAncestor(ctx context.Context, repoPath string, env []string, ancestorSHA string, descendantSHA string) (bool, error)
+	FindMergeCommit(ctx context.Context, repoPath string, env []string, baseSHA string, headSHA string) (string, error)
+	FindUnsignedCommit(ctx context.Context, repoPath string, env []string, baseSHA string, headSHA string) (string, error)
 	Blame(ctx context.Context, repoPath, rev, file string, lineFrom, lineTo int) types.BlameReader
-	Sync(ctx context.Context, repoPath string, source string) error
+	Sync(ctx context.Context, repoPath string, source string, refSpecs []string) error
 
 	//
 	// Diff operations
@@ -110,4 +113,12 @@ type GitAdapter interface {
 		dirPath string,
 		regExpDef string,
 		maxSize int) ([]types.FileContent, error)
+
+	Archive(ctx context.Context,
+		repoPath string,
+		ref string,
+		format enum.ArchiveFormat,
+		prefix string,
+		paths []string,
+		w io.Writer) error
 }
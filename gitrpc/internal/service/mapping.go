@@ -109,9 +109,21 @@ func mapGitCommit(gitCommit *types.Commit) (*rpc.Commit, error) {
 		Message:   gitCommit.Message,
 		Author:    mapGitSignature(gitCommit.Author),
 		Committer: mapGitSignature(gitCommit.Committer),
+		Signature: mapGitObjectSignature(gitCommit.Signature),
 	}, nil
 }
 
+func mapGitObjectSignature(signature *types.ObjectSignature) *rpc.ObjectSignature {
+	if signature == nil {
+		return nil
+	}
+
+	return &rpc.ObjectSignature{
+		Signature: signature.Signature,
+		Payload:   signature.Payload,
+	}
+}
+
 func mapGitSignature(gitSignature types.Signature) *rpc.Signature {
 	return &rpc.Signature{
 		Identity: &rpc.Identity{
@@ -173,6 +185,7 @@ func mapAnnotatedTag(tag *types.Tag) *rpc.CommitTag {
 		Title:       tag.Title,
 		Message:     tag.Message,
 		Tagger:      mapGitSignature(tag.Tagger),
+		Signature:   mapGitObjectSignature(tag.Signature),
 		IsAnnotated: true,
 		Commit:      nil,
 	}
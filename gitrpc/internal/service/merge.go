@@ -71,6 +71,11 @@ func (s MergeService) Merge(
 		HeadBranch:   request.HeadBranch,
 	}
 
+	// the head branch might be in a different repository (in case of a fork)
+	if request.HeadRepoUid != "" {
+		pr.HeadRepoPath = getFullPathForRepo(s.reposRoot, request.HeadRepoUid)
+	}
+
 	// Clone base repo.
 	tmpRepo, err := s.adapter.CreateTemporaryRepoForPR(ctx, s.reposTempDir, pr, baseBranch, trackingBranch)
 	if err != nil {
@@ -93,6 +98,14 @@ func (s MergeService) Merge(
 			request.HeadBranch, request.BaseBranch)
 	}
 
+	if request.Method == rpc.MergeRequest_fast_forward && tmpRepo.BaseSHA != mergeBaseCommitSHA {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"base branch '%s' has diverged from head branch '%s', fast-forward is not possible.",
+			request.BaseBranch,
+			request.HeadBranch)
+	}
+
 	if request.HeadExpectedSha != "" && request.HeadExpectedSha != tmpRepo.HeadSHA {
 		return nil, status.Errorf(
 			codes.FailedPrecondition,
@@ -16,7 +16,9 @@ package service
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 )
 
 // getFullPathForRepo returns the full path of a repo given the root dir of repos and the uid of the repo.
@@ -30,3 +32,30 @@ func getFullPathForRepo(reposRoot, uid string) string {
 		fmt.Sprintf("%s.%s", uid[4:], gitRepoSuffix), // remainder with .git
 	)
 }
+
+// getAlternateObjectDirsEnv returns the environment variables required to make the provided
+// alternate object directories (e.g. the quarantine directory of a running push) accessible to git.
+// NOTE: Only directories inside of the repository are accepted.
+func getAlternateObjectDirsEnv(repoPath string, dirs []string) ([]string, error) {
+	if len(dirs) == 0 {
+		return nil, nil
+	}
+
+	sanitized := make([]string, len(dirs))
+	for i, dir := range dirs {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(repoPath, dir)
+		}
+		dir = filepath.Clean(dir)
+
+		if !strings.HasPrefix(dir, repoPath+string(filepath.Separator)) {
+			return nil, ErrInvalidArgumentf("alternate object dir '%s' is outside of the repository", dirs[i])
+		}
+
+		sanitized[i] = dir
+	}
+
+	return []string{
+		"GIT_ALTERNATE_OBJECT_DIRECTORIES=" + strings.Join(sanitized, string(os.PathListSeparator)),
+	}, nil
+}
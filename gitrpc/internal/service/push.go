@@ -53,13 +53,15 @@ func (s PushService) PushRemote(
 	}
 
 	err = s.adapter.Push(ctx, repoPath, types.PushOptions{
-		Remote: request.RemoteUrl,
-		Force:  false,
-		Env:    nil,
-		Mirror: true,
+		Remote:   request.RemoteUrl,
+		Force:    false,
+		Env:      nil,
+		Mirror:   len(request.GetRefSpecs()) == 0,
+		RefSpecs: request.GetRefSpecs(),
+		Prune:    request.GetPrune(),
 	})
 	if err != nil {
-		return nil, err
+		return nil, processGitErrorf(err, "failed to push to remote")
 	}
 	return &rpc.PushRemoteResponse{}, nil
 }
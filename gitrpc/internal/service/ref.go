@@ -209,7 +209,7 @@ func (s ReferenceService) GetRef(ctx context.Context,
 
 	sha, err := s.adapter.GetRef(ctx, repoPath, reference)
 	if err != nil {
-		return nil, err
+		return nil, processGitErrorf(err, "failed to get reference '%s'", reference)
 	}
 
 	return &rpc.GetRefResponse{Sha: sha}, nil
@@ -255,6 +255,14 @@ func (s ReferenceService) UpdateRef(ctx context.Context,
 		return nil, processGitErrorf(err, "failed to clone shared repo")
 	}
 
+	// make objects of the source repository available, the push takes care of copying the required ones.
+	if request.GetSourceRepoUid() != "" {
+		sourceRepoPath := getFullPathForRepo(s.reposRoot, request.GetSourceRepoUid())
+		if err = addAlternates(sharedRepo.tmpPath, sourceRepoPath); err != nil {
+			return nil, ErrInternalf("failed to link objects of source repository", err)
+		}
+	}
+
 	pushOpts := types.PushOptions{
 		Remote: sharedRepo.remoteRepo.Path,
 		Env:    CreateEnvironmentForPush(ctx, base),
@@ -23,6 +23,8 @@ import (
 	"path"
 	"regexp"
 	"runtime/debug"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/harness/gitness/gitrpc/hash"
@@ -347,17 +349,30 @@ func (s RepositoryService) SyncRepository(
 		}
 	}
 
+	refsBefore, err := s.listBranchAndTagSHAs(ctx, repoPath)
+	if err != nil {
+		return nil, err
+	}
+
 	// sync repo content
-	err = s.adapter.Sync(ctx, repoPath, request.GetSource())
+	err = s.adapter.Sync(ctx, repoPath, request.GetSource(), request.GetRefSpecs())
 	if err != nil {
 		return nil, processGitErrorf(err, "failed to sync git repo")
 	}
 
+	refsAfter, err := s.listBranchAndTagSHAs(ctx, repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	refUpdates := diffReferenceSHAs(refsBefore, refsAfter)
+
 	// get remote default branch
 	defaultBranch, err := s.adapter.GetRemoteDefaultBranch(ctx, request.GetSource())
 	if errors.Is(err, types.ErrNoDefaultBranch) {
 		return &rpc.SyncRepositoryResponse{
 			DefaultBranch: "",
+			RefUpdates:    refUpdates,
 		}, nil
 	}
 	if err != nil {
@@ -372,9 +387,137 @@ func (s RepositoryService) SyncRepository(
 
 	return &rpc.SyncRepositoryResponse{
 		DefaultBranch: defaultBranch,
+		RefUpdates:    refUpdates,
 	}, nil
 }
 
+// listBranchAndTagSHAs returns the object SHAs of all branches and tags of the repository.
+func (s RepositoryService) listBranchAndTagSHAs(ctx context.Context, repoPath string) (map[string]string, error) {
+	shas := map[string]string{}
+	handler := func(e types.WalkReferencesEntry) error {
+		shas[e[types.GitReferenceFieldRefName]] = e[types.GitReferenceFieldObjectName]
+		return nil
+	}
+
+	err := s.adapter.WalkReferences(ctx, repoPath, handler, &types.WalkReferencesOptions{
+		Patterns: []string{gitReferenceNamePrefixBranch, gitReferenceNamePrefixTag},
+		Fields:   []types.GitReferenceField{types.GitReferenceFieldRefName, types.GitReferenceFieldObjectName},
+	})
+	if err != nil {
+		return nil, processGitErrorf(err, "failed to walk branch and tag references")
+	}
+
+	return shas, nil
+}
+
+// diffReferenceSHAs returns the references that differ between the two snapshots, sorted by name.
+func diffReferenceSHAs(before, after map[string]string) []*rpc.SyncReferenceUpdate {
+	var updates []*rpc.SyncReferenceUpdate
+	for ref, newSHA := range after {
+		if oldSHA := before[ref]; oldSHA != newSHA {
+			updates = append(updates, &rpc.SyncReferenceUpdate{Ref: ref, OldSha: oldSHA, NewSha: newSHA})
+		}
+	}
+	for ref, oldSHA := range before {
+		if _, ok := after[ref]; !ok {
+			updates = append(updates, &rpc.SyncReferenceUpdate{Ref: ref, OldSha: oldSHA})
+		}
+	}
+
+	sort.Slice(updates, func(i, j int) bool { return updates[i].Ref < updates[j].Ref })
+
+	return updates
+}
+
+func (s RepositoryService) ForkRepository(
+	ctx context.Context,
+	request *rpc.ForkRepositoryRequest,
+) (*rpc.ForkRepositoryResponse, error) {
+	base := request.GetBase()
+	if base == nil {
+		return nil, types.ErrBaseCannotBeEmpty
+	}
+
+	upstreamPath := getFullPathForRepo(s.reposRoot, request.GetUpstreamRepoUid())
+	if _, err := os.Stat(upstreamPath); os.IsNotExist(err) {
+		return nil, ErrNotFound(err)
+	} else if err != nil {
+		return nil, ErrInternalf("failed to check the status of the upstream repository", err)
+	}
+
+	defaultBranch, err := s.adapter.GetDefaultBranch(ctx, upstreamPath)
+	if err != nil {
+		return nil, processGitErrorf(err, "failed to get default branch of upstream repo")
+	}
+	defaultBranch = strings.TrimPrefix(strings.TrimSpace(defaultBranch), gitReferenceNamePrefixBranch)
+
+	// objects of the upstream repository might only be referenced by its forks,
+	// garbage collection of the upstream repository must never prune them.
+	if err = s.adapter.Config(ctx, upstreamPath, "gc.pruneExpire", "never"); err != nil {
+		return nil, processGitErrorf(err, "failed to disable pruning of upstream repository")
+	}
+
+	if err = s.createRepositoryInternal(
+		ctx,
+		base,
+		defaultBranch,
+		nil,
+		nil,
+		time.Time{},
+		nil,
+		time.Time{},
+	); err != nil {
+		return nil, err
+	}
+
+	// delete repo dir on error
+	defer func() {
+		if err != nil {
+			if cleanupErr := s.DeleteRepositoryBestEffort(ctx, base.GetRepoUid()); cleanupErr != nil {
+				log.Ctx(ctx).Warn().Err(cleanupErr).Msg("failed to cleanup repo dir")
+			}
+		}
+	}()
+
+	repoPath := getFullPathForRepo(s.reposRoot, base.GetRepoUid())
+
+	// share the objects of the upstream repository instead of copying them.
+	if err = addAlternates(repoPath, upstreamPath); err != nil {
+		return nil, ErrInternalf("failed to link objects of upstream repository", err)
+	}
+
+	// copy branches and tags only, pull request references stay with the upstream repository.
+	err = s.adapter.Sync(ctx, repoPath, upstreamPath, []string{
+		"+" + gitReferenceNamePrefixBranch + "*:" + gitReferenceNamePrefixBranch + "*",
+		"+" + gitReferenceNamePrefixTag + "*:" + gitReferenceNamePrefixTag + "*",
+	})
+	if err != nil {
+		return nil, processGitErrorf(err, "failed to fetch references of upstream repository")
+	}
+
+	return &rpc.ForkRepositoryResponse{
+		DefaultBranch: defaultBranch,
+	}, nil
+}
+
+// addAlternates makes the objects of the source repositories available in the bare repository at repoPath.
+func addAlternates(repoPath string, sourceRepoPaths ...string) error {
+	alternatesPath := path.Join(repoPath, "objects", "info", "alternates")
+	f, err := os.OpenFile(alternatesPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open alternates file '%s': %w", alternatesPath, err)
+	}
+	defer f.Close()
+
+	for _, sourceRepoPath := range sourceRepoPaths {
+		if _, err = fmt.Fprintln(f, path.Join(sourceRepoPath, "objects")); err != nil {
+			return fmt.Errorf("failed to write alternates file '%s': %w", alternatesPath, err)
+		}
+	}
+
+	return nil
+}
+
 func (s RepositoryService) HashRepository(
 	ctx context.Context,
 	request *rpc.HashRepositoryRequest,
@@ -0,0 +1,123 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/gitrpc/internal/gitea"
+	"github.com/harness/gitness/gitrpc/rpc"
+
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/setting"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "gitea-home")
+	if err != nil {
+		panic(err)
+	}
+
+	setting.Git.HomePath = home
+	if err = git.InitSimple(context.Background()); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+
+	_ = os.RemoveAll(home)
+	os.Exit(code)
+}
+
+func TestForkRepositoryKeepsUpstreamObjects(t *testing.T) {
+	ctx := context.Background()
+
+	reposRoot := t.TempDir()
+	s, err := NewRepositoryService(gitea.Adapter{}, nil, reposRoot, t.TempDir(),
+		filepath.Join(t.TempDir(), "hook"), t.TempDir())
+	require.NoError(t, err)
+
+	const upstreamUID = "upstream"
+	const forkUID = "fork"
+
+	upstreamPath := getFullPathForRepo(reposRoot, upstreamUID)
+	forkPath := getFullPathForRepo(reposRoot, forkUID)
+
+	workPath := t.TempDir()
+	runGit(t, workPath, "init", "--initial-branch=main")
+	commitFile(t, workPath, "initial.txt", "initial")
+	runGit(t, workPath, "checkout", "-b", "feature")
+	commitFile(t, workPath, "feature.txt", "feature")
+	featureSHA := strings.TrimSpace(runGit(t, workPath, "rev-parse", "HEAD"))
+	runGit(t, workPath, "clone", "--bare", workPath, upstreamPath)
+
+	_, err = s.ForkRepository(ctx, &rpc.ForkRepositoryRequest{
+		Base:            &rpc.WriteRequest{RepoUid: forkUID},
+		UpstreamRepoUid: upstreamUID,
+	})
+	require.NoError(t, err)
+
+	// the feature branch exists only in the fork now, its objects are unreachable in the upstream repository.
+	runGit(t, upstreamPath, "branch", "-D", "feature")
+	runGit(t, upstreamPath, "reflog", "expire", "--expire=now", "--all")
+
+	// make the objects of the upstream repository old enough to be pruned by default.
+	old := time.Now().AddDate(-1, 0, 0)
+	err = filepath.Walk(filepath.Join(upstreamPath, "objects"), func(path string, _ os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Chtimes(path, old, old)
+	})
+	require.NoError(t, err)
+
+	runGit(t, upstreamPath, "gc")
+
+	runGit(t, forkPath, "cat-file", "-e", featureSHA+"^{tree}")
+	require.Equal(t, "feature", strings.TrimSpace(runGit(t, forkPath, "show", "feature:feature.txt")))
+}
+
+func commitFile(t *testing.T, repoPath, name, content string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0o600))
+	runGit(t, repoPath, "add", name)
+	runGit(t, repoPath, "commit", "-m", name)
+}
+
+func runGit(t *testing.T, repoPath string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoPath
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Dev",
+		"GIT_AUTHOR_EMAIL=dev@example.com",
+		"GIT_COMMITTER_NAME=Dev",
+		"GIT_COMMITTER_EMAIL=dev@example.com",
+	)
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+
+	return string(out)
+}
@@ -98,6 +98,7 @@ func (s ReferenceService) ListCommitTags(request *rpc.ListCommitTagsRequest,
 			tags[wi].Message = aTags[ai].Message
 			tags[wi].Title = aTags[ai].Title
 			tags[wi].Tagger = mapGitSignature(aTags[ai].Tagger)
+			tags[wi].Signature = mapGitObjectSignature(aTags[ai].Signature)
 
 			ai++
 			wi++
@@ -39,6 +39,7 @@ var (
 	ErrEmptyHeadRef                = errors.New("empty head reference")
 	ErrNoDefaultBranch             = errors.New("no default branch")
 	ErrFailedToConnect             = errors.New("failed to connect")
+	ErrAuthenticationFailed        = errors.New("authentication failed")
 	ErrHunkNotFound                = errors.New("hunk not found")
 	ErrEmptySHA                    = errors.New("empty SHA")
 )
@@ -142,6 +142,15 @@ type Commit struct {
 	Message   string
 	Author    Signature
 	Committer Signature
+	// Signature is the cryptographic signature of the commit (nil if the commit isn't signed).
+	Signature *ObjectSignature
+}
+
+// ObjectSignature contains the cryptographic signature of a git object (commit or tag)
+// together with the payload that got signed.
+type ObjectSignature struct {
+	Signature []byte
+	Payload   []byte
 }
 
 type Branch struct {
@@ -158,6 +167,8 @@ type Tag struct {
 	Title      string
 	Message    string
 	Tagger     Signature
+	// Signature is the cryptographic signature of the tag (nil if the tag isn't signed).
+	Signature *ObjectSignature
 }
 
 type CreateTagOptions struct {
@@ -199,6 +210,8 @@ type PushOptions struct {
 	Env            []string
 	Timeout        time.Duration
 	Mirror         bool
+	RefSpecs       []string
+	Prune          bool
 }
 
 type TreeNodeWithCommit struct {
@@ -116,6 +116,7 @@ func mapRPCCommitTag(t *rpc.CommitTag) (*CommitTag, error) {
 		Message:     t.Message,
 		Tagger:      tagger,
 		Commit:      commit,
+		Signature:   mapRPCObjectSignature(t.GetSignature()),
 	}, nil
 }
 
@@ -153,9 +154,21 @@ func mapRPCCommit(c *rpc.Commit) (*Commit, error) {
 		Message:   c.GetMessage(),
 		Author:    *author,
 		Committer: *comitter,
+		Signature: mapRPCObjectSignature(c.GetSignature()),
 	}, nil
 }
 
+func mapRPCObjectSignature(s *rpc.ObjectSignature) *ObjectSignature {
+	if s == nil {
+		return nil
+	}
+
+	return &ObjectSignature{
+		Signature: s.GetSignature(),
+		Payload:   s.GetPayload(),
+	}
+}
+
 func mapRPCSignature(s *rpc.Signature) (*Signature, error) {
 	if s == nil {
 		return nil, fmt.Errorf("rpc signature is nil")
@@ -27,7 +27,6 @@ type MergeParams struct {
 	WriteParams
 	BaseBranch string
 	// HeadRepoUID specifies the UID of the repo that contains the head branch (required for forking).
-	// WARNING: This field is currently not supported yet!
 	HeadRepoUID string
 	HeadBranch  string
 	Title       string
@@ -93,6 +92,7 @@ func (c *Client) Merge(ctx context.Context, params *MergeParams) (MergeOutput, e
 	resp, err := c.mergeService.Merge(ctx, &rpc.MergeRequest{
 		Base:             mapToRPCWriteRequest(params.WriteParams),
 		BaseBranch:       params.BaseBranch,
+		HeadRepoUid:      params.HeadRepoUID,
 		HeadBranch:       params.HeadBranch,
 		Title:            params.Title,
 		Message:          params.Message,
@@ -23,6 +23,10 @@ import (
 type PushRemoteParams struct {
 	ReadParams
 	RemoteURL string
+	// RefSpecs restricts the push to the provided ref specs, the whole repository is mirrored if empty.
+	RefSpecs []string
+	// Prune deletes remote references that have no local counterpart matching the ref specs.
+	Prune bool
 }
 
 func (c *Client) PushRemote(ctx context.Context, params *PushRemoteParams) error {
@@ -33,6 +37,8 @@ func (c *Client) PushRemote(ctx context.Context, params *PushRemoteParams) error
 	_, err := c.pushService.PushRemote(ctx, &rpc.PushRemoteRequest{
 		Base:      mapToRPCReadRequest(params.ReadParams),
 		RemoteUrl: params.RemoteURL,
+		RefSpecs:  params.RefSpecs,
+		Prune:     params.Prune,
 	})
 	if err != nil {
 		return processRPCErrorf(err, "failed to push to remote")
@@ -59,6 +59,9 @@ type UpdateRefParams struct {
 	// OldValue is an optional value that can be used to ensure that the reference
 	// is updated iff its current value is matching the provided value.
 	OldValue string
+	// SourceRepoUID is an optional value that specifies the repository containing
+	// the objects NewValue is pointing at (required for forks).
+	SourceRepoUID string
 }
 
 func (c *Client) UpdateRef(ctx context.Context, params UpdateRefParams) error {
@@ -68,11 +71,12 @@ func (c *Client) UpdateRef(ctx context.Context, params UpdateRefParams) error {
 	}
 
 	_, err := c.refService.UpdateRef(ctx, &rpc.UpdateRefRequest{
-		Base:     mapToRPCWriteRequest(params.WriteParams),
-		RefName:  params.Name,
-		RefType:  refType,
-		NewValue: params.NewValue,
-		OldValue: params.OldValue,
+		Base:          mapToRPCWriteRequest(params.WriteParams),
+		RefName:       params.Name,
+		RefType:       refType,
+		NewValue:      params.NewValue,
+		OldValue:      params.OldValue,
+		SourceRepoUid: params.SourceRepoUID,
 	})
 	if err != nil {
 		return processRPCErrorf(err, "failed to update %s ref '%s'", params.Type.String(), params.Name)
@@ -70,10 +70,33 @@ type SyncRepositoryParams struct {
 	WriteParams
 	Source            string
 	CreateIfNotExists bool
+	// RefSpecs are the refspecs used to fetch from the source (optional, default: all references).
+	RefSpecs []string
 }
 
 type SyncRepositoryOutput struct {
 	DefaultBranch string
+	// RefUpdates contains the branches and tags changed by the sync.
+	// Created references have an empty OldSHA, deleted references have an empty NewSHA.
+	RefUpdates []SyncReferenceUpdate
+}
+
+type SyncReferenceUpdate struct {
+	Ref    string
+	OldSHA string
+	NewSHA string
+}
+
+type ForkRepositoryParams struct {
+	Actor   Identity
+	EnvVars map[string]string
+	// UpstreamRepoUID is the UID of the repository that is being forked.
+	UpstreamRepoUID string
+}
+
+type ForkRepositoryOutput struct {
+	UID           string
+	DefaultBranch string
 }
 
 type HashRepositoryParams struct {
@@ -179,13 +202,54 @@ func (c *Client) SyncRepository(ctx context.Context, params *SyncRepositoryParam
 		Base:              mapToRPCWriteRequest(params.WriteParams),
 		Source:            params.Source,
 		CreateIfNotExists: params.CreateIfNotExists,
+		RefSpecs:          params.RefSpecs,
 	})
 	if err != nil {
 		return nil, processRPCErrorf(err, "failed to sync repository on server to match provided source")
 	}
 
+	refUpdates := make([]SyncReferenceUpdate, len(result.RefUpdates))
+	for i, refUpdate := range result.RefUpdates {
+		refUpdates[i] = SyncReferenceUpdate{
+			Ref:    refUpdate.Ref,
+			OldSHA: refUpdate.OldSha,
+			NewSHA: refUpdate.NewSha,
+		}
+	}
+
 	return &SyncRepositoryOutput{
 		DefaultBranch: result.DefaultBranch,
+		RefUpdates:    refUpdates,
+	}, nil
+}
+
+// ForkRepository creates a new repository that shares the objects of the upstream repository
+// and contains all of its branches and tags.
+func (c *Client) ForkRepository(ctx context.Context, params *ForkRepositoryParams) (*ForkRepositoryOutput, error) {
+	if params == nil {
+		return nil, ErrNoParamsProvided
+	}
+
+	uid, err := newRepositoryUID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new uid: %w", err)
+	}
+
+	result, err := c.repoService.ForkRepository(ctx, &rpc.ForkRepositoryRequest{
+		Base: mapToRPCWriteRequest(WriteParams{
+			RepoUID: uid,
+			Actor:   params.Actor,
+			EnvVars: params.EnvVars,
+		}),
+		UpstreamRepoUid: params.UpstreamRepoUID,
+	})
+	if err != nil {
+		return nil, processRPCErrorf(err, "failed to fork repository on server (uid: '%s')", uid)
+	}
+
+	return &ForkRepositoryOutput{
+		UID:           uid,
+		DefaultBranch: result.GetDefaultBranch(),
 	}, nil
 }
 
@@ -210,6 +210,55 @@ func (HashAggregationType) EnumDescriptor() ([]byte, []int) {
 	return file_repo_proto_rawDescGZIP(), []int{3}
 }
 
+type ArchiveFormat int32
+
+const (
+	ArchiveFormat_ArchiveFormatTar   ArchiveFormat = 0
+	ArchiveFormat_ArchiveFormatTarGz ArchiveFormat = 1
+	ArchiveFormat_ArchiveFormatZip   ArchiveFormat = 2
+)
+
+// Enum value maps for ArchiveFormat.
+var (
+	ArchiveFormat_name = map[int32]string{
+		0: "ArchiveFormatTar",
+		1: "ArchiveFormatTarGz",
+		2: "ArchiveFormatZip",
+	}
+	ArchiveFormat_value = map[string]int32{
+		"ArchiveFormatTar":   0,
+		"ArchiveFormatTarGz": 1,
+		"ArchiveFormatZip":   2,
+	}
+)
+
+func (x ArchiveFormat) Enum() *ArchiveFormat {
+	p := new(ArchiveFormat)
+	*p = x
+	return p
+}
+
+func (x ArchiveFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_repo_proto_enumTypes[4].Descriptor()
+}
+
+func (ArchiveFormat) Type() protoreflect.EnumType {
+	return &file_repo_proto_enumTypes[4]
+}
+
+func (x ArchiveFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveFormat.Descriptor instead.
+func (ArchiveFormat) EnumDescriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{4}
+}
+
 type CreateRepositoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1926,6 +1975,7 @@ type SyncRepositoryRequest struct {
 	Base              *WriteRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Source            string        `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	CreateIfNotExists bool          `protobuf:"varint,3,opt,name=create_if_not_exists,json=createIfNotExists,proto3" json:"create_if_not_exists,omitempty"`
+	RefSpecs          []string      `protobuf:"bytes,4,rep,name=ref_specs,json=refSpecs,proto3" json:"ref_specs,omitempty"`
 }
 
 func (x *SyncRepositoryRequest) Reset() {
@@ -1981,12 +2031,20 @@ func (x *SyncRepositoryRequest) GetCreateIfNotExists() bool {
 	return false
 }
 
+func (x *SyncRepositoryRequest) GetRefSpecs() []string {
+	if x != nil {
+		return x.RefSpecs
+	}
+	return nil
+}
+
 type SyncRepositoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DefaultBranch string `protobuf:"bytes,1,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	DefaultBranch string                 `protobuf:"bytes,1,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	RefUpdates    []*SyncReferenceUpdate `protobuf:"bytes,2,rep,name=ref_updates,json=refUpdates,proto3" json:"ref_updates,omitempty"`
 }
 
 func (x *SyncRepositoryResponse) Reset() {
@@ -2028,18 +2086,25 @@ func (x *SyncRepositoryResponse) GetDefaultBranch() string {
 	return ""
 }
 
-type HashRepositoryRequest struct {
+func (x *SyncRepositoryResponse) GetRefUpdates() []*SyncReferenceUpdate {
+	if x != nil {
+		return x.RefUpdates
+	}
+	return nil
+}
+
+type SyncReferenceUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Base            *ReadRequest        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	HashType        HashType            `protobuf:"varint,2,opt,name=hash_type,json=hashType,proto3,enum=rpc.HashType" json:"hash_type,omitempty"`
-	AggregationType HashAggregationType `protobuf:"varint,3,opt,name=aggregation_type,json=aggregationType,proto3,enum=rpc.HashAggregationType" json:"aggregation_type,omitempty"`
+	Ref    string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	OldSha string `protobuf:"bytes,2,opt,name=old_sha,json=oldSha,proto3" json:"old_sha,omitempty"`
+	NewSha string `protobuf:"bytes,3,opt,name=new_sha,json=newSha,proto3" json:"new_sha,omitempty"`
 }
 
-func (x *HashRepositoryRequest) Reset() {
-	*x = HashRepositoryRequest{}
+func (x *SyncReferenceUpdate) Reset() {
+	*x = SyncReferenceUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_repo_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2047,13 +2112,13 @@ func (x *HashRepositoryRequest) Reset() {
 	}
 }
 
-func (x *HashRepositoryRequest) String() string {
+func (x *SyncReferenceUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HashRepositoryRequest) ProtoMessage() {}
+func (*SyncReferenceUpdate) ProtoMessage() {}
 
-func (x *HashRepositoryRequest) ProtoReflect() protoreflect.Message {
+func (x *SyncReferenceUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_repo_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2065,42 +2130,43 @@ func (x *HashRepositoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HashRepositoryRequest.ProtoReflect.Descriptor instead.
-func (*HashRepositoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncReferenceUpdate.ProtoReflect.Descriptor instead.
+func (*SyncReferenceUpdate) Descriptor() ([]byte, []int) {
 	return file_repo_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *HashRepositoryRequest) GetBase() *ReadRequest {
+func (x *SyncReferenceUpdate) GetRef() string {
 	if x != nil {
-		return x.Base
+		return x.Ref
 	}
-	return nil
+	return ""
 }
 
-func (x *HashRepositoryRequest) GetHashType() HashType {
+func (x *SyncReferenceUpdate) GetOldSha() string {
 	if x != nil {
-		return x.HashType
+		return x.OldSha
 	}
-	return HashType_HashTypeSHA256
+	return ""
 }
 
-func (x *HashRepositoryRequest) GetAggregationType() HashAggregationType {
+func (x *SyncReferenceUpdate) GetNewSha() string {
 	if x != nil {
-		return x.AggregationType
+		return x.NewSha
 	}
-	return HashAggregationType_HashAggregationTypeXOR
+	return ""
 }
 
-type HashRepositoryResponse struct {
+type ForkRepositoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Base            *WriteRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	UpstreamRepoUid string        `protobuf:"bytes,2,opt,name=upstream_repo_uid,json=upstreamRepoUid,proto3" json:"upstream_repo_uid,omitempty"`
 }
 
-func (x *HashRepositoryResponse) Reset() {
-	*x = HashRepositoryResponse{}
+func (x *ForkRepositoryRequest) Reset() {
+	*x = ForkRepositoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_repo_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2108,13 +2174,13 @@ func (x *HashRepositoryResponse) Reset() {
 	}
 }
 
-func (x *HashRepositoryResponse) String() string {
+func (x *ForkRepositoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HashRepositoryResponse) ProtoMessage() {}
+func (*ForkRepositoryRequest) ProtoMessage() {}
 
-func (x *HashRepositoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ForkRepositoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_repo_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2126,30 +2192,35 @@ func (x *HashRepositoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HashRepositoryResponse.ProtoReflect.Descriptor instead.
-func (*HashRepositoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForkRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*ForkRepositoryRequest) Descriptor() ([]byte, []int) {
 	return file_repo_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *HashRepositoryResponse) GetHash() []byte {
+func (x *ForkRepositoryRequest) GetBase() *WriteRequest {
 	if x != nil {
-		return x.Hash
+		return x.Base
 	}
 	return nil
 }
 
-type MergeBaseRequest struct {
+func (x *ForkRepositoryRequest) GetUpstreamRepoUid() string {
+	if x != nil {
+		return x.UpstreamRepoUid
+	}
+	return ""
+}
+
+type ForkRepositoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Base *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Ref1 string       `protobuf:"bytes,2,opt,name=ref1,proto3" json:"ref1,omitempty"`
-	Ref2 string       `protobuf:"bytes,3,opt,name=ref2,proto3" json:"ref2,omitempty"`
+	DefaultBranch string `protobuf:"bytes,1,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
 }
 
-func (x *MergeBaseRequest) Reset() {
-	*x = MergeBaseRequest{}
+func (x *ForkRepositoryResponse) Reset() {
+	*x = ForkRepositoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_repo_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2157,13 +2228,13 @@ func (x *MergeBaseRequest) Reset() {
 	}
 }
 
-func (x *MergeBaseRequest) String() string {
+func (x *ForkRepositoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeBaseRequest) ProtoMessage() {}
+func (*ForkRepositoryResponse) ProtoMessage() {}
 
-func (x *MergeBaseRequest) ProtoReflect() protoreflect.Message {
+func (x *ForkRepositoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_repo_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2175,42 +2246,30 @@ func (x *MergeBaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeBaseRequest.ProtoReflect.Descriptor instead.
-func (*MergeBaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForkRepositoryResponse.ProtoReflect.Descriptor instead.
+func (*ForkRepositoryResponse) Descriptor() ([]byte, []int) {
 	return file_repo_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *MergeBaseRequest) GetBase() *ReadRequest {
-	if x != nil {
-		return x.Base
-	}
-	return nil
-}
-
-func (x *MergeBaseRequest) GetRef1() string {
-	if x != nil {
-		return x.Ref1
-	}
-	return ""
-}
-
-func (x *MergeBaseRequest) GetRef2() string {
+func (x *ForkRepositoryResponse) GetDefaultBranch() string {
 	if x != nil {
-		return x.Ref2
+		return x.DefaultBranch
 	}
 	return ""
 }
 
-type MergeBaseResponse struct {
+type HashRepositoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MergeBaseSha string `protobuf:"bytes,1,opt,name=merge_base_sha,json=mergeBaseSha,proto3" json:"merge_base_sha,omitempty"`
+	Base            *ReadRequest        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	HashType        HashType            `protobuf:"varint,2,opt,name=hash_type,json=hashType,proto3,enum=rpc.HashType" json:"hash_type,omitempty"`
+	AggregationType HashAggregationType `protobuf:"varint,3,opt,name=aggregation_type,json=aggregationType,proto3,enum=rpc.HashAggregationType" json:"aggregation_type,omitempty"`
 }
 
-func (x *MergeBaseResponse) Reset() {
-	*x = MergeBaseResponse{}
+func (x *HashRepositoryRequest) Reset() {
+	*x = HashRepositoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_repo_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2218,13 +2277,13 @@ func (x *MergeBaseResponse) Reset() {
 	}
 }
 
-func (x *MergeBaseResponse) String() string {
+func (x *HashRepositoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeBaseResponse) ProtoMessage() {}
+func (*HashRepositoryRequest) ProtoMessage() {}
 
-func (x *MergeBaseResponse) ProtoReflect() protoreflect.Message {
+func (x *HashRepositoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_repo_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2236,29 +2295,42 @@ func (x *MergeBaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeBaseResponse.ProtoReflect.Descriptor instead.
-func (*MergeBaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HashRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*HashRepositoryRequest) Descriptor() ([]byte, []int) {
 	return file_repo_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *MergeBaseResponse) GetMergeBaseSha() string {
+func (x *HashRepositoryRequest) GetBase() *ReadRequest {
 	if x != nil {
-		return x.MergeBaseSha
+		return x.Base
 	}
-	return ""
+	return nil
 }
 
-type FileContent struct {
+func (x *HashRepositoryRequest) GetHashType() HashType {
+	if x != nil {
+		return x.HashType
+	}
+	return HashType_HashTypeSHA256
+}
+
+func (x *HashRepositoryRequest) GetAggregationType() HashAggregationType {
+	if x != nil {
+		return x.AggregationType
+	}
+	return HashAggregationType_HashAggregationTypeXOR
+}
+
+type HashRepositoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *FileContent) Reset() {
-	*x = FileContent{}
+func (x *HashRepositoryResponse) Reset() {
+	*x = HashRepositoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_repo_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2266,13 +2338,13 @@ func (x *FileContent) Reset() {
 	}
 }
 
-func (x *FileContent) String() string {
+func (x *HashRepositoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileContent) ProtoMessage() {}
+func (*HashRepositoryResponse) ProtoMessage() {}
 
-func (x *FileContent) ProtoReflect() protoreflect.Message {
+func (x *HashRepositoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_repo_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2284,39 +2356,30 @@ func (x *FileContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileContent.ProtoReflect.Descriptor instead.
-func (*FileContent) Descriptor() ([]byte, []int) {
+// Deprecated: Use HashRepositoryResponse.ProtoReflect.Descriptor instead.
+func (*HashRepositoryResponse) Descriptor() ([]byte, []int) {
 	return file_repo_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *FileContent) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *FileContent) GetContent() []byte {
+func (x *HashRepositoryResponse) GetHash() []byte {
 	if x != nil {
-		return x.Content
+		return x.Hash
 	}
 	return nil
 }
 
-type MatchFilesRequest struct {
+type MergeBaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Base    *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Ref     string       `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
-	DirPath string       `protobuf:"bytes,3,opt,name=dir_path,json=dirPath,proto3" json:"dir_path,omitempty"`
-	Pattern string       `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	MaxSize int32        `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	Base *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Ref1 string       `protobuf:"bytes,2,opt,name=ref1,proto3" json:"ref1,omitempty"`
+	Ref2 string       `protobuf:"bytes,3,opt,name=ref2,proto3" json:"ref2,omitempty"`
 }
 
-func (x *MatchFilesRequest) Reset() {
-	*x = MatchFilesRequest{}
+func (x *MergeBaseRequest) Reset() {
+	*x = MergeBaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_repo_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2324,13 +2387,13 @@ func (x *MatchFilesRequest) Reset() {
 	}
 }
 
-func (x *MatchFilesRequest) String() string {
+func (x *MergeBaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchFilesRequest) ProtoMessage() {}
+func (*MergeBaseRequest) ProtoMessage() {}
 
-func (x *MatchFilesRequest) ProtoReflect() protoreflect.Message {
+func (x *MergeBaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_repo_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2342,56 +2405,42 @@ func (x *MatchFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchFilesRequest.ProtoReflect.Descriptor instead.
-func (*MatchFilesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeBaseRequest.ProtoReflect.Descriptor instead.
+func (*MergeBaseRequest) Descriptor() ([]byte, []int) {
 	return file_repo_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *MatchFilesRequest) GetBase() *ReadRequest {
+func (x *MergeBaseRequest) GetBase() *ReadRequest {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *MatchFilesRequest) GetRef() string {
-	if x != nil {
-		return x.Ref
-	}
-	return ""
-}
-
-func (x *MatchFilesRequest) GetDirPath() string {
+func (x *MergeBaseRequest) GetRef1() string {
 	if x != nil {
-		return x.DirPath
+		return x.Ref1
 	}
 	return ""
 }
 
-func (x *MatchFilesRequest) GetPattern() string {
+func (x *MergeBaseRequest) GetRef2() string {
 	if x != nil {
-		return x.Pattern
+		return x.Ref2
 	}
 	return ""
 }
 
-func (x *MatchFilesRequest) GetMaxSize() int32 {
-	if x != nil {
-		return x.MaxSize
-	}
-	return 0
-}
-
-type MatchFilesResponse struct {
+type MergeBaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Files []*FileContent `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	MergeBaseSha string `protobuf:"bytes,1,opt,name=merge_base_sha,json=mergeBaseSha,proto3" json:"merge_base_sha,omitempty"`
 }
 
-func (x *MatchFilesResponse) Reset() {
-	*x = MatchFilesResponse{}
+func (x *MergeBaseResponse) Reset() {
+	*x = MergeBaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_repo_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2399,13 +2448,13 @@ func (x *MatchFilesResponse) Reset() {
 	}
 }
 
-func (x *MatchFilesResponse) String() string {
+func (x *MergeBaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchFilesResponse) ProtoMessage() {}
+func (*MergeBaseResponse) ProtoMessage() {}
 
-func (x *MatchFilesResponse) ProtoReflect() protoreflect.Message {
+func (x *MergeBaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_repo_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2417,28 +2466,30 @@ func (x *MatchFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchFilesResponse.ProtoReflect.Descriptor instead.
-func (*MatchFilesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeBaseResponse.ProtoReflect.Descriptor instead.
+func (*MergeBaseResponse) Descriptor() ([]byte, []int) {
 	return file_repo_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MatchFilesResponse) GetFiles() []*FileContent {
+func (x *MergeBaseResponse) GetMergeBaseSha() string {
 	if x != nil {
-		return x.Files
+		return x.MergeBaseSha
 	}
-	return nil
+	return ""
 }
 
-type GeneratePipelineRequest struct {
+type IsAncestorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Base *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Base                *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	AncestorCommitSha   string       `protobuf:"bytes,2,opt,name=ancestor_commit_sha,json=ancestorCommitSha,proto3" json:"ancestor_commit_sha,omitempty"`
+	DescendantCommitSha string       `protobuf:"bytes,3,opt,name=descendant_commit_sha,json=descendantCommitSha,proto3" json:"descendant_commit_sha,omitempty"`
 }
 
-func (x *GeneratePipelineRequest) Reset() {
-	*x = GeneratePipelineRequest{}
+func (x *IsAncestorRequest) Reset() {
+	*x = IsAncestorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_repo_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2446,14 +2497,529 @@ func (x *GeneratePipelineRequest) Reset() {
 	}
 }
 
-func (x *GeneratePipelineRequest) String() string {
+func (x *IsAncestorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratePipelineRequest) ProtoMessage() {}
+func (*IsAncestorRequest) ProtoMessage() {}
+
+func (x *IsAncestorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAncestorRequest.ProtoReflect.Descriptor instead.
+func (*IsAncestorRequest) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *IsAncestorRequest) GetBase() *ReadRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *IsAncestorRequest) GetAncestorCommitSha() string {
+	if x != nil {
+		return x.AncestorCommitSha
+	}
+	return ""
+}
+
+func (x *IsAncestorRequest) GetDescendantCommitSha() string {
+	if x != nil {
+		return x.DescendantCommitSha
+	}
+	return ""
+}
+
+type IsAncestorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsAncestor bool `protobuf:"varint,1,opt,name=is_ancestor,json=isAncestor,proto3" json:"is_ancestor,omitempty"`
+}
+
+func (x *IsAncestorResponse) Reset() {
+	*x = IsAncestorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsAncestorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAncestorResponse) ProtoMessage() {}
+
+func (x *IsAncestorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAncestorResponse.ProtoReflect.Descriptor instead.
+func (*IsAncestorResponse) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *IsAncestorResponse) GetIsAncestor() bool {
+	if x != nil {
+		return x.IsAncestor
+	}
+	return false
+}
+
+type FindMergeCommitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// base_sha is the commit up to which the history is inspected (exclusive).
+	// If empty, only commits that aren't reachable from any existing reference are inspected.
+	BaseSha string `protobuf:"bytes,2,opt,name=base_sha,json=baseSha,proto3" json:"base_sha,omitempty"`
+	HeadSha string `protobuf:"bytes,3,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+}
+
+func (x *FindMergeCommitRequest) Reset() {
+	*x = FindMergeCommitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMergeCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMergeCommitRequest) ProtoMessage() {}
+
+func (x *FindMergeCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMergeCommitRequest.ProtoReflect.Descriptor instead.
+func (*FindMergeCommitRequest) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FindMergeCommitRequest) GetBase() *ReadRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *FindMergeCommitRequest) GetBaseSha() string {
+	if x != nil {
+		return x.BaseSha
+	}
+	return ""
+}
+
+func (x *FindMergeCommitRequest) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
+}
+
+type FindMergeCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// merge_commit_sha is the sha of the first merge commit found (empty if there is none).
+	MergeCommitSha string `protobuf:"bytes,1,opt,name=merge_commit_sha,json=mergeCommitSha,proto3" json:"merge_commit_sha,omitempty"`
+}
+
+func (x *FindMergeCommitResponse) Reset() {
+	*x = FindMergeCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMergeCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMergeCommitResponse) ProtoMessage() {}
+
+func (x *FindMergeCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMergeCommitResponse.ProtoReflect.Descriptor instead.
+func (*FindMergeCommitResponse) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FindMergeCommitResponse) GetMergeCommitSha() string {
+	if x != nil {
+		return x.MergeCommitSha
+	}
+	return ""
+}
+
+type FindUnsignedCommitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// base_sha is the commit up to which the history is inspected (exclusive).
+	// If empty, only commits that aren't reachable from any existing reference are inspected.
+	BaseSha string `protobuf:"bytes,2,opt,name=base_sha,json=baseSha,proto3" json:"base_sha,omitempty"`
+	HeadSha string `protobuf:"bytes,3,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+}
+
+func (x *FindUnsignedCommitRequest) Reset() {
+	*x = FindUnsignedCommitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUnsignedCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUnsignedCommitRequest) ProtoMessage() {}
+
+func (x *FindUnsignedCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUnsignedCommitRequest.ProtoReflect.Descriptor instead.
+func (*FindUnsignedCommitRequest) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FindUnsignedCommitRequest) GetBase() *ReadRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *FindUnsignedCommitRequest) GetBaseSha() string {
+	if x != nil {
+		return x.BaseSha
+	}
+	return ""
+}
+
+func (x *FindUnsignedCommitRequest) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
+}
+
+type FindUnsignedCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unsigned_commit_sha is the sha of the first commit without signature found (empty if there is none).
+	UnsignedCommitSha string `protobuf:"bytes,1,opt,name=unsigned_commit_sha,json=unsignedCommitSha,proto3" json:"unsigned_commit_sha,omitempty"`
+}
+
+func (x *FindUnsignedCommitResponse) Reset() {
+	*x = FindUnsignedCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUnsignedCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUnsignedCommitResponse) ProtoMessage() {}
+
+func (x *FindUnsignedCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUnsignedCommitResponse.ProtoReflect.Descriptor instead.
+func (*FindUnsignedCommitResponse) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FindUnsignedCommitResponse) GetUnsignedCommitSha() string {
+	if x != nil {
+		return x.UnsignedCommitSha
+	}
+	return ""
+}
+
+type FileContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *FileContent) Reset() {
+	*x = FileContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileContent) ProtoMessage() {}
+
+func (x *FileContent) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileContent.ProtoReflect.Descriptor instead.
+func (*FileContent) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FileContent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileContent) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type MatchFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base    *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Ref     string       `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	DirPath string       `protobuf:"bytes,3,opt,name=dir_path,json=dirPath,proto3" json:"dir_path,omitempty"`
+	Pattern string       `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MaxSize int32        `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+}
+
+func (x *MatchFilesRequest) Reset() {
+	*x = MatchFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchFilesRequest) ProtoMessage() {}
+
+func (x *MatchFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchFilesRequest.ProtoReflect.Descriptor instead.
+func (*MatchFilesRequest) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MatchFilesRequest) GetBase() *ReadRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MatchFilesRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *MatchFilesRequest) GetDirPath() string {
+	if x != nil {
+		return x.DirPath
+	}
+	return ""
+}
+
+func (x *MatchFilesRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *MatchFilesRequest) GetMaxSize() int32 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+type MatchFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*FileContent `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *MatchFilesResponse) Reset() {
+	*x = MatchFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchFilesResponse) ProtoMessage() {}
+
+func (x *MatchFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchFilesResponse.ProtoReflect.Descriptor instead.
+func (*MatchFilesResponse) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MatchFilesResponse) GetFiles() []*FileContent {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type GeneratePipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base *ReadRequest `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+}
+
+func (x *GeneratePipelineRequest) Reset() {
+	*x = GeneratePipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePipelineRequest) ProtoMessage() {}
 
 func (x *GeneratePipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_repo_proto_msgTypes[37]
+	mi := &file_repo_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,7 +3032,7 @@ func (x *GeneratePipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneratePipelineRequest.ProtoReflect.Descriptor instead.
 func (*GeneratePipelineRequest) Descriptor() ([]byte, []int) {
-	return file_repo_proto_rawDescGZIP(), []int{37}
+	return file_repo_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GeneratePipelineRequest) GetBase() *ReadRequest {
@@ -2487,7 +3053,7 @@ type GeneratePipelineResponse struct {
 func (x *GeneratePipelineResponse) Reset() {
 	*x = GeneratePipelineResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repo_proto_msgTypes[38]
+		mi := &file_repo_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2500,7 +3066,7 @@ func (x *GeneratePipelineResponse) String() string {
 func (*GeneratePipelineResponse) ProtoMessage() {}
 
 func (x *GeneratePipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repo_proto_msgTypes[38]
+	mi := &file_repo_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2513,7 +3079,7 @@ func (x *GeneratePipelineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneratePipelineResponse.ProtoReflect.Descriptor instead.
 func (*GeneratePipelineResponse) Descriptor() ([]byte, []int) {
-	return file_repo_proto_rawDescGZIP(), []int{38}
+	return file_repo_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GeneratePipelineResponse) GetPipelineYaml() []byte {
@@ -2523,6 +3089,134 @@ func (x *GeneratePipelineResponse) GetPipelineYaml() []byte {
 	return nil
 }
 
+type GetArchiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base   *ReadRequest  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	GitRef string        `protobuf:"bytes,2,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`
+	Format ArchiveFormat `protobuf:"varint,3,opt,name=format,proto3,enum=rpc.ArchiveFormat" json:"format,omitempty"`
+	// prefix is prepended to all paths inside the archive (optional).
+	Prefix string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// paths limits the archive to the provided paths (optional).
+	Paths []string `protobuf:"bytes,5,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *GetArchiveRequest) Reset() {
+	*x = GetArchiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchiveRequest) ProtoMessage() {}
+
+func (x *GetArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchiveRequest.ProtoReflect.Descriptor instead.
+func (*GetArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetArchiveRequest) GetBase() *ReadRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetArchiveRequest) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
+func (x *GetArchiveRequest) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ArchiveFormatTar
+}
+
+func (x *GetArchiveRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *GetArchiveRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type GetArchiveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetArchiveResponse) Reset() {
+	*x = GetArchiveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repo_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchiveResponse) ProtoMessage() {}
+
+func (x *GetArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repo_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchiveResponse.ProtoReflect.Descriptor instead.
+func (*GetArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_repo_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetArchiveResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_repo_proto protoreflect.FileDescriptor
 
 var file_repo_proto_rawDesc = []byte{
@@ -2712,7 +3406,7 @@ var file_repo_proto_rawDesc = []byte{
 	0x11, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70,
 	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
 	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72,
 	0x70, 0x63, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
@@ -2720,8 +3414,30 @@ var file_repo_proto_rawDesc = []byte{
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2f, 0x0a,
 	0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65,
 	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x3f,
-	0x0a, 0x16, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x53, 0x70, 0x65, 0x63, 0x73, 0x22, 0x7a, 0x0a, 0x16, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x39, 0x0a, 0x0b,
+	0x72, 0x65, 0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x66,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66,
+	0x12, 0x17, 0x0a, 0x07, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x6c, 0x64, 0x53, 0x68, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77,
+	0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x53,
+	0x68, 0x61, 0x22, 0x6a, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x69, 0x64, 0x22, 0x3f,
+	0x0a, 0x16, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61,
 	0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22,
@@ -2748,123 +3464,203 @@ var file_repo_proto_rawDesc = []byte{
 	0x22, 0x39, 0x0a, 0x11, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x62,
 	0x61, 0x73, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
-	0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x68, 0x61, 0x22, 0x3b, 0x0a, 0x0b, 0x46,
-	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04,
-	0x62, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x69, 0x72, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x72, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6d,
-	0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
-	0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3c, 0x0a, 0x12, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x22, 0x3f, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x79, 0x61,
-	0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x59, 0x61, 0x6d, 0x6c, 0x2a, 0x52, 0x0a, 0x0c, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x54, 0x72, 0x65, 0x65, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
-	0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x6c, 0x6f, 0x62,
-	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x10, 0x02, 0x2a, 0x81, 0x01, 0x0a, 0x0c, 0x54,
-	0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
-	0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x10,
-	0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x72,
-	0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x65, 0x63, 0x10, 0x02,
-	0x12, 0x14, 0x0a, 0x10, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x54, 0x72, 0x65, 0x65, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x10, 0x04, 0x2a, 0x1e,
-	0x0a, 0x08, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x61,
-	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00, 0x2a, 0x31,
-	0x0a, 0x13, 0x48, 0x61, 0x73, 0x68, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x61, 0x73, 0x68, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x58, 0x4f, 0x52, 0x10,
-	0x00, 0x32, 0xc8, 0x08, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x74,
-	0x68, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x36, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x13, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x68, 0x61, 0x22, 0x9d, 0x01, 0x0a, 0x11,
+	0x49, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61,
+	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x35, 0x0a, 0x12, 0x49,
+	0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x22, 0x74, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x53, 0x68, 0x61, 0x12, 0x19, 0x0a,
+	0x08, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x53, 0x68, 0x61, 0x22, 0x43, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x77, 0x0a,
+	0x19, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x53, 0x68, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x68,
+	0x65, 0x61, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x53, 0x68, 0x61, 0x22, 0x4c, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x68, 0x61, 0x22, 0x3b, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12,
+	0x19, 0x0a, 0x08, 0x64, 0x69, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x69, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3c, 0x0a, 0x12, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x3f, 0x0a,
+	0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x3f,
+	0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x59, 0x61, 0x6d, 0x6c, 0x22,
+	0xac, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x69, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69,
+	0x74, 0x52, 0x65, 0x66, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x28,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x52, 0x0a, 0x0c, 0x54, 0x72, 0x65, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x72, 0x65, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x54, 0x72, 0x65, 0x65, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x6c,
+	0x6f, 0x62, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x10, 0x02, 0x2a, 0x81, 0x01, 0x0a,
+	0x0c, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x10, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x54, 0x72, 0x65, 0x65, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x72, 0x65, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x10, 0x04,
+	0x2a, 0x1e, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e,
+	0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00,
+	0x2a, 0x31, 0x0a, 0x13, 0x48, 0x61, 0x73, 0x68, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x61, 0x73, 0x68, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x58, 0x4f,
+	0x52, 0x10, 0x00, 0x2a, 0x53, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x61, 0x72, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x61, 0x72, 0x47, 0x7a,
+	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x5a, 0x69, 0x70, 0x10, 0x02, 0x32, 0xba, 0x0b, 0x0a, 0x11, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a,
+	0x0c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x62, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
+	0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x17,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x69, 0x76, 0x65,
+	0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10,
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3a, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x15, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65,
-	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x72, 0x6e, 0x65,
-	0x73, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x72, 0x70,
-	0x63, 0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x0e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x1a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x46, 0x6f,
+	0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x46,
+	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x0a, 0x49, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x16,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x73, 0x41,
+	0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
+	0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2879,130 +3675,159 @@ func file_repo_proto_rawDescGZIP() []byte {
 	return file_repo_proto_rawDescData
 }
 
-var file_repo_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_repo_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_repo_proto_goTypes = []interface{}{
 	(TreeNodeType)(0),                     // 0: rpc.TreeNodeType
 	(TreeNodeMode)(0),                     // 1: rpc.TreeNodeMode
 	(HashType)(0),                         // 2: rpc.HashType
 	(HashAggregationType)(0),              // 3: rpc.HashAggregationType
-	(*CreateRepositoryRequest)(nil),       // 4: rpc.CreateRepositoryRequest
-	(*CreateRepositoryRequestHeader)(nil), // 5: rpc.CreateRepositoryRequestHeader
-	(*CreateRepositoryResponse)(nil),      // 6: rpc.CreateRepositoryResponse
-	(*GetTreeNodeRequest)(nil),            // 7: rpc.GetTreeNodeRequest
-	(*GetTreeNodeResponse)(nil),           // 8: rpc.GetTreeNodeResponse
-	(*ListTreeNodesRequest)(nil),          // 9: rpc.ListTreeNodesRequest
-	(*ListTreeNodesResponse)(nil),         // 10: rpc.ListTreeNodesResponse
-	(*TreeNode)(nil),                      // 11: rpc.TreeNode
-	(*PathsDetailsRequest)(nil),           // 12: rpc.PathsDetailsRequest
-	(*PathsDetailsResponse)(nil),          // 13: rpc.PathsDetailsResponse
-	(*PathDetails)(nil),                   // 14: rpc.PathDetails
-	(*GetCommitRequest)(nil),              // 15: rpc.GetCommitRequest
-	(*GetCommitResponse)(nil),             // 16: rpc.GetCommitResponse
-	(*ListCommitsRequest)(nil),            // 17: rpc.ListCommitsRequest
-	(*ListCommitsResponse)(nil),           // 18: rpc.ListCommitsResponse
-	(*RenameDetails)(nil),                 // 19: rpc.RenameDetails
-	(*GetBlobRequest)(nil),                // 20: rpc.GetBlobRequest
-	(*GetBlobResponse)(nil),               // 21: rpc.GetBlobResponse
-	(*GetBlobResponseHeader)(nil),         // 22: rpc.GetBlobResponseHeader
-	(*GetSubmoduleRequest)(nil),           // 23: rpc.GetSubmoduleRequest
-	(*GetSubmoduleResponse)(nil),          // 24: rpc.GetSubmoduleResponse
-	(*Submodule)(nil),                     // 25: rpc.Submodule
-	(*GetCommitDivergencesRequest)(nil),   // 26: rpc.GetCommitDivergencesRequest
-	(*CommitDivergenceRequest)(nil),       // 27: rpc.CommitDivergenceRequest
-	(*GetCommitDivergencesResponse)(nil),  // 28: rpc.GetCommitDivergencesResponse
-	(*CommitDivergence)(nil),              // 29: rpc.CommitDivergence
-	(*DeleteRepositoryRequest)(nil),       // 30: rpc.DeleteRepositoryRequest
-	(*DeleteRepositoryResponse)(nil),      // 31: rpc.DeleteRepositoryResponse
-	(*SyncRepositoryRequest)(nil),         // 32: rpc.SyncRepositoryRequest
-	(*SyncRepositoryResponse)(nil),        // 33: rpc.SyncRepositoryResponse
-	(*HashRepositoryRequest)(nil),         // 34: rpc.HashRepositoryRequest
-	(*HashRepositoryResponse)(nil),        // 35: rpc.HashRepositoryResponse
-	(*MergeBaseRequest)(nil),              // 36: rpc.MergeBaseRequest
-	(*MergeBaseResponse)(nil),             // 37: rpc.MergeBaseResponse
-	(*FileContent)(nil),                   // 38: rpc.FileContent
-	(*MatchFilesRequest)(nil),             // 39: rpc.MatchFilesRequest
-	(*MatchFilesResponse)(nil),            // 40: rpc.MatchFilesResponse
-	(*GeneratePipelineRequest)(nil),       // 41: rpc.GeneratePipelineRequest
-	(*GeneratePipelineResponse)(nil),      // 42: rpc.GeneratePipelineResponse
-	(*FileUpload)(nil),                    // 43: rpc.FileUpload
-	(*WriteRequest)(nil),                  // 44: rpc.WriteRequest
-	(*Identity)(nil),                      // 45: rpc.Identity
-	(*ReadRequest)(nil),                   // 46: rpc.ReadRequest
-	(*Commit)(nil),                        // 47: rpc.Commit
+	(ArchiveFormat)(0),                    // 4: rpc.ArchiveFormat
+	(*CreateRepositoryRequest)(nil),       // 5: rpc.CreateRepositoryRequest
+	(*CreateRepositoryRequestHeader)(nil), // 6: rpc.CreateRepositoryRequestHeader
+	(*CreateRepositoryResponse)(nil),      // 7: rpc.CreateRepositoryResponse
+	(*GetTreeNodeRequest)(nil),            // 8: rpc.GetTreeNodeRequest
+	(*GetTreeNodeResponse)(nil),           // 9: rpc.GetTreeNodeResponse
+	(*ListTreeNodesRequest)(nil),          // 10: rpc.ListTreeNodesRequest
+	(*ListTreeNodesResponse)(nil),         // 11: rpc.ListTreeNodesResponse
+	(*TreeNode)(nil),                      // 12: rpc.TreeNode
+	(*PathsDetailsRequest)(nil),           // 13: rpc.PathsDetailsRequest
+	(*PathsDetailsResponse)(nil),          // 14: rpc.PathsDetailsResponse
+	(*PathDetails)(nil),                   // 15: rpc.PathDetails
+	(*GetCommitRequest)(nil),              // 16: rpc.GetCommitRequest
+	(*GetCommitResponse)(nil),             // 17: rpc.GetCommitResponse
+	(*ListCommitsRequest)(nil),            // 18: rpc.ListCommitsRequest
+	(*ListCommitsResponse)(nil),           // 19: rpc.ListCommitsResponse
+	(*RenameDetails)(nil),                 // 20: rpc.RenameDetails
+	(*GetBlobRequest)(nil),                // 21: rpc.GetBlobRequest
+	(*GetBlobResponse)(nil),               // 22: rpc.GetBlobResponse
+	(*GetBlobResponseHeader)(nil),         // 23: rpc.GetBlobResponseHeader
+	(*GetSubmoduleRequest)(nil),           // 24: rpc.GetSubmoduleRequest
+	(*GetSubmoduleResponse)(nil),          // 25: rpc.GetSubmoduleResponse
+	(*Submodule)(nil),                     // 26: rpc.Submodule
+	(*GetCommitDivergencesRequest)(nil),   // 27: rpc.GetCommitDivergencesRequest
+	(*CommitDivergenceRequest)(nil),       // 28: rpc.CommitDivergenceRequest
+	(*GetCommitDivergencesResponse)(nil),  // 29: rpc.GetCommitDivergencesResponse
+	(*CommitDivergence)(nil),              // 30: rpc.CommitDivergence
+	(*DeleteRepositoryRequest)(nil),       // 31: rpc.DeleteRepositoryRequest
+	(*DeleteRepositoryResponse)(nil),      // 32: rpc.DeleteRepositoryResponse
+	(*SyncRepositoryRequest)(nil),         // 33: rpc.SyncRepositoryRequest
+	(*SyncRepositoryResponse)(nil),        // 34: rpc.SyncRepositoryResponse
+	(*SyncReferenceUpdate)(nil),           // 35: rpc.SyncReferenceUpdate
+	(*ForkRepositoryRequest)(nil),         // 36: rpc.ForkRepositoryRequest
+	(*ForkRepositoryResponse)(nil),        // 37: rpc.ForkRepositoryResponse
+	(*HashRepositoryRequest)(nil),         // 38: rpc.HashRepositoryRequest
+	(*HashRepositoryResponse)(nil),        // 39: rpc.HashRepositoryResponse
+	(*MergeBaseRequest)(nil),              // 40: rpc.MergeBaseRequest
+	(*MergeBaseResponse)(nil),             // 41: rpc.MergeBaseResponse
+	(*IsAncestorRequest)(nil),             // 42: rpc.IsAncestorRequest
+	(*IsAncestorResponse)(nil),            // 43: rpc.IsAncestorResponse
+	(*FindMergeCommitRequest)(nil),        // 44: rpc.FindMergeCommitRequest
+	(*FindMergeCommitResponse)(nil),       // 45: rpc.FindMergeCommitResponse
+	(*FindUnsignedCommitRequest)(nil),     // 46: rpc.FindUnsignedCommitRequest
+	(*FindUnsignedCommitResponse)(nil),    // 47: rpc.FindUnsignedCommitResponse
+	(*FileContent)(nil),                   // 48: rpc.FileContent
+	(*MatchFilesRequest)(nil),             // 49: rpc.MatchFilesRequest
+	(*MatchFilesResponse)(nil),            // 50: rpc.MatchFilesResponse
+	(*GeneratePipelineRequest)(nil),       // 51: rpc.GeneratePipelineRequest
+	(*GeneratePipelineResponse)(nil),      // 52: rpc.GeneratePipelineResponse
+	(*GetArchiveRequest)(nil),             // 53: rpc.GetArchiveRequest
+	(*GetArchiveResponse)(nil),            // 54: rpc.GetArchiveResponse
+	(*FileUpload)(nil),                    // 55: rpc.FileUpload
+	(*WriteRequest)(nil),                  // 56: rpc.WriteRequest
+	(*Identity)(nil),                      // 57: rpc.Identity
+	(*ReadRequest)(nil),                   // 58: rpc.ReadRequest
+	(*Commit)(nil),                        // 59: rpc.Commit
 }
 var file_repo_proto_depIdxs = []int32{
-	5,  // 0: rpc.CreateRepositoryRequest.header:type_name -> rpc.CreateRepositoryRequestHeader
-	43, // 1: rpc.CreateRepositoryRequest.file:type_name -> rpc.FileUpload
-	44, // 2: rpc.CreateRepositoryRequestHeader.base:type_name -> rpc.WriteRequest
-	45, // 3: rpc.CreateRepositoryRequestHeader.author:type_name -> rpc.Identity
-	45, // 4: rpc.CreateRepositoryRequestHeader.committer:type_name -> rpc.Identity
-	46, // 5: rpc.GetTreeNodeRequest.base:type_name -> rpc.ReadRequest
-	11, // 6: rpc.GetTreeNodeResponse.node:type_name -> rpc.TreeNode
-	47, // 7: rpc.GetTreeNodeResponse.commit:type_name -> rpc.Commit
-	46, // 8: rpc.ListTreeNodesRequest.base:type_name -> rpc.ReadRequest
-	11, // 9: rpc.ListTreeNodesResponse.node:type_name -> rpc.TreeNode
+	6,  // 0: rpc.CreateRepositoryRequest.header:type_name -> rpc.CreateRepositoryRequestHeader
+	55, // 1: rpc.CreateRepositoryRequest.file:type_name -> rpc.FileUpload
+	56, // 2: rpc.CreateRepositoryRequestHeader.base:type_name -> rpc.WriteRequest
+	57, // 3: rpc.CreateRepositoryRequestHeader.author:type_name -> rpc.Identity
+	57, // 4: rpc.CreateRepositoryRequestHeader.committer:type_name -> rpc.Identity
+	58, // 5: rpc.GetTreeNodeRequest.base:type_name -> rpc.ReadRequest
+	12, // 6: rpc.GetTreeNodeResponse.node:type_name -> rpc.TreeNode
+	59, // 7: rpc.GetTreeNodeResponse.commit:type_name -> rpc.Commit
+	58, // 8: rpc.ListTreeNodesRequest.base:type_name -> rpc.ReadRequest
+	12, // 9: rpc.ListTreeNodesResponse.node:type_name -> rpc.TreeNode
 	0,  // 10: rpc.TreeNode.type:type_name -> rpc.TreeNodeType
 	1,  // 11: rpc.TreeNode.mode:type_name -> rpc.TreeNodeMode
-	46, // 12: rpc.PathsDetailsRequest.base:type_name -> rpc.ReadRequest
-	14, // 13: rpc.PathsDetailsResponse.path_details:type_name -> rpc.PathDetails
-	47, // 14: rpc.PathDetails.last_commit:type_name -> rpc.Commit
-	46, // 15: rpc.GetCommitRequest.base:type_name -> rpc.ReadRequest
-	47, // 16: rpc.GetCommitResponse.commit:type_name -> rpc.Commit
-	46, // 17: rpc.ListCommitsRequest.base:type_name -> rpc.ReadRequest
-	47, // 18: rpc.ListCommitsResponse.commit:type_name -> rpc.Commit
-	19, // 19: rpc.ListCommitsResponse.rename_details:type_name -> rpc.RenameDetails
-	46, // 20: rpc.GetBlobRequest.base:type_name -> rpc.ReadRequest
-	22, // 21: rpc.GetBlobResponse.header:type_name -> rpc.GetBlobResponseHeader
-	46, // 22: rpc.GetSubmoduleRequest.base:type_name -> rpc.ReadRequest
-	25, // 23: rpc.GetSubmoduleResponse.submodule:type_name -> rpc.Submodule
-	46, // 24: rpc.GetCommitDivergencesRequest.base:type_name -> rpc.ReadRequest
-	27, // 25: rpc.GetCommitDivergencesRequest.requests:type_name -> rpc.CommitDivergenceRequest
-	29, // 26: rpc.GetCommitDivergencesResponse.divergences:type_name -> rpc.CommitDivergence
-	44, // 27: rpc.DeleteRepositoryRequest.base:type_name -> rpc.WriteRequest
-	44, // 28: rpc.SyncRepositoryRequest.base:type_name -> rpc.WriteRequest
-	46, // 29: rpc.HashRepositoryRequest.base:type_name -> rpc.ReadRequest
-	2,  // 30: rpc.HashRepositoryRequest.hash_type:type_name -> rpc.HashType
-	3,  // 31: rpc.HashRepositoryRequest.aggregation_type:type_name -> rpc.HashAggregationType
-	46, // 32: rpc.MergeBaseRequest.base:type_name -> rpc.ReadRequest
-	46, // 33: rpc.MatchFilesRequest.base:type_name -> rpc.ReadRequest
-	38, // 34: rpc.MatchFilesResponse.files:type_name -> rpc.FileContent
-	46, // 35: rpc.GeneratePipelineRequest.base:type_name -> rpc.ReadRequest
-	4,  // 36: rpc.RepositoryService.CreateRepository:input_type -> rpc.CreateRepositoryRequest
-	7,  // 37: rpc.RepositoryService.GetTreeNode:input_type -> rpc.GetTreeNodeRequest
-	9,  // 38: rpc.RepositoryService.ListTreeNodes:input_type -> rpc.ListTreeNodesRequest
-	12, // 39: rpc.RepositoryService.PathsDetails:input_type -> rpc.PathsDetailsRequest
-	23, // 40: rpc.RepositoryService.GetSubmodule:input_type -> rpc.GetSubmoduleRequest
-	20, // 41: rpc.RepositoryService.GetBlob:input_type -> rpc.GetBlobRequest
-	17, // 42: rpc.RepositoryService.ListCommits:input_type -> rpc.ListCommitsRequest
-	15, // 43: rpc.RepositoryService.GetCommit:input_type -> rpc.GetCommitRequest
-	26, // 44: rpc.RepositoryService.GetCommitDivergences:input_type -> rpc.GetCommitDivergencesRequest
-	30, // 45: rpc.RepositoryService.DeleteRepository:input_type -> rpc.DeleteRepositoryRequest
-	32, // 46: rpc.RepositoryService.SyncRepository:input_type -> rpc.SyncRepositoryRequest
-	34, // 47: rpc.RepositoryService.HashRepository:input_type -> rpc.HashRepositoryRequest
-	36, // 48: rpc.RepositoryService.MergeBase:input_type -> rpc.MergeBaseRequest
-	39, // 49: rpc.RepositoryService.MatchFiles:input_type -> rpc.MatchFilesRequest
-	41, // 50: rpc.RepositoryService.GeneratePipeline:input_type -> rpc.GeneratePipelineRequest
-	6,  // 51: rpc.RepositoryService.CreateRepository:output_type -> rpc.CreateRepositoryResponse
-	8,  // 52: rpc.RepositoryService.GetTreeNode:output_type -> rpc.GetTreeNodeResponse
-	10, // 53: rpc.RepositoryService.ListTreeNodes:output_type -> rpc.ListTreeNodesResponse
-	13, // 54: rpc.RepositoryService.PathsDetails:output_type -> rpc.PathsDetailsResponse
-	24, // 55: rpc.RepositoryService.GetSubmodule:output_type -> rpc.GetSubmoduleResponse
-	21, // 56: rpc.RepositoryService.GetBlob:output_type -> rpc.GetBlobResponse
-	18, // 57: rpc.RepositoryService.ListCommits:output_type -> rpc.ListCommitsResponse
-	16, // 58: rpc.RepositoryService.GetCommit:output_type -> rpc.GetCommitResponse
-	28, // 59: rpc.RepositoryService.GetCommitDivergences:output_type -> rpc.GetCommitDivergencesResponse
-	31, // 60: rpc.RepositoryService.DeleteRepository:output_type -> rpc.DeleteRepositoryResponse
-	33, // 61: rpc.RepositoryService.SyncRepository:output_type -> rpc.SyncRepositoryResponse
-	35, // 62: rpc.RepositoryService.HashRepository:output_type -> rpc.HashRepositoryResponse
-	37, // 63: rpc.RepositoryService.MergeBase:output_type -> rpc.MergeBaseResponse
-	40, // 64: rpc.RepositoryService.MatchFiles:output_type -> rpc.MatchFilesResponse
-	42, // 65: rpc.RepositoryService.GeneratePipeline:output_type -> rpc.GeneratePipelineResponse
-	51, // [51:66] is the sub-list for method output_type
-	36, // [36:51] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	58, // 12: rpc.PathsDetailsRequest.base:type_name -> rpc.ReadRequest
+	15, // 13: rpc.PathsDetailsResponse.path_details:type_name -> rpc.PathDetails
+	59, // 14: rpc.PathDetails.last_commit:type_name -> rpc.Commit
+	58, // 15: rpc.GetCommitRequest.base:type_name -> rpc.ReadRequest
+	59, // 16: rpc.GetCommitResponse.commit:type_name -> rpc.Commit
+	58, // 17: rpc.ListCommitsRequest.base:type_name -> rpc.ReadRequest
+	59, // 18: rpc.ListCommitsResponse.commit:type_name -> rpc.Commit
+	20, // 19: rpc.ListCommitsResponse.rename_details:type_name -> rpc.RenameDetails
+	58, // 20: rpc.GetBlobRequest.base:type_name -> rpc.ReadRequest
+	23, // 21: rpc.GetBlobResponse.header:type_name -> rpc.GetBlobResponseHeader
+	58, // 22: rpc.GetSubmoduleRequest.base:type_name -> rpc.ReadRequest
+	26, // 23: rpc.GetSubmoduleResponse.submodule:type_name -> rpc.Submodule
+	58, // 24: rpc.GetCommitDivergencesRequest.base:type_name -> rpc.ReadRequest
+	28, // 25: rpc.GetCommitDivergencesRequest.requests:type_name -> rpc.CommitDivergenceRequest
+	30, // 26: rpc.GetCommitDivergencesResponse.divergences:type_name -> rpc.CommitDivergence
+	56, // 27: rpc.DeleteRepositoryRequest.base:type_name -> rpc.WriteRequest
+	56, // 28: rpc.SyncRepositoryRequest.base:type_name -> rpc.WriteRequest
+	35, // 29: rpc.SyncRepositoryResponse.ref_updates:type_name -> rpc.SyncReferenceUpdate
+	56, // 30: rpc.ForkRepositoryRequest.base:type_name -> rpc.WriteRequest
+	58, // 31: rpc.HashRepositoryRequest.base:type_name -> rpc.ReadRequest
+	2,  // 32: rpc.HashRepositoryRequest.hash_type:type_name -> rpc.HashType
+	3,  // 33: rpc.HashRepositoryRequest.aggregation_type:type_name -> rpc.HashAggregationType
+	58, // 34: rpc.MergeBaseRequest.base:type_name -> rpc.ReadRequest
+	58, // 35: rpc.IsAncestorRequest.base:type_name -> rpc.ReadRequest
+	58, // 36: rpc.FindMergeCommitRequest.base:type_name -> rpc.ReadRequest
+	58, // 37: rpc.FindUnsignedCommitRequest.base:type_name -> rpc.ReadRequest
+	58, // 38: rpc.MatchFilesRequest.base:type_name -> rpc.ReadRequest
+	48, // 39: rpc.MatchFilesResponse.files:type_name -> rpc.FileContent
+	58, // 40: rpc.GeneratePipelineRequest.base:type_name -> rpc.ReadRequest
+	58, // 41: rpc.GetArchiveRequest.base:type_name -> rpc.ReadRequest
+	4,  // 42: rpc.GetArchiveRequest.format:type_name -> rpc.ArchiveFormat
+	5,  // 43: rpc.RepositoryService.CreateRepository:input_type -> rpc.CreateRepositoryRequest
+	8,  // 44: rpc.RepositoryService.GetTreeNode:input_type -> rpc.GetTreeNodeRequest
+	10, // 45: rpc.RepositoryService.ListTreeNodes:input_type -> rpc.ListTreeNodesRequest
+	13, // 46: rpc.RepositoryService.PathsDetails:input_type -> rpc.PathsDetailsRequest
+	24, // 47: rpc.RepositoryService.GetSubmodule:input_type -> rpc.GetSubmoduleRequest
+	21, // 48: rpc.RepositoryService.GetBlob:input_type -> rpc.GetBlobRequest
+	18, // 49: rpc.RepositoryService.ListCommits:input_type -> rpc.ListCommitsRequest
+	16, // 50: rpc.RepositoryService.GetCommit:input_type -> rpc.GetCommitRequest
+	27, // 51: rpc.RepositoryService.GetCommitDivergences:input_type -> rpc.GetCommitDivergencesRequest
+	31, // 52: rpc.RepositoryService.DeleteRepository:input_type -> rpc.DeleteRepositoryRequest
+	33, // 53: rpc.RepositoryService.SyncRepository:input_type -> rpc.SyncRepositoryRequest
+	36, // 54: rpc.RepositoryService.ForkRepository:input_type -> rpc.ForkRepositoryRequest
+	38, // 55: rpc.RepositoryService.HashRepository:input_type -> rpc.HashRepositoryRequest
+	40, // 56: rpc.RepositoryService.MergeBase:input_type -> rpc.MergeBaseRequest
+	42, // 57: rpc.RepositoryService.IsAncestor:input_type -> rpc.IsAncestorRequest
+	44, // 58: rpc.RepositoryService.FindMergeCommit:input_type -> rpc.FindMergeCommitRequest
+	46, // 59: rpc.RepositoryService.FindUnsignedCommit:input_type -> rpc.FindUnsignedCommitRequest
+	49, // 60: rpc.RepositoryService.MatchFiles:input_type -> rpc.MatchFilesRequest
+	51, // 61: rpc.RepositoryService.GeneratePipeline:input_type -> rpc.GeneratePipelineRequest
+	53, // 62: rpc.RepositoryService.GetArchive:input_type -> rpc.GetArchiveRequest
+	7,  // 63: rpc.RepositoryService.CreateRepository:output_type -> rpc.CreateRepositoryResponse
+	9,  // 64: rpc.RepositoryService.GetTreeNode:output_type -> rpc.GetTreeNodeResponse
+	11, // 65: rpc.RepositoryService.ListTreeNodes:output_type -> rpc.ListTreeNodesResponse
+	14, // 66: rpc.RepositoryService.PathsDetails:output_type -> rpc.PathsDetailsResponse
+	25, // 67: rpc.RepositoryService.GetSubmodule:output_type -> rpc.GetSubmoduleResponse
+	22, // 68: rpc.RepositoryService.GetBlob:output_type -> rpc.GetBlobResponse
+	19, // 69: rpc.RepositoryService.ListCommits:output_type -> rpc.ListCommitsResponse
+	17, // 70: rpc.RepositoryService.GetCommit:output_type -> rpc.GetCommitResponse
+	29, // 71: rpc.RepositoryService.GetCommitDivergences:output_type -> rpc.GetCommitDivergencesResponse
+	32, // 72: rpc.RepositoryService.DeleteRepository:output_type -> rpc.DeleteRepositoryResponse
+	34, // 73: rpc.RepositoryService.SyncRepository:output_type -> rpc.SyncRepositoryResponse
+	37, // 74: rpc.RepositoryService.ForkRepository:output_type -> rpc.ForkRepositoryResponse
+	39, // 75: rpc.RepositoryService.HashRepository:output_type -> rpc.HashRepositoryResponse
+	41, // 76: rpc.RepositoryService.MergeBase:output_type -> rpc.MergeBaseResponse
+	43, // 77: rpc.RepositoryService.IsAncestor:output_type -> rpc.IsAncestorResponse
+	45, // 78: rpc.RepositoryService.FindMergeCommit:output_type -> rpc.FindMergeCommitResponse
+	47, // 79: rpc.RepositoryService.FindUnsignedCommit:output_type -> rpc.FindUnsignedCommitResponse
+	50, // 80: rpc.RepositoryService.MatchFiles:output_type -> rpc.MatchFilesResponse
+	52, // 81: rpc.RepositoryService.GeneratePipeline:output_type -> rpc.GeneratePipelineResponse
+	54, // 82: rpc.RepositoryService.GetArchive:output_type -> rpc.GetArchiveResponse
+	63, // [63:83] is the sub-list for method output_type
+	43, // [43:63] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_repo_proto_init() }
@@ -3373,7 +4198,7 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HashRepositoryRequest); i {
+			switch v := v.(*SyncReferenceUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3385,7 +4210,7 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HashRepositoryResponse); i {
+			switch v := v.(*ForkRepositoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3397,7 +4222,7 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MergeBaseRequest); i {
+			switch v := v.(*ForkRepositoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3409,7 +4234,7 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MergeBaseResponse); i {
+			switch v := v.(*HashRepositoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3421,7 +4246,7 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileContent); i {
+			switch v := v.(*HashRepositoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3433,7 +4258,7 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchFilesRequest); i {
+			switch v := v.(*MergeBaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3445,7 +4270,7 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchFilesResponse); i {
+			switch v := v.(*MergeBaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3457,7 +4282,7 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeneratePipelineRequest); i {
+			switch v := v.(*IsAncestorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3469,6 +4294,114 @@ func file_repo_proto_init() {
 			}
 		}
 		file_repo_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsAncestorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMergeCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMergeCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUnsignedCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUnsignedCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePipelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GeneratePipelineResponse); i {
 			case 0:
 				return &v.state
@@ -3480,6 +4413,30 @@ func file_repo_proto_init() {
 				return nil
 			}
 		}
+		file_repo_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repo_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchiveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_repo_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*CreateRepositoryRequest_Header)(nil),
@@ -3494,8 +4451,8 @@ func file_repo_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_repo_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   39,
+			NumEnums:      5,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
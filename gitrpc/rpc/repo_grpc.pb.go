@@ -33,10 +33,15 @@ type RepositoryServiceClient interface {
 	GetCommitDivergences(ctx context.Context, in *GetCommitDivergencesRequest, opts ...grpc.CallOption) (*GetCommitDivergencesResponse, error)
 	DeleteRepository(ctx context.Context, in *DeleteRepositoryRequest, opts ...grpc.CallOption) (*DeleteRepositoryResponse, error)
 	SyncRepository(ctx context.Context, in *SyncRepositoryRequest, opts ...grpc.CallOption) (*SyncRepositoryResponse, error)
+	ForkRepository(ctx context.Context, in *ForkRepositoryRequest, opts ...grpc.CallOption) (*ForkRepositoryResponse, error)
 	HashRepository(ctx context.Context, in *HashRepositoryRequest, opts ...grpc.CallOption) (*HashRepositoryResponse, error)
 	MergeBase(ctx context.Context, in *MergeBaseRequest, opts ...grpc.CallOption) (*MergeBaseResponse, error)
+	IsAncestor(ctx context.Context, in *IsAncestorRequest, opts ...grpc.CallOption) (*IsAncestorResponse, error)
+	FindMergeCommit(ctx context.Context, in *FindMergeCommitRequest, opts ...grpc.CallOption) (*FindMergeCommitResponse, error)
+	FindUnsignedCommit(ctx context.Context, in *FindUnsignedCommitRequest, opts ...grpc.CallOption) (*FindUnsignedCommitResponse, error)
 	MatchFiles(ctx context.Context, in *MatchFilesRequest, opts ...grpc.CallOption) (*MatchFilesResponse, error)
 	GeneratePipeline(ctx context.Context, in *GeneratePipelineRequest, opts ...grpc.CallOption) (*GeneratePipelineResponse, error)
+	GetArchive(ctx context.Context, in *GetArchiveRequest, opts ...grpc.CallOption) (RepositoryService_GetArchiveClient, error)
 }
 
 type repositoryServiceClient struct {
@@ -240,6 +245,15 @@ func (c *repositoryServiceClient) SyncRepository(ctx context.Context, in *SyncRe
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ForkRepository(ctx context.Context, in *ForkRepositoryRequest, opts ...grpc.CallOption) (*ForkRepositoryResponse, error) {
+	out := new(ForkRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RepositoryService/ForkRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) HashRepository(ctx context.Context, in *HashRepositoryRequest, opts ...grpc.CallOption) (*HashRepositoryResponse, error) {
 	out := new(HashRepositoryResponse)
 	err := c.cc.Invoke(ctx, "/rpc.RepositoryService/HashRepository", in, out, opts...)
@@ -258,6 +272,33 @@ func (c *repositoryServiceClient) MergeBase(ctx context.Context, in *MergeBaseRe
 	return out, nil
 }
 
+func (c *repositoryServiceClient) IsAncestor(ctx context.Context, in *IsAncestorRequest, opts ...grpc.CallOption) (*IsAncestorResponse, error) {
+	out := new(IsAncestorResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RepositoryService/IsAncestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) FindMergeCommit(ctx context.Context, in *FindMergeCommitRequest, opts ...grpc.CallOption) (*FindMergeCommitResponse, error) {
+	out := new(FindMergeCommitResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RepositoryService/FindMergeCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) FindUnsignedCommit(ctx context.Context, in *FindUnsignedCommitRequest, opts ...grpc.CallOption) (*FindUnsignedCommitResponse, error) {
+	out := new(FindUnsignedCommitResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RepositoryService/FindUnsignedCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) MatchFiles(ctx context.Context, in *MatchFilesRequest, opts ...grpc.CallOption) (*MatchFilesResponse, error) {
 	out := new(MatchFilesResponse)
 	err := c.cc.Invoke(ctx, "/rpc.RepositoryService/MatchFiles", in, out, opts...)
@@ -276,6 +317,38 @@ func (c *repositoryServiceClient) GeneratePipeline(ctx context.Context, in *Gene
 	return out, nil
 }
 
+func (c *repositoryServiceClient) GetArchive(ctx context.Context, in *GetArchiveRequest, opts ...grpc.CallOption) (RepositoryService_GetArchiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[4], "/rpc.RepositoryService/GetArchive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryServiceGetArchiveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepositoryService_GetArchiveClient interface {
+	Recv() (*GetArchiveResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryServiceGetArchiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryServiceGetArchiveClient) Recv() (*GetArchiveResponse, error) {
+	m := new(GetArchiveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RepositoryServiceServer is the server API for RepositoryService service.
 // All implementations must embed UnimplementedRepositoryServiceServer
 // for forward compatibility
@@ -291,10 +364,15 @@ type RepositoryServiceServer interface {
 	GetCommitDivergences(context.Context, *GetCommitDivergencesRequest) (*GetCommitDivergencesResponse, error)
 	DeleteRepository(context.Context, *DeleteRepositoryRequest) (*DeleteRepositoryResponse, error)
 	SyncRepository(context.Context, *SyncRepositoryRequest) (*SyncRepositoryResponse, error)
+	ForkRepository(context.Context, *ForkRepositoryRequest) (*ForkRepositoryResponse, error)
 	HashRepository(context.Context, *HashRepositoryRequest) (*HashRepositoryResponse, error)
 	MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error)
+	IsAncestor(context.Context, *IsAncestorRequest) (*IsAncestorResponse, error)
+	FindMergeCommit(context.Context, *FindMergeCommitRequest) (*FindMergeCommitResponse, error)
+	FindUnsignedCommit(context.Context, *FindUnsignedCommitRequest) (*FindUnsignedCommitResponse, error)
 	MatchFiles(context.Context, *MatchFilesRequest) (*MatchFilesResponse, error)
 	GeneratePipeline(context.Context, *GeneratePipelineRequest) (*GeneratePipelineResponse, error)
+	GetArchive(*GetArchiveRequest, RepositoryService_GetArchiveServer) error
 	mustEmbedUnimplementedRepositoryServiceServer()
 }
 
@@ -335,18 +413,33 @@ func (UnimplementedRepositoryServiceServer) DeleteRepository(context.Context, *D
 func (UnimplementedRepositoryServiceServer) SyncRepository(context.Context, *SyncRepositoryRequest) (*SyncRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncRepository not implemented")
 }
+func (UnimplementedRepositoryServiceServer) ForkRepository(context.Context, *ForkRepositoryRequest) (*ForkRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkRepository not implemented")
+}
 func (UnimplementedRepositoryServiceServer) HashRepository(context.Context, *HashRepositoryRequest) (*HashRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HashRepository not implemented")
 }
 func (UnimplementedRepositoryServiceServer) MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MergeBase not implemented")
 }
+func (UnimplementedRepositoryServiceServer) IsAncestor(context.Context, *IsAncestorRequest) (*IsAncestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAncestor not implemented")
+}
+func (UnimplementedRepositoryServiceServer) FindMergeCommit(context.Context, *FindMergeCommitRequest) (*FindMergeCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMergeCommit not implemented")
+}
+func (UnimplementedRepositoryServiceServer) FindUnsignedCommit(context.Context, *FindUnsignedCommitRequest) (*FindUnsignedCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUnsignedCommit not implemented")
+}
 func (UnimplementedRepositoryServiceServer) MatchFiles(context.Context, *MatchFilesRequest) (*MatchFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MatchFiles not implemented")
 }
 func (UnimplementedRepositoryServiceServer) GeneratePipeline(context.Context, *GeneratePipelineRequest) (*GeneratePipelineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeneratePipeline not implemented")
 }
+func (UnimplementedRepositoryServiceServer) GetArchive(*GetArchiveRequest, RepositoryService_GetArchiveServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetArchive not implemented")
+}
 func (UnimplementedRepositoryServiceServer) mustEmbedUnimplementedRepositoryServiceServer() {}
 
 // UnsafeRepositoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -575,6 +668,24 @@ func _RepositoryService_SyncRepository_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ForkRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ForkRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RepositoryService/ForkRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ForkRepository(ctx, req.(*ForkRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_HashRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HashRepositoryRequest)
 	if err := dec(in); err != nil {
@@ -611,6 +722,60 @@ func _RepositoryService_MergeBase_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_IsAncestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsAncestorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).IsAncestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RepositoryService/IsAncestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).IsAncestor(ctx, req.(*IsAncestorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_FindMergeCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMergeCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).FindMergeCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RepositoryService/FindMergeCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).FindMergeCommit(ctx, req.(*FindMergeCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_FindUnsignedCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUnsignedCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).FindUnsignedCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RepositoryService/FindUnsignedCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).FindUnsignedCommit(ctx, req.(*FindUnsignedCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_MatchFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MatchFilesRequest)
 	if err := dec(in); err != nil {
@@ -647,6 +812,27 @@ func _RepositoryService_GeneratePipeline_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_GetArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServiceServer).GetArchive(m, &repositoryServiceGetArchiveServer{stream})
+}
+
+type RepositoryService_GetArchiveServer interface {
+	Send(*GetArchiveResponse) error
+	grpc.ServerStream
+}
+
+type repositoryServiceGetArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryServiceGetArchiveServer) Send(m *GetArchiveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RepositoryService_ServiceDesc is the grpc.ServiceDesc for RepositoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -682,6 +868,10 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncRepository",
 			Handler:    _RepositoryService_SyncRepository_Handler,
 		},
+		{
+			MethodName: "ForkRepository",
+			Handler:    _RepositoryService_ForkRepository_Handler,
+		},
 		{
 			MethodName: "HashRepository",
 			Handler:    _RepositoryService_HashRepository_Handler,
@@ -690,6 +880,18 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MergeBase",
 			Handler:    _RepositoryService_MergeBase_Handler,
 		},
+		{
+			MethodName: "IsAncestor",
+			Handler:    _RepositoryService_IsAncestor_Handler,
+		},
+		{
+			MethodName: "FindMergeCommit",
+			Handler:    _RepositoryService_FindMergeCommit_Handler,
+		},
+		{
+			MethodName: "FindUnsignedCommit",
+			Handler:    _RepositoryService_FindUnsignedCommit_Handler,
+		},
 		{
 			MethodName: "MatchFiles",
 			Handler:    _RepositoryService_MatchFiles_Handler,
@@ -720,6 +922,11 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RepositoryService_ListCommits_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetArchive",
+			Handler:       _RepositoryService_GetArchive_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "repo.proto",
 }
@@ -77,6 +77,9 @@ type ServicePackParams struct {
 	GitProtocol string
 	Data        io.ReadCloser
 	Options     []string // (key, value) pair
+	// Stateful runs the service in stateful mode as required by ssh.
+	// In that mode request data is streamed concurrently while the response is being received.
+	Stateful bool
 }
 
 func (c *Client) ServicePack(ctx context.Context, w io.Writer, params *ServicePackParams) error {
@@ -95,6 +98,7 @@ func (c *Client) ServicePack(ctx context.Context, w io.Writer, params *ServicePa
 		Service:          params.Service,
 		GitConfigOptions: params.Options,
 		GitProtocol:      params.GitProtocol,
+		Stateful:         params.Stateful,
 	}
 	switch params.Service {
 	case rpc.ServiceUploadPack:
@@ -130,22 +134,15 @@ func (c *Client) ServicePack(ctx context.Context, w io.Writer, params *ServicePa
 
 	log.Debug().Msg("Send request stream.")
 
-	// send body as stream
-	stdout := streamio.NewWriter(func(p []byte) error {
-		return stream.Send(&rpc.ServicePackRequest{
-			Data: p,
-		})
-	})
-
-	_, err = io.Copy(stdout, params.Data)
-	if err != nil {
-		return fmt.Errorf("PostUploadPack() error copying reader: %w", err)
-	}
-
-	log.Debug().Msg("completed sending request stream.")
-
-	if err = stream.CloseSend(); err != nil {
-		return fmt.Errorf("PostUploadPack() error closing the stream: %w", err)
+	if params.Stateful {
+		// the git client waits for responses before sending more data, so we can't wait for all input.
+		go func() {
+			if sendErr := sendServicePackData(stream, params.Data); sendErr != nil {
+				log.Debug().Err(sendErr).Msg("stopped sending request stream.")
+			}
+		}()
+	} else if err = sendServicePackData(stream, params.Data); err != nil {
+		return err
 	}
 
 	log.Debug().Msg("start receiving response stream.")
@@ -178,3 +175,23 @@ func (c *Client) ServicePack(ctx context.Context, w io.Writer, params *ServicePa
 
 	return nil
 }
+
+func sendServicePackData(stream rpc.SmartHTTPService_ServicePackClient, data io.Reader) error {
+	// send body as stream
+	stdout := streamio.NewWriter(func(p []byte) error {
+		return stream.Send(&rpc.ServicePackRequest{
+			Data: p,
+		})
+	})
+
+	_, err := io.Copy(stdout, data)
+	if err != nil {
+		return fmt.Errorf("PostUploadPack() error copying reader: %w", err)
+	}
+
+	if err = stream.CloseSend(); err != nil {
+		return fmt.Errorf("PostUploadPack() error closing the stream: %w", err)
+	}
+
+	return nil
+}
@@ -56,6 +56,8 @@ type CommitTag struct {
 	Message     string
 	Tagger      *Signature
 	Commit      *Commit
+	// Signature is the cryptographic signature of the tag (nil if the tag isn't signed).
+	Signature *ObjectSignature
 }
 
 type CreateCommitTagParams struct {
@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/harness/gitness/types/enum"
+
+// AuditEvent is a record of a security-relevant or administrative action.
+type AuditEvent struct {
+	ID      int64 `json:"id"`
+	Created int64 `json:"created"`
+
+	// ActorID and ActorUID identify the principal that performed the action.
+	// The UID is recorded as well, as the principal might not exist anymore.
+	ActorID  int64  `json:"actor_id"`
+	ActorUID string `json:"actor_uid"`
+
+	Action       enum.AuditAction       `json:"action"`
+	ResourceType enum.AuditResourceType `json:"resource_type"`
+	// ResourceName identifies the resource at the time of the action, e.g. the path of a repository.
+	ResourceName string `json:"resource_name"`
+	// SpaceID is the space the resource belongs to, it's zero for resources outside of spaces.
+	SpaceID int64 `json:"space_id,omitempty"`
+
+	// Data contains additional details of the action.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// AuditFilter stores audit event query parameters.
+type AuditFilter struct {
+	Page     int    `json:"page"`
+	Size     int    `json:"size"`
+	ActorUID string `json:"actor"`
+	// SpaceID restricts the events to the space and all of its sub-spaces, it's skipped if zero.
+	SpaceID       int64                    `json:"-"`
+	ResourceTypes []enum.AuditResourceType `json:"resource_type"`
+	Actions       []enum.AuditAction       `json:"action"`
+	// After and Before restrict the events to the time range (unix millis), they are skipped if zero.
+	After  int64      `json:"after"`
+	Before int64      `json:"before"`
+	Order  enum.Order `json:"order"`
+	// Cursor restricts the events to the ones following the cursor in the order of the filter.
+	// It's used instead of the page to iterate over all events.
+	Cursor *AuditCursor `json:"-"`
+}
+
+// AuditCursor identifies the position of an audit event in the list of audit events.
+type AuditCursor struct {
+	Created int64
+	ID      int64
+}
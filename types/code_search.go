@@ -0,0 +1,52 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/harness/gitness/types/enum"
+
+// CodeSearchFilter stores the parameters of a code search.
+type CodeSearchFilter struct {
+	Query         string              `json:"query"`
+	Mode          enum.CodeSearchMode `json:"mode"`
+	CaseSensitive bool                `json:"case_sensitive"`
+	// Path is a glob pattern the paths of the files have to match (e.g. "app/**/*.go").
+	Path string `json:"path"`
+	// Language is the name of the programming language of the files (e.g. "Go").
+	Language string `json:"language"`
+	// Context is the number of lines returned before and after each matching line.
+	Context int `json:"context"`
+	// Size is the maximum number of files returned.
+	Size int `json:"size"`
+}
+
+// CodeSearchResult contains the matches of a code search in a single file.
+type CodeSearchResult struct {
+	RepoID    int64             `json:"repo_id"`
+	RepoPath  string            `json:"repo_path"`
+	CommitSHA string            `json:"commit_sha"`
+	Path      string            `json:"path"`
+	Language  string            `json:"language,omitempty"`
+	Matches   []CodeSearchMatch `json:"matches"`
+	// Truncated is true in case the file contains more matches than returned.
+	Truncated bool `json:"truncated"`
+}
+
+// CodeSearchMatch describes a matching line together with the lines surrounding it.
+type CodeSearchMatch struct {
+	LineNumber int      `json:"line_number"`
+	Line       string   `json:"line"`
+	Before     []string `json:"before,omitempty"`
+	After      []string `json:"after,omitempty"`
+}
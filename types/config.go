@@ -95,6 +95,14 @@ type Config struct {
 			Proto string `envconfig:"GITNESS_HTTP_PROTO" default:"http"`
 		}
 
+		// SSH defines the configuration parameters of the embedded ssh server used for git operations.
+		SSH struct {
+			Enabled bool `envconfig:"GITNESS_SSH_ENABLED" default:"false"`
+			Port    int  `envconfig:"GITNESS_SSH_PORT" default:"3022"`
+			// HostKeyPath is the path of the private host key - a new key is generated if the file doesn't exist.
+			HostKeyPath string `envconfig:"GITNESS_SSH_HOST_KEY_PATH" default:"ssh_host_ed25519_key"`
+		}
+
 		// Acme defines Acme configuration parameters.
 		Acme struct {
 			Enabled bool   `envconfig:"GITNESS_ACME_ENABLED"`
@@ -125,6 +133,84 @@ type Config struct {
 		Expire     time.Duration `envconfig:"GITNESS_TOKEN_EXPIRE" default:"720h"`
 	}
 
+	// OIDC defines the configuration of the OpenID Connect single sign-on login.
+	OIDC struct {
+		Enabled bool `envconfig:"GITNESS_OIDC_ENABLED"`
+
+		// Issuer is the URL of the identity provider, used for discovery (/.well-known/openid-configuration).
+		Issuer       string `envconfig:"GITNESS_OIDC_ISSUER"`
+		ClientID     string `envconfig:"GITNESS_OIDC_CLIENT_ID"`
+		ClientSecret string `envconfig:"GITNESS_OIDC_CLIENT_SECRET"`
+
+		// RedirectURL is the callback URL registered with the identity provider.
+		// If not provided, {GITNESS_URL_API}/v1/login/oidc/callback is used.
+		RedirectURL string   `envconfig:"GITNESS_OIDC_REDIRECT_URL"`
+		Scopes      []string `envconfig:"GITNESS_OIDC_SCOPES" default:"openid,profile,email"`
+
+		// UIDClaim is the claim used as uid for just-in-time provisioned users.
+		UIDClaim string `envconfig:"GITNESS_OIDC_UID_CLAIM" default:"preferred_username"`
+
+		// GroupsClaim is the claim containing the groups of the user.
+		GroupsClaim string `envconfig:"GITNESS_OIDC_GROUPS_CLAIM" default:"groups"`
+
+		// GroupMappings maps groups of the identity provider to space memberships.
+		// Each mapping has the format "{group}={space path}:{role}", e.g. "developers=acme:contributor".
+		GroupMappings []string `envconfig:"GITNESS_OIDC_GROUP_MAPPINGS"`
+
+		// DisablePasswordLogin disables user registration and login with local passwords for all non-admin users.
+		DisablePasswordLogin bool `envconfig:"GITNESS_OIDC_DISABLE_PASSWORD_LOGIN"`
+	}
+
+	// LDAP defines the configuration of the LDAP (or Active Directory) authentication.
+	LDAP struct {
+		Enabled bool `envconfig:"GITNESS_LDAP_ENABLED"`
+
+		// URL of the directory server, e.g. ldaps://ldap.example.com:636.
+		URL                string        `envconfig:"GITNESS_LDAP_URL"`
+		StartTLS           bool          `envconfig:"GITNESS_LDAP_START_TLS"`
+		InsecureSkipVerify bool          `envconfig:"GITNESS_LDAP_INSECURE_SKIP_VERIFY"`
+		Timeout            time.Duration `envconfig:"GITNESS_LDAP_TIMEOUT" default:"10s"`
+
+		// BindDN and BindPassword are the credentials used to search the directory.
+		// If not provided, an anonymous bind is used.
+		BindDN       string `envconfig:"GITNESS_LDAP_BIND_DN"`
+		BindPassword string `envconfig:"GITNESS_LDAP_BIND_PASSWORD"`
+
+		// UserBaseDN is the base DN of user searches.
+		UserBaseDN string `envconfig:"GITNESS_LDAP_USER_BASE_DN"`
+		// UserFilter is the filter used to find the user logging in, %s is replaced with the login identifier.
+		// For Active Directory use e.g. "(&(objectClass=user)(sAMAccountName=%s))".
+		UserFilter           string `envconfig:"GITNESS_LDAP_USER_FILTER" default:"(&(objectClass=person)(uid=%s))"`
+		UIDAttribute         string `envconfig:"GITNESS_LDAP_UID_ATTRIBUTE" default:"uid"`
+		EmailAttribute       string `envconfig:"GITNESS_LDAP_EMAIL_ATTRIBUTE" default:"mail"`
+		DisplayNameAttribute string `envconfig:"GITNESS_LDAP_DISPLAY_NAME_ATTRIBUTE" default:"cn"`
+
+		// GroupBaseDN is the base DN of group searches.
+		GroupBaseDN          string `envconfig:"GITNESS_LDAP_GROUP_BASE_DN"`
+		GroupNameAttribute   string `envconfig:"GITNESS_LDAP_GROUP_NAME_ATTRIBUTE" default:"cn"`
+		GroupMemberAttribute string `envconfig:"GITNESS_LDAP_GROUP_MEMBER_ATTRIBUTE" default:"member"`
+
+		// GroupMappings maps groups of the directory to space memberships.
+		// Each mapping has the format "{group}={space path}:{role}", e.g. "developers=acme:contributor".
+		GroupMappings []string `envconfig:"GITNESS_LDAP_GROUP_MAPPINGS"`
+
+		// GroupSyncCron is the schedule of the job syncing the groups of the directory into space memberships.
+		GroupSyncCron string `envconfig:"GITNESS_LDAP_GROUP_SYNC_CRON" default:"*/15 * * * *"`
+	}
+
+	// TOTP defines the configuration of the two-factor authentication with time-based one-time passwords.
+	// It applies to logins with a password, single sign-on logins rely on the identity provider instead.
+	TOTP struct {
+		// Required forces all users to enroll in two-factor authentication on their next login.
+		Required bool `envconfig:"GITNESS_TOTP_REQUIRED"`
+
+		// Issuer is the name the accounts are shown with in authenticator apps.
+		Issuer string `envconfig:"GITNESS_TOTP_ISSUER" default:"Gitness"`
+
+		// ChallengeLifetime is the time users have to provide the one-time password after their password was verified.
+		ChallengeLifetime time.Duration `envconfig:"GITNESS_TOTP_CHALLENGE_LIFETIME" default:"5m"`
+	}
+
 	Logs struct {
 		// S3 provides optional storage option for logs.
 		S3 struct {
@@ -135,6 +221,46 @@ type Config struct {
 		}
 	}
 
+	// LFS defines the configuration parameters of the git LFS object storage.
+	LFS struct {
+		// LocalPath is the directory in which LFS objects are stored if no S3 bucket is configured.
+		LocalPath string `envconfig:"GITNESS_LFS_LOCAL_PATH" default:"lfs"`
+
+		// MaxObjectSize is the maximum size (in bytes) of an LFS object, larger uploads are rejected.
+		// NOTE: S3 can't move objects larger than 5 GiB into place with a single copy.
+		MaxObjectSize int64 `envconfig:"GITNESS_LFS_MAX_OBJECT_SIZE" default:"2147483648"`
+
+		// S3 provides optional storage option for LFS objects.
+		S3 struct {
+			Bucket    string `envconfig:"GITNESS_LFS_S3_BUCKET"`
+			Prefix    string `envconfig:"GITNESS_LFS_S3_PREFIX"`
+			Endpoint  string `envconfig:"GITNESS_LFS_S3_ENDPOINT"`
+			PathStyle bool   `envconfig:"GITNESS_LFS_S3_PATH_STYLE"`
+		}
+	}
+
+	// CodeSearch defines the configuration parameters of the code search.
+	CodeSearch struct {
+		// IndexPath is the directory in which the search indexes of the repositories are stored.
+		IndexPath string `envconfig:"GITNESS_CODE_SEARCH_INDEX_PATH" default:"search"`
+
+		// MaxFileSize is the maximum size (in bytes) of indexed files, larger files are skipped.
+		MaxFileSize int64 `envconfig:"GITNESS_CODE_SEARCH_MAX_FILE_SIZE" default:"1048576"`
+
+		// MaxCacheSize is the maximum total size (in bytes) of the search indexes kept in memory.
+		// Indexes exceeding the limit are evicted and loaded from disk again when needed.
+		MaxCacheSize int64 `envconfig:"GITNESS_CODE_SEARCH_MAX_CACHE_SIZE" default:"268435456"`
+	}
+
+	// Mirror defines the configuration parameters of pull mirror repositories.
+	Mirror struct {
+		// DefaultInterval is the sync interval used for mirrors that are created without an explicit interval.
+		DefaultInterval time.Duration `envconfig:"GITNESS_MIRROR_DEFAULT_INTERVAL" default:"8h"`
+
+		// MinInterval is the smallest sync interval that can be configured for a mirror.
+		MinInterval time.Duration `envconfig:"GITNESS_MIRROR_MIN_INTERVAL" default:"10m"`
+	}
+
 	// Cors defines http cors parameters
 	Cors struct {
 		AllowedOrigins   []string `envconfig:"GITNESS_CORS_ALLOWED_ORIGINS"   default:"*"`
@@ -0,0 +1,75 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+// AuditAction defines the action recorded by an audit event.
+type AuditAction string
+
+func (AuditAction) Enum() []interface{}                { return toInterfaceSlice(auditActions) }
+func (a AuditAction) Sanitize() (AuditAction, bool)    { return Sanitize(a, GetAllAuditActions) }
+func GetAllAuditActions() ([]AuditAction, AuditAction) { return auditActions, "" }
+
+// AuditAction enumeration.
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+	AuditActionMove   AuditAction = "move"
+)
+
+var auditActions = sortEnum([]AuditAction{
+	AuditActionCreate,
+	AuditActionUpdate,
+	AuditActionDelete,
+	AuditActionMove,
+})
+
+// AuditResourceType defines the type of the resource an audit event is recorded for.
+type AuditResourceType string
+
+func (AuditResourceType) Enum() []interface{} { return toInterfaceSlice(auditResourceTypes) }
+func (t AuditResourceType) Sanitize() (AuditResourceType, bool) {
+	return Sanitize(t, GetAllAuditResourceTypes)
+}
+func GetAllAuditResourceTypes() ([]AuditResourceType, AuditResourceType) {
+	return auditResourceTypes, ""
+}
+
+// AuditResourceType enumeration.
+const (
+	AuditResourceTypeRepo       AuditResourceType = "repo"
+	AuditResourceTypeSpace      AuditResourceType = "space"
+	AuditResourceTypeMembership AuditResourceType = "membership"
+	AuditResourceTypeToken      AuditResourceType = "token"
+	AuditResourceTypeSecret     AuditResourceType = "secret"
+	AuditResourceTypeWebhook    AuditResourceType = "webhook"
+	AuditResourceTypeUser       AuditResourceType = "user"
+	AuditResourceTypeRule       AuditResourceType = "rule"
+	AuditResourceTypePublicKey  AuditResourceType = "public_key"
+	AuditResourceTypeSigningKey AuditResourceType = "signing_key"
+)
+
+var auditResourceTypes = sortEnum([]AuditResourceType{
+	AuditResourceTypeRepo,
+	AuditResourceTypeSpace,
+	AuditResourceTypeMembership,
+	AuditResourceTypeToken,
+	AuditResourceTypeSecret,
+	AuditResourceTypeWebhook,
+	AuditResourceTypeUser,
+	AuditResourceTypeRule,
+	AuditResourceTypePublicKey,
+	AuditResourceTypeSigningKey,
+})
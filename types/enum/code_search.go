@@ -0,0 +1,39 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+// CodeSearchMode defines how the query of a code search is interpreted.
+type CodeSearchMode string
+
+func (CodeSearchMode) Enum() []interface{} { return toInterfaceSlice(codeSearchModes) }
+func (m CodeSearchMode) Sanitize() (CodeSearchMode, bool) {
+	return Sanitize(m, GetAllCodeSearchModes)
+}
+func GetAllCodeSearchModes() ([]CodeSearchMode, CodeSearchMode) {
+	return codeSearchModes, CodeSearchModeLiteral
+}
+
+const (
+	// CodeSearchModeLiteral matches the query as is.
+	CodeSearchModeLiteral CodeSearchMode = "literal"
+
+	// CodeSearchModeRegex interprets the query as a regular expression (RE2 syntax).
+	CodeSearchModeRegex CodeSearchMode = "regex"
+)
+
+var codeSearchModes = sortEnum([]CodeSearchMode{
+	CodeSearchModeLiteral,
+	CodeSearchModeRegex,
+})
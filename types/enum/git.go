@@ -14,7 +14,11 @@
 
 package enum
 
-import "strings"
+import (
+	"strings"
+
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+)
 
 // BranchSortOption specifies the available sort options for branches.
 type BranchSortOption int
@@ -87,3 +91,37 @@ func (o TagSortOption) String() string {
 		return undefined
 	}
 }
+
+// ArchiveFormat specifies the format of a repository archive.
+type ArchiveFormat gitrpcenum.ArchiveFormat
+
+func (ArchiveFormat) Enum() []interface{} { return toInterfaceSlice(gitrpcenum.ArchiveFormats) }
+func (f ArchiveFormat) Sanitize() (ArchiveFormat, bool) {
+	s, ok := gitrpcenum.ArchiveFormat(f).Sanitize()
+	return ArchiveFormat(s), ok
+}
+
+// ContentType returns the media type of the archive format.
+func (f ArchiveFormat) ContentType() string {
+	switch gitrpcenum.ArchiveFormat(f) {
+	case gitrpcenum.ArchiveFormatZip:
+		return "application/zip"
+	case gitrpcenum.ArchiveFormatTarGz:
+		return "application/gzip"
+	case gitrpcenum.ArchiveFormatTar:
+		return "application/x-tar"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// ParseArchiveName splits an archive name like "main.tar.gz" into the git ref and the archive format.
+func ParseArchiveName(name string) (string, ArchiveFormat, bool) {
+	for _, f := range gitrpcenum.ArchiveFormats {
+		ext := "." + f.Extension()
+		if strings.HasSuffix(name, ext) && len(name) > len(ext) {
+			return strings.TrimSuffix(name, ext), ArchiveFormat(f), true
+		}
+	}
+	return "", "", false
+}
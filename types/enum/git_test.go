@@ -0,0 +1,42 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+import "testing"
+
+func TestParseArchiveName(t *testing.T) {
+	tests := []struct {
+		text   string
+		ref    string
+		format ArchiveFormat
+		ok     bool
+	}{
+		{"main.zip", "main", "zip", true},
+		{"main.tar.gz", "main", "tar.gz", true},
+		{"main.tar", "main", "tar", true},
+		{"release/v1.0.tar.gz", "release/v1.0", "tar.gz", true},
+		{"main", "", "", false},
+		{".zip", "", "", false},
+		{"main.rar", "", "", false},
+	}
+
+	for _, test := range tests {
+		ref, format, ok := ParseArchiveName(test.text)
+		if ref != test.ref || format != test.format || ok != test.ok {
+			t.Errorf("Want archive name %q parsed as (%q, %q, %t), got (%q, %q, %t)",
+				test.text, test.ref, test.format, test.ok, ref, format, ok)
+		}
+	}
+}
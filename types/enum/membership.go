@@ -111,3 +111,16 @@ func (s MembershipSpaceSort) String() string {
 		return undefined
 	}
 }
+
+// MembershipSource defines how a membership was granted.
+type MembershipSource string
+
+// MembershipSource enumeration.
+const (
+	// MembershipSourceManual is used for memberships granted by users.
+	MembershipSourceManual MembershipSource = "manual"
+	// MembershipSourceLDAP is used for memberships managed by the LDAP group sync.
+	MembershipSourceLDAP MembershipSource = "ldap"
+	// MembershipSourceOIDC is used for memberships granted based on the groups of an OpenID Connect login.
+	MembershipSourceOIDC MembershipSource = "oidc"
+)
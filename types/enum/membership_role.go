@@ -32,6 +32,7 @@ var MembershipRoles = sortEnum([]MembershipRole{
 
 var membershipRoleReaderPermissions = slices.Clip(slices.Insert([]Permission{}, 0,
 	PermissionRepoView,
+	PermissionRepoReview,
 	PermissionSpaceView,
 	PermissionServiceAccountView,
 	PermissionPipelineView,
@@ -0,0 +1,37 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+// MirrorSyncStatus defines the outcome of the last sync of a pull or push mirror.
+type MirrorSyncStatus string
+
+func (MirrorSyncStatus) Enum() []interface{} { return toInterfaceSlice(mirrorSyncStatuses) }
+
+const (
+	// MirrorSyncStatusNone is used for mirrors that haven't been synced yet.
+	MirrorSyncStatusNone MirrorSyncStatus = ""
+
+	// MirrorSyncStatusSuccess is used if the last sync of the mirror succeeded.
+	MirrorSyncStatusSuccess MirrorSyncStatus = "success"
+
+	// MirrorSyncStatusFailure is used if the last sync of the mirror failed.
+	MirrorSyncStatusFailure MirrorSyncStatus = "failure"
+)
+
+var mirrorSyncStatuses = sortEnum([]MirrorSyncStatus{
+	MirrorSyncStatusNone,
+	MirrorSyncStatusSuccess,
+	MirrorSyncStatusFailure,
+})
@@ -51,6 +51,8 @@ const (
 	PermissionRepoDelete            Permission = "repo_delete"
 	PermissionRepoPush              Permission = "repo_push"
 	PermissionRepoReportCommitCheck Permission = "repo_reportCommitCheck"
+	// PermissionRepoReview allows to create and comment on pull requests and issues, and to review pull requests.
+	PermissionRepoReview Permission = "repo_review"
 )
 
 const (
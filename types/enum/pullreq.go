@@ -62,6 +62,7 @@ var pullReqSorts = sortEnum([]PullReqSort{
 
 // PullReqActivityType defines pull request activity message type.
 // Essentially, the Type determines the structure of the pull request activity's Payload structure.
+// The same activity model is used for issue activities.
 type PullReqActivityType string
 
 func (PullReqActivityType) Enum() []interface{} { return toInterfaceSlice(pullReqActivityTypes) }
@@ -84,6 +85,15 @@ const (
 	PullReqActivityTypeBranchUpdate PullReqActivityType = "branch-update"
 	PullReqActivityTypeBranchDelete PullReqActivityType = "branch-delete"
 	PullReqActivityTypeMerge        PullReqActivityType = "merge"
+	PullReqActivityTypeAutoMerge    PullReqActivityType = "auto-merge"
+	PullReqActivityTypeLabelModify  PullReqActivityType = "label-modify"
+	PullReqActivityTypeReviewerAdd  PullReqActivityType = "reviewer-add"
+
+	// activity types used only by issues.
+
+	PullReqActivityTypeAssigneeModify   PullReqActivityType = "assignee-modify"
+	PullReqActivityTypeReference        PullReqActivityType = "reference"
+	PullReqActivityTypeIssueStateChange PullReqActivityType = "issue-state-change"
 )
 
 var pullReqActivityTypes = sortEnum([]PullReqActivityType{
@@ -95,6 +105,12 @@ var pullReqActivityTypes = sortEnum([]PullReqActivityType{
 	PullReqActivityTypeBranchUpdate,
 	PullReqActivityTypeBranchDelete,
 	PullReqActivityTypeMerge,
+	PullReqActivityTypeAutoMerge,
+	PullReqActivityTypeLabelModify,
+	PullReqActivityTypeReviewerAdd,
+	PullReqActivityTypeAssigneeModify,
+	PullReqActivityTypeReference,
+	PullReqActivityTypeIssueStateChange,
 })
 
 // PullReqActivityKind defines kind of pull request activity system message.
@@ -125,6 +141,24 @@ var pullReqActivityKinds = sortEnum([]PullReqActivityKind{
 	PullReqActivityKindChangeComment,
 })
 
+// PullReqLabelActivityType defines the type of change of a label on a pull request.
+type PullReqLabelActivityType string
+
+func (PullReqLabelActivityType) Enum() []interface{} {
+	return toInterfaceSlice(pullReqLabelActivityTypes)
+}
+
+// PullReqLabelActivityType enumeration.
+const (
+	PullReqLabelActivityTypeAssign   PullReqLabelActivityType = "assign"
+	PullReqLabelActivityTypeUnassign PullReqLabelActivityType = "unassign"
+)
+
+var pullReqLabelActivityTypes = sortEnum([]PullReqLabelActivityType{
+	PullReqLabelActivityTypeAssign,
+	PullReqLabelActivityTypeUnassign,
+})
+
 // PullReqCommentStatus defines status of a pull request comment.
 type PullReqCommentStatus string
 
@@ -213,6 +247,22 @@ func (m MergeMethod) Sanitize() (MergeMethod, bool) {
 	return MergeMethod(s), ok
 }
 
+// CreatesMergeCommit returns true if merging with the method results in a merge commit.
+func (m MergeMethod) CreatesMergeCommit() bool {
+	switch gitrpcenum.MergeMethod(m) {
+	case gitrpcenum.MergeMethodMerge, gitrpcenum.MergeMethodRebaseMerge:
+		return true
+	default:
+		return false
+	}
+}
+
+// CreatesCommits returns true if merging with the method writes new commits onto the target branch.
+// Only fast-forward merges move the target branch to the existing source commit.
+func (m MergeMethod) CreatesCommits() bool {
+	return gitrpcenum.MergeMethod(m) != gitrpcenum.MergeMethodFastForward
+}
+
 type MergeCheckStatus string
 
 const (
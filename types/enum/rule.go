@@ -0,0 +1,76 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+import "strings"
+
+// RuleAttr defines protection rule attributes that can be used for sorting and filtering.
+type RuleAttr int
+
+const (
+	RuleAttrNone RuleAttr = iota
+	RuleAttrUID
+	RuleAttrCreated
+	RuleAttrUpdated
+)
+
+// ParseRuleAttr parses the protection rule attribute string
+// and returns the equivalent enumeration.
+func ParseRuleAttr(s string) RuleAttr {
+	switch strings.ToLower(s) {
+	case uid:
+		return RuleAttrUID
+	case created, createdAt:
+		return RuleAttrCreated
+	case updated, updatedAt:
+		return RuleAttrUpdated
+	default:
+		return RuleAttrNone
+	}
+}
+
+// String returns the string representation of the attribute.
+func (a RuleAttr) String() string {
+	switch a {
+	case RuleAttrUID:
+		return uid
+	case RuleAttrCreated:
+		return created
+	case RuleAttrUpdated:
+		return updated
+	case RuleAttrNone:
+		return ""
+	default:
+		return undefined
+	}
+}
+
+// RuleParent defines different types of parents of a protection rule.
+type RuleParent string
+
+func (RuleParent) Enum() []interface{} { return toInterfaceSlice(ruleParents) }
+
+const (
+	// RuleParentRepo describes a repo as protection rule owner.
+	RuleParentRepo RuleParent = "repo"
+
+	// RuleParentSpace describes a space as protection rule owner.
+	RuleParentSpace RuleParent = "space"
+)
+
+var ruleParents = sortEnum([]RuleParent{
+	RuleParentRepo,
+	RuleParentSpace,
+})
@@ -0,0 +1,61 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+// SigningKeyType defines the type of a key used to sign commits and tags.
+type SigningKeyType string
+
+func (SigningKeyType) Enum() []interface{} { return toInterfaceSlice(signingKeyTypes) }
+
+const (
+	// SigningKeyTypeGPG describes an OpenPGP public key.
+	SigningKeyTypeGPG SigningKeyType = "gpg"
+
+	// SigningKeyTypeSSH describes an ssh public key.
+	SigningKeyTypeSSH SigningKeyType = "ssh"
+)
+
+var signingKeyTypes = sortEnum([]SigningKeyType{
+	SigningKeyTypeGPG,
+	SigningKeyTypeSSH,
+})
+
+// SignatureVerificationStatus defines the result of the verification of a commit or tag signature.
+type SignatureVerificationStatus string
+
+func (SignatureVerificationStatus) Enum() []interface{} {
+	return toInterfaceSlice(signatureVerificationStatuses)
+}
+
+const (
+	// SignatureVerificationStatusVerified the signature is valid and was created with a key of the committer.
+	SignatureVerificationStatusVerified SignatureVerificationStatus = "verified"
+
+	// SignatureVerificationStatusUnverified the object isn't signed or the signature format isn't supported.
+	SignatureVerificationStatusUnverified SignatureVerificationStatus = "unverified"
+
+	// SignatureVerificationStatusUnknownKey the signature was created with a key that isn't known for the committer.
+	SignatureVerificationStatusUnknownKey SignatureVerificationStatus = "unknown_key"
+
+	// SignatureVerificationStatusBadSignature the signature doesn't match the signed object.
+	SignatureVerificationStatusBadSignature SignatureVerificationStatus = "bad_signature"
+)
+
+var signatureVerificationStatuses = sortEnum([]SignatureVerificationStatus{
+	SignatureVerificationStatusVerified,
+	SignatureVerificationStatusUnverified,
+	SignatureVerificationStatusUnknownKey,
+	SignatureVerificationStatusBadSignature,
+})
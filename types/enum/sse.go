@@ -28,4 +28,6 @@ const (
 	SSETypeRepositoryExportCompleted = "repository_export_completed"
 
 	SSETypePullrequesUpdated = "pullreq_updated"
+
+	SSETypeIssueUpdated = "issue_updated"
 )
@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+import "golang.org/x/exp/slices"
+
+// TokenScope represents a set of permissions an access token can be restricted to.
+type TokenScope string
+
+func (TokenScope) Enum() []interface{}              { return toInterfaceSlice(TokenScopes) }
+func (s TokenScope) Sanitize() (TokenScope, bool)   { return Sanitize(s, GetAllTokenScopes) }
+func GetAllTokenScopes() ([]TokenScope, TokenScope) { return TokenScopes, "" }
+
+const (
+	// TokenScopeRepoRead allows to read spaces and repositories.
+	TokenScopeRepoRead TokenScope = "repo:read"
+
+	// TokenScopeRepoWrite allows to push to, merge into and edit repositories.
+	TokenScopeRepoWrite TokenScope = "repo:write"
+
+	// TokenScopePullReqWrite allows to create, comment on and review pull requests, and to manage issues.
+	// Creating a pull request requires push access to the source repository (TokenScopeRepoWrite) as well.
+	TokenScopePullReqWrite TokenScope = "pullreq:write"
+
+	// TokenScopeCheckWrite allows to report the status checks of commits.
+	TokenScopeCheckWrite TokenScope = "check:write"
+
+	// TokenScopePipelineExecute allows to read and execute pipelines.
+	TokenScopePipelineExecute TokenScope = "pipeline:execute"
+
+	// TokenScopeAdmin doesn't restrict the permissions of the principal of the token,
+	// it's required to manage the account of the principal and for system administration.
+	TokenScopeAdmin TokenScope = "admin"
+)
+
+var TokenScopes = sortEnum([]TokenScope{
+	TokenScopeRepoRead,
+	TokenScopeRepoWrite,
+	TokenScopePullReqWrite,
+	TokenScopeCheckWrite,
+	TokenScopePipelineExecute,
+	TokenScopeAdmin,
+})
+
+var tokenScopeRepoReadPermissions = []Permission{
+	PermissionSpaceView,
+	PermissionRepoView,
+}
+
+var tokenScopePermissions = map[TokenScope][]Permission{
+	TokenScopeRepoRead: tokenScopeRepoReadPermissions,
+	TokenScopeRepoWrite: append(slices.Clone(tokenScopeRepoReadPermissions),
+		PermissionRepoPush,
+		PermissionRepoEdit,
+		PermissionRepoReview,
+	),
+	TokenScopePullReqWrite: append(slices.Clone(tokenScopeRepoReadPermissions),
+		PermissionRepoReview,
+	),
+	TokenScopeCheckWrite: append(slices.Clone(tokenScopeRepoReadPermissions),
+		PermissionRepoReportCommitCheck,
+	),
+	TokenScopePipelineExecute: append(slices.Clone(tokenScopeRepoReadPermissions),
+		PermissionPipelineView,
+		PermissionPipelineExecute,
+	),
+}
+
+// Grants returns true in case the scope grants the permission.
+func (s TokenScope) Grants(permission Permission) bool {
+	if s == TokenScopeAdmin {
+		return true
+	}
+
+	return slices.Contains(tokenScopePermissions[s], permission)
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+import "testing"
+
+func TestTokenScopeGrants(t *testing.T) {
+	tests := []struct {
+		scope      TokenScope
+		permission Permission
+		want       bool
+	}{
+		{TokenScopeRepoRead, PermissionRepoView, true},
+		{TokenScopeRepoRead, PermissionRepoPush, false},
+		{TokenScopeRepoWrite, PermissionRepoPush, true},
+		{TokenScopeRepoWrite, PermissionRepoDelete, false},
+		{TokenScopePullReqWrite, PermissionRepoReview, true},
+		{TokenScopePullReqWrite, PermissionRepoPush, false},
+		{TokenScopeCheckWrite, PermissionRepoReportCommitCheck, true},
+		{TokenScopePipelineExecute, PermissionPipelineExecute, true},
+		{TokenScopePipelineExecute, PermissionPipelineEdit, false},
+		{TokenScopeAdmin, PermissionUserEditAdmin, true},
+		{TokenScope("invalid"), PermissionRepoView, false},
+	}
+
+	for _, test := range tests {
+		got, want := test.scope.Grants(test.permission), test.want
+		if got != want {
+			t.Errorf("Want scope %q granting %q to be %t, got %t", test.scope, test.permission, want, got)
+		}
+	}
+}
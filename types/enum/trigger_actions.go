@@ -36,6 +36,10 @@ const (
 	TriggerActionPullReqReopened TriggerAction = "pullreq_reopened"
 	// TriggerActionPullReqBranchUpdated gets triggered when a pull request source branch gets updated.
 	TriggerActionPullReqBranchUpdated TriggerAction = "pullreq_branch_updated"
+	// TriggerActionPullReqLabelAdded gets triggered when a label gets added to a pull request.
+	TriggerActionPullReqLabelAdded TriggerAction = "pullreq_label_added"
+	// TriggerActionPullReqLabelRemoved gets triggered when a label gets removed from a pull request.
+	TriggerActionPullReqLabelRemoved TriggerAction = "pullreq_label_removed"
 )
 
 func (TriggerAction) Enum() []interface{}               { return toInterfaceSlice(triggerActions) }
@@ -43,7 +47,9 @@ func (t TriggerAction) Sanitize() (TriggerAction, bool) { return Sanitize(t, Get
 func (t TriggerAction) GetTriggerEvent() TriggerEvent {
 	if t == TriggerActionPullReqCreated ||
 		t == TriggerActionPullReqBranchUpdated ||
-		t == TriggerActionPullReqReopened {
+		t == TriggerActionPullReqReopened ||
+		t == TriggerActionPullReqLabelAdded ||
+		t == TriggerActionPullReqLabelRemoved {
 		return TriggerEventPullRequest
 	}
 	if t == TriggerActionTagCreated || t == TriggerActionTagUpdated {
@@ -67,6 +73,8 @@ var triggerActions = sortEnum([]TriggerAction{
 	TriggerActionPullReqCreated,
 	TriggerActionPullReqReopened,
 	TriggerActionPullReqBranchUpdated,
+	TriggerActionPullReqLabelAdded,
+	TriggerActionPullReqLabelRemoved,
 })
 
 // Trigger types.
@@ -51,3 +51,16 @@ func ParseUserAttr(s string) UserAttr {
 		return UserAttrNone
 	}
 }
+
+// UserSource defines where a user was provisioned from.
+type UserSource string
+
+// UserSource enumeration.
+const (
+	// UserSourceLocal is used for users that were created locally (registration, admin or bootstrap).
+	UserSourceLocal UserSource = ""
+	// UserSourceLDAP is used for users that were provisioned on their first LDAP login.
+	UserSourceLDAP UserSource = "ldap"
+	// UserSourceOIDC is used for users that were provisioned on their first OpenID Connect login.
+	UserSourceOIDC UserSource = "oidc"
+)
@@ -133,6 +133,25 @@ const (
 	WebhookTriggerPullReqReopened WebhookTrigger = "pullreq_reopened"
 	// WebhookTriggerPullReqBranchUpdated gets triggered when a pull request source branch gets updated.
 	WebhookTriggerPullReqBranchUpdated WebhookTrigger = "pullreq_branch_updated"
+	// WebhookTriggerPullReqMerged gets triggered when a pull request gets merged.
+	WebhookTriggerPullReqMerged WebhookTrigger = "pullreq_merged"
+	// WebhookTriggerPullReqClosed gets triggered when a pull request gets closed.
+	WebhookTriggerPullReqClosed WebhookTrigger = "pullreq_closed"
+	// WebhookTriggerPullReqCommentCreated gets triggered when a comment gets created on a pull request.
+	WebhookTriggerPullReqCommentCreated WebhookTrigger = "pullreq_comment_created"
+	// WebhookTriggerPullReqReviewSubmitted gets triggered when a review gets submitted for a pull request.
+	WebhookTriggerPullReqReviewSubmitted WebhookTrigger = "pullreq_review_submitted"
+	// WebhookTriggerPullReqReviewerAdded gets triggered when a reviewer gets added to a pull request.
+	WebhookTriggerPullReqReviewerAdded WebhookTrigger = "pullreq_reviewer_added"
+	// WebhookTriggerPullReqLabelAdded gets triggered when a label gets added to a pull request.
+	WebhookTriggerPullReqLabelAdded WebhookTrigger = "pullreq_label_added"
+	// WebhookTriggerPullReqLabelRemoved gets triggered when a label gets removed from a pull request.
+	WebhookTriggerPullReqLabelRemoved WebhookTrigger = "pullreq_label_removed"
+
+	// WebhookTriggerExecutionStarted gets triggered when a pipeline execution starts running.
+	WebhookTriggerExecutionStarted WebhookTrigger = "execution_started"
+	// WebhookTriggerExecutionFinished gets triggered when a pipeline execution finishes.
+	WebhookTriggerExecutionFinished WebhookTrigger = "execution_finished"
 )
 
 var webhookTriggers = sortEnum([]WebhookTrigger{
@@ -145,4 +164,41 @@ var webhookTriggers = sortEnum([]WebhookTrigger{
 	WebhookTriggerPullReqCreated,
 	WebhookTriggerPullReqReopened,
 	WebhookTriggerPullReqBranchUpdated,
+	WebhookTriggerPullReqMerged,
+	WebhookTriggerPullReqClosed,
+	WebhookTriggerPullReqCommentCreated,
+	WebhookTriggerPullReqReviewSubmitted,
+	WebhookTriggerPullReqReviewerAdded,
+	WebhookTriggerPullReqLabelAdded,
+	WebhookTriggerPullReqLabelRemoved,
+	WebhookTriggerExecutionStarted,
+	WebhookTriggerExecutionFinished,
+})
+
+// WebhookFormat defines the different formats a webhook payload can be rendered in.
+type WebhookFormat string
+
+func (WebhookFormat) Enum() []interface{}               { return toInterfaceSlice(webhookFormats) }
+func (s WebhookFormat) Sanitize() (WebhookFormat, bool) { return Sanitize(s, GetAllWebhookFormats) }
+
+func GetAllWebhookFormats() ([]WebhookFormat, WebhookFormat) {
+	return webhookFormats, WebhookFormatNative
+}
+
+const (
+	// WebhookFormatNative describes the native gitness webhook payload.
+	WebhookFormatNative WebhookFormat = "native"
+	// WebhookFormatSlack describes a Slack Block Kit message payload.
+	WebhookFormatSlack WebhookFormat = "slack"
+	// WebhookFormatMSTeams describes a Microsoft Teams MessageCard payload.
+	WebhookFormatMSTeams WebhookFormat = "msteams"
+	// WebhookFormatDiscord describes a Discord embed message payload.
+	WebhookFormatDiscord WebhookFormat = "discord"
+)
+
+var webhookFormats = sortEnum([]WebhookFormat{
+	WebhookFormatNative,
+	WebhookFormatSlack,
+	WebhookFormatMSTeams,
+	WebhookFormatDiscord,
 })
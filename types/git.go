@@ -62,6 +62,11 @@ type Commit struct {
 	Message   string    `json:"message"`
 	Author    Signature `json:"author"`
 	Committer Signature `json:"committer"`
+
+	// Signature is the cryptographic signature of the commit (nil if the commit isn't signed).
+	Signature *ObjectSignature `json:"-"`
+	// Verification is the result of the signature verification (nil if the commit wasn't verified).
+	Verification *SignatureVerification `json:"verification,omitempty"`
 }
 
 type Signature struct {
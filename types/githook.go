@@ -25,6 +25,9 @@ type GithookPayload struct {
 	PrincipalID int64
 	RequestID   string
 	Disabled    bool
+	// Internal indicates that the git operation is executed by gitness itself (e.g. merging a pull request)
+	// and protection rules that only apply to user operations are skipped.
+	Internal bool
 }
 
 func (p *GithookPayload) Validate() error {
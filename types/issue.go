@@ -0,0 +1,117 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"github.com/harness/gitness/types/enum"
+)
+
+// Issue represents an issue of a repository.
+type Issue struct {
+	ID      int64 `json:"-"` // not returned, it's an internal field
+	Version int64 `json:"-"` // not returned, it's an internal field
+	Number  int64 `json:"number"`
+
+	CreatedBy int64 `json:"-"` // not returned, because the author info is in the Author field
+	Created   int64 `json:"created"`
+	Updated   int64 `json:"-"` // not returned, it's updated by the server internally. Clients should use Edited.
+	Edited    int64 `json:"edited"`
+
+	State enum.IssueState `json:"state"`
+
+	Title       string `json:"title"`
+	Description string `json:"description"`
+
+	RepoID int64 `json:"repo_id"`
+
+	ActivitySeq  int64 `json:"-"` // not returned, because it's a server's internal field
+	CommentCount int   `json:"comment_count"`
+
+	ClosedBy *int64 `json:"-"` // not returned, because the closer info is in the Closer field
+	Closed   *int64 `json:"closed"`
+
+	Author PrincipalInfo  `json:"author"`
+	Closer *PrincipalInfo `json:"closer"`
+
+	// Assignees contains the principals the issue is assigned to.
+	Assignees []*PrincipalInfo `json:"assignees,omitempty"`
+
+	// Labels contains the labels assigned to the issue.
+	Labels []*Label `json:"labels,omitempty"`
+}
+
+// IssueFilter stores issue query parameters.
+type IssueFilter struct {
+	Page       int               `json:"page"`
+	Size       int               `json:"size"`
+	Query      string            `json:"query"`
+	CreatedBy  int64             `json:"created_by"`
+	AssigneeID int64             `json:"assignee_id"`
+	RepoID     int64             `json:"-"`
+	LabelIDs   []int64           `json:"label_id"`
+	States     []enum.IssueState `json:"state"`
+	Sort       enum.IssueSort    `json:"sort"`
+	Order      enum.Order        `json:"order"`
+}
+
+// IssueAssignee represents an assignee of an issue.
+type IssueAssignee struct {
+	IssueID     int64 `json:"-"`
+	PrincipalID int64 `json:"-"`
+	CreatedBy   int64 `json:"-"`
+	Created     int64 `json:"created"`
+}
+
+// IssueLabel represents a label assigned to an issue.
+type IssueLabel struct {
+	IssueID   int64 `json:"-"`
+	LabelID   int64 `json:"-"`
+	CreatedBy int64 `json:"-"`
+	Created   int64 `json:"created"`
+}
+
+type IssueActivityPayloadAssignee struct {
+	Type        enum.IssueAssigneeActivityType `json:"type"`
+	PrincipalID int64                          `json:"principal_id"`
+	DisplayName string                         `json:"display_name"`
+}
+
+func (a *IssueActivityPayloadAssignee) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeAssigneeModify
+}
+
+// IssueActivityPayloadReference describes a reference to the issue from a commit or a pull request.
+type IssueActivityPayloadReference struct {
+	Type          enum.IssueReferenceType `json:"type"`
+	CommitSHA     string                  `json:"commit_sha,omitempty"`
+	PullReqNumber int64                   `json:"pullreq_number,omitempty"`
+	Title         string                  `json:"title"`
+	Closing       bool                    `json:"closing"`
+}
+
+func (a *IssueActivityPayloadReference) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeReference
+}
+
+// IssueActivityPayloadStateChange describes a change of the state of the issue.
+type IssueActivityPayloadStateChange struct {
+	Old     enum.IssueState `json:"old"`
+	New     enum.IssueState `json:"new"`
+	Message string          `json:"message,omitempty"`
+}
+
+func (a *IssueActivityPayloadStateChange) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeIssueStateChange
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/harness/gitness/types/enum"
+
+// Label represents a label that can be assigned to pull requests.
+// Labels defined in a space are available to all repositories and child spaces of the space.
+type Label struct {
+	ID         int64            `json:"id"`
+	ParentID   int64            `json:"parent_id"`
+	ParentType enum.LabelParent `json:"parent_type"`
+	CreatedBy  int64            `json:"created_by"`
+	Created    int64            `json:"created"`
+	Updated    int64            `json:"updated"`
+
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+}
+
+// LabelFilter stores label query parameters for listing.
+type LabelFilter struct {
+	Query string `json:"query"`
+	Page  int    `json:"page"`
+	Size  int    `json:"size"`
+
+	// Inherited includes the labels defined in the ancestor spaces of the repo or space.
+	Inherited bool `json:"inherited"`
+}
+
+// PullReqLabel represents the assignment of a label to a pull request.
+type PullReqLabel struct {
+	PullReqID int64 `json:"pullreq_id"`
+	LabelID   int64 `json:"label_id"`
+	CreatedBy int64 `json:"created_by"`
+	Created   int64 `json:"created"`
+}
@@ -0,0 +1,31 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// LFSObject represents a git LFS object stored for a repository.
+type LFSObject struct {
+	ID        int64  `db:"lfs_object_id"         json:"-"`
+	RepoID    int64  `db:"lfs_object_repo_id"    json:"-"`
+	OID       string `db:"lfs_object_oid"        json:"oid"`
+	Size      int64  `db:"lfs_object_size"       json:"size"`
+	Created   int64  `db:"lfs_object_created"    json:"created"`
+	CreatedBy int64  `db:"lfs_object_created_by" json:"created_by"`
+}
+
+// LFSUsage represents the storage used by the git LFS objects of a repository.
+type LFSUsage struct {
+	Count int64 `json:"count"`
+	Size  int64 `json:"size"`
+}
@@ -32,7 +32,8 @@ type Membership struct {
 	Created   int64 `json:"created"`
 	Updated   int64 `json:"updated"`
 
-	Role enum.MembershipRole `json:"role"`
+	Role   enum.MembershipRole   `json:"role"`
+	Source enum.MembershipSource `json:"source"`
 }
 
 // MembershipUser adds user info to the Membership data.
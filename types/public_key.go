@@ -0,0 +1,27 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// PublicKey represents an ssh public key of a principal used for git operations over ssh.
+type PublicKey struct {
+	ID          int64  `db:"public_key_id"           json:"-"`
+	PrincipalID int64  `db:"public_key_principal_id" json:"-"`
+	Created     int64  `db:"public_key_created"      json:"created"`
+	UID         string `db:"public_key_uid"          json:"uid"`
+	Fingerprint string `db:"public_key_fingerprint"  json:"fingerprint"`
+	Content     string `db:"public_key_content"      json:"content"`
+	Comment     string `db:"public_key_comment"      json:"comment"`
+	Type        string `db:"public_key_type"         json:"type"`
+}
@@ -41,6 +41,7 @@ type PullReq struct {
 	SourceRepoID int64  `json:"source_repo_id"`
 	SourceBranch string `json:"source_branch"`
 	SourceSHA    string `json:"source_sha"`
+	SourcePusher int64  `json:"-"` // not returned, it's the principal that pushed the latest source commit
 	TargetRepoID int64  `json:"target_repo_id"`
 	TargetBranch string `json:"target_branch"`
 
@@ -56,9 +57,20 @@ type PullReq struct {
 	MergeSHA         *string               `json:"merge_sha"`
 	MergeConflicts   *string               `json:"merge_conflicts,omitempty"`
 
-	Author PrincipalInfo  `json:"author"`
-	Merger *PrincipalInfo `json:"merger"`
-	Stats  PullReqStats   `json:"stats"`
+	// AutoMergeMethod is set if the pull request should be merged automatically once all requirements are met.
+	AutoMergeBy     *int64            `json:"-"` // not returned, because the principal info is in the AutoMerger field
+	AutoMergeMethod *enum.MergeMethod `json:"auto_merge_method"`
+
+	Author     PrincipalInfo  `json:"author"`
+	Merger     *PrincipalInfo `json:"merger"`
+	AutoMerger *PrincipalInfo `json:"auto_merger"`
+	Stats      PullReqStats   `json:"stats"`
+
+	// Labels contains the labels assigned to the pull request.
+	Labels []*Label `json:"labels,omitempty"`
+
+	// UnmetRequirements lists the requirements that must be fulfilled before the pull request can be merged.
+	UnmetRequirements []string `json:"unmet_requirements,omitempty"`
 }
 
 // DiffStats shows total number of commits and modified files.
@@ -85,6 +97,9 @@ type PullReqFilter struct {
 	SourceBranch  string              `json:"source_branch"`
 	TargetRepoID  int64               `json:"-"`
 	TargetBranch  string              `json:"target_branch"`
+	SourceSHA     string              `json:"-"`
+	AutoMerge     bool                `json:"-"` // only pull requests with auto-merge enabled
+	LabelIDs      []int64             `json:"label_id"`
 	States        []enum.PullReqState `json:"state"`
 	Sort          enum.PullReqSort    `json:"sort"`
 	Order         enum.Order          `json:"order"`
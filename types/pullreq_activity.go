@@ -42,9 +42,11 @@ type PullReqActivity struct {
 	Edited    int64  `json:"edited"`
 	Deleted   *int64 `json:"deleted,omitempty"`
 
+	// An activity belongs either to a pull request or to an issue.
 	ParentID  *int64 `json:"parent_id"`
 	RepoID    int64  `json:"repo_id"`
-	PullReqID int64  `json:"pullreq_id"`
+	PullReqID int64  `json:"pullreq_id,omitempty"`
+	IssueID   int64  `json:"issue_id,omitempty"`
 
 	Order    int64 `json:"order"`
 	SubOrder int64 `json:"sub_order"`
@@ -190,6 +192,12 @@ var allPullReqActivityPayloads = func(
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadReviewSubmit{} },
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadBranchUpdate{} },
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadBranchDelete{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadAutoMerge{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadLabel{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadReviewerAdd{} },
+	func() PullReqActivityPayload { return &IssueActivityPayloadAssignee{} },
+	func() PullReqActivityPayload { return &IssueActivityPayloadReference{} },
+	func() PullReqActivityPayload { return &IssueActivityPayloadStateChange{} },
 })
 
 // newPayloadForActivity returns a new payload instance for the requested activity type.
@@ -277,3 +285,34 @@ type PullRequestActivityPayloadBranchDelete struct {
 func (a *PullRequestActivityPayloadBranchDelete) ActivityType() enum.PullReqActivityType {
 	return enum.PullReqActivityTypeBranchDelete
 }
+
+type PullRequestActivityPayloadAutoMerge struct {
+	Enabled     bool             `json:"enabled"`
+	MergeMethod enum.MergeMethod `json:"merge_method,omitempty"`
+}
+
+func (a *PullRequestActivityPayloadAutoMerge) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeAutoMerge
+}
+
+type PullRequestActivityPayloadLabel struct {
+	Type    enum.PullReqLabelActivityType `json:"type"`
+	LabelID int64                         `json:"label_id"`
+	Label   string                        `json:"label"`
+	Color   string                        `json:"color"`
+}
+
+func (a *PullRequestActivityPayloadLabel) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeLabelModify
+}
+
+// PullRequestActivityPayloadReviewerAdd describes reviewers added to a pull request by the system.
+type PullRequestActivityPayloadReviewerAdd struct {
+	PrincipalIDs []int64 `json:"principal_ids"`
+	// CodeOwners is set if the reviewers were added as the code owners of the changed files.
+	CodeOwners bool `json:"code_owners"`
+}
+
+func (a *PullRequestActivityPayloadReviewerAdd) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeReviewerAdd
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/harness/gitness/types/enum"
+
+// PushMirror is an external remote a repository is replicated to after every change of its branches or tags.
+type PushMirror struct {
+	ID        int64  `db:"push_mirror_id"         json:"-"`
+	RepoID    int64  `db:"push_mirror_repo_id"    json:"-"`
+	UID       string `db:"push_mirror_uid"        json:"uid"`
+	CreatedBy int64  `db:"push_mirror_created_by" json:"created_by"`
+	Created   int64  `db:"push_mirror_created"    json:"created"`
+	Updated   int64  `db:"push_mirror_updated"    json:"updated"`
+
+	RemoteURL   string `db:"push_mirror_remote_url"  json:"remote_url"`
+	Credentials []byte `db:"push_mirror_credentials" json:"-"`
+
+	// ProtectedBranchesOnly restricts the mirror to branches that are protected by a protection rule.
+	ProtectedBranchesOnly bool `db:"push_mirror_protected_branches_only" json:"protected_branches_only"`
+
+	LastPush       int64                 `db:"push_mirror_last_push"        json:"last_push"`
+	LastPushStatus enum.MirrorSyncStatus `db:"push_mirror_last_push_status" json:"last_push_status"`
+	LastPushError  string                `db:"push_mirror_last_push_error"  json:"last_push_error,omitempty"`
+}
@@ -36,6 +36,7 @@ type Repository struct {
 	DefaultBranch string `json:"default_branch"`
 	ForkID        int64  `json:"fork_id"`
 	PullReqSeq    int64  `json:"-"`
+	IssueSeq      int64  `json:"-"`
 
 	NumForks       int `json:"num_forks"`
 	NumPulls       int `json:"num_pulls"`
@@ -44,15 +45,50 @@ type Repository struct {
 	NumMergedPulls int `json:"num_merged_pulls"`
 
 	Importing bool `json:"importing"`
+	IsMirror  bool `json:"is_mirror"`
+
+	// pull request merge requirements
+	PullReqMinApprovals          int  `json:"pullreq_min_approvals"`
+	PullReqDismissStaleApprovals bool `json:"pullreq_dismiss_stale_approvals"`
+	PullReqRequirePeerApproval   bool `json:"pullreq_require_peer_approval"`
+
+	PullReqRequireCodeOwnerApproval bool `json:"pullreq_require_code_owner_approval"`
+
+	// PullReqAllowedMergeMethods restricts the merge methods of pull requests, all methods are allowed if empty.
+	PullReqAllowedMergeMethods []enum.MergeMethod `json:"pullreq_allowed_merge_methods"`
+
+	// pull request merge commit templates, the default title and message are used if empty
+	PullReqMergeTitleTemplate    string `json:"pullreq_merge_title_template"`
+	PullReqMergeMessageTemplate  string `json:"pullreq_merge_message_template"`
+	PullReqSquashTitleTemplate   string `json:"pullreq_squash_title_template"`
+	PullReqSquashMessageTemplate string `json:"pullreq_squash_message_template"`
 
 	// git urls
 	GitURL string `json:"git_url"`
+
+	// Mirror contains the sync details of mirror repositories.
+	Mirror *RepoMirror `json:"mirror,omitempty"`
 }
 
 func (r Repository) GetGitUID() string {
 	return r.GitUID
 }
 
+// IsMergeMethodAllowed returns true if pull requests of the repository can be merged with the merge method.
+func (r Repository) IsMergeMethodAllowed(method enum.MergeMethod) bool {
+	if len(r.PullReqAllowedMergeMethods) == 0 {
+		return true
+	}
+
+	for _, allowed := range r.PullReqAllowedMergeMethods {
+		if allowed == method {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RepoFilter stores repo query parameters.
 type RepoFilter struct {
 	Page  int           `json:"page"`
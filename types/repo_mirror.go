@@ -0,0 +1,36 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/harness/gitness/types/enum"
+
+// RepoMirror contains the details of a repository that is periodically synced from an external remote.
+type RepoMirror struct {
+	RepoID    int64 `db:"mirror_repo_id"    json:"-"`
+	CreatedBy int64 `db:"mirror_created_by" json:"-"`
+	Created   int64 `db:"mirror_created"    json:"created"`
+	Updated   int64 `db:"mirror_updated"    json:"updated"`
+
+	RemoteURL   string `db:"mirror_remote_url"  json:"remote_url"`
+	Credentials []byte `db:"mirror_credentials" json:"-"`
+
+	// Interval is the time between two syncs of the mirror in seconds.
+	Interval int64 `db:"mirror_interval"  json:"interval"`
+	NextSync int64 `db:"mirror_next_sync" json:"next_sync"`
+
+	LastSync       int64                 `db:"mirror_last_sync"        json:"last_sync"`
+	LastSyncStatus enum.MirrorSyncStatus `db:"mirror_last_sync_status" json:"last_sync_status"`
+	LastSyncError  string                `db:"mirror_last_sync_error"  json:"last_sync_error,omitempty"`
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	gitrpcenum "github.com/harness/gitness/gitrpc/enum"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestRepositoryIsMergeMethodAllowed(t *testing.T) {
+	merge := enum.MergeMethod(gitrpcenum.MergeMethodMerge)
+	squash := enum.MergeMethod(gitrpcenum.MergeMethodSquash)
+	fastForward := enum.MergeMethod(gitrpcenum.MergeMethodFastForward)
+
+	tests := []struct {
+		name    string
+		allowed []enum.MergeMethod
+		method  enum.MergeMethod
+		exp     bool
+	}{
+		{name: "all-allowed", method: fastForward, exp: true},
+		{name: "allowed", allowed: []enum.MergeMethod{merge, squash}, method: squash, exp: true},
+		{name: "not-allowed", allowed: []enum.MergeMethod{merge, squash}, method: fastForward, exp: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := Repository{PullReqAllowedMergeMethods: test.allowed}
+			if got := repo.IsMergeMethodAllowed(test.method); got != test.exp {
+				t.Errorf("expected %t for merge method %s, got %t", test.exp, test.method, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"github.com/harness/gitness/types/enum"
+)
+
+// Rule represents a branch protection rule.
+type Rule struct {
+	ID         int64           `json:"id"`
+	Version    int64           `json:"version"`
+	ParentID   int64           `json:"parent_id"`
+	ParentType enum.RuleParent `json:"parent_type"`
+	CreatedBy  int64           `json:"created_by"`
+	Created    int64           `json:"created"`
+	Updated    int64           `json:"updated"`
+
+	UID         string         `json:"uid"`
+	Description string         `json:"description"`
+	Pattern     string         `json:"pattern"`
+	Definition  RuleDefinition `json:"definition"`
+}
+
+// RuleDefinition contains the restrictions enforced by a branch protection rule.
+type RuleDefinition struct {
+	// BlockForcePush blocks updates of a branch that aren't fast-forward.
+	BlockForcePush bool `json:"block_force_push"`
+
+	// BlockDeletion blocks the deletion of a branch.
+	BlockDeletion bool `json:"block_deletion"`
+
+	// BlockDirectPush blocks any push creating or updating a branch - changes have to be merged via pull requests.
+	BlockDirectPush bool `json:"block_direct_push"`
+
+	// RequireLinearHistory blocks merge commits from being pushed or created via pull request merges.
+	RequireLinearHistory bool `json:"require_linear_history"`
+
+	// RequireSignedCommits blocks pushes containing commits without a signature.
+	// Pull requests can only be merged by fast-forward, as the server doesn't sign the commits it creates.
+	RequireSignedCommits bool `json:"require_signed_commits"`
+
+	// BypassIDs contains the ids of the principals that are allowed to bypass the rule.
+	BypassIDs []int64 `json:"bypass_ids"`
+}
+
+// RuleFilter stores protection rule query parameters for listing.
+type RuleFilter struct {
+	Query string        `json:"query"`
+	Page  int           `json:"page"`
+	Size  int           `json:"size"`
+	Sort  enum.RuleAttr `json:"sort"`
+	Order enum.Order    `json:"order"`
+}
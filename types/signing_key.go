@@ -0,0 +1,45 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/harness/gitness/types/enum"
+
+// SigningKey represents a gpg or ssh public key of a principal used to verify commit and tag signatures.
+type SigningKey struct {
+	ID          int64               `db:"signing_key_id"           json:"-"`
+	PrincipalID int64               `db:"signing_key_principal_id" json:"-"`
+	Created     int64               `db:"signing_key_created"      json:"created"`
+	UID         string              `db:"signing_key_uid"          json:"uid"`
+	Type        enum.SigningKeyType `db:"signing_key_type"         json:"type"`
+	Fingerprint string              `db:"signing_key_fingerprint"  json:"fingerprint"`
+	Content     string              `db:"signing_key_content"      json:"content"`
+}
+
+// ObjectSignature contains the cryptographic signature of a commit or tag together with the signed payload.
+type ObjectSignature struct {
+	Signature []byte
+	Payload   []byte
+}
+
+// SignatureVerification contains the result of the verification of a commit or tag signature.
+type SignatureVerification struct {
+	Status enum.SignatureVerificationStatus `json:"status"`
+	// Signer is the principal owning the key the object was signed with (only set if verified).
+	Signer *PrincipalInfo `json:"signer,omitempty"`
+	// KeyType is the type of the key the object was signed with (empty if not signed).
+	KeyType enum.SigningKeyType `json:"key_type,omitempty"`
+	// KeyFingerprint identifies the key the object was signed with (for unknown gpg keys the key id).
+	KeyFingerprint string `json:"key_fingerprint,omitempty"`
+}
@@ -30,6 +30,43 @@ type Token struct {
 	// IssuedAt is the unix time at which the token was issued.
 	IssuedAt  int64 `db:"token_issued_at"          json:"issued_at"`
 	CreatedBy int64 `db:"token_created_by"         json:"created_by"`
+
+	TokenRestrictions
+}
+
+// TokenRestrictions limit the access of a token to a subset of the permissions of its principal.
+// A token without restrictions has all permissions of its principal.
+type TokenRestrictions struct {
+	// Scopes restrict the permissions of the token to the union of the permissions granted by the scopes.
+	Scopes []enum.TokenScope `json:"scopes,omitempty"`
+	// SpaceIDs and RepoIDs restrict the token to the spaces (including their subspaces) and repositories.
+	SpaceIDs []int64 `json:"space_ids,omitempty"`
+	RepoIDs  []int64 `json:"repo_ids,omitempty"`
+}
+
+// IsRestricted returns true in case the token doesn't have all permissions of its principal.
+func (r *TokenRestrictions) IsRestricted() bool {
+	return len(r.Scopes) > 0 || r.IsResourceRestricted()
+}
+
+// IsResourceRestricted returns true in case the token is restricted to a list of spaces or repositories.
+func (r *TokenRestrictions) IsResourceRestricted() bool {
+	return len(r.SpaceIDs) > 0 || len(r.RepoIDs) > 0
+}
+
+// Grants returns true in case the scopes of the token grant the permission.
+func (r *TokenRestrictions) Grants(permission enum.Permission) bool {
+	if len(r.Scopes) == 0 {
+		return true
+	}
+
+	for _, scope := range r.Scopes {
+		if scope.Grants(permission) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // TokenResponse is returned as part of token creation for PAT / SAT / User Session.
@@ -0,0 +1,83 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+type (
+	// TOTP contains the time-based one-time password (two-factor authentication) configuration of a user.
+	TOTP struct {
+		PrincipalID int64
+		Created     int64
+		Updated     int64
+		Version     int64
+
+		// Secret is the encrypted shared secret of the authenticator app.
+		Secret []byte
+
+		// Enabled is set once the user verified the enrollment with a first one-time password.
+		Enabled bool
+
+		// RecoveryCodes contains the hashes of the unused recovery codes.
+		RecoveryCodes []string
+
+		// LastUsedStep is the time step of the last accepted one-time password, used to prevent replay.
+		LastUsedStep int64
+
+		// Challenge is the nonce of the pending login challenge, empty if there is none.
+		Challenge string
+
+		// FailedAttempts is the number of invalid one-time passwords since the last successful login.
+		FailedAttempts int
+
+		// LockedUntil is the time (in unix milliseconds) until which no login challenges are accepted.
+		LockedUntil int64
+	}
+
+	// TOTPStatus describes the two-factor authentication state of a user.
+	TOTPStatus struct {
+		Enabled           bool `json:"enabled"`
+		Required          bool `json:"required"`
+		RecoveryCodesLeft int  `json:"recovery_codes_left"`
+	}
+
+	// TOTPEnrollment contains the details required to add a user account to an authenticator app.
+	TOTPEnrollment struct {
+		Secret string `json:"secret"`
+		URI    string `json:"uri"`
+		// QRCode is the URI as QR code png image in data url format.
+		QRCode string `json:"qr_code"`
+	}
+
+	// TOTPRecoveryCodes contains newly generated recovery codes. They are only shown once.
+	TOTPRecoveryCodes struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+
+	// TwoFactorChallenge is returned by login in case a one-time password is required to get the session token.
+	TwoFactorChallenge struct {
+		Token     string `json:"token"`
+		ExpiresAt int64  `json:"expires_at"`
+		// Enrollment is set in case two-factor authentication is required, but the user didn't enroll yet.
+		Enrollment *TOTPEnrollment `json:"enrollment,omitempty"`
+	}
+
+	// LoginResponse is returned by login. It either contains the session token,
+	// or the challenge that has to be completed with a one-time password.
+	LoginResponse struct {
+		*TokenResponse
+		TwoFactorChallenge *TwoFactorChallenge `json:"two_factor_challenge,omitempty"`
+		// RecoveryCodes are set in case the user enrolled in two-factor authentication during login.
+		RecoveryCodes []string `json:"recovery_codes,omitempty"`
+	}
+)
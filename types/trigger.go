@@ -26,6 +26,9 @@ type Trigger struct {
 	CreatedBy   int64                `json:"created_by"`
 	Disabled    bool                 `json:"disabled"`
 	Actions     []enum.TriggerAction `json:"actions"`
+	Cron        string               `json:"cron"`
+	Branch      string               `json:"branch"`
+	Timezone    string               `json:"timezone"`
 	UID         string               `json:"uid"`
 	Created     int64                `json:"created"`
 	Updated     int64                `json:"updated"`
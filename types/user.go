@@ -35,6 +35,10 @@ type (
 
 		// User specific fields
 		Password string `db:"principal_user_password"    json:"-"`
+
+		// Source and ExternalID link the user to the identity it was provisioned from (e.g. the directory uid).
+		Source     enum.UserSource `db:"principal_user_source"      json:"-"`
+		ExternalID string          `db:"principal_user_external_id" json:"-"`
 	}
 
 	// UserInput store user account details used to
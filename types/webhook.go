@@ -37,6 +37,7 @@ type Webhook struct {
 	Secret                string                       `json:"-"`
 	Enabled               bool                         `json:"enabled"`
 	Insecure              bool                         `json:"insecure"`
+	Format                enum.WebhookFormat           `json:"format"`
 	Triggers              []enum.WebhookTrigger        `json:"triggers"`
 	LatestExecutionResult *enum.WebhookExecutionResult `json:"latest_execution_result,omitempty"`
 }